@@ -72,7 +72,8 @@ func main() {
 	}()
 
 	// Инициализация gRPC сервера
-	server := grpc.NewAuthServer(authUC, tokenService, log)
+	server := grpc.NewAuthServer(authUC, tokenService, log,
+		grpc.WithServiceToken(viper.GetString("auth.service_token")))
 
 	s := ggrpc.NewServer()
 	pb.RegisterAuthServiceServer(s, server)
@@ -2,7 +2,12 @@ package grpc
 
 import (
 	"context"
+	"crypto/subtle"
 	stdErrors "errors"
+	"strings"
+	"time"
+
+	"github.com/netabakovv/forum/back/auth_service/internal/entities"
 	"github.com/netabakovv/forum/back/auth_service/internal/service"
 	"github.com/netabakovv/forum/back/auth_service/internal/usecase"
 	"github.com/netabakovv/forum/back/pkg/errors"
@@ -10,22 +15,42 @@ import (
 	pb "github.com/netabakovv/forum/back/proto"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// AuthorizationKey — ключ метаданных gRPC с токеном доступа вызывающего в формате "Bearer <token>"
+const AuthorizationKey = "authorization"
+
 type AuthServer struct {
 	pb.UnimplementedAuthServiceServer
 	authUC       usecase.AuthUsecaseInterface
 	tokenService service.TokenServiceInterface
 	logger       logger.Logger
+	serviceToken string
 }
 
-func NewAuthServer(authUC usecase.AuthUsecaseInterface, tokenService service.TokenServiceInterface, logger logger.Logger) *AuthServer {
-	return &AuthServer{
+// Option подключает к серверу необязательные настройки
+type Option func(*AuthServer)
+
+// WithServiceToken задаёт токен, которым другие сервисы подтверждают вызовы
+// внутренних методов (ListUserEvents). Без него эти методы недоступны.
+func WithServiceToken(token string) Option {
+	return func(s *AuthServer) {
+		s.serviceToken = token
+	}
+}
+
+func NewAuthServer(authUC usecase.AuthUsecaseInterface, tokenService service.TokenServiceInterface, logger logger.Logger, opts ...Option) *AuthServer {
+	s := &AuthServer{
 		authUC:       authUC,
 		tokenService: tokenService,
 		logger:       logger,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Register создает нового пользователя и возвращает токены доступа
@@ -173,14 +198,31 @@ func (s *AuthServer) ValidateToken(ctx context.Context, req *pb.ValidateRequest)
 			status.Error(codes.Internal, "internal error")
 	}
 
+	// Имя и права берутся из базы, а не из токена: после смены имени токен
+	// ещё живёт со старым, и с ним записи получали бы устаревшее имя автора
+	user, err := s.authUC.GetUser(ctx, claims.UserID)
+	if stdErrors.Is(err, errors.ErrUserNotFound) {
+		s.logger.Warn("token of missing user", logger.NewField("user_id", claims.UserID))
+		return &pb.ValidateResponse{IsValid: false},
+			status.Error(codes.Unauthenticated, "user not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get token user",
+			logger.NewField("error", err),
+			logger.NewField("user_id", claims.UserID),
+		)
+		return &pb.ValidateResponse{IsValid: false},
+			status.Error(codes.Internal, "internal error")
+	}
+
 	s.logger.Info("token validated successfully",
 		logger.NewField("user_id", claims.UserID),
 	)
 
 	return &pb.ValidateResponse{
-		UserId:   claims.UserID, // конвертируем int64 в string
-		Username: claims.Username,
-		IsAdmin:  claims.IsAdmin,
+		UserId:   user.ID,
+		Username: user.Username,
+		IsAdmin:  user.IsAdmin,
 		IsValid:  true,
 	}, nil
 }
@@ -229,6 +271,27 @@ func (s *AuthServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.Log
 	}, nil
 }
 
+// LookupUsers находит пользователей по именам одним запросом
+func (s *AuthServer) LookupUsers(ctx context.Context, req *pb.LookupUsersRequest) (*pb.LookupUsersResponse, error) {
+	users, err := s.authUC.LookupUsers(ctx, req.Usernames)
+	if stdErrors.Is(err, errors.ErrTooManyUsernames) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		s.logger.Error("failed to lookup users",
+			logger.NewField("error", err),
+			logger.NewField("count", len(req.Usernames)),
+		)
+		return nil, status.Error(codes.Internal, "failed to lookup users")
+	}
+
+	resp := &pb.LookupUsersResponse{Users: make([]*pb.UserSummary, len(users))}
+	for i, user := range users {
+		resp.Users[i] = &pb.UserSummary{UserId: user.ID, Username: user.Username}
+	}
+	return resp, nil
+}
+
 func (s *AuthServer) CheckAdminStatus(ctx context.Context, req *pb.CheckAdminRequest) (*pb.CheckAdminResponse, error) {
 	if req.UserId == 0 {
 		s.logger.Warn("empty user id provided")
@@ -252,3 +315,209 @@ func (s *AuthServer) CheckAdminStatus(ctx context.Context, req *pb.CheckAdminReq
 		IsAdmin: isAdmin,
 	}, nil
 }
+
+// GetUserByID возвращает профиль пользователя. Счётчики постов и комментариев
+// ведёт forum_service, здесь они не заполняются.
+func (s *AuthServer) GetUserByID(ctx context.Context, req *pb.GetUserRequest) (*pb.UserProfileResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	user, err := s.authUC.GetUser(ctx, req.UserId)
+	if stdErrors.Is(err, errors.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get user",
+			logger.NewField("error", err),
+			logger.NewField("user_id", req.UserId),
+		)
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+
+	return &pb.UserProfileResponse{
+		UserId:    user.ID,
+		Username:  user.Username,
+		CreatedAt: user.CreatedAt.Unix(),
+		IsAdmin:   user.IsAdmin,
+	}, nil
+}
+
+// ChangeUsername меняет имя владельца access_token и возвращает токены с новым именем
+func (s *AuthServer) ChangeUsername(ctx context.Context, req *pb.ChangeUsernameRequest) (*pb.ChangeUsernameResponse, error) {
+	if req.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "access token is required")
+	}
+	claims, err := s.tokenService.ValidateToken(req.AccessToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	tokens, err := s.authUC.ChangeUsername(ctx, claims.UserID, req.Username)
+	switch {
+	case err == nil:
+	case stdErrors.Is(err, errors.ErrDuplicateUsername):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case stdErrors.Is(err, errors.ErrUserNotFound):
+		return nil, status.Error(codes.NotFound, "user not found")
+	case stdErrors.Is(err, errors.ErrEmptyUsername), stdErrors.Is(err, errors.ErrUsernameTooShort),
+		stdErrors.Is(err, errors.ErrUsernameTooLong), stdErrors.Is(err, errors.ErrInvalidUsername):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		s.logger.Error("failed to change username",
+			logger.NewField("error", err),
+			logger.NewField("user_id", claims.UserID),
+		)
+		return nil, status.Error(codes.Internal, "failed to change username")
+	}
+
+	return &pb.ChangeUsernameResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt.Unix(),
+		Username:     req.Username,
+	}, nil
+}
+
+// ListUserEvents отдаёт журнал событий пользователей; его читает forum_service
+// со служебным токеном
+func (s *AuthServer) ListUserEvents(ctx context.Context, req *pb.ListUserEventsRequest) (*pb.ListUserEventsResponse, error) {
+	if err := s.requireService(ctx); err != nil {
+		return nil, err
+	}
+
+	events, err := s.authUC.UserEvents(ctx, req.AfterId, int(req.Limit))
+	if err != nil {
+		s.logger.Error("failed to list user events",
+			logger.NewField("error", err),
+			logger.NewField("after_id", req.AfterId),
+		)
+		return nil, status.Error(codes.Internal, "failed to list user events")
+	}
+
+	resp := &pb.ListUserEventsResponse{Events: make([]*pb.UserEvent, len(events))}
+	for i, event := range events {
+		resp.Events[i] = &pb.UserEvent{
+			Id:        event.ID,
+			UserId:    event.UserID,
+			Type:      event.Type,
+			Username:  event.Username,
+			CreatedAt: event.CreatedAt.Unix(),
+		}
+	}
+	return resp, nil
+}
+
+// bearerToken достаёт токен вызывающего из метаданных, префикс "Bearer " необязателен
+func bearerToken(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(AuthorizationKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(values[0], "Bearer "))
+}
+
+// requireService пропускает только вызовы со служебным токеном в метаданных
+func (s *AuthServer) requireService(ctx context.Context) error {
+	token := bearerToken(ctx)
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "service token is required")
+	}
+	if s.serviceToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.serviceToken)) != 1 {
+		return status.Error(codes.PermissionDenied, "invalid service token")
+	}
+	return nil
+}
+
+// requireAdmin пропускает только вызовы с токеном администратора в метаданных.
+// Права проверяются по базе, а не по токену: их могли отозвать после выдачи.
+func (s *AuthServer) requireAdmin(ctx context.Context) error {
+	token := bearerToken(ctx)
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "access token is required")
+	}
+	claims, err := s.tokenService.ValidateToken(token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	isAdmin, err := s.authUC.IsAdmin(ctx, claims.UserID)
+	if err != nil {
+		s.logger.Error("failed to check admin status",
+			logger.NewField("error", err),
+			logger.NewField("user_id", claims.UserID),
+		)
+		return status.Error(codes.Internal, "failed to check admin status")
+	}
+	if !isAdmin {
+		return status.Error(codes.PermissionDenied, "admin rights required")
+	}
+	return nil
+}
+
+// ExportUsers отдаёт страницу пользователей для архива форума
+func (s *AuthServer) ExportUsers(ctx context.Context, req *pb.ExportUsersRequest) (*pb.ExportUsersResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	users, err := s.authUC.ExportUsers(ctx, req.AfterId, int(req.Limit), req.WithPasswords)
+	if err != nil {
+		s.logger.Error("failed to export users",
+			logger.NewField("error", err),
+			logger.NewField("after_id", req.AfterId),
+		)
+		return nil, status.Error(codes.Internal, "failed to export users")
+	}
+
+	resp := &pb.ExportUsersResponse{Users: make([]*pb.ExportedUser, len(users))}
+	for i, user := range users {
+		resp.Users[i] = &pb.ExportedUser{
+			UserId:       user.ID,
+			Username:     user.Username,
+			PasswordHash: user.PasswordHash,
+			CreatedAt:    user.CreatedAt.Unix(),
+		}
+	}
+	return resp, nil
+}
+
+// ImportUser создаёт пользователя из архива форума
+func (s *AuthServer) ImportUser(ctx context.Context, req *pb.ImportUserRequest) (*pb.ImportUserResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	user := &entities.User{
+		Username:     req.Username,
+		PasswordHash: req.PasswordHash,
+		CreatedAt:    time.Unix(req.CreatedAt, 0).UTC(),
+	}
+	id, result, err := s.authUC.ImportUser(ctx, user, req.MergeExisting)
+	switch {
+	case err == nil:
+	case stdErrors.Is(err, errors.ErrDuplicateUsername):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case stdErrors.Is(err, errors.ErrEmptyUsername), stdErrors.Is(err, errors.ErrUsernameTooShort),
+		stdErrors.Is(err, errors.ErrUsernameTooLong), stdErrors.Is(err, errors.ErrInvalidUsername):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		s.logger.Error("failed to import user",
+			logger.NewField("error", err),
+			logger.NewField("username", req.Username),
+		)
+		return nil, status.Error(codes.Internal, "failed to import user")
+	}
+
+	resp := &pb.ImportUserResponse{UserId: id}
+	switch result {
+	case usecase.ImportCreated:
+		resp.Result = pb.ImportUserResult_IMPORT_USER_CREATED
+	case usecase.ImportExisting:
+		resp.Result = pb.ImportUserResult_IMPORT_USER_EXISTS
+	case usecase.ImportMerged:
+		resp.Result = pb.ImportUserResult_IMPORT_USER_MERGED
+	}
+	return resp, nil
+}
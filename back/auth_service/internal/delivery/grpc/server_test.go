@@ -7,11 +7,13 @@ import (
 
 	"github.com/netabakovv/forum/back/auth_service/internal/delivery/grpc"
 	"github.com/netabakovv/forum/back/auth_service/internal/entities"
+	"github.com/netabakovv/forum/back/auth_service/internal/usecase"
 	"github.com/netabakovv/forum/back/pkg/errors"
 	"github.com/netabakovv/forum/back/pkg/logger"
 	pb "github.com/netabakovv/forum/back/proto"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -24,6 +26,12 @@ type mockAuthUsecase struct {
 	isAdminFunc       func(ctx context.Context, userID int64) (bool, error)
 	logoutFunc        func(ctx context.Context, refreshToken string) error
 	validateTokenFunc func(ctx context.Context, token string) (*entities.TokenClaims, error)
+	lookupUsersFunc   func(ctx context.Context, usernames []string) ([]*entities.User, error)
+	getUserFunc       func(ctx context.Context, userID int64) (*entities.User, error)
+	changeUsernameFn  func(ctx context.Context, userID int64, username string) (*entities.TokenPair, error)
+	userEventsFunc    func(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error)
+	exportUsersFunc   func(ctx context.Context, afterID int64, limit int, withPasswords bool) ([]*entities.User, error)
+	importUserFunc    func(ctx context.Context, user *entities.User, mergeExisting bool) (int64, usecase.ImportResult, error)
 }
 
 func (m *mockAuthUsecase) Register(ctx context.Context, username, password string) (*entities.TokenPair, error) {
@@ -61,6 +69,13 @@ func (m *mockAuthUsecase) IsAdmin(ctx context.Context, userID int64) (bool, erro
 	return false, nil
 }
 
+func (m *mockAuthUsecase) LookupUsers(ctx context.Context, usernames []string) ([]*entities.User, error) {
+	if m.lookupUsersFunc != nil {
+		return m.lookupUsersFunc(ctx, usernames)
+	}
+	return nil, nil
+}
+
 func (m *mockAuthUsecase) Logout(ctx context.Context, refreshToken string) error {
 	if m.logoutFunc != nil {
 		return m.logoutFunc(ctx, refreshToken)
@@ -75,6 +90,41 @@ func (m *mockAuthUsecase) ValidateToken(ctx context.Context, token string) (*ent
 	return nil, nil
 }
 
+func (m *mockAuthUsecase) GetUser(ctx context.Context, userID int64) (*entities.User, error) {
+	if m.getUserFunc != nil {
+		return m.getUserFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockAuthUsecase) ChangeUsername(ctx context.Context, userID int64, username string) (*entities.TokenPair, error) {
+	if m.changeUsernameFn != nil {
+		return m.changeUsernameFn(ctx, userID, username)
+	}
+	return nil, nil
+}
+
+func (m *mockAuthUsecase) UserEvents(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error) {
+	if m.userEventsFunc != nil {
+		return m.userEventsFunc(ctx, afterID, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockAuthUsecase) ExportUsers(ctx context.Context, afterID int64, limit int, withPasswords bool) ([]*entities.User, error) {
+	if m.exportUsersFunc != nil {
+		return m.exportUsersFunc(ctx, afterID, limit, withPasswords)
+	}
+	return nil, nil
+}
+
+func (m *mockAuthUsecase) ImportUser(ctx context.Context, user *entities.User, mergeExisting bool) (int64, usecase.ImportResult, error) {
+	if m.importUserFunc != nil {
+		return m.importUserFunc(ctx, user, mergeExisting)
+	}
+	return 0, 0, nil
+}
+
 type mockTokenService struct {
 	generateTokenPairFunc func(userID int64, username string, isAdmin bool) (*entities.TokenPair, error)
 	validateTokenFunc     func(tokenString string) (*entities.TokenClaims, error)
@@ -394,6 +444,7 @@ func TestAuthServer_ValidateToken(t *testing.T) {
 		mockSetup     func(*mockTokenService)
 		expectedError codes.Code
 		expectedValid bool
+		expectedName  string
 	}{
 		{
 			name: "валидный токен",
@@ -410,6 +461,32 @@ func TestAuthServer_ValidateToken(t *testing.T) {
 				}
 			},
 			expectedValid: true,
+			expectedName:  "testuser",
+		},
+		{
+			name: "имя сменилось после выдачи токена",
+			request: &pb.ValidateRequest{
+				AccessToken: "old_name_token",
+			},
+			mockSetup: func(m *mockTokenService) {
+				m.validateTokenFunc = func(token string) (*entities.TokenClaims, error) {
+					return &entities.TokenClaims{UserID: 3, Username: "oldname"}, nil
+				}
+			},
+			expectedValid: true,
+			expectedName:  "newname",
+		},
+		{
+			name: "пользователь удалён",
+			request: &pb.ValidateRequest{
+				AccessToken: "deleted_user_token",
+			},
+			mockSetup: func(m *mockTokenService) {
+				m.validateTokenFunc = func(token string) (*entities.TokenClaims, error) {
+					return &entities.TokenClaims{UserID: 2, Username: "ghost"}, nil
+				}
+			},
+			expectedError: codes.Unauthenticated,
 		},
 		{
 			name: "пустой токен",
@@ -451,7 +528,18 @@ func TestAuthServer_ValidateToken(t *testing.T) {
 				tt.mockSetup(mockTS)
 			}
 
-			server := grpc.NewAuthServer(&mockAuthUsecase{}, mockTS, &mockLogger{})
+			mockUC := &mockAuthUsecase{
+				getUserFunc: func(ctx context.Context, userID int64) (*entities.User, error) {
+					switch userID {
+					case 1:
+						return &entities.User{ID: 1, Username: "testuser"}, nil
+					case 3:
+						return &entities.User{ID: 3, Username: "newname"}, nil
+					}
+					return nil, errors.ErrUserNotFound
+				},
+			}
+			server := grpc.NewAuthServer(mockUC, mockTS, &mockLogger{})
 
 			resp, err := server.ValidateToken(context.Background(), tt.request)
 
@@ -474,6 +562,9 @@ func TestAuthServer_ValidateToken(t *testing.T) {
 			if resp.IsValid != tt.expectedValid {
 				t.Errorf("ожидался IsValid=%v, получили %v", tt.expectedValid, resp.IsValid)
 			}
+			if resp.Username != tt.expectedName {
+				t.Errorf("ожидалось имя %q, получили %q", tt.expectedName, resp.Username)
+			}
 		})
 	}
 }
@@ -569,6 +660,31 @@ func TestAuthServer_Logout(t *testing.T) {
 }
 
 // Тесты для CheckAdminStatus
+func TestAuthServer_LookupUsers(t *testing.T) {
+	mockUC := &mockAuthUsecase{
+		lookupUsersFunc: func(ctx context.Context, usernames []string) ([]*entities.User, error) {
+			if len(usernames) > 2 {
+				return nil, errors.ErrTooManyUsernames
+			}
+			return []*entities.User{{ID: 1, Username: "alice"}}, nil
+		},
+	}
+	server := grpc.NewAuthServer(mockUC, &mockTokenService{}, &mockLogger{})
+
+	resp, err := server.LookupUsers(context.Background(), &pb.LookupUsersRequest{Usernames: []string{"alice", "ghost"}})
+	if err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+	if len(resp.Users) != 1 || resp.Users[0].UserId != 1 || resp.Users[0].Username != "alice" {
+		t.Errorf("ожидался только alice, получили %v", resp.Users)
+	}
+
+	_, err = server.LookupUsers(context.Background(), &pb.LookupUsersRequest{Usernames: []string{"a", "b", "c"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ожидался код %v, получили %v", codes.InvalidArgument, status.Code(err))
+	}
+}
+
 func TestAuthServer_CheckAdminStatus(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -655,3 +771,210 @@ func TestAuthServer_CheckAdminStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthServer_GetUserByID(t *testing.T) {
+	mockUC := &mockAuthUsecase{
+		getUserFunc: func(ctx context.Context, userID int64) (*entities.User, error) {
+			if userID == 1 {
+				return &entities.User{ID: 1, Username: "alice", IsAdmin: true}, nil
+			}
+			return nil, errors.ErrUserNotFound
+		},
+	}
+	server := grpc.NewAuthServer(mockUC, &mockTokenService{}, &mockLogger{})
+
+	resp, err := server.GetUserByID(context.Background(), &pb.GetUserRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+	if resp.Username != "alice" || !resp.IsAdmin {
+		t.Errorf("неожиданный профиль: %+v", resp)
+	}
+
+	_, err = server.GetUserByID(context.Background(), &pb.GetUserRequest{UserId: 2})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("ожидался NotFound, получили %v", err)
+	}
+}
+
+func TestAuthServer_ChangeUsername(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *pb.ChangeUsernameRequest
+		ucErr         error
+		expectedError codes.Code
+	}{
+		{name: "успешная смена", request: &pb.ChangeUsernameRequest{AccessToken: "valid_token", Username: "alice2"}},
+		{name: "пустой токен", request: &pb.ChangeUsernameRequest{Username: "alice2"}, expectedError: codes.InvalidArgument},
+		{name: "имя занято", request: &pb.ChangeUsernameRequest{AccessToken: "valid_token", Username: "bob"},
+			ucErr: errors.ErrDuplicateUsername, expectedError: codes.AlreadyExists},
+		{name: "некорректное имя", request: &pb.ChangeUsernameRequest{AccessToken: "valid_token", Username: "a b"},
+			ucErr: errors.ErrInvalidUsername, expectedError: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTS := &mockTokenService{
+				validateTokenFunc: func(token string) (*entities.TokenClaims, error) {
+					return &entities.TokenClaims{UserID: 1, Username: "alice"}, nil
+				},
+			}
+			mockUC := &mockAuthUsecase{
+				changeUsernameFn: func(ctx context.Context, userID int64, username string) (*entities.TokenPair, error) {
+					if tt.ucErr != nil {
+						return nil, tt.ucErr
+					}
+					return &entities.TokenPair{AccessToken: "new_access", RefreshToken: "new_refresh", ExpiresAt: time.Now()}, nil
+				},
+			}
+			server := grpc.NewAuthServer(mockUC, mockTS, &mockLogger{})
+
+			resp, err := server.ChangeUsername(context.Background(), tt.request)
+			if status.Code(err) != tt.expectedError {
+				t.Fatalf("ожидался код ошибки %v, получили %v", tt.expectedError, err)
+			}
+			if err == nil && (resp.AccessToken != "new_access" || resp.Username != tt.request.Username) {
+				t.Errorf("неожиданный ответ: %+v", resp)
+			}
+		})
+	}
+}
+
+// withToken — контекст входящего вызова с токеном доступа в метаданных
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpc.AuthorizationKey, "Bearer "+token))
+}
+
+// adminTokens — токены "admin" (пользователь 1, администратор) и "user" (пользователь 2)
+func adminTokens() (*mockTokenService, func(ctx context.Context, userID int64) (bool, error)) {
+	ts := &mockTokenService{
+		validateTokenFunc: func(token string) (*entities.TokenClaims, error) {
+			switch token {
+			case "admin":
+				return &entities.TokenClaims{UserID: 1}, nil
+			case "user":
+				return &entities.TokenClaims{UserID: 2}, nil
+			}
+			return nil, errors.ErrTokenInvalid
+		},
+	}
+	isAdmin := func(ctx context.Context, userID int64) (bool, error) {
+		return userID == 1, nil
+	}
+	return ts, isAdmin
+}
+
+func TestAuthServer_ExportUsers(t *testing.T) {
+	mockTS, isAdmin := adminTokens()
+	mockUC := &mockAuthUsecase{
+		isAdminFunc: isAdmin,
+		exportUsersFunc: func(ctx context.Context, afterID int64, limit int, withPasswords bool) ([]*entities.User, error) {
+			if afterID != 10 || limit != 50 || !withPasswords {
+				t.Errorf("неожиданные параметры: %d %d %v", afterID, limit, withPasswords)
+			}
+			return []*entities.User{{ID: 11, Username: "alice", PasswordHash: "hash", CreatedAt: time.Unix(1700000000, 0)}}, nil
+		},
+	}
+	server := grpc.NewAuthServer(mockUC, mockTS, &mockLogger{})
+	req := &pb.ExportUsersRequest{AfterId: 10, Limit: 50, WithPasswords: true}
+
+	resp, err := server.ExportUsers(withToken("admin"), req)
+	if err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+	if len(resp.Users) != 1 || resp.Users[0].Username != "alice" || resp.Users[0].CreatedAt != 1700000000 {
+		t.Errorf("неожиданный ответ: %+v", resp)
+	}
+
+	denied := map[string]struct {
+		ctx  context.Context
+		code codes.Code
+	}{
+		"без токена":       {context.Background(), codes.Unauthenticated},
+		"недействительный": {withToken("bad"), codes.Unauthenticated},
+		"не администратор": {withToken("user"), codes.PermissionDenied},
+	}
+	for name, tt := range denied {
+		t.Run(name, func(t *testing.T) {
+			_, err := server.ExportUsers(tt.ctx, req)
+			if status.Code(err) != tt.code {
+				t.Errorf("ожидался %v, получили %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestAuthServer_ImportUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		result   usecase.ImportResult
+		ucErr    error
+		code     codes.Code
+		expected pb.ImportUserResult
+	}{
+		{name: "создан", ctx: withToken("admin"), result: usecase.ImportCreated, expected: pb.ImportUserResult_IMPORT_USER_CREATED},
+		{name: "уже загружен", ctx: withToken("admin"), result: usecase.ImportExisting, expected: pb.ImportUserResult_IMPORT_USER_EXISTS},
+		{name: "привязан к существующему", ctx: withToken("admin"), result: usecase.ImportMerged, expected: pb.ImportUserResult_IMPORT_USER_MERGED},
+		{name: "имя занято", ctx: withToken("admin"), ucErr: errors.ErrDuplicateUsername, code: codes.AlreadyExists},
+		{name: "некорректное имя", ctx: withToken("admin"), ucErr: errors.ErrInvalidUsername, code: codes.InvalidArgument},
+		{name: "не администратор", ctx: withToken("user"), code: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTS, isAdmin := adminTokens()
+			mockUC := &mockAuthUsecase{
+				isAdminFunc: isAdmin,
+				importUserFunc: func(ctx context.Context, user *entities.User, mergeExisting bool) (int64, usecase.ImportResult, error) {
+					if user.Username != "alice" || user.CreatedAt.Unix() != 1700000000 || !mergeExisting {
+						t.Errorf("неожиданные параметры: %+v %v", user, mergeExisting)
+					}
+					if tt.ucErr != nil {
+						return 0, 0, tt.ucErr
+					}
+					return 42, tt.result, nil
+				},
+			}
+			server := grpc.NewAuthServer(mockUC, mockTS, &mockLogger{})
+
+			resp, err := server.ImportUser(tt.ctx, &pb.ImportUserRequest{Username: "alice", CreatedAt: 1700000000, MergeExisting: true})
+			if status.Code(err) != tt.code {
+				t.Fatalf("ожидался код ошибки %v, получили %v", tt.code, err)
+			}
+			if err == nil && (resp.UserId != 42 || resp.Result != tt.expected) {
+				t.Errorf("неожиданный ответ: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestAuthServer_ListUserEvents(t *testing.T) {
+	mockUC := &mockAuthUsecase{
+		userEventsFunc: func(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error) {
+			return []*entities.UserEvent{{ID: afterID + 1, UserID: 1, Type: "user.updated", Username: "alice"}}, nil
+		},
+	}
+	server := grpc.NewAuthServer(mockUC, &mockTokenService{}, &mockLogger{}, grpc.WithServiceToken("service"))
+
+	resp, err := server.ListUserEvents(withToken("service"), &pb.ListUserEventsRequest{AfterId: 4, Limit: 10})
+	if err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+	if len(resp.Events) != 1 || resp.Events[0].Id != 5 || resp.Events[0].Username != "alice" {
+		t.Errorf("неожиданный ответ: %+v", resp)
+	}
+
+	if _, err := server.ListUserEvents(context.Background(), &pb.ListUserEventsRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("без токена ожидался Unauthenticated, получили %v", err)
+	}
+	if _, err := server.ListUserEvents(withToken("other"), &pb.ListUserEventsRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("с чужим токеном ожидался PermissionDenied, получили %v", err)
+	}
+
+	// без настроенного служебного токена метод закрыт
+	server = grpc.NewAuthServer(mockUC, &mockTokenService{}, &mockLogger{})
+	if _, err := server.ListUserEvents(withToken(""), &pb.ListUserEventsRequest{}); status.Code(err) == codes.OK {
+		t.Error("ожидалась ошибка без настроенного служебного токена")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/netabakovv/forum/back/auth_service/internal/entities"
+	"github.com/netabakovv/forum/back/auth_service/internal/usecase"
 )
 
 // MockAuthUsecase мок для AuthUsecaseInterface
@@ -22,6 +23,12 @@ type MockAuthUsecase struct {
 	RevokeTokensFunc  func(ctx context.Context, userID int64) error
 	LogoutFunc        func(ctx context.Context, refreshToken string) error
 	ValidateTokenFunc func(ctx context.Context, token string) (*entities.TokenClaims, error)
+	LookupUsersFunc   func(ctx context.Context, usernames []string) ([]*entities.User, error)
+	GetUserFunc       func(ctx context.Context, userID int64) (*entities.User, error)
+	ChangeUsernameFn  func(ctx context.Context, userID int64, username string) (*entities.TokenPair, error)
+	UserEventsFunc    func(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error)
+	ExportUsersFunc   func(ctx context.Context, afterID int64, limit int, withPasswords bool) ([]*entities.User, error)
+	ImportUserFunc    func(ctx context.Context, user *entities.User, mergeExisting bool) (int64, usecase.ImportResult, error)
 }
 
 func (m *MockAuthUsecase) Register(ctx context.Context, username, password string) (*entities.TokenPair, error) {
@@ -52,6 +59,13 @@ func (m *MockAuthUsecase) IsAdmin(ctx context.Context, userID int64) (bool, erro
 	return false, nil
 }
 
+func (m *MockAuthUsecase) LookupUsers(ctx context.Context, usernames []string) ([]*entities.User, error) {
+	if m.LookupUsersFunc != nil {
+		return m.LookupUsersFunc(ctx, usernames)
+	}
+	return nil, nil
+}
+
 func (m *MockAuthUsecase) RevokeTokens(ctx context.Context, userID int64) error {
 	if m.RevokeTokensFunc != nil {
 		return m.RevokeTokensFunc(ctx, userID)
@@ -73,6 +87,41 @@ func (m *MockAuthUsecase) ValidateToken(ctx context.Context, token string) (*ent
 	return nil, nil
 }
 
+func (m *MockAuthUsecase) GetUser(ctx context.Context, userID int64) (*entities.User, error) {
+	if m.GetUserFunc != nil {
+		return m.GetUserFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockAuthUsecase) ChangeUsername(ctx context.Context, userID int64, username string) (*entities.TokenPair, error) {
+	if m.ChangeUsernameFn != nil {
+		return m.ChangeUsernameFn(ctx, userID, username)
+	}
+	return nil, nil
+}
+
+func (m *MockAuthUsecase) UserEvents(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error) {
+	if m.UserEventsFunc != nil {
+		return m.UserEventsFunc(ctx, afterID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockAuthUsecase) ExportUsers(ctx context.Context, afterID int64, limit int, withPasswords bool) ([]*entities.User, error) {
+	if m.ExportUsersFunc != nil {
+		return m.ExportUsersFunc(ctx, afterID, limit, withPasswords)
+	}
+	return nil, nil
+}
+
+func (m *MockAuthUsecase) ImportUser(ctx context.Context, user *entities.User, mergeExisting bool) (int64, usecase.ImportResult, error) {
+	if m.ImportUserFunc != nil {
+		return m.ImportUserFunc(ctx, user, mergeExisting)
+	}
+	return 0, 0, nil
+}
+
 func TestAuthHandler_Register(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -19,3 +19,15 @@ type SafeUser struct {
 	CreatedAt time.Time `json:"created_at"`
 	IsAdmin   bool      `json:"is_admin"`
 }
+
+// UserEventUpdated — у пользователя изменилось имя
+const UserEventUpdated = "user.updated"
+
+// UserEvent — запись журнала событий пользователей, который читают другие сервисы
+type UserEvent struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Type      string    `json:"type"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
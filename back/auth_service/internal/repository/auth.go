@@ -4,11 +4,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	stdErrors "errors"
 	"fmt"
 
 	"github.com/netabakovv/forum/back/auth_service/internal/entities"
 	"github.com/netabakovv/forum/back/pkg/errors"
 	"github.com/netabakovv/forum/back/pkg/logger"
+
+	"github.com/lib/pq"
 )
 
 // UserRepository определяет методы для работы с пользователями в БД
@@ -16,6 +19,16 @@ type UserRepository interface {
 	Create(ctx context.Context, user *entities.User) error
 	GetByID(ctx context.Context, id int64) (*entities.User, error)
 	GetByUsername(ctx context.Context, username string) (*entities.User, error)
+	GetByUsernames(ctx context.Context, usernames []string) ([]*entities.User, error)
+	// Users возвращает страницу пользователей вместе с хешами паролей по возрастанию ID
+	Users(ctx context.Context, afterID int64, limit int) ([]*entities.User, error)
+	// Import создаёт пользователя с датой регистрации и хешем пароля из user, например при переносе
+	// форума. Права администратора не переносятся: их выдают в этой базе.
+	Import(ctx context.Context, user *entities.User) error
+	// UpdateUsername меняет имя пользователя и в той же транзакции пишет событие user.updated
+	UpdateUsername(ctx context.Context, userID int64, username string) error
+	// Events возвращает до limit событий пользователей с ID больше afterID по возрастанию ID
+	Events(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error)
 }
 
 // TokenRepository определяет методы для работы с refresh токенами в БД
@@ -107,6 +120,138 @@ func (r *userRepo) GetByUsername(ctx context.Context, username string) (*entitie
 	return user, err
 }
 
+// GetByUsernames возвращает пользователей с указанными именами; неизвестные имена пропускаются
+func (r *userRepo) GetByUsernames(ctx context.Context, usernames []string) ([]*entities.User, error) {
+	query := `
+        SELECT id, username, created_at, is_admin
+        FROM users
+        WHERE username = ANY($1)`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(usernames))
+	if err != nil {
+		return nil, fmt.Errorf("get users by usernames: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*entities.User
+	for rows.Next() {
+		user := &entities.User{}
+		if err := rows.Scan(&user.ID, &user.Username, &user.CreatedAt, &user.IsAdmin); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+// Users возвращает до limit пользователей с ID больше afterID
+func (r *userRepo) Users(ctx context.Context, afterID int64, limit int) ([]*entities.User, error) {
+	query := `
+        SELECT id, username, password_hash, created_at, COALESCE(is_admin, FALSE)
+        FROM users
+        WHERE id > $1
+        ORDER BY id
+        LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*entities.User
+	for rows.Next() {
+		user := &entities.User{}
+		if err := rows.Scan(&user.ID, &user.Username, &user.PasswordHash, &user.CreatedAt, &user.IsAdmin); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+func (r *userRepo) Import(ctx context.Context, user *entities.User) error {
+	query := `
+        INSERT INTO users (username, password_hash, created_at, is_admin)
+        VALUES ($1, $2, $3, FALSE)
+        RETURNING id`
+	err := r.db.QueryRowContext(ctx, query,
+		user.Username, user.PasswordHash, user.CreatedAt,
+	).Scan(&user.ID)
+	if err != nil {
+		return fmt.Errorf("import user: %w", err)
+	}
+	return nil
+}
+
+// pgUniqueViolation — код ошибки PostgreSQL при нарушении уникальности
+const pgUniqueViolation = "23505"
+
+func (r *userRepo) UpdateUsername(ctx context.Context, userID int64, username string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE users SET username = $2 WHERE id = $1`, userID, username)
+	var pqErr *pq.Error
+	if stdErrors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation {
+		return errors.ErrDuplicateUsername
+	}
+	if err != nil {
+		return fmt.Errorf("update username: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.ErrUserNotFound
+	}
+
+	// Читатели журнала идут по возрастанию id и не должны пропустить событие,
+	// id которого выдан раньше, а закоммичено оно позже. Поэтому записи
+	// в журнал идут по одной: имена меняются редко.
+	if _, err := tx.ExecContext(ctx, `LOCK TABLE user_events IN EXCLUSIVE MODE`); err != nil {
+		return fmt.Errorf("lock user events: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+        INSERT INTO user_events (user_id, type, username)
+        VALUES ($1, $2, $3)`,
+		userID, entities.UserEventUpdated, username,
+	); err != nil {
+		return fmt.Errorf("insert user event: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	r.log.Info("username changed",
+		logger.NewField("user_id", userID),
+		logger.NewField("username", username),
+	)
+	return nil
+}
+
+func (r *userRepo) Events(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error) {
+	query := `
+        SELECT id, user_id, type, username, created_at
+        FROM user_events
+        WHERE id > $1
+        ORDER BY id
+        LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list user events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*entities.UserEvent
+	for rows.Next() {
+		event := &entities.UserEvent{}
+		if err := rows.Scan(&event.ID, &event.UserID, &event.Type, &event.Username, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan user event: %w", err)
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
 func (r *tokenRepo) Create(ctx context.Context, token *entities.RefreshToken) error {
 	query := `
         INSERT INTO refresh_tokens (user_id, token, expires_at, created_at)
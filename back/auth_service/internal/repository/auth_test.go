@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
 	"github.com/netabakovv/forum/back/auth_service/internal/entities"
 	"github.com/netabakovv/forum/back/auth_service/internal/repository"
+	"github.com/netabakovv/forum/back/pkg/errors"
 	"github.com/netabakovv/forum/back/pkg/logger/mocks"
 	"github.com/stretchr/testify/require"
 	"regexp"
@@ -148,6 +150,82 @@ func TestUserRepo_GetByUsername(t *testing.T) {
 	require.Equal(t, expected.Username, user.Username)
 }
 
+func TestUserRepo_GetByUsernames(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	repo := repository.NewUserRepository(db, mockLogger)
+
+	usernames := []string{"alice", "ghost"}
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE username = ANY($1)`)).
+		WithArgs(pq.Array(usernames)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "created_at", "is_admin"}).
+			AddRow(1, "alice", time.Now(), false))
+
+	users, err := repo.GetByUsernames(context.Background(), usernames)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, int64(1), users[0].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepo_Users(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	repo := repository.NewUserRepository(db, mockLogger)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE id > $1 ORDER BY id LIMIT $2`)).
+		WithArgs(int64(10), 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "password_hash", "created_at", "is_admin"}).
+			AddRow(11, "alice", "hash1", time.Now(), true).
+			AddRow(12, "bob", "hash2", time.Now(), false))
+
+	users, err := repo.Users(context.Background(), 10, 2)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	require.Equal(t, "hash1", users[0].PasswordHash)
+	require.True(t, users[0].IsAdmin)
+	require.Equal(t, int64(12), users[1].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepo_Import(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	repo := repository.NewUserRepository(db, mockLogger)
+
+	user := &entities.User{
+		Username:     "alice",
+		PasswordHash: "hash",
+		IsAdmin:      true,
+		CreatedAt:    time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+	}
+	mock.ExpectQuery(`INSERT INTO users .* FALSE\)`).
+		WithArgs(user.Username, user.PasswordHash, user.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+
+	require.NoError(t, repo.Import(context.Background(), user))
+	require.Equal(t, int64(7), user.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestTokenRepo_Create(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -264,3 +342,77 @@ func TestTokenRepo_RevokeAllUserTokens(t *testing.T) {
 	err = repo.RevokeAllUserTokens(context.Background(), 1)
 	require.NoError(t, err)
 }
+
+func TestUserRepo_UpdateUsername(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	repo := repository.NewUserRepository(db, mockLogger)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE users SET username`).
+			WithArgs(int64(7), "alice2").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`LOCK TABLE user_events`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO user_events`).
+			WithArgs(int64(7), entities.UserEventUpdated, "alice2").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mockLogger.EXPECT().Info("username changed", gomock.Any(), gomock.Any())
+
+		require.NoError(t, repo.UpdateUsername(ctx, 7, "alice2"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("name taken", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE users SET username`).
+			WithArgs(int64(7), "bob").
+			WillReturnError(&pq.Error{Code: "23505"})
+		mock.ExpectRollback()
+
+		require.ErrorIs(t, repo.UpdateUsername(ctx, 7, "bob"), errors.ErrDuplicateUsername)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE users SET username`).
+			WithArgs(int64(99), "ghost").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		require.ErrorIs(t, repo.UpdateUsername(ctx, 99, "ghost"), errors.ErrUserNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUserRepo_Events(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repository.NewUserRepository(db, mocks.NewMockLogger(ctrl))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM user_events WHERE id > $1 ORDER BY id LIMIT $2`)).
+		WithArgs(int64(3), 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "type", "username", "created_at"}).
+			AddRow(4, 7, entities.UserEventUpdated, "alice2", time.Now()))
+
+	events, err := repo.Events(context.Background(), 3, 100)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, int64(7), events[0].UserID)
+	require.Equal(t, "alice2", events[0].Username)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
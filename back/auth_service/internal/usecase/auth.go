@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"context"
+	stdErrors "errors"
+	"regexp"
 	"time"
+	"unicode/utf8"
 
 	"github.com/netabakovv/forum/back/pkg/errors"
 	"github.com/netabakovv/forum/back/pkg/logger"
@@ -19,9 +22,15 @@ type AuthUsecaseInterface interface {
 	Login(ctx context.Context, username, password string) (*entities.TokenPair, *entities.User, error)
 	RefreshTokens(ctx context.Context, refreshToken string) (*entities.TokenPair, error)
 	IsAdmin(ctx context.Context, userID int64) (bool, error)
+	LookupUsers(ctx context.Context, usernames []string) ([]*entities.User, error)
 	RevokeTokens(ctx context.Context, userID int64) error
 	Logout(ctx context.Context, refreshToken string) error
 	ValidateToken(ctx context.Context, token string) (*entities.TokenClaims, error)
+	GetUser(ctx context.Context, userID int64) (*entities.User, error)
+	ChangeUsername(ctx context.Context, userID int64, username string) (*entities.TokenPair, error)
+	UserEvents(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error)
+	ExportUsers(ctx context.Context, afterID int64, limit int, withPasswords bool) ([]*entities.User, error)
+	ImportUser(ctx context.Context, user *entities.User, mergeExisting bool) (int64, ImportResult, error)
 }
 
 type LoginResponse struct {
@@ -212,6 +221,192 @@ func (uc *AuthUsecase) IsAdmin(ctx context.Context, userID int64) (bool, error)
 	return user.IsAdmin, nil
 }
 
+// MaxLookupUsernames — сколько имён можно найти одним запросом LookupUsers
+const MaxLookupUsernames = 100
+
+// LookupUsers находит пользователей по именам. Повторы и пустые имена отбрасываются,
+// неизвестные имена в результат не попадают.
+func (uc *AuthUsecase) LookupUsers(ctx context.Context, usernames []string) ([]*entities.User, error) {
+	unique := make([]string, 0, len(usernames))
+	seen := make(map[string]bool, len(usernames))
+	for _, username := range usernames {
+		if username == "" || seen[username] {
+			continue
+		}
+		seen[username] = true
+		unique = append(unique, username)
+	}
+	if len(unique) > MaxLookupUsernames {
+		return nil, errors.ErrTooManyUsernames
+	}
+	if len(unique) == 0 {
+		return nil, nil
+	}
+	return uc.userRepo.GetByUsernames(ctx, unique)
+}
+
+func (uc *AuthUsecase) GetUser(ctx context.Context, userID int64) (*entities.User, error) {
+	return uc.userRepo.GetByID(ctx, userID)
+}
+
+const (
+	MinUsernameLength = 3
+	MaxUsernameLength = 50 // users.username VARCHAR(50)
+)
+
+// usernamePattern совпадает с тем, что распознаётся как @упоминание в forum_service
+var usernamePattern = regexp.MustCompile(`^[\p{L}\p{N}_][\p{L}\p{N}_.-]*$`)
+
+func validateUsername(username string) error {
+	switch n := utf8.RuneCountInString(username); {
+	case n == 0:
+		return errors.ErrEmptyUsername
+	case n < MinUsernameLength:
+		return errors.ErrUsernameTooShort
+	case n > MaxUsernameLength:
+		return errors.ErrUsernameTooLong
+	case !usernamePattern.MatchString(username):
+		return errors.ErrInvalidUsername
+	}
+	return nil
+}
+
+// ChangeUsername меняет имя пользователя и выдаёт новую пару токенов с новым именем.
+// Старые refresh токены отзываются: обновление по ним выдало бы токены со старым именем.
+// Об изменении узнают другие сервисы из журнала событий (UserEvents).
+func (uc *AuthUsecase) ChangeUsername(ctx context.Context, userID int64, username string) (*entities.TokenPair, error) {
+	if err := validateUsername(username); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Username != username {
+		if err := uc.userRepo.UpdateUsername(ctx, userID, username); err != nil {
+			uc.logger.Warn("failed to change username",
+				logger.NewField("error", err),
+				logger.NewField("user_id", userID),
+			)
+			return nil, err
+		}
+		if err := uc.tokenRepo.RevokeAllUserTokens(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	tokens, err := uc.tokenService.GenerateTokenPair(userID, username, user.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tokenRepo.Create(ctx, &entities.RefreshToken{
+		UserID:    userID,
+		Token:     tokens.RefreshToken,
+		ExpiresAt: time.Now().Add(uc.RefreshTokenTTL),
+	}); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("username change successful",
+		logger.NewField("user_id", userID),
+		logger.NewField("old_username", user.Username),
+		logger.NewField("username", username),
+	)
+	return tokens, nil
+}
+
+const (
+	DefaultUserEventsLimit = 100
+	MaxUserEventsLimit     = 500
+)
+
+// UserEvents возвращает события пользователей после afterID.
+// Без limit возвращается DefaultUserEventsLimit событий, больше MaxUserEventsLimit — нельзя.
+func (uc *AuthUsecase) UserEvents(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error) {
+	switch {
+	case limit <= 0:
+		limit = DefaultUserEventsLimit
+	case limit > MaxUserEventsLimit:
+		limit = MaxUserEventsLimit
+	}
+	return uc.userRepo.Events(ctx, afterID, limit)
+}
+
+const (
+	DefaultExportUsersLimit = 500
+	MaxExportUsersLimit     = 1000
+)
+
+// ExportUsers возвращает пользователей с ID больше afterID для архива форума.
+// Хеши паролей остаются только с withPasswords.
+func (uc *AuthUsecase) ExportUsers(ctx context.Context, afterID int64, limit int, withPasswords bool) ([]*entities.User, error) {
+	switch {
+	case limit <= 0:
+		limit = DefaultExportUsersLimit
+	case limit > MaxExportUsersLimit:
+		limit = MaxExportUsersLimit
+	}
+	users, err := uc.userRepo.Users(ctx, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	if !withPasswords {
+		for _, user := range users {
+			user.PasswordHash = ""
+		}
+	}
+	return users, nil
+}
+
+// ImportResult — чем закончилась загрузка пользователя из архива
+type ImportResult int
+
+const (
+	ImportCreated  ImportResult = iota + 1 // создан новый пользователь
+	ImportExisting                         // уже загружен раньше: то же имя и дата регистрации
+	ImportMerged                           // имя занято другим пользователем, возвращён он
+)
+
+// ImportUser создаёт пользователя из архива форума и возвращает его ID.
+// Пользователь с тем же именем и датой регистрации считается уже загруженным.
+// Если имя занято пользователем с другой датой, это другой человек: без
+// mergeExisting возвращается errors.ErrDuplicateUsername, с ним — ID занявшего
+// имя. Администратором загруженный пользователь не становится.
+func (uc *AuthUsecase) ImportUser(ctx context.Context, user *entities.User, mergeExisting bool) (int64, ImportResult, error) {
+	if err := validateUsername(user.Username); err != nil {
+		return 0, 0, err
+	}
+
+	existing, err := uc.userRepo.GetByUsername(ctx, user.Username)
+	switch {
+	case err == nil:
+		if existing.CreatedAt.Truncate(time.Second).Equal(user.CreatedAt.Truncate(time.Second)) {
+			return existing.ID, ImportExisting, nil
+		}
+		if !mergeExisting {
+			return 0, 0, errors.ErrDuplicateUsername
+		}
+		uc.logger.Warn("imported user merged into existing one",
+			logger.NewField("user_id", existing.ID),
+			logger.NewField("username", user.Username),
+		)
+		return existing.ID, ImportMerged, nil
+	case !stdErrors.Is(err, errors.ErrUserNotFound):
+		return 0, 0, err
+	}
+
+	created := &entities.User{
+		Username:     user.Username,
+		PasswordHash: user.PasswordHash,
+		CreatedAt:    user.CreatedAt,
+	}
+	if err := uc.userRepo.Import(ctx, created); err != nil {
+		return 0, 0, err
+	}
+	return created.ID, ImportCreated, nil
+}
+
 func (uc *AuthUsecase) RevokeTokens(ctx context.Context, userID int64) error {
 	uc.logger.Info("attempting to revoke all user tokens",
 		logger.NewField("user_id", userID),
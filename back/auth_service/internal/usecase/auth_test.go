@@ -288,6 +288,181 @@ func TestAuthUsecase_IsAdmin(t *testing.T) {
 	require.True(t, isAdmin)
 }
 
+func TestAuthUsecase_LookupUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userRepo := mock_repo.NewMockUserRepository(ctrl)
+	tokenRepo := mock_repo.NewMockTokenRepository(ctrl)
+	tokenService := mock_service.NewMockTokenServiceInterface(ctrl)
+	logger := mock_logger.NewMockLogger(ctrl)
+
+	uc := usecase.NewAuthUsecase(userRepo, tokenRepo, tokenService, logger)
+
+	userRepo.EXPECT().GetByUsernames(ctx, []string{"alice", "bob"}).Return([]*entities.User{
+		{ID: 1, Username: "alice"},
+	}, nil)
+
+	users, err := uc.LookupUsers(ctx, []string{"alice", "", "bob", "alice"})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+
+	users, err = uc.LookupUsers(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, users)
+
+	tooMany := make([]string, usecase.MaxLookupUsernames+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("user%d", i)
+	}
+	_, err = uc.LookupUsers(ctx, tooMany)
+	require.ErrorIs(t, err, errors.ErrTooManyUsernames)
+}
+
+func TestAuthUsecase_ChangeUsername(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userRepo := mock_repo.NewMockUserRepository(ctrl)
+	tokenRepo := mock_repo.NewMockTokenRepository(ctrl)
+	tokenService := mock_service.NewMockTokenServiceInterface(ctrl)
+	logger := mock_logger.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	uc := usecase.NewAuthUsecase(userRepo, tokenRepo, tokenService, logger)
+	tokens := &entities.TokenPair{AccessToken: "access", RefreshToken: "refresh", ExpiresAt: time.Now().Add(time.Hour)}
+
+	t.Run("success", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, int64(7)).Return(&entities.User{ID: 7, Username: "alice", IsAdmin: true}, nil)
+		userRepo.EXPECT().UpdateUsername(ctx, int64(7), "alice.smith").Return(nil)
+		tokenRepo.EXPECT().RevokeAllUserTokens(ctx, int64(7)).Return(nil)
+		tokenService.EXPECT().GenerateTokenPair(int64(7), "alice.smith", true).Return(tokens, nil)
+		tokenRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		got, err := uc.ChangeUsername(ctx, 7, "alice.smith")
+		require.NoError(t, err)
+		require.Equal(t, tokens, got)
+	})
+
+	t.Run("name taken", func(t *testing.T) {
+		userRepo.EXPECT().GetByID(ctx, int64(7)).Return(&entities.User{ID: 7, Username: "alice"}, nil)
+		userRepo.EXPECT().UpdateUsername(ctx, int64(7), "bob").Return(errors.ErrDuplicateUsername)
+
+		_, err := uc.ChangeUsername(ctx, 7, "bob")
+		require.ErrorIs(t, err, errors.ErrDuplicateUsername)
+	})
+
+	invalid := map[string]error{
+		"":            errors.ErrEmptyUsername,
+		"al":          errors.ErrUsernameTooShort,
+		"-alice":      errors.ErrInvalidUsername,
+		"alice smith": errors.ErrInvalidUsername,
+	}
+	for username, want := range invalid {
+		_, err := uc.ChangeUsername(ctx, 7, username)
+		require.ErrorIs(t, err, want, username)
+	}
+}
+
+func TestAuthUsecase_UserEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userRepo := mock_repo.NewMockUserRepository(ctrl)
+	uc := usecase.NewAuthUsecase(userRepo, mock_repo.NewMockTokenRepository(ctrl),
+		mock_service.NewMockTokenServiceInterface(ctrl), mock_logger.NewMockLogger(ctrl))
+
+	userRepo.EXPECT().Events(ctx, int64(5), usecase.DefaultUserEventsLimit).Return(nil, nil)
+	userRepo.EXPECT().Events(ctx, int64(5), usecase.MaxUserEventsLimit).Return(nil, nil)
+
+	_, err := uc.UserEvents(ctx, 5, 0)
+	require.NoError(t, err)
+	_, err = uc.UserEvents(ctx, 5, 10000)
+	require.NoError(t, err)
+}
+
+func TestAuthUsecase_ExportUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userRepo := mock_repo.NewMockUserRepository(ctrl)
+	uc := usecase.NewAuthUsecase(userRepo, mock_repo.NewMockTokenRepository(ctrl),
+		mock_service.NewMockTokenServiceInterface(ctrl), mock_logger.NewMockLogger(ctrl))
+
+	userRepo.EXPECT().Users(ctx, int64(0), usecase.DefaultExportUsersLimit).Return([]*entities.User{
+		{ID: 1, Username: "alice", PasswordHash: "secret-hash"},
+	}, nil)
+	users, err := uc.ExportUsers(ctx, 0, 0, false)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Empty(t, users[0].PasswordHash)
+
+	// с паролями хеши остаются
+	userRepo.EXPECT().Users(ctx, int64(1), usecase.MaxExportUsersLimit).Return([]*entities.User{
+		{ID: 2, Username: "bob", PasswordHash: "secret-hash"},
+	}, nil)
+	users, err = uc.ExportUsers(ctx, 1, 100000, true)
+	require.NoError(t, err)
+	require.Equal(t, "secret-hash", users[0].PasswordHash)
+}
+
+func TestAuthUsecase_ImportUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userRepo := mock_repo.NewMockUserRepository(ctrl)
+	mockLogger := mock_logger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	uc := usecase.NewAuthUsecase(userRepo, mock_repo.NewMockTokenRepository(ctrl),
+		mock_service.NewMockTokenServiceInterface(ctrl), mockLogger)
+	registered := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("новый пользователь без прав администратора", func(t *testing.T) {
+		userRepo.EXPECT().GetByUsername(ctx, "alice").Return(nil, errors.ErrUserNotFound)
+		userRepo.EXPECT().Import(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, u *entities.User) error {
+			require.Equal(t, registered, u.CreatedAt)
+			require.Equal(t, "hash", u.PasswordHash)
+			require.False(t, u.IsAdmin)
+			u.ID = 42
+			return nil
+		})
+		id, result, err := uc.ImportUser(ctx, &entities.User{Username: "alice", PasswordHash: "hash", CreatedAt: registered, IsAdmin: true}, false)
+		require.NoError(t, err)
+		require.Equal(t, int64(42), id)
+		require.Equal(t, usecase.ImportCreated, result)
+	})
+
+	t.Run("повторная загрузка", func(t *testing.T) {
+		userRepo.EXPECT().GetByUsername(ctx, "alice").Return(&entities.User{ID: 42, Username: "alice", CreatedAt: registered}, nil)
+		id, result, err := uc.ImportUser(ctx, &entities.User{Username: "alice", CreatedAt: registered}, false)
+		require.NoError(t, err)
+		require.Equal(t, int64(42), id)
+		require.Equal(t, usecase.ImportExisting, result)
+	})
+
+	t.Run("имя занято другим пользователем", func(t *testing.T) {
+		userRepo.EXPECT().GetByUsername(ctx, "bob").Return(&entities.User{ID: 7, Username: "bob", CreatedAt: registered.Add(time.Hour)}, nil).Times(2)
+		_, _, err := uc.ImportUser(ctx, &entities.User{Username: "bob", CreatedAt: registered}, false)
+		require.ErrorIs(t, err, errors.ErrDuplicateUsername)
+
+		id, result, err := uc.ImportUser(ctx, &entities.User{Username: "bob", CreatedAt: registered}, true)
+		require.NoError(t, err)
+		require.Equal(t, int64(7), id)
+		require.Equal(t, usecase.ImportMerged, result)
+	})
+
+	t.Run("некорректное имя", func(t *testing.T) {
+		_, _, err := uc.ImportUser(ctx, &entities.User{Username: "a b", CreatedAt: registered}, false)
+		require.ErrorIs(t, err, errors.ErrInvalidUsername)
+	})
+}
+
 func TestAuthUsecase_RevokeTokens(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
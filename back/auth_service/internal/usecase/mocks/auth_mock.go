@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	entities "github.com/netabakovv/forum/back/auth_service/internal/entities"
+	usecase "github.com/netabakovv/forum/back/auth_service/internal/usecase"
 )
 
 // MockAuthUsecaseInterface is a mock of AuthUsecaseInterface interface.
@@ -35,6 +36,67 @@ func (m *MockAuthUsecaseInterface) EXPECT() *MockAuthUsecaseInterfaceMockRecorde
 	return m.recorder
 }
 
+// ChangeUsername mocks base method.
+func (m *MockAuthUsecaseInterface) ChangeUsername(ctx context.Context, userID int64, username string) (*entities.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUsername", ctx, userID, username)
+	ret0, _ := ret[0].(*entities.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeUsername indicates an expected call of ChangeUsername.
+func (mr *MockAuthUsecaseInterfaceMockRecorder) ChangeUsername(ctx, userID, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUsername", reflect.TypeOf((*MockAuthUsecaseInterface)(nil).ChangeUsername), ctx, userID, username)
+}
+
+// ExportUsers mocks base method.
+func (m *MockAuthUsecaseInterface) ExportUsers(ctx context.Context, afterID int64, limit int, withPasswords bool) ([]*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUsers", ctx, afterID, limit, withPasswords)
+	ret0, _ := ret[0].([]*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUsers indicates an expected call of ExportUsers.
+func (mr *MockAuthUsecaseInterfaceMockRecorder) ExportUsers(ctx, afterID, limit, withPasswords interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUsers", reflect.TypeOf((*MockAuthUsecaseInterface)(nil).ExportUsers), ctx, afterID, limit, withPasswords)
+}
+
+// GetUser mocks base method.
+func (m *MockAuthUsecaseInterface) GetUser(ctx context.Context, userID int64) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockAuthUsecaseInterfaceMockRecorder) GetUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockAuthUsecaseInterface)(nil).GetUser), ctx, userID)
+}
+
+// ImportUser mocks base method.
+func (m *MockAuthUsecaseInterface) ImportUser(ctx context.Context, user *entities.User, mergeExisting bool) (int64, usecase.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUser", ctx, user, mergeExisting)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(usecase.ImportResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ImportUser indicates an expected call of ImportUser.
+func (mr *MockAuthUsecaseInterfaceMockRecorder) ImportUser(ctx, user, mergeExisting interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUser", reflect.TypeOf((*MockAuthUsecaseInterface)(nil).ImportUser), ctx, user, mergeExisting)
+}
+
 // IsAdmin mocks base method.
 func (m *MockAuthUsecaseInterface) IsAdmin(ctx context.Context, userID int64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +142,21 @@ func (mr *MockAuthUsecaseInterfaceMockRecorder) Logout(ctx, refreshToken interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthUsecaseInterface)(nil).Logout), ctx, refreshToken)
 }
 
+// LookupUsers mocks base method.
+func (m *MockAuthUsecaseInterface) LookupUsers(ctx context.Context, usernames []string) ([]*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupUsers", ctx, usernames)
+	ret0, _ := ret[0].([]*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupUsers indicates an expected call of LookupUsers.
+func (mr *MockAuthUsecaseInterfaceMockRecorder) LookupUsers(ctx, usernames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupUsers", reflect.TypeOf((*MockAuthUsecaseInterface)(nil).LookupUsers), ctx, usernames)
+}
+
 // RefreshTokens mocks base method.
 func (m *MockAuthUsecaseInterface) RefreshTokens(ctx context.Context, refreshToken string) (*entities.TokenPair, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +201,21 @@ func (mr *MockAuthUsecaseInterfaceMockRecorder) RevokeTokens(ctx, userID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeTokens", reflect.TypeOf((*MockAuthUsecaseInterface)(nil).RevokeTokens), ctx, userID)
 }
 
+// UserEvents mocks base method.
+func (m *MockAuthUsecaseInterface) UserEvents(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserEvents", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entities.UserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserEvents indicates an expected call of UserEvents.
+func (mr *MockAuthUsecaseInterfaceMockRecorder) UserEvents(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserEvents", reflect.TypeOf((*MockAuthUsecaseInterface)(nil).UserEvents), ctx, afterID, limit)
+}
+
 // ValidateToken mocks base method.
 func (m *MockAuthUsecaseInterface) ValidateToken(ctx context.Context, token string) (*entities.TokenClaims, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,186 @@
+// Команда forumctl выгружает содержимое форума в архив и загружает его обратно:
+//
+//	go run forum_service/cmd/forumctl/main.go export -config config.yaml -token $TOKEN -o forum.ndjson
+//	go run forum_service/cmd/forumctl/main.go import -config config.yaml -token $TOKEN -i forum.ndjson
+//
+// Посты, комментарии, категории и сообщения чата читаются из базы forum_service
+// (forumPath в конфиге). Пользователей хранит auth_service, к ним forumctl
+// обращается по gRPC от имени администратора: -token — его токен доступа,
+// по умолчанию берётся из FORUMCTL_TOKEN. Токен живёт недолго, но пользователи
+// идут в архиве первыми, поэтому он нужен только в начале работы.
+//
+// Хеши паролей выгружаются только с -with-passwords; без них загруженные
+// пользователи не смогут войти. Права администратора не переносятся.
+// Если имя пользователя из архива занято другим пользователем, его записи
+// пропускаются; с -merge-users они привязываются к занявшему имя.
+// Загрузка повторяема: записи, уже загруженные из того же источника,
+// пропускаются. Схема базы форума должна быть актуальной: миграции применяет
+// forum_service при запуске.
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/netabakovv/forum/back/forum_service/internal/repository"
+	"github.com/netabakovv/forum/back/forum_service/internal/usecase"
+	"github.com/netabakovv/forum/back/forum_service/internal/users"
+	"github.com/netabakovv/forum/back/pkg/logger"
+	"github.com/netabakovv/forum/back/pkg/markdown"
+	pb "github.com/netabakovv/forum/back/proto"
+
+	_ "github.com/lib/pq"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "использование: forumctl export|import [флаги]")
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	flags := flag.NewFlagSet("forumctl "+os.Args[1], flag.ExitOnError)
+	configPath := flags.String("config", "/app/config.yaml", "путь к конфигу с forumPath и auth_service.port")
+	authAddr := flags.String("auth", "", "адрес auth_service, по умолчанию auth_service:<auth_service.port>")
+	token := flags.String("token", os.Getenv("FORUMCTL_TOKEN"), "токен доступа администратора, по умолчанию FORUMCTL_TOKEN")
+
+	log := logger.NewStdLogger()
+	switch os.Args[1] {
+	case "export":
+		// не stdout: туда же пишет лог
+		output := flags.String("o", "forum.ndjson", "файл архива")
+		source := flags.String("source", "", "имя источника в архиве, по умолчанию адрес базы форума")
+		withPasswords := flags.Bool("with-passwords", false, "выгрузить хеши паролей")
+		flags.Parse(os.Args[2:])
+
+		uc, closeAll := archiveUsecase(*configPath, *authAddr, *token, false, log)
+		defer closeAll()
+		if *source == "" {
+			*source = defaultSource(viper.GetString("forumPath"))
+		}
+		runExport(uc, *output, usecase.ExportOptions{Source: *source, WithPasswords: *withPasswords}, log)
+
+	case "import":
+		input := flags.String("i", "forum.ndjson", "файл архива")
+		mergeUsers := flags.Bool("merge-users", false, "привязывать записи к существующему пользователю с тем же именем")
+		flags.Parse(os.Args[2:])
+
+		uc, closeAll := archiveUsecase(*configPath, *authAddr, *token, *mergeUsers, log)
+		defer closeAll()
+		runImport(uc, *input, log)
+
+	default:
+		usage()
+	}
+}
+
+// archiveUsecase подключается к базе форума и к auth_service
+func archiveUsecase(configPath, authAddr, token string, mergeUsers bool, log logger.Logger) (*usecase.ArchiveUsecase, func()) {
+	viper.SetConfigFile(configPath)
+	if err := viper.ReadInConfig(); err != nil {
+		log.Fatal("ошибка инициализации конфига", logger.NewField("error", err))
+	}
+	if token == "" {
+		log.Fatal("нужен токен доступа администратора: -token или FORUMCTL_TOKEN")
+	}
+
+	forumDB, err := sql.Open("postgres", viper.GetString("forumPath"))
+	if err != nil {
+		log.Fatal("не удалось подключиться к базе форума", logger.NewField("error", err))
+	}
+	if authAddr == "" {
+		authAddr = fmt.Sprintf("auth_service:%s", viper.GetString("auth_service.port"))
+	}
+	authConn, err := grpc.Dial(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("ошибка подключения к auth service", logger.NewField("error", err))
+	}
+	authClient := pb.NewAuthServiceClient(authConn)
+
+	// HTML текстов строится заново теми же настройками, что у forum_service
+	renderer := markdown.New(markdown.Config{
+		AllowedTags:       viper.GetStringSlice("markdown.allowed_tags"),
+		AllowedAttributes: viper.GetStringMapStringSlice("markdown.allowed_attributes"),
+	})
+	mentionUC := usecase.NewMentionUsecase(repository.NewMentionRepository(forumDB, log),
+		users.NewResolver(authClient, viper.GetString("auth.service_token")), viper.GetString("mentions.profile_url"), log)
+
+	uc := usecase.NewArchiveUsecase(
+		users.NewArchive(authClient, token, mergeUsers),
+		repository.NewCategoryRepository(forumDB, log),
+		repository.NewPostRepository(forumDB, log),
+		repository.NewArchiveRepository(forumDB, log),
+		renderer,
+		mentionUC,
+		log,
+	)
+	return uc, func() {
+		forumDB.Close()
+		authConn.Close()
+	}
+}
+
+// defaultSource — адрес базы форума без учётных данных и параметров
+func defaultSource(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err != nil || u.Host == "" {
+		return "forum"
+	}
+	return u.Host + u.Path
+}
+
+func runExport(uc *usecase.ArchiveUsecase, output string, opts usecase.ExportOptions, log logger.Logger) {
+	f, err := os.Create(output)
+	if err != nil {
+		log.Fatal("не удалось создать файл архива", logger.NewField("error", err))
+	}
+	w := bufio.NewWriter(f)
+
+	counts, err := uc.Export(context.Background(), w, opts)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Close()
+	}
+	if err != nil {
+		log.Fatal("ошибка выгрузки", logger.NewField("error", err))
+	}
+	log.Info("архив выгружен",
+		logger.NewField("source", opts.Source),
+		logger.NewField("records", counts))
+}
+
+func runImport(uc *usecase.ArchiveUsecase, input string, log logger.Logger) {
+	f, err := os.Open(input)
+	if err != nil {
+		log.Fatal("не удалось открыть файл архива", logger.NewField("error", err))
+	}
+	defer f.Close()
+
+	report, err := uc.Import(context.Background(), f)
+	if report != nil {
+		for _, c := range report.Conflicts {
+			log.Warn("конфликт при загрузке", logger.NewField("conflict", c.String()))
+		}
+	}
+	if err != nil {
+		log.Fatal("ошибка загрузки, её можно повторить: загруженные записи будут пропущены",
+			logger.NewField("error", err))
+	}
+	log.Info("архив загружен",
+		logger.NewField("imported", report.Imported),
+		logger.NewField("skipped", report.Skipped),
+		logger.NewField("conflicts", len(report.Conflicts)))
+}
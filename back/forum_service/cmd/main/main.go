@@ -1,16 +1,24 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
+	"github.com/netabakovv/forum/back/forum_service/internal/blobstore"
 	serv "github.com/netabakovv/forum/back/forum_service/internal/delivery/grpc"
 	"github.com/netabakovv/forum/back/forum_service/internal/delivery/ws"
+	"github.com/netabakovv/forum/back/forum_service/internal/filter"
 	"github.com/netabakovv/forum/back/forum_service/internal/repository"
 	"github.com/netabakovv/forum/back/forum_service/internal/usecase"
+	"github.com/netabakovv/forum/back/forum_service/internal/users"
 	"github.com/netabakovv/forum/back/pkg/logger"
+	"github.com/netabakovv/forum/back/pkg/markdown"
 	pb "github.com/netabakovv/forum/back/proto"
 
 	"github.com/golang-migrate/migrate/v4"
@@ -18,6 +26,7 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	_ "github.com/lib/pq"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -51,23 +60,111 @@ func main() {
 	postRepo := repository.NewPostRepository(db, log)
 	commentRepo := repository.NewCommentRepository(db, log)
 	chatRepo := repository.NewChatRepository(db, log)
+	categoryRepo := repository.NewCategoryRepository(db, log)
+	voteRepo := repository.NewVoteRepository(db, log)
+	pollRepo := repository.NewPollRepository(db, log)
+	reportRepo := repository.NewReportRepository(db, log)
+	heldRepo := repository.NewHeldRepository(db, log)
+	revisionRepo := repository.NewRevisionRepository(db, log)
+	contentRepo := repository.NewContentRepository(db, log)
+	attachmentRepo := repository.NewAttachmentRepository(db, log)
+	bookmarkRepo := repository.NewBookmarkRepository(db, log)
+	notificationRepo := repository.NewNotificationRepository(db, log)
+	mentionRepo := repository.NewMentionRepository(db, log)
+	viewRepo := repository.NewViewRepository(db, log)
+	authorRepo := repository.NewAuthorRepository(db, log)
+
+	// Хранилище файлов вложений
+	blobStore, err := blobstore.NewLocalStore(viper.GetString("attachments.dir"))
+	if err != nil {
+		log.Fatal("ошибка инициализации хранилища вложений", logger.NewField("error", err))
+	}
+	attachmentLimits := initAttachmentLimits()
+
+	// Markdown постов и комментариев
+	renderer := markdown.New(markdown.Config{
+		AllowedTags:       viper.GetStringSlice("markdown.allowed_tags"),
+		AllowedAttributes: viper.GetStringMapStringSlice("markdown.allowed_attributes"),
+	})
+	// Упоминания: имена пользователей проверяются в auth service
+	userResolver := users.NewResolver(authClient, viper.GetString("auth.service_token"))
+	mentionUC := usecase.NewMentionUsecase(mentionRepo, userResolver, viper.GetString("mentions.profile_url"), log)
+	go func() {
+		if err := usecase.RenderMissingContent(context.Background(), contentRepo, renderer, mentionUC, log); err != nil {
+			log.Error("ошибка построения HTML для сохранённых текстов", logger.NewField("error", err))
+		}
+	}()
+
+	// Фильтры текстов; возраст аккаунта тоже узнаётся в auth service
+	contentFilter := initContentFilter(log, userResolver)
 
 	// Use cases
-	postUC := usecase.NewPostUsecase(postRepo, log)
-	commentUC := usecase.NewCommentUsecase(commentRepo, log)
-	chatUC := usecase.NewChatUsecase(chatRepo, log, &pb.ChatConfig{
+	postUC := usecase.NewPostUsecase(postRepo, renderer, mentionUC, contentFilter, log)
+	notificationUC := usecase.NewNotificationUsecase(notificationRepo, postRepo, log)
+	commentUC := usecase.NewCommentUsecase(commentRepo, postRepo, renderer, mentionUC, contentFilter, notificationUC, log)
+	searchUC := usecase.NewSearchUsecase(postRepo, commentRepo, log)
+	categoryUC := usecase.NewCategoryUsecase(categoryRepo, log)
+	voteUC := usecase.NewVoteUsecase(voteRepo, postRepo, commentRepo, log)
+	pollUC := usecase.NewPollUsecase(pollRepo, postRepo, log)
+	reportUC := usecase.NewReportUsecase(reportRepo, postRepo, commentRepo, chatRepo, log)
+	moderationUC := usecase.NewModerationUsecase(heldRepo, renderer, mentionUC, notificationUC, log)
+	revisionUC := usecase.NewRevisionUsecase(revisionRepo, postRepo, commentRepo, renderer, mentionUC, log)
+	attachmentUC := usecase.NewAttachmentUsecase(attachmentRepo, blobStore, attachmentLimits, log)
+	bookmarkUC := usecase.NewBookmarkUsecase(bookmarkRepo, postRepo, log)
+	chatUC := usecase.NewChatUsecase(chatRepo, mentionUC, contentFilter, log, &pb.ChatConfig{
 		MessageLifetimeMinutes: 1,
 		MaxMessageLength:       1000,
 		OnlyAuthenticated:      true})
 	cleanup := usecase.NewCleanupService(chatUC, log)
 	cleanup.Start(viper.GetDuration("chat.cleanup_interval"), viper.GetDuration("chat.message_lifetime"))
 	defer cleanup.Stop()
+	trashPurge := usecase.NewTrashPurgeService(postUC, commentUC, attachmentUC, log)
+	trashPurge.Start(viper.GetDuration("trash.purge_interval"), viper.GetDuration("trash.retention"))
+	defer trashPurge.Stop()
+	publisher := usecase.NewScheduledPublisher(postUC, log)
+	publisher.Start(viper.GetDuration("drafts.publish_interval"))
+	defer publisher.Stop()
+	hotRanks := usecase.NewHotRankService(postUC, log)
+	hotRanks.Start(viper.GetDuration("ranking.hot_refresh_interval"), viper.GetDuration("ranking.hot_window"))
+	defer hotRanks.Stop()
+	viewCounter := usecase.NewViewCounter(viewRepo, viper.GetDuration("views.dedup_window"), log)
+	viewCounter.Start(viper.GetDuration("views.flush_interval"))
+	defer viewCounter.Stop()
 
 	// gRPC сервер
-	grpcServer := grpc.NewServer()
+	authInterceptor := serv.NewAuthInterceptor(authClient, viper.GetDuration("auth.token_cache_ttl"), log)
+	if err := authInterceptor.TrustProxies(viper.GetStringSlice("auth.trusted_proxies")); err != nil {
+		log.Fatal("неверные настройки доверенных прокси", logger.NewField("error", err))
+	}
+	authorNames := usecase.NewAuthorNameSync(authorRepo, userResolver, authInterceptor, log)
+	authorNames.Start(viper.GetDuration("users.sync_interval"))
+	defer authorNames.Stop()
+
+	// Файл вложения целиком передаётся одним сообщением, поэтому лимит
+	// сообщения — максимальный размер файла с запасом на метаданные
+	maxMsgSize := int(attachmentLimits.MaxFileSize) + 1<<20
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(authInterceptor.Unary()),
+		grpc.StreamInterceptor(authInterceptor.Stream()),
+		grpc.MaxRecvMsgSize(maxMsgSize),
+		grpc.MaxSendMsgSize(maxMsgSize),
+	)
 
 	// Форум сервер
-	forumServer := serv.NewForumServer(authClient, postUC, commentUC, chatUC)
+	forumServer := serv.NewForumServer(authClient, postUC, commentUC, chatUC,
+		serv.WithSearch(searchUC),
+		serv.WithCategories(categoryUC),
+		serv.WithVotes(voteUC),
+		serv.WithPolls(pollUC),
+		serv.WithReports(reportUC),
+		serv.WithModeration(moderationUC),
+		serv.WithRevisions(revisionUC),
+		serv.WithAttachments(attachmentUC),
+		serv.WithBookmarks(bookmarkUC),
+		serv.WithNotifications(notificationUC),
+		serv.WithMentions(mentionUC),
+		serv.WithViews(viewCounter),
+	)
 	pb.RegisterForumServiceServer(grpcServer, forumServer)
 
 	// WebSocket чат
@@ -79,6 +176,10 @@ func main() {
 	}, authClient)
 	http.HandleFunc("/ws/chat", chatHandler.HandleWebSocket)
 
+	// Конфиг отслеживается только теперь: viper не защищает чтение настроек
+	// от их перечитывания, а при старте они читаются без блокировок
+	viper.WatchConfig()
+
 	// Запуск серверов
 	go func() {
 		if err := http.ListenAndServe(":8080", nil); err != nil {
@@ -91,6 +192,16 @@ func main() {
 		log.Fatal("ошибка запуска gRPC сервера", logger.NewField("error", err))
 	}
 
+	// По SIGTERM сервер дожидается текущих запросов, после чего отложенные
+	// Stop фоновых служб доделывают работу, в том числе записывают просмотры
+	go func() {
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+		<-stop
+		log.Info("остановка forum_service")
+		grpcServer.GracefulStop()
+	}()
+
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatal("ошибка работы gRPC сервера", logger.NewField("error", err))
 	}
@@ -101,6 +212,58 @@ func initConfig() error {
 	return viper.ReadInConfig()
 }
 
+// initContentFilter строит цепочку фильтров из секции content_filters и
+// перестраивает её при изменении конфига. Некорректный конфиг при старте
+// останавливает сервис, а при перечитывании — оставляет прежние правила.
+// Конфиг начинает отслеживаться в main, когда он весь прочитан при старте.
+func initContentFilter(log logger.Logger, accounts filter.AccountAges) *filter.Chain {
+	load := func() ([]filter.Rule, error) {
+		var cfg filter.Config
+		if err := viper.UnmarshalKey("content_filters", &cfg); err != nil {
+			return nil, err
+		}
+		return filter.Rules(cfg, accounts)
+	}
+
+	rules, err := load()
+	if err != nil {
+		log.Fatal("ошибка настройки фильтров текстов", logger.NewField("error", err))
+	}
+	chain := filter.NewChain(rules, log)
+	log.Info("фильтры текстов настроены", logger.NewField("rules", len(rules)))
+
+	viper.OnConfigChange(func(fsnotify.Event) {
+		rules, err := load()
+		if err != nil {
+			log.Error("фильтры текстов не перечитаны, действуют прежние правила",
+				logger.NewField("error", err))
+			return
+		}
+		chain.SetRules(rules)
+		log.Info("фильтры текстов перечитаны", logger.NewField("rules", len(rules)))
+	})
+	return chain
+}
+
+// initAttachmentLimits читает ограничения вложений из конфига; незаданные
+// значения берутся по умолчанию
+func initAttachmentLimits() usecase.AttachmentLimits {
+	limits := usecase.DefaultAttachmentLimits()
+	if viper.IsSet("attachments.max_file_size") {
+		limits.MaxFileSize = int64(viper.GetSizeInBytes("attachments.max_file_size"))
+	}
+	if viper.IsSet("attachments.user_quota") {
+		limits.UserQuota = int64(viper.GetSizeInBytes("attachments.user_quota"))
+	}
+	if viper.IsSet("attachments.allowed_types") {
+		limits.AllowedTypes = viper.GetStringSlice("attachments.allowed_types")
+	}
+	if viper.IsSet("attachments.thumbnail_size") {
+		limits.ThumbnailSize = viper.GetInt("attachments.thumbnail_size")
+	}
+	return limits
+}
+
 func initDB(log logger.Logger) *sql.DB {
 	// Используем строку подключения из конфига
 	dbURL := viper.GetString("forumPath")
@@ -0,0 +1,42 @@
+// Команда recount пересчитывает число комментариев и время последнего комментария
+// у постов форума. Счётчики ведёт репозиторий, а команда нужна после ручных правок
+// в базе или восстановления из резервной копии:
+//
+//	go run forum_service/cmd/recount/main.go -config config.yaml
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+
+	"github.com/netabakovv/forum/back/forum_service/internal/repository"
+	"github.com/netabakovv/forum/back/pkg/logger"
+
+	_ "github.com/lib/pq"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	configPath := flag.String("config", "/app/config.yaml", "путь к конфигу forum_service")
+	flag.Parse()
+
+	log := logger.NewStdLogger()
+
+	viper.SetConfigFile(*configPath)
+	if err := viper.ReadInConfig(); err != nil {
+		log.Fatal("ошибка инициализации конфига", logger.NewField("error", err))
+	}
+
+	db, err := sql.Open("postgres", viper.GetString("forumPath"))
+	if err != nil {
+		log.Fatal("не удалось подключиться к базе данных", logger.NewField("error", err))
+	}
+	defer db.Close()
+
+	repaired, err := repository.NewPostRepository(db, log).RecountComments(context.Background())
+	if err != nil {
+		log.Fatal("ошибка пересчёта комментариев", logger.NewField("error", err))
+	}
+	log.Info("счётчики комментариев пересчитаны", logger.NewField("repaired_posts", repaired))
+}
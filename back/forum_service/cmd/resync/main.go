@@ -0,0 +1,68 @@
+// Команда resync сверяет имена авторов в постах, комментариях, сообщениях чата
+// и голосах в опросах с auth_service (GetUserByID) и исправляет расхождения.
+// Обычно имена обновляются по журналу событий auth_service, а команда нужна
+// после восстановления из резервной копии, ручных правок или потери событий:
+//
+//	go run forum_service/cmd/resync/main.go -config config.yaml -auth localhost:50053
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+
+	"github.com/netabakovv/forum/back/forum_service/internal/repository"
+	"github.com/netabakovv/forum/back/forum_service/internal/usecase"
+	"github.com/netabakovv/forum/back/forum_service/internal/users"
+	"github.com/netabakovv/forum/back/pkg/logger"
+	pb "github.com/netabakovv/forum/back/proto"
+
+	_ "github.com/lib/pq"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	configPath := flag.String("config", "/app/config.yaml", "путь к конфигу forum_service")
+	authAddr := flag.String("auth", "", "адрес auth_service, по умолчанию auth_service:<auth_service.port>")
+	flag.Parse()
+
+	log := logger.NewStdLogger()
+
+	viper.SetConfigFile(*configPath)
+	if err := viper.ReadInConfig(); err != nil {
+		log.Fatal("ошибка инициализации конфига", logger.NewField("error", err))
+	}
+	if *authAddr == "" {
+		*authAddr = fmt.Sprintf("auth_service:%s", viper.GetString("auth_service.port"))
+	}
+
+	db, err := sql.Open("postgres", viper.GetString("forumPath"))
+	if err != nil {
+		log.Fatal("не удалось подключиться к базе данных", logger.NewField("error", err))
+	}
+	defer db.Close()
+
+	authConn, err := grpc.Dial(*authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("ошибка подключения к auth service", logger.NewField("error", err))
+	}
+	defer authConn.Close()
+
+	sync := usecase.NewAuthorNameSync(
+		repository.NewAuthorRepository(db, log),
+		users.NewResolver(pb.NewAuthServiceClient(authConn), viper.GetString("auth.service_token")),
+		nil,
+		log,
+	)
+	result, err := sync.Resync(context.Background())
+	if err != nil {
+		log.Fatal("ошибка сверки имён авторов", logger.NewField("error", err))
+	}
+	log.Info("имена авторов сверены",
+		logger.NewField("authors", result.Authors),
+		logger.NewField("missing", result.Missing),
+		logger.NewField("fixed_records", result.Fixed))
+}
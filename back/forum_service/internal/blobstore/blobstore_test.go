@@ -0,0 +1,45 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/netabakovv/forum/back/forum_service/internal/blobstore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	key, err := blobstore.NewKey()
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, key, []byte("data")))
+	require.NoError(t, store.Put(ctx, blobstore.ThumbnailKey(key), []byte("thumb")))
+
+	data, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Get(ctx, key)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+	assert.NoError(t, store.Delete(ctx, key), "повторное удаление не ошибка")
+
+	data, err = store.Get(ctx, blobstore.ThumbnailKey(key))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("thumb"), data)
+}
+
+func TestLocalStore_InvalidKey(t *testing.T) {
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../../etc/passwd", "ABCDEF", "0123456789abcdef0123456789abcdef/x"} {
+		assert.ErrorIs(t, store.Put(context.Background(), key, nil), blobstore.ErrInvalidKey, key)
+	}
+}
@@ -0,0 +1,284 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/netabakovv/forum/back/pkg/logger"
+	pb "github.com/netabakovv/forum/back/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AuthorizationKey — ключ метаданных gRPC с токеном доступа в формате "Bearer <token>"
+	AuthorizationKey = "authorization"
+	// ClientIPKey — ключ метаданных gRPC с адресом клиента, который передаёт gateway.
+	// Учитывается только от доверенных прокси, см. AuthInterceptor.TrustProxies
+	ClientIPKey = "x-forwarded-for"
+	// DefaultTokenCacheTTL — сколько хранится результат проверки токена
+	DefaultTokenCacheTTL = 30 * time.Second
+
+	// maxCachedTokens — размер кэша, после которого из него вычищаются просроченные записи
+	maxCachedTokens = 10000
+)
+
+// User — пользователь, подтверждённый сервисом авторизации
+type User struct {
+	ID       int64
+	Username string
+	IsAdmin  bool
+}
+
+type userKey struct{}
+
+// ContextWithUser кладёт проверенного пользователя в контекст
+func ContextWithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext возвращает пользователя, проверенного перехватчиком авторизации
+func UserFromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userKey{}).(User)
+	return user, ok && user.ID != 0
+}
+
+type clientIPKey struct{}
+
+// ContextWithClientIP кладёт адрес клиента в контекст
+func ContextWithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+type cachedUser struct {
+	user      User
+	expiresAt time.Time
+}
+
+// AuthInterceptor проверяет токен из метаданных запроса через auth service
+// и кладёт пользователя в контекст. Запросы без токена проходят как анонимные,
+// запросы с недействительным токеном отклоняются. Адрес клиента тоже
+// определяется здесь и кладётся в контекст.
+type AuthInterceptor struct {
+	auth    pb.AuthServiceClient
+	ttl     time.Duration
+	logger  logger.Logger
+	now     func() time.Time
+	proxies []*net.IPNet
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]cachedUser
+}
+
+// NewAuthInterceptor создаёт перехватчик; ttl <= 0 означает DefaultTokenCacheTTL
+func NewAuthInterceptor(auth pb.AuthServiceClient, ttl time.Duration, logger logger.Logger) *AuthInterceptor {
+	if ttl <= 0 {
+		ttl = DefaultTokenCacheTTL
+	}
+	return &AuthInterceptor{
+		auth:   auth,
+		ttl:    ttl,
+		logger: logger,
+		now:    time.Now,
+		cache:  make(map[[sha256.Size]byte]cachedUser),
+	}
+}
+
+// TrustProxies задаёт сети (CIDR), запросам из которых можно верить в адресе
+// клиента из метаданных ClientIPKey. Обычно это адрес gateway. Без доверенных
+// сетей адресом клиента считается адрес соединения.
+func (a *AuthInterceptor) TrustProxies(cidrs []string) error {
+	proxies := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("неверная сеть доверенного прокси %q: %w", cidr, err)
+		}
+		proxies = append(proxies, network)
+	}
+	a.proxies = proxies
+	return nil
+}
+
+// Unary — перехватчик для обычных вызовов
+func (a *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream — перехватчик для потоковых вызовов
+func (a *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *AuthInterceptor) authenticate(ctx context.Context) (context.Context, error) {
+	ctx = ContextWithClientIP(ctx, a.clientIP(ctx))
+
+	token := bearerToken(ctx)
+	if token == "" {
+		return ctx, nil
+	}
+
+	key := sha256.Sum256([]byte(token))
+	if user, ok := a.cached(key); ok {
+		return ContextWithUser(ctx, user), nil
+	}
+
+	resp, err := a.auth.ValidateToken(ctx, &pb.ValidateRequest{AccessToken: token})
+	if err != nil {
+		a.logger.Warn("ошибка проверки токена", logger.NewField("error", err))
+		return nil, status.Error(codes.Unauthenticated, "недействительный токен")
+	}
+	if !resp.IsValid || resp.UserId == 0 {
+		return nil, status.Error(codes.Unauthenticated, "недействительный токен")
+	}
+
+	user := User{ID: resp.UserId, Username: resp.Username, IsAdmin: resp.IsAdmin}
+	a.store(key, user)
+	return ContextWithUser(ctx, user), nil
+}
+
+func (a *AuthInterceptor) cached(key [sha256.Size]byte) (User, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	entry, ok := a.cache[key]
+	if !ok {
+		return User{}, false
+	}
+	if !a.now().Before(entry.expiresAt) {
+		delete(a.cache, key)
+		return User{}, false
+	}
+	return entry.user, true
+}
+
+func (a *AuthInterceptor) store(key [sha256.Size]byte, user User) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	if len(a.cache) >= maxCachedTokens {
+		for k, entry := range a.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(a.cache, k)
+			}
+		}
+	}
+	a.cache[key] = cachedUser{user: user, expiresAt: now.Add(a.ttl)}
+}
+
+// ForgetUser сбрасывает закешированные токены пользователя, чтобы следующий
+// запрос заново получил его имя и права в auth service
+func (a *AuthInterceptor) ForgetUser(userID int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for k, entry := range a.cache {
+		if entry.user.ID == userID {
+			delete(a.cache, k)
+		}
+	}
+}
+
+// bearerToken достаёт токен из метаданных, префикс "Bearer " необязателен
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(AuthorizationKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(values[0], "Bearer "))
+}
+
+// clientIP возвращает адрес клиента: из метаданных gateway, если запрос пришёл
+// от доверенного прокси, иначе адрес соединения
+func (a *AuthInterceptor) clientIP(ctx context.Context) string {
+	remote := peerIP(ctx)
+	if !a.trusted(remote) {
+		return remote
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return remote
+	}
+	values := md.Get(ClientIPKey)
+	if len(values) == 0 {
+		return remote
+	}
+	// gateway передаёт один адрес; всё, что дописано дальше по цепочке, не проверено
+	first, _, _ := strings.Cut(values[0], ",")
+	ip := net.ParseIP(strings.TrimSpace(first))
+	if ip == nil {
+		return remote
+	}
+	return ip.String()
+}
+
+func (a *AuthInterceptor) trusted(remote string) bool {
+	ip := net.ParseIP(remote)
+	if ip == nil {
+		return false
+	}
+	for _, network := range a.proxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// peerIP возвращает адрес, с которого пришёл запрос
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// clientIP возвращает адрес клиента, определённый перехватчиком, а без
+// перехватчика — адрес соединения. Пустая строка, если адрес неизвестен.
+func clientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok && ip != "" {
+		return ip
+	}
+	return peerIP(ctx)
+}
+
+// authStream подменяет контекст потока на контекст с пользователем
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
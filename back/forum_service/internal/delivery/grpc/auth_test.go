@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/netabakovv/forum/back/pkg/logger"
+	pb "github.com/netabakovv/forum/back/proto"
+	mock_proto "github.com/netabakovv/forum/back/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationKey, "Bearer "+token))
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	interceptor := NewAuthInterceptor(auth, time.Minute, logger.NewStdLogger())
+	now := time.Now()
+	interceptor.now = func() time.Time { return now }
+	unary := interceptor.Unary()
+
+	var got User
+	var authenticated bool
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		got, authenticated = UserFromContext(ctx)
+		return nil, nil
+	}
+
+	t.Run("без токена запрос анонимный", func(t *testing.T) {
+		_, err := unary(context.Background(), nil, nil, handler)
+		require.NoError(t, err)
+		assert.False(t, authenticated)
+	})
+
+	t.Run("проверенный токен кэшируется", func(t *testing.T) {
+		auth.EXPECT().ValidateToken(gomock.Any(), &pb.ValidateRequest{AccessToken: "good"}).
+			Return(&pb.ValidateResponse{IsValid: true, UserId: 7, Username: "alice"}, nil).Times(1)
+
+		for i := 0; i < 2; i++ {
+			_, err := unary(withToken("good"), nil, nil, handler)
+			require.NoError(t, err)
+			assert.True(t, authenticated)
+			assert.Equal(t, User{ID: 7, Username: "alice"}, got)
+		}
+	})
+
+	t.Run("просроченная запись проверяется заново", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		auth.EXPECT().ValidateToken(gomock.Any(), &pb.ValidateRequest{AccessToken: "good"}).
+			Return(&pb.ValidateResponse{IsValid: false}, nil)
+
+		_, err := unary(withToken("good"), nil, nil, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("ошибка auth service", func(t *testing.T) {
+		auth.EXPECT().ValidateToken(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.Unavailable, "нет связи"))
+
+		_, err := unary(withToken("other"), nil, nil, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("после смены имени токен проверяется заново", func(t *testing.T) {
+		gomock.InOrder(
+			auth.EXPECT().ValidateToken(gomock.Any(), &pb.ValidateRequest{AccessToken: "renamed"}).
+				Return(&pb.ValidateResponse{IsValid: true, UserId: 8, Username: "bob"}, nil),
+			auth.EXPECT().ValidateToken(gomock.Any(), &pb.ValidateRequest{AccessToken: "renamed"}).
+				Return(&pb.ValidateResponse{IsValid: true, UserId: 8, Username: "bob2"}, nil),
+		)
+
+		_, err := unary(withToken("renamed"), nil, nil, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "bob", got.Username)
+
+		interceptor.ForgetUser(8)
+		_, err = unary(withToken("renamed"), nil, nil, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "bob2", got.Username)
+	})
+}
+
+func fromPeer(addr string, forwarded string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 40000}})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(ClientIPKey, forwarded))
+}
+
+func TestAuthInterceptor_ClientIP(t *testing.T) {
+	interceptor := NewAuthInterceptor(nil, time.Minute, logger.NewStdLogger())
+	require.NoError(t, interceptor.TrustProxies([]string{"10.0.0.0/8"}))
+	unary := interceptor.Unary()
+
+	var got string
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		got = clientIP(ctx)
+		return nil, nil
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"адрес от доверенного прокси", fromPeer("10.0.0.5", "203.0.113.7"), "203.0.113.7"},
+		{"заголовок от клиента напрямую", fromPeer("198.51.100.1", "203.0.113.7"), "198.51.100.1"},
+		{"некорректный адрес в заголовке", fromPeer("10.0.0.5", "not-an-ip"), "10.0.0.5"},
+		{"без адреса соединения", metadata.NewIncomingContext(context.Background(), metadata.Pairs(ClientIPKey, "203.0.113.7")), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := unary(tt.ctx, nil, nil, handler)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.Error(t, interceptor.TrustProxies([]string{"10.0.0.0"}))
+}
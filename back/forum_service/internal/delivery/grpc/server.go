@@ -2,10 +2,16 @@ package grpc
 
 import (
 	"context"
+	stdErrors "errors"
+	"strconv"
 	"time"
 
 	"github.com/netabakovv/forum/back/forum_service/internal/entities"
+	"github.com/netabakovv/forum/back/forum_service/internal/policy"
+	"github.com/netabakovv/forum/back/forum_service/internal/repository"
 	"github.com/netabakovv/forum/back/forum_service/internal/usecase"
+	"github.com/netabakovv/forum/back/pkg/diff"
+	"github.com/netabakovv/forum/back/pkg/errors"
 	pb "github.com/netabakovv/forum/back/proto"
 
 	"google.golang.org/grpc/codes"
@@ -18,6 +24,106 @@ type ForumServer struct {
 	postUC      usecase.PostUsecaseInterface
 	commentUC   usecase.CommentUsecaseInterface
 	chatUC      usecase.ChatUsecaseInterface
+	searchUC    usecase.SearchUsecaseInterface
+	categoryUC  usecase.CategoryUsecaseInterface
+	voteUC      usecase.VoteUsecaseInterface
+	revisionUC  usecase.RevisionUsecaseInterface
+	attachUC    usecase.AttachmentUsecaseInterface
+	bookmarkUC  usecase.BookmarkUsecaseInterface
+	notifyUC    usecase.NotificationUsecaseInterface
+	mentionUC   usecase.MentionUsecaseInterface
+	viewUC      usecase.ViewUsecaseInterface
+	pollUC      usecase.PollUsecaseInterface
+	reportUC    usecase.ReportUsecaseInterface
+	moderateUC  usecase.ModerationUsecaseInterface
+	policy      *policy.Policy
+}
+
+// Option подключает к серверу необязательные возможности форума
+type Option func(*ForumServer)
+
+// WithSearch включает полнотекстовый поиск
+func WithSearch(searchUC usecase.SearchUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.searchUC = searchUC
+	}
+}
+
+// WithCategories включает управление категориями
+func WithCategories(categoryUC usecase.CategoryUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.categoryUC = categoryUC
+	}
+}
+
+// WithVotes включает голосование за посты и комментарии
+func WithVotes(voteUC usecase.VoteUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.voteUC = voteUC
+	}
+}
+
+// WithRevisions включает историю правок и откат к прежним версиям
+func WithRevisions(revisionUC usecase.RevisionUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.revisionUC = revisionUC
+	}
+}
+
+// WithAttachments включает вложения к постам и комментариям
+func WithAttachments(attachUC usecase.AttachmentUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.attachUC = attachUC
+	}
+}
+
+// WithBookmarks включает закладки на посты
+func WithBookmarks(bookmarkUC usecase.BookmarkUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.bookmarkUC = bookmarkUC
+	}
+}
+
+// WithNotifications включает подписки на обсуждения и ленту уведомлений
+func WithNotifications(notifyUC usecase.NotificationUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.notifyUC = notifyUC
+	}
+}
+
+// WithMentions включает ленту упоминаний пользователя
+func WithMentions(mentionUC usecase.MentionUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.mentionUC = mentionUC
+	}
+}
+
+// WithViews включает учёт просмотров постов
+func WithViews(viewUC usecase.ViewUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.viewUC = viewUC
+	}
+}
+
+// WithPolls включает опросы в постах
+func WithPolls(pollUC usecase.PollUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.pollUC = pollUC
+	}
+}
+
+// WithReports включает жалобы на контент и очередь модерации
+func WithReports(reportUC usecase.ReportUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.reportUC = reportUC
+	}
+}
+
+// WithModeration включает очередь текстов, задержанных фильтрами
+func WithModeration(moderateUC usecase.ModerationUsecaseInterface) Option {
+	return func(s *ForumServer) {
+		s.moderateUC = moderateUC
+	}
 }
 
 // NewForumServer — конструктор (удобно для внедрения зависимостей)
@@ -26,17 +132,27 @@ func NewForumServer(
 	postUC usecase.PostUsecaseInterface,
 	commentUC usecase.CommentUsecaseInterface,
 	chatUC usecase.ChatUsecaseInterface,
+	opts ...Option,
 ) *ForumServer {
-	return &ForumServer{
+	s := &ForumServer{
 		authService: authService,
 		postUC:      postUC,
 		commentUC:   commentUC,
 		chatUC:      chatUC,
+		policy:      policy.New(authService),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // Post operations
 func (s *ForumServer) CreatePost(ctx context.Context, req *pb.CreatePostRequest) (*pb.PostResponse, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if req.Title == "" || req.Content == "" {
 		return nil, status.Error(codes.InvalidArgument, "заголовок и содержание обязательны")
 	}
@@ -44,74 +160,152 @@ func (s *ForumServer) CreatePost(ctx context.Context, req *pb.CreatePostRequest)
 	post := &entities.Post{
 		Title:        req.Title,
 		Content:      req.Content,
-		AuthorID:     req.AuthorId,
-		AuthorName:   req.AuthorUsername,
+		AuthorID:     user.ID,
+		AuthorName:   user.Username,
 		CreatedAt:    time.Now(),
 		CommentCount: 0,
+		CategoryID:   req.CategoryId,
+		Tags:         req.Tags,
+		Status:       postStatus(req.Status),
+	}
+	if req.PublishAt != 0 {
+		publishAt := time.Unix(req.PublishAt, 0)
+		post.PublishAt = &publishAt
+	}
+	if req.Poll != nil {
+		if s.pollUC == nil {
+			return nil, status.Error(codes.Unimplemented, "опросы не настроены")
+		}
+		post.Poll = pollFromProto(req.Poll)
 	}
 
-	err := s.postUC.CreatePost(ctx, post)
+	err = s.postUC.CreatePost(ctx, post)
+	if isPostValidationError(err) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "не удалось создать пост")
 	}
 
-	return &pb.PostResponse{
-		Post: &pb.Post{
-			Id:             post.ID,
-			Title:          post.Title,
-			Content:        post.Content,
-			AuthorId:       post.AuthorID,
-			AuthorUsername: post.AuthorName,
-			CreatedAt:      post.CreatedAt.Unix(),
-			CommentCount:   post.CommentCount,
-		},
-	}, nil
+	return &pb.PostResponse{Post: postToProto(post)}, nil
 }
 
 func (s *ForumServer) GetPost(ctx context.Context, req *pb.GetPostRequest) (*pb.PostResponse, error) {
 	post, err := s.postUC.GetPostByID(ctx, req.PostId)
+	if stdErrors.Is(err, errors.ErrPostNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "не удалось получить пост")
 	}
+	if post.Unpublished() && post.AuthorID != viewerID(ctx) {
+		return nil, status.Error(codes.NotFound, errors.ErrPostNotFound.Error())
+	}
+	// Просмотр сразу виден в ответе, хотя в базу попадёт при следующей записи
+	if s.viewUC != nil && !post.Unpublished() && !post.Deleted && s.viewUC.RecordView(post.ID, viewerKey(ctx)) {
+		post.ViewCount++
+	}
+	if err := s.fillPostVotes(ctx, viewerID(ctx), []*entities.Post{post}); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostBookmarks(ctx, viewerID(ctx), []*entities.Post{post}); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostAttachments(ctx, []*entities.Post{post}); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostPolls(ctx, viewerID(ctx), []*entities.Post{post}); err != nil {
+		return nil, err
+	}
 
-	return &pb.PostResponse{
-		Post: &pb.Post{
-			Id:             post.ID,
-			Title:          post.Title,
-			Content:        post.Content,
-			AuthorId:       post.AuthorID,
-			AuthorUsername: post.AuthorName,
-			CreatedAt:      post.CreatedAt.Unix(),
-			CommentCount:   post.CommentCount,
-		},
-	}, nil
+	return &pb.PostResponse{Post: postToProto(post)}, nil
 }
 
 func (s *ForumServer) GetByPostID(ctx context.Context, req *pb.GetCommentsByPostIDRequest) (*pb.ListCommentsResponse, error) {
-	comments, err := s.commentUC.GetByPostID(ctx, req.PostId)
+	return s.listComments(ctx, req.PostId, req.View, viewerID(ctx))
+}
+
+// listComments отдаёт комментарии поста плоским списком или деревом
+func (s *ForumServer) listComments(ctx context.Context, postID int64, view pb.CommentView, viewerID int64) (*pb.ListCommentsResponse, error) {
+	var (
+		comments []*entities.Comment
+		err      error
+	)
+	if view == pb.CommentView_COMMENT_VIEW_TREE {
+		comments, err = s.commentUC.GetTreeByPostID(ctx, postID)
+	} else {
+		comments, err = s.commentUC.GetByPostID(ctx, postID)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "не удалось получить комментарии")
 	}
+	if err := s.fillCommentVotes(ctx, viewerID, comments); err != nil {
+		return nil, err
+	}
+	if err := s.fillCommentAttachments(ctx, comments); err != nil {
+		return nil, err
+	}
+
 	protoComments := make([]*pb.Comment, 0, len(comments))
+	total := 0
 	for _, c := range comments {
-		protoComments = append(protoComments, &pb.Comment{
-			Id:             c.ID,
-			PostId:         c.PostID,
-			AuthorId:       c.AuthorID,
-			AuthorUsername: c.AuthorName,
-			Content:        c.Content,
-			CreatedAt:      c.CreatedAt.Unix(),
-		})
+		protoComments = append(protoComments, commentToProto(c))
+		total += countComments(c)
 	}
 	return &pb.ListCommentsResponse{
 		Comments:   protoComments,
-		TotalCount: int32(len(comments)),
+		TotalCount: int32(total),
 	}, nil
 }
 
+func commentToProto(comment *entities.Comment) *pb.Comment {
+	pbComment := &pb.Comment{
+		Id:             comment.ID,
+		Content:        comment.Content,
+		ContentHtml:    comment.ContentHTML,
+		AuthorId:       comment.AuthorID,
+		AuthorUsername: comment.AuthorName,
+		PostId:         comment.PostID,
+		CreatedAt:      comment.CreatedAt.Unix(),
+		Depth:          comment.Depth,
+		Path:           comment.Path,
+		Deleted:        comment.Deleted,
+		Score:          comment.Score,
+		MyVote:         comment.MyVote,
+		Deletion:       deletionToProto(comment.Deletion),
+		Attachments:    attachmentsToProto(comment.Attachments),
+		Hold:           holdToProto(comment.Hold),
+	}
+	if comment.ParentID != nil {
+		pbComment.ParentId = *comment.ParentID
+	}
+	for _, reply := range comment.Replies {
+		pbComment.Replies = append(pbComment.Replies, commentToProto(reply))
+	}
+	return pbComment
+}
+
+// countComments считает комментарий вместе со всеми вложенными ответами
+func countComments(comment *entities.Comment) int {
+	n := 1
+	for _, reply := range comment.Replies {
+		n += countComments(reply)
+	}
+	return n
+}
+
+// UpdatePost меняет только переданные поля: остальные берутся из текущей версии поста
 func (s *ForumServer) UpdatePost(ctx context.Context, req *pb.UpdatePostRequest) (*pb.PostResponse, error) {
-	post := &entities.Post{
-		ID: req.PostId,
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	post, err := s.livePost(ctx, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, user.ID, post.AuthorID); err != nil {
+		return nil, err
 	}
 
 	if req.Title != nil {
@@ -120,90 +314,443 @@ func (s *ForumServer) UpdatePost(ctx context.Context, req *pb.UpdatePostRequest)
 	if req.Content != nil {
 		post.Content = *req.Content
 	}
+	if req.CategoryId != nil {
+		post.CategoryID = nil
+		if *req.CategoryId != 0 {
+			post.CategoryID = req.CategoryId
+		}
+	}
+	post.EditorID = user.ID
+	// Теги из загруженного поста не пересохраняем: nil означает «без изменений»
+	tags := post.Tags
+	post.Tags = nil
+	if req.Tags != nil {
+		post.Tags = req.Tags.Tags
+		if post.Tags == nil {
+			post.Tags = []string{}
+		}
+	}
 
-	err := s.postUC.UpdatePost(ctx, post)
+	err = s.postUC.UpdatePost(ctx, post)
+	if isPostValidationError(err) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "не удалось обновить пост")
 	}
-
-	updatedPost, err := s.postUC.GetPostByID(ctx, req.PostId)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "не удалось получить обновлённый пост")
+	if post.Tags == nil {
+		post.Tags = tags
 	}
+	now := time.Now()
+	post.UpdatedAt = &now
 
-	return &pb.PostResponse{
-		Post: &pb.Post{
-			Id:             updatedPost.ID,
-			Title:          updatedPost.Title,
-			Content:        updatedPost.Content,
-			AuthorId:       updatedPost.AuthorID,
-			AuthorUsername: updatedPost.AuthorName,
-			CreatedAt:      updatedPost.CreatedAt.Unix(),
-			CommentCount:   updatedPost.CommentCount,
-		},
-	}, nil
+	return &pb.PostResponse{Post: postToProto(post)}, nil
 }
 
 func (s *ForumServer) DeletePost(ctx context.Context, req *pb.DeletePostRequest) (*pb.EmptyMessage, error) {
-	err := s.postUC.DeletePost(ctx, req.PostId)
+	user, err := currentUser(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "не удалось удалить пост")
+		return nil, err
+	}
+	post, err := s.livePost(ctx, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, user.ID, post.AuthorID); err != nil {
+		return nil, err
+	}
+
+	err = s.postUC.DeletePost(ctx, req.PostId, user.ID, req.Reason)
+	if err != nil {
+		return nil, trashStatus(err, "не удалось удалить пост")
 	}
 	return &pb.EmptyMessage{}, nil
 }
 
 func (s *ForumServer) Posts(ctx context.Context, req *pb.ListPostsRequest) (*pb.ListPostsResponse, error) {
-	posts, err := s.postUC.Posts(ctx)
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "размер страницы не может быть отрицательным")
+	}
+	if req.CreatedFrom != nil && req.CreatedTo != nil && *req.CreatedFrom >= *req.CreatedTo {
+		return nil, status.Error(codes.InvalidArgument, "некорректный диапазон дат")
+	}
+
+	cursor, err := entities.ParsePostCursor(req.Cursor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := entities.PostFilter{
+		AuthorID:   req.AuthorId,
+		CategoryID: req.CategoryId,
+		Tag:        req.Tag,
+		Ascending:  req.Order == pb.SortOrder_SORT_ORDER_ASC,
+		Limit:      int(req.Limit),
+		After:      cursor,
+		ViewerID:   viewerID(ctx),
+		Sort:       postSort(req.Sort),
+		Window:     timeWindow(req.Window),
+
+		IgnorePinned: req.IgnorePinned,
+	}
+	if req.CreatedFrom != nil {
+		from := time.Unix(*req.CreatedFrom, 0)
+		filter.CreatedFrom = &from
+	}
+	if req.CreatedTo != nil {
+		to := time.Unix(*req.CreatedTo, 0)
+		filter.CreatedTo = &to
+	}
+
+	page, err := s.postUC.Posts(ctx, filter)
+	if stdErrors.Is(err, errors.ErrInvalidTag) || stdErrors.Is(err, errors.ErrInvalidSort) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "не удалось получить список постов")
 	}
+	posts := page.Posts
+	if err := s.fillPostVotes(ctx, viewerID(ctx), posts); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostBookmarks(ctx, viewerID(ctx), posts); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostAttachments(ctx, posts); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostPolls(ctx, viewerID(ctx), posts); err != nil {
+		return nil, err
+	}
 
 	pbPosts := make([]*pb.Post, len(posts))
 	for i, post := range posts {
-		pbPosts[i] = &pb.Post{
-			Id:             post.ID,
-			Title:          post.Title,
-			Content:        post.Content,
-			AuthorId:       post.AuthorID,
-			AuthorUsername: post.AuthorName,
-			CreatedAt:      post.CreatedAt.Unix(),
-			CommentCount:   post.CommentCount,
-		}
+		pbPosts[i] = postToProto(post)
 	}
 
 	return &pb.ListPostsResponse{
-		Posts: pbPosts,
+		Posts:      pbPosts,
+		NextCursor: page.NextCursor.String(),
 	}, nil
 }
 
+func postToProto(post *entities.Post) *pb.Post {
+	pbPost := &pb.Post{
+		Id:             post.ID,
+		Title:          post.Title,
+		Content:        post.Content,
+		ContentHtml:    post.ContentHTML,
+		AuthorId:       post.AuthorID,
+		AuthorUsername: post.AuthorName,
+		CreatedAt:      post.CreatedAt.Unix(),
+		CommentCount:   post.CommentCount,
+		Tags:           post.Tags,
+		Score:          post.Score,
+		MyVote:         post.MyVote,
+		Deleted:        post.Deleted,
+		Deletion:       deletionToProto(post.Deletion),
+		Attachments:    attachmentsToProto(post.Attachments),
+		IsPinned:       post.IsPinned,
+		IsLocked:       post.IsLocked,
+		Bookmarked:     post.Bookmarked,
+		ViewCount:      post.ViewCount,
+		Poll:           pollToProto(post.Poll, time.Now()),
+		Hold:           holdToProto(post.Hold),
+	}
+	if post.CategoryID != nil {
+		pbPost.CategoryId = *post.CategoryID
+	}
+	switch post.Status {
+	case entities.PostStatusDraft:
+		pbPost.Status = pb.PostStatus_POST_STATUS_DRAFT
+	case entities.PostStatusScheduled:
+		pbPost.Status = pb.PostStatus_POST_STATUS_SCHEDULED
+	case entities.PostStatusHeld:
+		pbPost.Status = pb.PostStatus_POST_STATUS_HELD
+	}
+	if post.PublishAt != nil {
+		pbPost.PublishAt = post.PublishAt.Unix()
+	}
+	if post.LastCommentAt != nil {
+		pbPost.LastCommentAt = post.LastCommentAt.Unix()
+	}
+	if post.UpdatedAt != nil {
+		pbPost.UpdatedAt = post.UpdatedAt.Unix()
+	}
+	return pbPost
+}
+
+func postSort(s pb.PostSort) string {
+	switch s {
+	case pb.PostSort_POST_SORT_TOP:
+		return entities.PostSortTop
+	case pb.PostSort_POST_SORT_ACTIVE:
+		return entities.PostSortActive
+	case pb.PostSort_POST_SORT_HOT:
+		return entities.PostSortHot
+	case pb.PostSort_POST_SORT_VIEWS:
+		return entities.PostSortViews
+	default:
+		return entities.PostSortNew
+	}
+}
+
+func timeWindow(w pb.TimeWindow) time.Duration {
+	switch w {
+	case pb.TimeWindow_TIME_WINDOW_DAY:
+		return 24 * time.Hour
+	case pb.TimeWindow_TIME_WINDOW_WEEK:
+		return 7 * 24 * time.Hour
+	case pb.TimeWindow_TIME_WINDOW_MONTH:
+		return 30 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+func postStatus(s pb.PostStatus) string {
+	switch s {
+	case pb.PostStatus_POST_STATUS_DRAFT:
+		return entities.PostStatusDraft
+	case pb.PostStatus_POST_STATUS_SCHEDULED:
+		return entities.PostStatusScheduled
+	case pb.PostStatus_POST_STATUS_HELD:
+		// Задержать пост может только фильтр: такой статус в запросе отклоняется
+		return entities.PostStatusHeld
+	default:
+		return entities.PostStatusPublished
+	}
+}
+
+// ListMyDrafts отдаёт черновики и запланированные посты текущего пользователя
+func (s *ForumServer) ListMyDrafts(ctx context.Context, req *pb.ListMyDraftsRequest) (*pb.ListPostsResponse, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "некорректные параметры страницы")
+	}
+
+	posts, err := s.postUC.Drafts(ctx, user.ID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить черновики")
+	}
+	if err := s.fillPostAttachments(ctx, posts); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostPolls(ctx, user.ID, posts); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListPostsResponse{Posts: make([]*pb.Post, len(posts))}
+	for i, post := range posts {
+		resp.Posts[i] = postToProto(post)
+	}
+	return resp, nil
+}
+
+// PublishPost публикует черновик сразу или планирует его публикацию
+func (s *ForumServer) PublishPost(ctx context.Context, req *pb.PublishPostRequest) (*pb.PostResponse, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	post, err := s.livePost(ctx, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, user.ID, post.AuthorID); err != nil {
+		return nil, err
+	}
+
+	var publishAt *time.Time
+	if req.PublishAt != 0 {
+		t := time.Unix(req.PublishAt, 0)
+		publishAt = &t
+	}
+	err = s.postUC.PublishPost(ctx, post, publishAt)
+	switch {
+	case stdErrors.Is(err, errors.ErrInvalidPublishTime):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case stdErrors.Is(err, errors.ErrPostAlreadyPublished),
+		stdErrors.Is(err, errors.ErrPostHeld):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, "не удалось опубликовать пост")
+	}
+	return &pb.PostResponse{Post: postToProto(post)}, nil
+}
+
+// isPostValidationError сообщает, что пост отклонён из-за некорректных данных клиента
+func isPostValidationError(err error) bool {
+	return stdErrors.Is(err, errors.ErrInvalidTag) ||
+		stdErrors.Is(err, errors.ErrTooManyTags) ||
+		stdErrors.Is(err, errors.ErrCategoryNotFound) ||
+		stdErrors.Is(err, errors.ErrInvalidPostStatus) ||
+		stdErrors.Is(err, errors.ErrInvalidPublishTime) ||
+		stdErrors.Is(err, errors.ErrTooManyMentions) ||
+		stdErrors.Is(err, errors.ErrInvalidPoll) ||
+		stdErrors.Is(err, errors.ErrPollOptionTooLong) ||
+		stdErrors.Is(err, errors.ErrInvalidPollClose) ||
+		stdErrors.Is(err, errors.ErrContentRejected)
+}
+
+// Category operations
+func (s *ForumServer) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CategoryResponse, error) {
+	if s.categoryUC == nil {
+		return nil, status.Error(codes.Unimplemented, "категории не настроены")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	category := &entities.Category{
+		Slug:        req.Slug,
+		Title:       req.Title,
+		Description: req.Description,
+		Position:    req.Position,
+	}
+	if err := s.categoryUC.CreateCategory(ctx, category); err != nil {
+		return nil, categoryStatus(err, "не удалось создать категорию")
+	}
+	return &pb.CategoryResponse{Category: categoryToProto(category)}, nil
+}
+
+func (s *ForumServer) GetCategory(ctx context.Context, req *pb.GetCategoryRequest) (*pb.CategoryResponse, error) {
+	if s.categoryUC == nil {
+		return nil, status.Error(codes.Unimplemented, "категории не настроены")
+	}
+
+	category, err := s.categoryUC.GetCategoryByID(ctx, req.CategoryId)
+	if err != nil {
+		return nil, categoryStatus(err, "не удалось получить категорию")
+	}
+	return &pb.CategoryResponse{Category: categoryToProto(category)}, nil
+}
+
+func (s *ForumServer) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.CategoryResponse, error) {
+	if s.categoryUC == nil {
+		return nil, status.Error(codes.Unimplemented, "категории не настроены")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	category, err := s.categoryUC.GetCategoryByID(ctx, req.CategoryId)
+	if err != nil {
+		return nil, categoryStatus(err, "не удалось получить категорию")
+	}
+	if req.Slug != nil {
+		category.Slug = *req.Slug
+	}
+	if req.Title != nil {
+		category.Title = *req.Title
+	}
+	if req.Description != nil {
+		category.Description = *req.Description
+	}
+	if req.Position != nil {
+		category.Position = *req.Position
+	}
+
+	if err := s.categoryUC.UpdateCategory(ctx, category); err != nil {
+		return nil, categoryStatus(err, "не удалось обновить категорию")
+	}
+	return &pb.CategoryResponse{Category: categoryToProto(category)}, nil
+}
+
+func (s *ForumServer) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.EmptyMessage, error) {
+	if s.categoryUC == nil {
+		return nil, status.Error(codes.Unimplemented, "категории не настроены")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.categoryUC.DeleteCategory(ctx, req.CategoryId); err != nil {
+		return nil, categoryStatus(err, "не удалось удалить категорию")
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *ForumServer) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	if s.categoryUC == nil {
+		return nil, status.Error(codes.Unimplemented, "категории не настроены")
+	}
+
+	categories, err := s.categoryUC.Categories(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить категории")
+	}
+
+	pbCategories := make([]*pb.Category, len(categories))
+	for i, category := range categories {
+		pbCategories[i] = categoryToProto(category)
+	}
+	return &pb.ListCategoriesResponse{Categories: pbCategories}, nil
+}
+
+func categoryToProto(category *entities.Category) *pb.Category {
+	return &pb.Category{
+		Id:          category.ID,
+		Slug:        category.Slug,
+		Title:       category.Title,
+		Description: category.Description,
+		Position:    category.Position,
+	}
+}
+
+// categoryStatus переводит ошибки категорий в gRPC-статусы
+func categoryStatus(err error, msg string) error {
+	switch {
+	case stdErrors.Is(err, errors.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case stdErrors.Is(err, errors.ErrDuplicateSlug):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case stdErrors.Is(err, errors.ErrInvalidSlug), stdErrors.Is(err, errors.ErrEmptyTitle):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, msg)
+	}
+}
+
 // Comment operations
 func (s *ForumServer) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CommentResponse, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if req.Content == "" {
 		return nil, status.Error(codes.InvalidArgument, "содержание комментария обязательно")
 	}
 
 	comment := &entities.Comment{
 		Content:    req.Content,
-		AuthorID:   req.AuthorId,
+		AuthorID:   user.ID,
 		PostID:     req.PostId,
-		AuthorName: req.AuthorUsername,
+		ParentID:   req.ParentId,
+		AuthorName: user.Username,
 	}
 
-	err := s.commentUC.CreateComment(ctx, comment)
-	if err != nil {
+	err = s.commentUC.CreateComment(ctx, comment)
+	switch {
+	case stdErrors.Is(err, errors.ErrParentNotFound),
+		stdErrors.Is(err, errors.ErrReplyPostMismatch),
+		stdErrors.Is(err, errors.ErrReplyToDeleted),
+		stdErrors.Is(err, errors.ErrCommentTooDeep),
+		stdErrors.Is(err, errors.ErrTooManyMentions),
+		stdErrors.Is(err, errors.ErrContentRejected):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case stdErrors.Is(err, errors.ErrPostNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case stdErrors.Is(err, errors.ErrPostLocked):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
 		return nil, status.Error(codes.Internal, "не удалось создать комментарий")
 	}
 
-	return &pb.CommentResponse{
-		Comment: &pb.Comment{
-			Id:             comment.ID,
-			Content:        comment.Content,
-			AuthorId:       comment.AuthorID,
-			AuthorUsername: comment.AuthorName,
-			PostId:         comment.PostID,
-			CreatedAt:      comment.CreatedAt.Unix(),
-		},
-	}, nil
+	return &pb.CommentResponse{Comment: commentToProto(comment)}, nil
 }
 
 func (s *ForumServer) GetCommentByID(ctx context.Context, req *pb.GetCommentRequest) (*pb.CommentResponse, error) {
@@ -211,88 +758,1490 @@ func (s *ForumServer) GetCommentByID(ctx context.Context, req *pb.GetCommentRequ
 		return nil, status.Error(codes.InvalidArgument, "идентификатор комментария обязателен")
 	}
 	comment, err := s.commentUC.GetCommentByID(ctx, req.CommentId)
+	if stdErrors.Is(err, errors.ErrCommentNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "не удалось получить комментарий")
 	}
-	return &pb.CommentResponse{
-		Comment: &pb.Comment{
-			Id:             comment.ID,
-			Content:        comment.Content,
-			AuthorId:       comment.AuthorID,
-			AuthorUsername: comment.AuthorName,
-			PostId:         comment.PostID,
-			CreatedAt:      comment.CreatedAt.Unix(),
-		},
-	}, nil
+	if err := s.fillCommentAttachments(ctx, []*entities.Comment{comment}); err != nil {
+		return nil, err
+	}
+	return &pb.CommentResponse{Comment: commentToProto(comment)}, nil
 }
 
 func (s *ForumServer) Comments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
 	if req.PostId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "идентификатор поста обязателен")
 	}
+	return s.listComments(ctx, req.PostId, req.View, viewerID(ctx))
+}
+
+func (s *ForumServer) UpdateComment(ctx context.Context, req *pb.UpdateCommentRequest) (*pb.CommentResponse, error) {
+	if req.CommentId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "идентификатор комментария обязателен")
+	}
 
-	comments, err := s.commentUC.GetByPostID(ctx, req.PostId)
+	user, err := currentUser(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "не удалось получить комментарии")
+		return nil, err
+	}
+	comment, err := s.liveComment(ctx, req.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, user.ID, comment.AuthorID); err != nil {
+		return nil, err
 	}
+	comment.Content = req.GetContent()
+	comment.EditorID = user.ID
 
-	pbComments := make([]*pb.Comment, len(comments))
-	for i, comment := range comments {
-		pbComments[i] = &pb.Comment{
-			Id:             comment.ID,
-			Content:        comment.Content,
-			AuthorId:       comment.AuthorID,
-			AuthorUsername: comment.AuthorName,
-			PostId:         comment.PostID,
-			CreatedAt:      comment.CreatedAt.Unix(),
-		}
+	err = s.commentUC.UpdateComment(ctx, comment)
+	if stdErrors.Is(err, errors.ErrCommentNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if stdErrors.Is(err, errors.ErrTooManyMentions) || stdErrors.Is(err, errors.ErrContentRejected) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось обновить комментарий")
 	}
 
-	return &pb.ListCommentsResponse{
-		Comments: pbComments,
-	}, nil
+	return &pb.CommentResponse{Comment: commentToProto(comment)}, nil
 }
 
-func (s *ForumServer) UpdateComment(ctx context.Context, req *pb.UpdateCommentRequest) (*pb.CommentResponse, error) {
+func (s *ForumServer) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.EmptyMessage, error) {
 	if req.CommentId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "идентификатор комментария обязателен")
 	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := s.liveComment(ctx, req.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, user.ID, comment.AuthorID); err != nil {
+		return nil, err
+	}
 
-	comment := &entities.Comment{
-		ID:      req.CommentId,
-		Content: req.GetContent(),
+	if err := s.commentUC.DeleteComment(ctx, req.CommentId, user.ID, req.Reason); err != nil {
+		return nil, trashStatus(err, "не удалось удалить комментарий")
 	}
+	return &pb.EmptyMessage{}, nil
+}
 
-	err := s.commentUC.UpdateComment(ctx, comment)
+// livePost загружает неудалённый пост для правки или удаления
+func (s *ForumServer) livePost(ctx context.Context, id int64) (*entities.Post, error) {
+	post, err := s.postUC.GetPostByID(ctx, id)
+	if stdErrors.Is(err, errors.ErrPostNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
 	if err != nil {
-		return nil, status.Error(codes.Internal, "не удалось обновить комментарий")
+		return nil, status.Error(codes.Internal, "не удалось получить пост")
+	}
+	if post.Deleted {
+		return nil, status.Error(codes.NotFound, errors.ErrPostNotFound.Error())
 	}
+	return post, nil
+}
 
-	return &pb.CommentResponse{
-		Comment: &pb.Comment{
-			Id:             comment.ID,
-			Content:        comment.Content,
-			AuthorId:       comment.AuthorID,
-			AuthorUsername: comment.AuthorName,
-			PostId:         comment.PostID,
-			CreatedAt:      comment.CreatedAt.Unix(),
-		},
-	}, nil
+// liveComment загружает неудалённый комментарий для правки или удаления
+func (s *ForumServer) liveComment(ctx context.Context, id int64) (*entities.Comment, error) {
+	comment, err := s.commentUC.GetCommentByID(ctx, id)
+	if stdErrors.Is(err, errors.ErrCommentNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить комментарий")
+	}
+	if comment.Deleted {
+		return nil, status.Error(codes.NotFound, errors.ErrCommentNotFound.Error())
+	}
+	return comment, nil
 }
 
-// Chat operations
-func (s *ForumServer) SendMessage(ctx context.Context, req *pb.ChatMessage) (*pb.EmptyMessage, error) {
-	if req.Content == "" {
-		return nil, status.Error(codes.InvalidArgument, "содержание сообщения обязательно")
+// currentUser возвращает пользователя, подтверждённого перехватчиком авторизации
+func currentUser(ctx context.Context) (User, error) {
+	user, ok := UserFromContext(ctx)
+	if !ok {
+		return User{}, status.Error(codes.Unauthenticated, errors.ErrNotAuthorized.Error())
 	}
+	return user, nil
+}
 
-	msg := &entities.ChatMessage{
-		UserID:    req.UserId,
-		Content:   req.Content,
-		CreatedAt: time.Now(),
+// viewerID возвращает ID вошедшего пользователя или 0 для анонимного запроса
+func viewerID(ctx context.Context) int64 {
+	user, _ := UserFromContext(ctx)
+	return user.ID
+}
+
+// viewerKey определяет зрителя для учёта просмотров: вошедшего пользователя,
+// а для анонимного запроса — IP клиента
+func viewerKey(ctx context.Context) string {
+	if id := viewerID(ctx); id != 0 {
+		return "user:" + strconv.FormatInt(id, 10)
+	}
+	if ip := clientIP(ctx); ip != "" {
+		return "ip:" + ip
+	}
+	return ""
+}
+
+// authorize пропускает правку или удаление контента автора ownerID, только если
+// это разрешает политика доступа
+func (s *ForumServer) authorize(ctx context.Context, actorID, ownerID int64) error {
+	err := s.policy.CanModify(ctx, actorID, ownerID)
+	if stdErrors.Is(err, errors.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "не удалось проверить права доступа")
 	}
+	return nil
+}
 
-	err := s.chatUC.SendMessage(ctx, msg)
+// requireAdmin пропускает только администраторов
+func (s *ForumServer) requireAdmin(ctx context.Context) error {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	isAdmin, err := s.policy.IsAdmin(ctx, user.ID)
+	if err != nil {
+		return status.Error(codes.Internal, "не удалось проверить права доступа")
+	}
+	if !isAdmin {
+		return status.Error(codes.PermissionDenied, errors.ErrPermissionDenied.Error())
+	}
+	return nil
+}
+
+// PinPost закрепляет пост в начале ленты или снимает закрепление
+func (s *ForumServer) PinPost(ctx context.Context, req *pb.PinPostRequest) (*pb.PostResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	post, err := s.postUC.PinPost(ctx, req.PostId, req.Pinned)
+	if stdErrors.Is(err, errors.ErrPostNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось закрепить пост")
+	}
+	return &pb.PostResponse{Post: postToProto(post)}, nil
+}
+
+// LockPost закрывает пост для новых комментариев или открывает снова
+func (s *ForumServer) LockPost(ctx context.Context, req *pb.LockPostRequest) (*pb.PostResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	post, err := s.postUC.LockPost(ctx, req.PostId, req.Locked)
+	if stdErrors.Is(err, errors.ErrPostNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось закрыть пост")
+	}
+	return &pb.PostResponse{Post: postToProto(post)}, nil
+}
+
+// Trash operations
+func (s *ForumServer) ListTrash(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "параметры страницы не могут быть отрицательными")
+	}
+
+	resp := &pb.ListTrashResponse{}
+	switch req.Target {
+	case pb.TrashTarget_TRASH_COMMENTS:
+		comments, err := s.commentUC.DeletedComments(ctx, int(req.Limit), int(req.Offset))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "не удалось получить корзину комментариев")
+		}
+		for _, comment := range comments {
+			resp.Comments = append(resp.Comments, commentToProto(comment))
+		}
+	default:
+		posts, err := s.postUC.DeletedPosts(ctx, int(req.Limit), int(req.Offset))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "не удалось получить корзину постов")
+		}
+		for _, post := range posts {
+			resp.Posts = append(resp.Posts, postToProto(post))
+		}
+	}
+	return resp, nil
+}
+
+func (s *ForumServer) RestorePost(ctx context.Context, req *pb.RestoreRequest) (*pb.PostResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	post, err := s.postUC.RestorePost(ctx, req.TargetId)
+	if err != nil {
+		return nil, trashStatus(err, "не удалось восстановить пост")
+	}
+	return &pb.PostResponse{Post: postToProto(post)}, nil
+}
+
+func (s *ForumServer) RestoreComment(ctx context.Context, req *pb.RestoreRequest) (*pb.CommentResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	comment, err := s.commentUC.RestoreComment(ctx, req.TargetId)
+	if err != nil {
+		return nil, trashStatus(err, "не удалось восстановить комментарий")
+	}
+	return &pb.CommentResponse{Comment: commentToProto(comment)}, nil
+}
+
+// trashStatus переводит ошибки удаления и восстановления в коды gRPC
+func trashStatus(err error, internal string) error {
+	switch {
+	case stdErrors.Is(err, errors.ErrPostNotFound), stdErrors.Is(err, errors.ErrCommentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case stdErrors.Is(err, errors.ErrReasonTooLong):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, internal)
+	}
+}
+
+func holdToProto(hold *entities.Hold) *pb.Hold {
+	if hold == nil {
+		return nil
+	}
+	return &pb.Hold{
+		Reason: hold.Reason,
+		HeldAt: hold.HeldAt.Unix(),
+	}
+}
+
+func deletionToProto(deletion *entities.Deletion) *pb.Deletion {
+	if deletion == nil {
+		return nil
+	}
+	return &pb.Deletion{
+		DeletedAt: deletion.DeletedAt.Unix(),
+		DeletedBy: deletion.DeletedBy,
+		Reason:    deletion.Reason,
+	}
+}
+
+// Report operations
+func (s *ForumServer) Report(ctx context.Context, req *pb.ReportRequest) (*pb.ReportResponse, error) {
+	if s.reportUC == nil {
+		return nil, status.Error(codes.Unimplemented, "жалобы не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TargetType == pb.ReportTarget_REPORT_TARGET_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "не указан тип цели жалобы")
+	}
+
+	report := &entities.Report{
+		TargetType: reportTargetFromProto(req.TargetType),
+		TargetID:   req.TargetId,
+		ReporterID: user.ID,
+		Reason:     reportReasonFromProto(req.Reason),
+		Details:    req.Details,
+	}
+	if err := s.reportUC.Report(ctx, report); err != nil {
+		return nil, reportStatus(err, "не удалось сохранить жалобу")
+	}
+	return &pb.ReportResponse{Report: reportToProto(report)}, nil
+}
+
+// ListReports возвращает очередь модерации: жалобы, сгруппированные по целям
+func (s *ForumServer) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
+	if s.reportUC == nil {
+		return nil, status.Error(codes.Unimplemented, "жалобы не настроены")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "параметры страницы не могут быть отрицательными")
+	}
+
+	filter := entities.ReportFilter{
+		Status: reportStatusFromProto(req.Status),
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	}
+	if req.TargetType != nil {
+		if *req.TargetType == pb.ReportTarget_REPORT_TARGET_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, "не указан тип цели жалобы")
+		}
+		filter.TargetType = reportTargetFromProto(*req.TargetType)
+	}
+	groups, err := s.reportUC.Reports(ctx, filter)
+	if err != nil {
+		return nil, reportStatus(err, "не удалось получить жалобы")
+	}
+
+	resp := &pb.ListReportsResponse{Groups: make([]*pb.ReportGroup, len(groups))}
+	for i, group := range groups {
+		pbGroup := &pb.ReportGroup{
+			TargetType:     reportTargetToProto(group.TargetType),
+			TargetId:       group.TargetID,
+			TargetAuthorId: group.TargetAuthorID,
+			Count:          group.Count,
+			LastReportedAt: group.LastReportedAt.Unix(),
+			Reports:        make([]*pb.Report, len(group.Reports)),
+		}
+		for j, report := range group.Reports {
+			pbGroup.Reports[j] = reportToProto(report)
+		}
+		resp.Groups[i] = pbGroup
+	}
+	return resp, nil
+}
+
+// ResolveReport закрывает жалобы на цель: отклоняет их, удаляет контент или
+// выносит автору предупреждение
+func (s *ForumServer) ResolveReport(ctx context.Context, req *pb.ResolveReportRequest) (*pb.ResolveReportResponse, error) {
+	if s.reportUC == nil {
+		return nil, status.Error(codes.Unimplemented, "жалобы не настроены")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result string
+	switch req.Action {
+	case pb.ReportAction_REPORT_ACTION_DISMISS:
+		result = entities.ReportStatusDismissed
+	case pb.ReportAction_REPORT_ACTION_DELETE:
+		result = entities.ReportStatusRemoved
+	case pb.ReportAction_REPORT_ACTION_WARN:
+		result = entities.ReportStatusWarned
+	default:
+		return nil, status.Error(codes.InvalidArgument, "не указано решение по жалобе")
+	}
+	resolved, err := s.reportUC.Resolve(ctx, req.ReportId, result, user.ID, req.Note)
+	if err != nil {
+		return nil, reportStatus(err, "не удалось рассмотреть жалобу")
+	}
+	return &pb.ResolveReportResponse{Resolved: int32(resolved)}, nil
+}
+
+func (s *ForumServer) ListMyWarnings(ctx context.Context, req *pb.ListWarningsRequest) (*pb.ListWarningsResponse, error) {
+	if s.reportUC == nil {
+		return nil, status.Error(codes.Unimplemented, "жалобы не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "параметры страницы не могут быть отрицательными")
+	}
+
+	warnings, err := s.reportUC.Warnings(ctx, user.ID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить предупреждения")
+	}
+	resp := &pb.ListWarningsResponse{Warnings: make([]*pb.Warning, len(warnings))}
+	for i, warning := range warnings {
+		resp.Warnings[i] = &pb.Warning{
+			Id:        warning.ID,
+			ReportId:  warning.ReportID,
+			Reason:    reportReasonToProto(warning.Reason),
+			Note:      warning.Note,
+			CreatedAt: warning.CreatedAt.Unix(),
+		}
+	}
+	return resp, nil
+}
+
+// ListHeld возвращает очередь текстов, задержанных фильтрами
+func (s *ForumServer) ListHeld(ctx context.Context, req *pb.ListHeldRequest) (*pb.ListHeldResponse, error) {
+	if s.moderateUC == nil {
+		return nil, status.Error(codes.Unimplemented, "модерация не настроена")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "параметры страницы не могут быть отрицательными")
+	}
+
+	items, err := s.moderateUC.Held(ctx, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить очередь модерации")
+	}
+	resp := &pb.ListHeldResponse{Items: make([]*pb.HeldContent, len(items))}
+	for i, held := range items {
+		resp.Items[i] = heldToProto(held)
+	}
+	return resp, nil
+}
+
+// ModerateHeld одобряет или отклоняет задержанный текст
+func (s *ForumServer) ModerateHeld(ctx context.Context, req *pb.ModerateHeldRequest) (*pb.ModerateHeldResponse, error) {
+	if s.moderateUC == nil {
+		return nil, status.Error(codes.Unimplemented, "модерация не настроена")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var held *entities.HeldContent
+	switch req.Decision {
+	case pb.HeldDecision_HELD_DECISION_APPROVE:
+		held, err = s.moderateUC.Approve(ctx, req.HeldId, user.ID)
+	case pb.HeldDecision_HELD_DECISION_REJECT:
+		held, err = s.moderateUC.Reject(ctx, req.HeldId, user.ID, req.Reason)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "не указано решение по тексту")
+	}
+	switch {
+	case stdErrors.Is(err, errors.ErrReasonTooLong):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case stdErrors.Is(err, errors.ErrHeldNotFound),
+		stdErrors.Is(err, errors.ErrPostNotFound),
+		stdErrors.Is(err, errors.ErrCommentNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case stdErrors.Is(err, errors.ErrPostLocked),
+		stdErrors.Is(err, errors.ErrCategoryNotFound):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, "не удалось рассмотреть текст")
+	}
+	return &pb.ModerateHeldResponse{Held: heldToProto(held)}, nil
+}
+
+func heldToProto(held *entities.HeldContent) *pb.HeldContent {
+	pbHeld := &pb.HeldContent{
+		Id:             held.ID,
+		TargetType:     pb.HeldTarget_HELD_TARGET_POST,
+		TargetId:       held.TargetID,
+		PostId:         held.PostID,
+		IsEdit:         held.IsEdit,
+		AuthorId:       held.AuthorID,
+		AuthorUsername: held.AuthorName,
+		Title:          held.Title,
+		Content:        held.Content,
+		ContentHtml:    held.ContentHTML,
+		Tags:           held.Tags,
+		Reason:         held.Reason,
+		CreatedAt:      held.CreatedAt.Unix(),
+	}
+	if held.TargetType == repository.TargetTypeComment {
+		pbHeld.TargetType = pb.HeldTarget_HELD_TARGET_COMMENT
+	}
+	if held.ParentID != nil {
+		pbHeld.ParentId = *held.ParentID
+	}
+	if held.CategoryID != nil {
+		pbHeld.CategoryId = *held.CategoryID
+	}
+	return pbHeld
+}
+
+// reportStatus переводит ошибки жалоб в коды gRPC
+func reportStatus(err error, internal string) error {
+	switch {
+	case stdErrors.Is(err, errors.ErrInvalidReportTarget),
+		stdErrors.Is(err, errors.ErrInvalidReportReason),
+		stdErrors.Is(err, errors.ErrReportDetailsMissing),
+		stdErrors.Is(err, errors.ErrReportDetailsTooLong),
+		stdErrors.Is(err, errors.ErrInvalidReportStatus),
+		stdErrors.Is(err, errors.ErrReasonTooLong):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case stdErrors.Is(err, errors.ErrPostNotFound),
+		stdErrors.Is(err, errors.ErrCommentNotFound),
+		stdErrors.Is(err, errors.ErrMessageNotFound),
+		stdErrors.Is(err, errors.ErrReportNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case stdErrors.Is(err, errors.ErrAlreadyReported):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case stdErrors.Is(err, errors.ErrReportResolved):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, internal)
+	}
+}
+
+func reportTargetFromProto(t pb.ReportTarget) string {
+	switch t {
+	case pb.ReportTarget_REPORT_TARGET_COMMENT:
+		return repository.TargetTypeComment
+	case pb.ReportTarget_REPORT_TARGET_CHAT_MESSAGE:
+		return repository.TargetTypeChat
+	case pb.ReportTarget_REPORT_TARGET_POST:
+		return repository.TargetTypePost
+	default:
+		return ""
+	}
+}
+
+func reportTargetToProto(t string) pb.ReportTarget {
+	switch t {
+	case repository.TargetTypeComment:
+		return pb.ReportTarget_REPORT_TARGET_COMMENT
+	case repository.TargetTypeChat:
+		return pb.ReportTarget_REPORT_TARGET_CHAT_MESSAGE
+	case repository.TargetTypePost:
+		return pb.ReportTarget_REPORT_TARGET_POST
+	default:
+		return pb.ReportTarget_REPORT_TARGET_UNSPECIFIED
+	}
+}
+
+// reportReasons сопоставляет причины жалоб в proto и в БД
+var reportReasons = map[pb.ReportReason]string{
+	pb.ReportReason_REPORT_REASON_SPAM:      entities.ReportReasonSpam,
+	pb.ReportReason_REPORT_REASON_ABUSE:     entities.ReportReasonAbuse,
+	pb.ReportReason_REPORT_REASON_OFF_TOPIC: entities.ReportReasonOffTopic,
+	pb.ReportReason_REPORT_REASON_ILLEGAL:   entities.ReportReasonIllegal,
+	pb.ReportReason_REPORT_REASON_OTHER:     entities.ReportReasonOther,
+}
+
+// reportReasonFromProto возвращает пустую строку для REPORT_REASON_UNSPECIFIED,
+// её отклоняет usecase
+func reportReasonFromProto(reason pb.ReportReason) string {
+	return reportReasons[reason]
+}
+
+func reportReasonToProto(reason string) pb.ReportReason {
+	for pbReason, r := range reportReasons {
+		if r == reason {
+			return pbReason
+		}
+	}
+	return pb.ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+// reportStatuses сопоставляет статусы жалоб в proto и в БД
+var reportStatuses = map[pb.ReportStatus]string{
+	pb.ReportStatus_REPORT_STATUS_OPEN:      entities.ReportStatusOpen,
+	pb.ReportStatus_REPORT_STATUS_DISMISSED: entities.ReportStatusDismissed,
+	pb.ReportStatus_REPORT_STATUS_REMOVED:   entities.ReportStatusRemoved,
+	pb.ReportStatus_REPORT_STATUS_WARNED:    entities.ReportStatusWarned,
+}
+
+// reportStatusFromProto возвращает пустую строку для REPORT_STATUS_UNSPECIFIED
+// (usecase подставит открытые жалобы) и для неизвестного статуса — значение,
+// которое отклонит usecase
+func reportStatusFromProto(st pb.ReportStatus) string {
+	if st == pb.ReportStatus_REPORT_STATUS_UNSPECIFIED {
+		return ""
+	}
+	if result, ok := reportStatuses[st]; ok {
+		return result
+	}
+	return "unknown"
+}
+
+func reportStatusToProto(st string) pb.ReportStatus {
+	for pbStatus, result := range reportStatuses {
+		if result == st {
+			return pbStatus
+		}
+	}
+	return pb.ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func reportToProto(report *entities.Report) *pb.Report {
+	pbReport := &pb.Report{
+		Id:             report.ID,
+		TargetType:     reportTargetToProto(report.TargetType),
+		TargetId:       report.TargetID,
+		TargetAuthorId: report.TargetAuthorID,
+		TargetContent:  report.TargetContent,
+		ReporterId:     report.ReporterID,
+		Reason:         reportReasonToProto(report.Reason),
+		Details:        report.Details,
+		Status:         reportStatusToProto(report.Status),
+		ResolvedBy:     report.ResolvedBy,
+		ResolutionNote: report.ResolutionNote,
+		CreatedAt:      report.CreatedAt.Unix(),
+	}
+	if report.ResolvedAt != nil {
+		pbReport.ResolvedAt = report.ResolvedAt.Unix()
+	}
+	return pbReport
+}
+
+// Search operations
+func (s *ForumServer) SearchPosts(ctx context.Context, req *pb.SearchPostsRequest) (*pb.SearchPostsResponse, error) {
+	if s.searchUC == nil {
+		return nil, status.Error(codes.Unimplemented, "поиск не настроен")
+	}
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "размер страницы не может быть отрицательным")
+	}
+
+	result, err := s.searchUC.Search(ctx, entities.SearchQuery{
+		Text:     req.Query,
+		AuthorID: req.AuthorId,
+		Limit:    int(req.Limit),
+		Offset:   int(req.Offset),
+	})
+	if stdErrors.Is(err, errors.ErrEmptySearchQuery) || stdErrors.Is(err, errors.ErrSearchTooDeep) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось выполнить поиск")
+	}
+
+	hits := make([]*pb.SearchHit, len(result.Hits))
+	for i, hit := range result.Hits {
+		hitType := pb.SearchHitType_SEARCH_HIT_POST
+		if hit.TargetType == repository.TargetTypeComment {
+			hitType = pb.SearchHitType_SEARCH_HIT_COMMENT
+		}
+		hits[i] = &pb.SearchHit{
+			Type:           hitType,
+			PostId:         hit.PostID,
+			CommentId:      hit.CommentID,
+			Title:          hit.Title,
+			Snippet:        hit.Snippet,
+			AuthorId:       hit.AuthorID,
+			AuthorUsername: hit.AuthorName,
+			CreatedAt:      hit.CreatedAt.Unix(),
+			Rank:           hit.Rank,
+		}
+	}
+
+	return &pb.SearchPostsResponse{
+		Hits:       hits,
+		TotalCount: int32(result.TotalCount),
+	}, nil
+}
+
+// Vote operations
+func (s *ForumServer) Vote(ctx context.Context, req *pb.VoteRequest) (*pb.VoteResponse, error) {
+	if s.voteUC == nil {
+		return nil, status.Error(codes.Unimplemented, "голосование не настроено")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	score, err := s.voteUC.Vote(ctx, &entities.Vote{
+		UserID:     user.ID,
+		TargetType: voteTargetType(req.TargetType),
+		TargetID:   req.TargetId,
+		Value:      req.Value,
+	})
+	if err != nil {
+		return nil, voteStatus(err)
+	}
+	return &pb.VoteResponse{Score: score, MyVote: req.Value}, nil
+}
+
+func (s *ForumServer) RemoveVote(ctx context.Context, req *pb.RemoveVoteRequest) (*pb.VoteResponse, error) {
+	if s.voteUC == nil {
+		return nil, status.Error(codes.Unimplemented, "голосование не настроено")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	score, err := s.voteUC.RemoveVote(ctx, user.ID, voteTargetType(req.TargetType), req.TargetId)
+	if err != nil {
+		return nil, voteStatus(err)
+	}
+	return &pb.VoteResponse{Score: score}, nil
+}
+
+func voteTargetType(t pb.VoteTargetType) string {
+	if t == pb.VoteTargetType_VOTE_TARGET_COMMENT {
+		return repository.TargetTypeComment
+	}
+	return repository.TargetTypePost
+}
+
+// voteStatus переводит ошибки голосования в gRPC-статусы
+func voteStatus(err error) error {
+	switch {
+	case stdErrors.Is(err, errors.ErrInvalidVote), stdErrors.Is(err, errors.ErrEmptyTargetID):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case stdErrors.Is(err, errors.ErrSelfVote):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case stdErrors.Is(err, errors.ErrPostNotFound), stdErrors.Is(err, errors.ErrCommentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, "не удалось проголосовать")
+	}
+}
+
+// Poll operations
+func (s *ForumServer) CastPollVote(ctx context.Context, req *pb.CastPollVoteRequest) (*pb.PollResponse, error) {
+	if s.pollUC == nil {
+		return nil, status.Error(codes.Unimplemented, "опросы не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.pollUC.CastVote(ctx, req.PostId, user.ID, user.Username, req.OptionIds)
+	if err != nil {
+		return nil, pollStatus(err)
+	}
+	return &pb.PollResponse{Poll: pollToProto(poll, time.Now())}, nil
+}
+
+func (s *ForumServer) GetPollResults(ctx context.Context, req *pb.GetPollResultsRequest) (*pb.PollResponse, error) {
+	if s.pollUC == nil {
+		return nil, status.Error(codes.Unimplemented, "опросы не настроены")
+	}
+
+	poll, err := s.pollUC.Results(ctx, req.PostId, viewerID(ctx))
+	if err != nil {
+		return nil, pollStatus(err)
+	}
+	return &pb.PollResponse{Poll: pollToProto(poll, time.Now())}, nil
+}
+
+// pollStatus переводит ошибки опросов в gRPC-статусы
+func pollStatus(err error) error {
+	switch {
+	case stdErrors.Is(err, errors.ErrInvalidPollChoice):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case stdErrors.Is(err, errors.ErrPollNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case stdErrors.Is(err, errors.ErrPollClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case stdErrors.Is(err, errors.ErrPollAlreadyVoted):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Error(codes.Internal, "не удалось обработать опрос")
+	}
+}
+
+func pollFromProto(in *pb.PollInput) *entities.Poll {
+	poll := &entities.Poll{
+		Options:        make([]*entities.PollOption, len(in.Options)),
+		MultipleChoice: in.MultipleChoice,
+		Public:         in.Public,
+	}
+	for i, text := range in.Options {
+		poll.Options[i] = &entities.PollOption{Text: text}
+	}
+	if in.ClosesAt != 0 {
+		closesAt := time.Unix(in.ClosesAt, 0)
+		poll.ClosesAt = &closesAt
+	}
+	return poll
+}
+
+func pollToProto(poll *entities.Poll, now time.Time) *pb.Poll {
+	if poll == nil {
+		return nil
+	}
+	pbPoll := &pb.Poll{
+		Options:        make([]*pb.PollOption, len(poll.Options)),
+		MultipleChoice: poll.MultipleChoice,
+		Public:         poll.Public,
+		Closed:         poll.Closed(now),
+		TotalVoters:    poll.TotalVoters,
+		MyChoices:      poll.MyChoices,
+	}
+	if poll.ClosesAt != nil {
+		pbPoll.ClosesAt = poll.ClosesAt.Unix()
+	}
+	for i, option := range poll.Options {
+		pbOption := &pb.PollOption{Id: option.ID, Text: option.Text, Votes: option.Votes}
+		for _, voter := range option.Voters {
+			pbOption.Voters = append(pbOption.Voters, &pb.UserSummary{UserId: voter.UserID, Username: voter.Username})
+		}
+		pbPoll.Options[i] = pbOption
+	}
+	return pbPoll
+}
+
+// Bookmark operations
+func (s *ForumServer) AddBookmark(ctx context.Context, req *pb.AddBookmarkRequest) (*pb.BookmarkResponse, error) {
+	if s.bookmarkUC == nil {
+		return nil, status.Error(codes.Unimplemented, "закладки не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmark := &entities.Bookmark{UserID: user.ID, PostID: req.PostId, Note: req.Note}
+	if err := s.bookmarkUC.AddBookmark(ctx, bookmark); err != nil {
+		return nil, bookmarkStatus(err)
+	}
+	bookmark.Post.Bookmarked = true
+	if err := s.fillPostVotes(ctx, user.ID, []*entities.Post{bookmark.Post}); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostAttachments(ctx, []*entities.Post{bookmark.Post}); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostPolls(ctx, user.ID, []*entities.Post{bookmark.Post}); err != nil {
+		return nil, err
+	}
+	return &pb.BookmarkResponse{Bookmark: bookmarkToProto(bookmark)}, nil
+}
+
+func (s *ForumServer) RemoveBookmark(ctx context.Context, req *pb.RemoveBookmarkRequest) (*pb.EmptyMessage, error) {
+	if s.bookmarkUC == nil {
+		return nil, status.Error(codes.Unimplemented, "закладки не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.bookmarkUC.RemoveBookmark(ctx, user.ID, req.PostId); err != nil {
+		return nil, bookmarkStatus(err)
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+// ListBookmarks возвращает закладки вызывающего пользователя, недавние первыми
+func (s *ForumServer) ListBookmarks(ctx context.Context, req *pb.ListBookmarksRequest) (*pb.ListBookmarksResponse, error) {
+	if s.bookmarkUC == nil {
+		return nil, status.Error(codes.Unimplemented, "закладки не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "некорректные параметры страницы")
+	}
+
+	bookmarks, err := s.bookmarkUC.Bookmarks(ctx, user.ID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить закладки")
+	}
+	posts := make([]*entities.Post, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		posts[i] = bookmark.Post
+	}
+	if err := s.fillPostVotes(ctx, user.ID, posts); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostAttachments(ctx, posts); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostPolls(ctx, user.ID, posts); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListBookmarksResponse{Bookmarks: make([]*pb.Bookmark, len(bookmarks))}
+	for i, bookmark := range bookmarks {
+		resp.Bookmarks[i] = bookmarkToProto(bookmark)
+	}
+	return resp, nil
+}
+
+func bookmarkToProto(bookmark *entities.Bookmark) *pb.Bookmark {
+	return &pb.Bookmark{
+		Post:      postToProto(bookmark.Post),
+		Note:      bookmark.Note,
+		CreatedAt: bookmark.CreatedAt.Unix(),
+	}
+}
+
+// bookmarkStatus переводит ошибки закладок в gRPC-статусы
+func bookmarkStatus(err error) error {
+	switch {
+	case stdErrors.Is(err, errors.ErrNoteTooLong):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case stdErrors.Is(err, errors.ErrPostNotFound), stdErrors.Is(err, errors.ErrBookmarkNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, "не удалось обработать закладку")
+	}
+}
+
+// Notification operations
+func (s *ForumServer) FollowPost(ctx context.Context, req *pb.FollowPostRequest) (*pb.FollowPostResponse, error) {
+	return s.setSubscription(ctx, req.PostId, true)
+}
+
+func (s *ForumServer) UnfollowPost(ctx context.Context, req *pb.FollowPostRequest) (*pb.FollowPostResponse, error) {
+	return s.setSubscription(ctx, req.PostId, false)
+}
+
+func (s *ForumServer) setSubscription(ctx context.Context, postID int64, subscribed bool) (*pb.FollowPostResponse, error) {
+	if s.notifyUC == nil {
+		return nil, status.Error(codes.Unimplemented, "уведомления не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscribed {
+		err = s.notifyUC.Follow(ctx, user.ID, postID)
+	} else {
+		err = s.notifyUC.Unfollow(ctx, user.ID, postID)
+	}
+	if stdErrors.Is(err, errors.ErrPostNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось изменить подписку")
+	}
+	return &pb.FollowPostResponse{PostId: postID, Subscribed: subscribed}, nil
+}
+
+// ListNotifications возвращает уведомления вызывающего пользователя вместе с числом непрочитанных
+func (s *ForumServer) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	if s.notifyUC == nil {
+		return nil, status.Error(codes.Unimplemented, "уведомления не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "некорректные параметры страницы")
+	}
+
+	notifications, err := s.notifyUC.Notifications(ctx, user.ID, req.UnreadOnly, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить уведомления")
+	}
+	unread, err := s.notifyUC.UnreadCount(ctx, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить уведомления")
+	}
+
+	resp := &pb.ListNotificationsResponse{
+		Notifications: make([]*pb.Notification, len(notifications)),
+		UnreadCount:   unread,
+	}
+	for i, n := range notifications {
+		resp.Notifications[i] = notificationToProto(n)
+	}
+	return resp, nil
+}
+
+func (s *ForumServer) GetUnreadCount(ctx context.Context, _ *pb.EmptyMessage) (*pb.UnreadCountResponse, error) {
+	if s.notifyUC == nil {
+		return nil, status.Error(codes.Unimplemented, "уведомления не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.unreadCount(ctx, user.ID)
+}
+
+func (s *ForumServer) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.UnreadCountResponse, error) {
+	if s.notifyUC == nil {
+		return nil, status.Error(codes.Unimplemented, "уведомления не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.notifyUC.MarkRead(ctx, user.ID, req.Ids); err != nil {
+		return nil, status.Error(codes.Internal, "не удалось отметить уведомления")
+	}
+	return s.unreadCount(ctx, user.ID)
+}
+
+func (s *ForumServer) MarkAllRead(ctx context.Context, _ *pb.EmptyMessage) (*pb.UnreadCountResponse, error) {
+	if s.notifyUC == nil {
+		return nil, status.Error(codes.Unimplemented, "уведомления не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.notifyUC.MarkAllRead(ctx, user.ID); err != nil {
+		return nil, status.Error(codes.Internal, "не удалось отметить уведомления")
+	}
+	return s.unreadCount(ctx, user.ID)
+}
+
+func (s *ForumServer) unreadCount(ctx context.Context, userID int64) (*pb.UnreadCountResponse, error) {
+	unread, err := s.notifyUC.UnreadCount(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить число непрочитанных уведомлений")
+	}
+	return &pb.UnreadCountResponse{UnreadCount: unread}, nil
+}
+
+func notificationToProto(n *entities.Notification) *pb.Notification {
+	return &pb.Notification{
+		Id:            n.ID,
+		Type:          pb.NotificationType_NOTIFICATION_COMMENT,
+		PostId:        n.PostID,
+		PostTitle:     n.PostTitle,
+		CommentId:     n.CommentID,
+		ActorId:       n.ActorID,
+		ActorUsername: n.ActorName,
+		Count:         n.Count,
+		CreatedAt:     n.CreatedAt.Unix(),
+		UpdatedAt:     n.UpdatedAt.Unix(),
+		Read:          n.ReadAt != nil,
+	}
+}
+
+// ListMentions возвращает упоминания вызывающего пользователя, новые первыми
+func (s *ForumServer) ListMentions(ctx context.Context, req *pb.ListMentionsRequest) (*pb.ListMentionsResponse, error) {
+	if s.mentionUC == nil {
+		return nil, status.Error(codes.Unimplemented, "упоминания не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "некорректные параметры страницы")
+	}
+
+	mentions, err := s.mentionUC.UserMentions(ctx, user.ID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить упоминания")
+	}
+
+	resp := &pb.ListMentionsResponse{Mentions: make([]*pb.Mention, len(mentions))}
+	for i, m := range mentions {
+		resp.Mentions[i] = &pb.Mention{
+			TargetType: m.TargetType,
+			TargetId:   m.TargetID,
+			UserId:     m.UserID,
+			Username:   m.Username,
+			AuthorId:   m.AuthorID,
+			CreatedAt:  m.CreatedAt.Unix(),
+		}
+	}
+	return resp, nil
+}
+
+func (s *ForumServer) GetPostRevisions(ctx context.Context, req *pb.GetRevisionsRequest) (*pb.RevisionsResponse, error) {
+	if s.revisionUC == nil {
+		return nil, status.Error(codes.Unimplemented, "история правок не настроена")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.revisionUC.PostRevisions(ctx, req.TargetId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить историю правок")
+	}
+	return revisionsToProto(revisions), nil
+}
+
+func (s *ForumServer) GetCommentRevisions(ctx context.Context, req *pb.GetRevisionsRequest) (*pb.RevisionsResponse, error) {
+	if s.revisionUC == nil {
+		return nil, status.Error(codes.Unimplemented, "история правок не настроена")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.revisionUC.CommentRevisions(ctx, req.TargetId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "не удалось получить историю правок")
+	}
+	return revisionsToProto(revisions), nil
+}
+
+func (s *ForumServer) RollbackPost(ctx context.Context, req *pb.RollbackRequest) (*pb.PostResponse, error) {
+	if s.revisionUC == nil {
+		return nil, status.Error(codes.Unimplemented, "история правок не настроена")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.revisionUC.RollbackPost(ctx, req.TargetId, req.RevisionId, user.ID)
+	if err != nil {
+		return nil, rollbackStatus(err)
+	}
+	return &pb.PostResponse{Post: postToProto(post)}, nil
+}
+
+func (s *ForumServer) RollbackComment(ctx context.Context, req *pb.RollbackRequest) (*pb.CommentResponse, error) {
+	if s.revisionUC == nil {
+		return nil, status.Error(codes.Unimplemented, "история правок не настроена")
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.revisionUC.RollbackComment(ctx, req.TargetId, req.RevisionId, user.ID)
+	if err != nil {
+		return nil, rollbackStatus(err)
+	}
+	return &pb.CommentResponse{Comment: commentToProto(comment)}, nil
+}
+
+func rollbackStatus(err error) error {
+	switch {
+	case stdErrors.Is(err, errors.ErrRevisionNotFound),
+		stdErrors.Is(err, errors.ErrPostNotFound),
+		stdErrors.Is(err, errors.ErrCommentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case stdErrors.Is(err, errors.ErrEditHeld):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case isPostValidationError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "не удалось откатить правку")
+	}
+}
+
+var diffOps = map[diff.Op]pb.DiffOp{
+	diff.Equal:  pb.DiffOp_DIFF_EQUAL,
+	diff.Insert: pb.DiffOp_DIFF_INSERT,
+	diff.Delete: pb.DiffOp_DIFF_DELETE,
+}
+
+func revisionsToProto(revisions []*entities.Revision) *pb.RevisionsResponse {
+	resp := &pb.RevisionsResponse{Revisions: make([]*pb.Revision, 0, len(revisions))}
+	for _, rev := range revisions {
+		pbRev := &pb.Revision{
+			Id:        rev.ID,
+			TargetId:  rev.TargetID,
+			Title:     rev.Title,
+			Content:   rev.Content,
+			EditorId:  rev.EditorID,
+			CreatedAt: rev.CreatedAt.Unix(),
+		}
+		for _, line := range rev.Diff {
+			pbRev.Diff = append(pbRev.Diff, &pb.DiffLine{Op: diffOps[line.Op], Text: line.Text})
+		}
+		resp.Revisions = append(resp.Revisions, pbRev)
+	}
+	return resp
+}
+
+// fillPostVotes проставляет постам голос viewerID. Без голосования или для анонима ничего не делает.
+func (s *ForumServer) fillPostVotes(ctx context.Context, viewerID int64, posts []*entities.Post) error {
+	if s.voteUC == nil || viewerID == 0 || len(posts) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	votes, err := s.voteUC.UserVotes(ctx, viewerID, repository.TargetTypePost, ids)
+	if err != nil {
+		return status.Error(codes.Internal, "не удалось получить голоса")
+	}
+	for _, post := range posts {
+		post.MyVote = votes[post.ID]
+	}
+	return nil
+}
+
+// fillPostBookmarks отмечает посты из закладок viewerID. Без закладок или для анонима ничего не делает.
+func (s *ForumServer) fillPostBookmarks(ctx context.Context, viewerID int64, posts []*entities.Post) error {
+	if s.bookmarkUC == nil || viewerID == 0 || len(posts) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	bookmarked, err := s.bookmarkUC.Bookmarked(ctx, viewerID, ids)
+	if err != nil {
+		return status.Error(codes.Internal, "не удалось получить закладки")
+	}
+	for _, post := range posts {
+		post.Bookmarked = bookmarked[post.ID]
+	}
+	return nil
+}
+
+// fillCommentVotes проставляет голос viewerID комментариям, включая вложенные ответы
+func (s *ForumServer) fillCommentVotes(ctx context.Context, viewerID int64, comments []*entities.Comment) error {
+	if s.voteUC == nil || viewerID == 0 || len(comments) == 0 {
+		return nil
+	}
+
+	all := flattenComments(comments)
+	ids := make([]int64, len(all))
+	for i, comment := range all {
+		ids[i] = comment.ID
+	}
+	votes, err := s.voteUC.UserVotes(ctx, viewerID, repository.TargetTypeComment, ids)
+	if err != nil {
+		return status.Error(codes.Internal, "не удалось получить голоса")
+	}
+	for _, comment := range all {
+		comment.MyVote = votes[comment.ID]
+	}
+	return nil
+}
+
+// flattenComments собирает комментарии дерева вместе со всеми вложенными ответами
+func flattenComments(comments []*entities.Comment) []*entities.Comment {
+	var all []*entities.Comment
+	for _, comment := range comments {
+		all = append(all, comment)
+		all = append(all, flattenComments(comment.Replies)...)
+	}
+	return all
+}
+
+// Attachment operations
+func (s *ForumServer) UploadAttachment(ctx context.Context, req *pb.UploadAttachmentRequest) (*pb.AttachmentResponse, error) {
+	if s.attachUC == nil {
+		return nil, status.Error(codes.Unimplemented, "вложения не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := s.targetOwner(ctx, req.TargetType, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, user.ID, ownerID); err != nil {
+		return nil, err
+	}
+
+	att := &entities.Attachment{
+		TargetType: attachmentTargetType(req.TargetType),
+		TargetID:   req.TargetId,
+		UploaderID: user.ID,
+		FileName:   req.FileName,
+	}
+	if err := s.attachUC.Upload(ctx, att, req.Data); err != nil {
+		return nil, attachmentStatus(err, "не удалось загрузить вложение")
+	}
+	return &pb.AttachmentResponse{Attachment: attachmentToProto(att)}, nil
+}
+
+func (s *ForumServer) GetAttachment(ctx context.Context, req *pb.GetAttachmentRequest) (*pb.AttachmentContentResponse, error) {
+	if s.attachUC == nil {
+		return nil, status.Error(codes.Unimplemented, "вложения не настроены")
+	}
+	att, err := s.attachUC.Attachment(ctx, req.Id, viewerID(ctx))
+	if err != nil {
+		return nil, attachmentStatus(err, "не удалось получить вложение")
+	}
+	data, err := s.attachUC.Content(ctx, att, req.Thumbnail)
+	if err != nil {
+		return nil, attachmentStatus(err, "не удалось получить вложение")
+	}
+	return &pb.AttachmentContentResponse{Attachment: attachmentToProto(att), Data: data}, nil
+}
+
+func (s *ForumServer) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.EmptyMessage, error) {
+	if s.attachUC == nil {
+		return nil, status.Error(codes.Unimplemented, "вложения не настроены")
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	att, err := s.attachUC.Attachment(ctx, req.Id, user.ID)
+	if err != nil {
+		return nil, attachmentStatus(err, "не удалось получить вложение")
+	}
+	if err := s.authorize(ctx, user.ID, att.UploaderID); err != nil {
+		return nil, err
+	}
+	if err := s.attachUC.DeleteAttachment(ctx, att); err != nil {
+		return nil, attachmentStatus(err, "не удалось удалить вложение")
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+// targetOwner возвращает автора неудалённого поста или комментария, к которому прикрепляют файл
+func (s *ForumServer) targetOwner(ctx context.Context, targetType pb.AttachmentTarget, targetID int64) (int64, error) {
+	if targetID == 0 {
+		return 0, status.Error(codes.InvalidArgument, errors.ErrEmptyTargetID.Error())
+	}
+	if targetType == pb.AttachmentTarget_ATTACHMENT_TARGET_COMMENT {
+		comment, err := s.liveComment(ctx, targetID)
+		if err != nil {
+			return 0, err
+		}
+		return comment.AuthorID, nil
+	}
+	post, err := s.livePost(ctx, targetID)
+	if err != nil {
+		return 0, err
+	}
+	return post.AuthorID, nil
+}
+
+func attachmentTargetType(t pb.AttachmentTarget) string {
+	if t == pb.AttachmentTarget_ATTACHMENT_TARGET_COMMENT {
+		return repository.TargetTypeComment
+	}
+	return repository.TargetTypePost
+}
+
+// attachmentStatus переводит ошибки вложений в gRPC-статусы
+func attachmentStatus(err error, internal string) error {
+	switch {
+	case stdErrors.Is(err, errors.ErrEmptyFile),
+		stdErrors.Is(err, errors.ErrUnsupportedFileType),
+		stdErrors.Is(err, errors.ErrInvalidImage):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case stdErrors.Is(err, errors.ErrFileTooLarge), stdErrors.Is(err, errors.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case stdErrors.Is(err, errors.ErrAttachmentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, internal)
+	}
+}
+
+func attachmentToProto(att *entities.Attachment) *pb.Attachment {
+	pbAtt := &pb.Attachment{
+		Id:           att.ID,
+		TargetId:     att.TargetID,
+		UploaderId:   att.UploaderID,
+		FileName:     att.FileName,
+		ContentType:  att.ContentType,
+		Size:         att.Size,
+		HasThumbnail: att.ThumbnailKey != "",
+		Width:        att.Width,
+		Height:       att.Height,
+		CreatedAt:    att.CreatedAt.Unix(),
+	}
+	if att.TargetType == repository.TargetTypeComment {
+		pbAtt.TargetType = pb.AttachmentTarget_ATTACHMENT_TARGET_COMMENT
+	}
+	return pbAtt
+}
+
+func attachmentsToProto(attachments []*entities.Attachment) []*pb.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	pbAttachments := make([]*pb.Attachment, len(attachments))
+	for i, att := range attachments {
+		pbAttachments[i] = attachmentToProto(att)
+	}
+	return pbAttachments
+}
+
+// fillPostPolls проставляет неудалённым постам опросы с результатами и выбором viewerID
+func (s *ForumServer) fillPostPolls(ctx context.Context, viewerID int64, posts []*entities.Post) error {
+	if s.pollUC == nil {
+		return nil
+	}
+
+	var ids []int64
+	for _, post := range posts {
+		if !post.Deleted {
+			ids = append(ids, post.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	polls, err := s.pollUC.Polls(ctx, ids, viewerID)
+	if err != nil {
+		return status.Error(codes.Internal, "не удалось получить опросы")
+	}
+	for _, post := range posts {
+		post.Poll = polls[post.ID]
+	}
+	return nil
+}
+
+// fillPostAttachments проставляет вложения неудалённым постам
+func (s *ForumServer) fillPostAttachments(ctx context.Context, posts []*entities.Post) error {
+	if s.attachUC == nil {
+		return nil
+	}
+
+	var ids []int64
+	for _, post := range posts {
+		if !post.Deleted {
+			ids = append(ids, post.ID)
+		}
+	}
+	attachments, err := s.attachUC.Attachments(ctx, repository.TargetTypePost, ids)
+	if err != nil {
+		return status.Error(codes.Internal, "не удалось получить вложения")
+	}
+	for _, post := range posts {
+		post.Attachments = attachments[post.ID]
+	}
+	return nil
+}
+
+// fillCommentAttachments проставляет вложения неудалённым комментариям, включая вложенные ответы
+func (s *ForumServer) fillCommentAttachments(ctx context.Context, comments []*entities.Comment) error {
+	if s.attachUC == nil {
+		return nil
+	}
+
+	var ids []int64
+	all := flattenComments(comments)
+	for _, comment := range all {
+		if !comment.Deleted {
+			ids = append(ids, comment.ID)
+		}
+	}
+	attachments, err := s.attachUC.Attachments(ctx, repository.TargetTypeComment, ids)
+	if err != nil {
+		return status.Error(codes.Internal, "не удалось получить вложения")
+	}
+	for _, comment := range all {
+		comment.Attachments = attachments[comment.ID]
+	}
+	return nil
+}
+
+// Chat operations
+func (s *ForumServer) SendMessage(ctx context.Context, req *pb.ChatMessage) (*pb.EmptyMessage, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "содержание сообщения обязательно")
+	}
+
+	msg := &entities.ChatMessage{
+		UserID:    user.ID,
+		Username:  user.Username,
+		Content:   req.Content,
+		CreatedAt: time.Now(),
+	}
+
+	err = s.chatUC.SendMessage(ctx, msg)
+	if stdErrors.Is(err, errors.ErrTooManyMentions) || stdErrors.Is(err, errors.ErrContentRejected) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "не удалось отправить сообщение")
 	}
@@ -309,6 +2258,7 @@ func (s *ForumServer) GetMessages(ctx context.Context, req *pb.GetMessagesReques
 	pbMessages := make([]*pb.ChatMessage, len(messages))
 	for i, msg := range messages {
 		pbMessages[i] = &pb.ChatMessage{
+			Id:        msg.ID,
 			UserId:    msg.UserID,
 			Content:   msg.Content,
 			CreatedAt: msg.CreatedAt.Unix(),
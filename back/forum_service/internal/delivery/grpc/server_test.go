@@ -3,6 +3,7 @@ package grpc_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,10 +14,18 @@ import (
 	"github.com/netabakovv/forum/back/forum_service/internal/delivery/grpc"
 	"github.com/netabakovv/forum/back/forum_service/internal/entities"
 	mock_usecase "github.com/netabakovv/forum/back/forum_service/internal/usecase/mocks"
+	"github.com/netabakovv/forum/back/pkg/diff"
+	forumErrors "github.com/netabakovv/forum/back/pkg/errors"
 	pb "github.com/netabakovv/forum/back/proto"
+	mock_proto "github.com/netabakovv/forum/back/proto/mocks"
 	"github.com/stretchr/testify/assert"
 )
 
+// asUser возвращает контекст запроса, прошедшего перехватчик авторизации
+func asUser(id int64) context.Context {
+	return grpc.ContextWithUser(context.Background(), grpc.User{ID: id, Username: "user"})
+}
+
 func TestCreatePost_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -30,8 +39,8 @@ func TestCreatePost_Success(t *testing.T) {
 	req := &pb.CreatePostRequest{
 		Title:          "Test Title",
 		Content:        "Test Content",
-		AuthorId:       1,
-		AuthorUsername: "user",
+		AuthorId:       99,
+		AuthorUsername: "impostor",
 	}
 
 	postUC.EXPECT().CreatePost(gomock.Any(), gomock.Any()).DoAndReturn(
@@ -40,10 +49,12 @@ func TestCreatePost_Success(t *testing.T) {
 			return nil
 		})
 
-	resp, err := server.CreatePost(context.Background(), req)
+	resp, err := server.CreatePost(asUser(1), req)
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, int64(42), resp.Post.Id)
+	assert.Equal(t, int64(1), resp.Post.AuthorId, "автор берётся из токена, а не из запроса")
+	assert.Equal(t, "user", resp.Post.AuthorUsername)
 	assert.Equal(t, req.Title, resp.Post.Title)
 	assert.Equal(t, req.Content, resp.Post.Content)
 }
@@ -56,7 +67,7 @@ func TestCreatePost_InvalidInput(t *testing.T) {
 		Content: "",
 	}
 
-	resp, err := server.CreatePost(context.Background(), req)
+	resp, err := server.CreatePost(asUser(1), req)
 	assert.Nil(t, resp)
 	assert.Error(t, err)
 }
@@ -72,6 +83,7 @@ func TestGetPost_Success(t *testing.T) {
 		ID:           10,
 		Title:        "Title",
 		Content:      "Content",
+		ContentHTML:  "<p>Content</p>",
 		AuthorID:     1,
 		AuthorName:   "Author",
 		CreatedAt:    time.Now(),
@@ -85,6 +97,36 @@ func TestGetPost_Success(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, int64(10), resp.Post.Id)
 	assert.Equal(t, "Title", resp.Post.Title)
+	assert.Equal(t, "Content", resp.Post.Content)
+	assert.Equal(t, "<p>Content</p>", resp.Post.ContentHtml)
+}
+
+func TestGetPost_CountsView(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	viewUC := mock_usecase.NewMockViewUsecaseInterface(ctrl)
+	server := grpc.NewForumServer(nil, postUC, nil, nil, grpc.WithViews(viewUC))
+	ctx := grpc.ContextWithClientIP(context.Background(), "203.0.113.7")
+
+	postUC.EXPECT().GetPostByID(ctx, int64(10)).Return(&entities.Post{ID: 10, Status: entities.PostStatusPublished, ViewCount: 5}, nil)
+	viewUC.EXPECT().RecordView(int64(10), "ip:203.0.113.7").Return(true)
+	resp, err := server.GetPost(ctx, &pb.GetPostRequest{PostId: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), resp.Post.ViewCount)
+
+	userCtx := asUser(3)
+	postUC.EXPECT().GetPostByID(userCtx, int64(10)).Return(&entities.Post{ID: 10, Status: entities.PostStatusPublished, ViewCount: 5}, nil)
+	viewUC.EXPECT().RecordView(int64(10), "user:3").Return(false)
+	resp, err = server.GetPost(userCtx, &pb.GetPostRequest{PostId: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), resp.Post.ViewCount)
+
+	// черновик автора просмотром не считается
+	postUC.EXPECT().GetPostByID(userCtx, int64(11)).Return(&entities.Post{ID: 11, AuthorID: 3, Status: entities.PostStatusDraft}, nil)
+	_, err = server.GetPost(userCtx, &pb.GetPostRequest{PostId: 11})
+	require.NoError(t, err)
 }
 
 func TestForumServer_GetByPostID(t *testing.T) {
@@ -120,6 +162,47 @@ func TestForumServer_GetByPostID(t *testing.T) {
 	require.Equal(t, "hi", resp.Comments[0].Content)
 }
 
+func TestForumServer_GetByPostID_Tree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	commentUC := mock_usecase.NewMockCommentUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, nil, commentUC, nil)
+
+	ctx := context.Background()
+	parentID := int64(1)
+	commentUC.EXPECT().GetTreeByPostID(ctx, int64(2)).Return([]*entities.Comment{
+		{ID: 1, PostID: 2, Deleted: true, Replies: []*entities.Comment{
+			{ID: 2, PostID: 2, ParentID: &parentID, Depth: 1, Content: "reply"},
+		}},
+	}, nil)
+
+	resp, err := srv.GetByPostID(ctx, &pb.GetCommentsByPostIDRequest{PostId: 2, View: pb.CommentView_COMMENT_VIEW_TREE})
+	require.NoError(t, err)
+	require.Len(t, resp.Comments, 1)
+	assert.True(t, resp.Comments[0].Deleted)
+	require.Len(t, resp.Comments[0].Replies, 1)
+	assert.Equal(t, int64(1), resp.Comments[0].Replies[0].ParentId)
+	assert.Equal(t, int32(2), resp.TotalCount)
+}
+
+func TestCreateComment_InvalidParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	commentUC := mock_usecase.NewMockCommentUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, nil, commentUC, nil)
+
+	commentUC.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Return(forumErrors.ErrReplyPostMismatch)
+
+	parentID := int64(7)
+	resp, err := srv.CreateComment(asUser(1), &pb.CreateCommentRequest{
+		Content: "reply", PostId: 2, ParentId: &parentID,
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestUpdatePost_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -147,11 +230,104 @@ func TestUpdatePost_Success(t *testing.T) {
 		Content: &content,
 	}
 
-	resp, err := server.UpdatePost(context.Background(), req)
+	resp, err := server.UpdatePost(asUser(1), req)
 	assert.NoError(t, err)
 	assert.Equal(t, title, resp.Post.Title)
 }
 
+func TestUpdatePost_KeepsUnsetFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	server := grpc.NewForumServer(nil, postUC, nil, nil)
+
+	categoryID := int64(3)
+	postUC.EXPECT().GetPostByID(gomock.Any(), int64(1)).Return(&entities.Post{
+		ID:         1,
+		Title:      "Old Title",
+		Content:    "Old Content",
+		AuthorID:   2,
+		CategoryID: &categoryID,
+		Tags:       []string{"go"},
+		CreatedAt:  time.Now(),
+	}, nil)
+	postUC.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, post *entities.Post) error {
+			assert.Equal(t, "Old Title", post.Title)
+			assert.Equal(t, "New Content", post.Content)
+			assert.Equal(t, &categoryID, post.CategoryID)
+			assert.Nil(t, post.Tags, "теги не переданы и не должны перезаписываться")
+			return nil
+		})
+
+	content := "New Content"
+	resp, err := server.UpdatePost(asUser(2), &pb.UpdatePostRequest{PostId: 1, Content: &content})
+	require.NoError(t, err)
+	assert.Equal(t, "Old Title", resp.Post.Title)
+	assert.Equal(t, categoryID, resp.Post.CategoryId)
+	assert.Equal(t, []string{"go"}, resp.Post.Tags)
+}
+
+func TestUpdatePost_ClearCategoryAndTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	server := grpc.NewForumServer(nil, postUC, nil, nil)
+
+	categoryID := int64(3)
+	postUC.EXPECT().GetPostByID(gomock.Any(), int64(1)).Return(&entities.Post{
+		ID: 1, Title: "Title", AuthorID: 2, CategoryID: &categoryID, Tags: []string{"go"},
+	}, nil)
+	postUC.EXPECT().UpdatePost(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, post *entities.Post) error {
+			assert.Nil(t, post.CategoryID)
+			assert.Equal(t, []string{}, post.Tags)
+			return nil
+		})
+
+	noCategory := int64(0)
+	resp, err := server.UpdatePost(asUser(2), &pb.UpdatePostRequest{
+		PostId:     1,
+		CategoryId: &noCategory,
+		Tags:       &pb.TagList{},
+	})
+	require.NoError(t, err)
+	assert.Zero(t, resp.Post.CategoryId)
+	assert.Empty(t, resp.Post.Tags)
+}
+
+func TestUpdatePost_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	server := grpc.NewForumServer(nil, postUC, nil, nil)
+
+	postUC.EXPECT().GetPostByID(gomock.Any(), int64(5)).Return(nil, forumErrors.ErrPostNotFound)
+
+	resp, err := server.UpdatePost(asUser(1), &pb.UpdatePostRequest{PostId: 5})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreatePost_InvalidTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	server := grpc.NewForumServer(nil, postUC, nil, nil)
+
+	postUC.EXPECT().CreatePost(gomock.Any(), gomock.Any()).Return(forumErrors.ErrInvalidTag)
+
+	resp, err := server.CreatePost(asUser(1), &pb.CreatePostRequest{
+		Title: "Title", Content: "Content", Tags: []string{"два слова"},
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestDeletePost_Error(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -159,11 +335,70 @@ func TestDeletePost_Error(t *testing.T) {
 	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
 	server := grpc.NewForumServer(nil, postUC, nil, nil)
 
-	postUC.EXPECT().DeletePost(gomock.Any(), int64(42)).Return(errors.New("db error"))
+	postUC.EXPECT().GetPostByID(gomock.Any(), int64(42)).Return(&entities.Post{ID: 42, AuthorID: 7}, nil).Times(2)
 
-	resp, err := server.DeletePost(context.Background(), &pb.DeletePostRequest{PostId: 42})
+	postUC.EXPECT().DeletePost(gomock.Any(), int64(42), int64(7), "").Return(errors.New("db error"))
+	resp, err := server.DeletePost(asUser(7), &pb.DeletePostRequest{PostId: 42})
 	assert.Nil(t, resp)
-	assert.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	postUC.EXPECT().DeletePost(gomock.Any(), int64(42), int64(7), "дубль").Return(forumErrors.ErrPostNotFound)
+	_, err = server.DeletePost(asUser(7), &pb.DeletePostRequest{PostId: 42, Reason: "дубль"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestForumServer_Authorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	commentUC := mock_usecase.NewMockCommentUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(auth, postUC, commentUC, nil)
+
+	t.Run("чужой пост нельзя удалить", func(t *testing.T) {
+		ctx := asUser(3)
+		postUC.EXPECT().GetPostByID(ctx, int64(1)).Return(&entities.Post{ID: 1, AuthorID: 2}, nil)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 3}).Return(&pb.CheckAdminResponse{}, nil)
+
+		_, err := srv.DeletePost(ctx, &pb.DeletePostRequest{PostId: 1, DeletedBy: 2})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "deleted_by из запроса не даёт прав")
+	})
+
+	t.Run("анонимная правка поста", func(t *testing.T) {
+		title := "Title"
+		_, err := srv.UpdatePost(context.Background(), &pb.UpdatePostRequest{PostId: 1, Title: &title, EditorId: 2})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("администратор удаляет чужой комментарий", func(t *testing.T) {
+		ctx := asUser(9)
+		commentUC.EXPECT().GetCommentByID(ctx, int64(5)).Return(&entities.Comment{ID: 5, AuthorID: 2}, nil)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 9}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil)
+		commentUC.EXPECT().DeleteComment(ctx, int64(5), int64(9), "").Return(nil)
+
+		_, err := srv.DeleteComment(ctx, &pb.DeleteCommentRequest{CommentId: 5})
+		assert.NoError(t, err)
+	})
+
+	t.Run("auth service недоступен", func(t *testing.T) {
+		ctx := asUser(9)
+		commentUC.EXPECT().GetCommentByID(ctx, int64(5)).Return(&entities.Comment{ID: 5, AuthorID: 2}, nil)
+		auth.EXPECT().CheckAdminStatus(ctx, gomock.Any()).Return(nil, status.Error(codes.Unavailable, "нет связи"))
+
+		content := "text"
+		_, err := srv.UpdateComment(ctx, &pb.UpdateCommentRequest{CommentId: 5, Content: &content})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("удалённый комментарий не правится", func(t *testing.T) {
+		ctx := asUser(2)
+		commentUC.EXPECT().GetCommentByID(ctx, int64(6)).Return(&entities.Comment{ID: 6, AuthorID: 2, Deleted: true}, nil)
+
+		content := "text"
+		_, err := srv.UpdateComment(ctx, &pb.UpdateCommentRequest{CommentId: 6, Content: &content})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
 }
 
 func TestForumServer_Posts(t *testing.T) {
@@ -185,13 +420,65 @@ func TestForumServer_Posts(t *testing.T) {
 		CommentCount: 3,
 	}
 
-	postUC.EXPECT().Posts(ctx).Return([]*entities.Post{mockPost}, nil)
+	postUC.EXPECT().Posts(ctx, entities.PostFilter{Sort: entities.PostSortNew}).Return(&entities.PostPage{
+		Posts: []*entities.Post{mockPost},
+	}, nil)
 
 	resp, err := srv.Posts(ctx, &pb.ListPostsRequest{})
 	require.NoError(t, err)
 	require.Len(t, resp.Posts, 1)
 	require.Equal(t, int64(1), resp.Posts[0].Id)
 	require.Equal(t, "Test", resp.Posts[0].Title)
+	require.Empty(t, resp.NextCursor)
+}
+
+func TestForumServer_Posts_Pagination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, postUC, nil, nil)
+
+	ctx := context.Background()
+	next := &entities.PostCursor{CreatedAt: time.UnixMicro(1700000000123456).UTC(), ID: 7}
+	from, to := int64(1690000000), int64(1700000000)
+
+	postUC.EXPECT().Posts(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter entities.PostFilter) (*entities.PostPage, error) {
+			assert.True(t, filter.Ascending)
+			assert.Equal(t, 10, filter.Limit)
+			assert.Equal(t, next, filter.After)
+			assert.Equal(t, from, filter.CreatedFrom.Unix())
+			assert.Equal(t, to, filter.CreatedTo.Unix())
+			return &entities.PostPage{NextCursor: next}, nil
+		})
+
+	resp, err := srv.Posts(ctx, &pb.ListPostsRequest{
+		Cursor:      next.String(),
+		Limit:       10,
+		Order:       pb.SortOrder_SORT_ORDER_ASC,
+		CreatedFrom: &from,
+		CreatedTo:   &to,
+	})
+	require.NoError(t, err)
+	require.Equal(t, next.String(), resp.NextCursor)
+}
+
+func TestForumServer_Posts_InvalidArgument(t *testing.T) {
+	srv := grpc.NewForumServer(nil, nil, nil, nil)
+	from, to := int64(20), int64(10)
+
+	for name, req := range map[string]*pb.ListPostsRequest{
+		"bad cursor":     {Cursor: "!!!"},
+		"negative limit": {Limit: -1},
+		"bad range":      {CreatedFrom: &from, CreatedTo: &to},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := srv.Posts(context.Background(), req)
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
 }
 
 func TestCreateComment_Success(t *testing.T) {
@@ -209,13 +496,11 @@ func TestCreateComment_Success(t *testing.T) {
 		})
 
 	req := &pb.CreateCommentRequest{
-		PostId:         1,
-		Content:        "Nice post!",
-		AuthorId:       2,
-		AuthorUsername: "tester",
+		PostId:  1,
+		Content: "Nice post!",
 	}
 
-	resp, err := server.CreateComment(context.Background(), req)
+	resp, err := server.CreateComment(grpc.ContextWithUser(context.Background(), grpc.User{ID: 2, Username: "tester"}), req)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(77), resp.Comment.Id)
 	assert.Equal(t, "Nice post!", resp.Comment.Content)
@@ -312,26 +597,22 @@ func TestForumServer_UpdateComment(t *testing.T) {
 
 	srv := grpc.NewForumServer(nil, mockPostUC, mockCommentUC, mockChatUC)
 
-	ctx := context.Background()
+	ctx := asUser(3)
 	now := time.Now()
 
-	comment := &entities.Comment{
+	mockCommentUC.EXPECT().GetCommentByID(ctx, int64(1)).Return(&entities.Comment{
 		ID:         1,
 		PostID:     2,
 		AuthorID:   3,
 		AuthorName: "bob",
-		Content:    "updated",
+		Content:    "old",
 		CreatedAt:  now,
-	}
-
+	}, nil)
 	mockCommentUC.EXPECT().
 		UpdateComment(ctx, gomock.AssignableToTypeOf(&entities.Comment{})).
 		DoAndReturn(func(_ context.Context, c *entities.Comment) error {
-			// симулируем, что usecase подставляет остальные поля
-			c.AuthorID = comment.AuthorID
-			c.AuthorName = comment.AuthorName
-			c.PostID = comment.PostID
-			c.CreatedAt = comment.CreatedAt
+			assert.Equal(t, "updated", c.Content)
+			assert.Equal(t, int64(3), c.EditorID)
 			return nil
 		})
 
@@ -344,12 +625,13 @@ func TestForumServer_UpdateComment(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "updated", resp.Comment.Content)
 	require.Equal(t, "bob", resp.Comment.AuthorUsername)
+	require.Equal(t, int64(2), resp.Comment.PostId)
 }
 
 func TestSendMessage_EmptyContent(t *testing.T) {
 	server := grpc.NewForumServer(nil, nil, nil, nil)
 
-	resp, err := server.SendMessage(context.Background(), &pb.ChatMessage{
+	resp, err := server.SendMessage(asUser(42), &pb.ChatMessage{
 		Content: "",
 	})
 	assert.Nil(t, resp)
@@ -367,16 +649,15 @@ func TestForumServer_SendMessage(t *testing.T) {
 	srv := grpc.NewForumServer(nil, mockPostUC, mockCommentUC, mockChatUC)
 
 	t.Run("успешная отправка сообщения", func(t *testing.T) {
-		ctx := context.Background()
+		ctx := asUser(42)
 		req := &pb.ChatMessage{
-			UserId:  42,
 			Content: "привет, мир!",
 		}
 
 		mockChatUC.EXPECT().
 			SendMessage(ctx, gomock.Any()).
 			DoAndReturn(func(_ context.Context, msg *entities.ChatMessage) error {
-				require.Equal(t, req.UserId, msg.UserID)
+				require.Equal(t, int64(42), msg.UserID)
 				require.Equal(t, req.Content, msg.Content)
 				require.WithinDuration(t, time.Now(), msg.CreatedAt, time.Second)
 				return nil
@@ -388,9 +669,8 @@ func TestForumServer_SendMessage(t *testing.T) {
 	})
 
 	t.Run("пустое сообщение — ошибка", func(t *testing.T) {
-		ctx := context.Background()
+		ctx := asUser(42)
 		req := &pb.ChatMessage{
-			UserId:  42,
 			Content: "",
 		}
 
@@ -403,9 +683,8 @@ func TestForumServer_SendMessage(t *testing.T) {
 	})
 
 	t.Run("ошибка при отправке в usecase", func(t *testing.T) {
-		ctx := context.Background()
+		ctx := asUser(42)
 		req := &pb.ChatMessage{
-			UserId:  42,
 			Content: "fail me",
 		}
 
@@ -420,6 +699,17 @@ func TestForumServer_SendMessage(t *testing.T) {
 		require.Equal(t, codes.Internal, s.Code())
 		require.Contains(t, s.Message(), "не удалось отправить сообщение")
 	})
+
+	t.Run("отклонено фильтром", func(t *testing.T) {
+		ctx := asUser(42)
+		rejected := fmt.Errorf("%w: слишком много ссылок", forumErrors.ErrContentRejected)
+		mockChatUC.EXPECT().SendMessage(ctx, gomock.Any()).Return(rejected)
+
+		_, err := srv.SendMessage(ctx, &pb.ChatMessage{Content: "https://x.example"})
+		s, _ := status.FromError(err)
+		require.Equal(t, codes.InvalidArgument, s.Code())
+		require.Contains(t, s.Message(), "слишком много ссылок")
+	})
 }
 
 func TestGetMessages_Success(t *testing.T) {
@@ -437,3 +727,783 @@ func TestGetMessages_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, resp.Messages, 1)
 }
+
+func TestForumServer_SearchPosts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	searchUC := mock_usecase.NewMockSearchUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, nil, nil, nil, grpc.WithSearch(searchUC))
+
+	ctx := context.Background()
+	searchUC.EXPECT().Search(ctx, entities.SearchQuery{Text: "go", Limit: 5}).Return(&entities.SearchResult{
+		Hits: []*entities.SearchHit{
+			{TargetType: "comment", PostID: 1, CommentID: 2, Snippet: "<mark>go</mark>", CreatedAt: time.Now()},
+		},
+		TotalCount: 1,
+	}, nil)
+
+	resp, err := srv.SearchPosts(ctx, &pb.SearchPostsRequest{Query: "go", Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, resp.Hits, 1)
+	require.Equal(t, pb.SearchHitType_SEARCH_HIT_COMMENT, resp.Hits[0].Type)
+	require.Equal(t, int64(2), resp.Hits[0].CommentId)
+	require.Equal(t, int32(1), resp.TotalCount)
+}
+
+func TestForumServer_SearchPosts_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resp, err := grpc.NewForumServer(nil, nil, nil, nil).SearchPosts(context.Background(), &pb.SearchPostsRequest{Query: "go"})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	searchUC := mock_usecase.NewMockSearchUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, nil, nil, nil, grpc.WithSearch(searchUC))
+	searchUC.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, forumErrors.ErrEmptySearchQuery)
+
+	resp, err = srv.SearchPosts(context.Background(), &pb.SearchPostsRequest{})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestForumServer_Categories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	categoryUC := mock_usecase.NewMockCategoryUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(auth, nil, nil, nil, grpc.WithCategories(categoryUC))
+	ctx := asUser(9)
+	auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 9}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil)
+
+	categoryUC.EXPECT().Categories(ctx).Return([]*entities.Category{
+		{ID: 1, Slug: "news", Title: "Новости"},
+		{ID: 2, Slug: "help", Title: "Помощь", Position: 1},
+	}, nil)
+
+	resp, err := srv.ListCategories(ctx, &pb.ListCategoriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Categories, 2)
+	assert.Equal(t, "help", resp.Categories[1].Slug)
+
+	title := "Вопросы"
+	categoryUC.EXPECT().GetCategoryByID(ctx, int64(2)).Return(&entities.Category{ID: 2, Slug: "help", Title: "Помощь"}, nil)
+	categoryUC.EXPECT().UpdateCategory(ctx, &entities.Category{ID: 2, Slug: "help", Title: title}).Return(nil)
+
+	updated, err := srv.UpdateCategory(ctx, &pb.UpdateCategoryRequest{CategoryId: 2, Title: &title})
+	require.NoError(t, err)
+	assert.Equal(t, title, updated.Category.Title)
+}
+
+func TestForumServer_Categories_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := grpc.NewForumServer(nil, nil, nil, nil).ListCategories(context.Background(), &pb.ListCategoriesRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	categoryUC := mock_usecase.NewMockCategoryUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(auth, nil, nil, nil, grpc.WithCategories(categoryUC))
+	admin := asUser(9)
+	auth.EXPECT().CheckAdminStatus(admin, &pb.CheckAdminRequest{UserId: 9}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil).AnyTimes()
+
+	user := asUser(3)
+	auth.EXPECT().CheckAdminStatus(user, &pb.CheckAdminRequest{UserId: 3}).Return(&pb.CheckAdminResponse{}, nil)
+	_, err = srv.DeleteCategory(user, &pb.DeleteCategoryRequest{CategoryId: 9})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = srv.CreateCategory(context.Background(), &pb.CreateCategoryRequest{Slug: "news", Title: "Новости"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	categoryUC.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).Return(forumErrors.ErrDuplicateSlug)
+	_, err = srv.CreateCategory(admin, &pb.CreateCategoryRequest{Slug: "news", Title: "Новости"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	categoryUC.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).Return(forumErrors.ErrInvalidSlug)
+	_, err = srv.CreateCategory(admin, &pb.CreateCategoryRequest{Slug: "новости", Title: "Новости"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	categoryUC.EXPECT().DeleteCategory(gomock.Any(), int64(9)).Return(forumErrors.ErrCategoryNotFound)
+	_, err = srv.DeleteCategory(admin, &pb.DeleteCategoryRequest{CategoryId: 9})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestForumServer_Vote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	voteUC := mock_usecase.NewMockVoteUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, nil, nil, nil, grpc.WithVotes(voteUC))
+	ctx := asUser(1)
+
+	voteUC.EXPECT().Vote(ctx, &entities.Vote{UserID: 1, TargetType: "comment", TargetID: 3, Value: 1}).Return(int64(5), nil)
+	resp, err := srv.Vote(ctx, &pb.VoteRequest{
+		TargetType: pb.VoteTargetType_VOTE_TARGET_COMMENT, TargetId: 3, Value: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), resp.Score)
+	assert.Equal(t, int32(1), resp.MyVote)
+
+	voteUC.EXPECT().Vote(ctx, gomock.Any()).Return(int64(0), forumErrors.ErrSelfVote)
+	_, err = srv.Vote(ctx, &pb.VoteRequest{TargetId: 3, Value: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	voteUC.EXPECT().RemoveVote(ctx, int64(1), "post", int64(3)).Return(int64(2), nil)
+	resp, err = srv.RemoveVote(ctx, &pb.RemoveVoteRequest{TargetId: 3})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.Score)
+	assert.Zero(t, resp.MyVote)
+}
+
+func TestForumServer_Posts_MyVote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	voteUC := mock_usecase.NewMockVoteUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, postUC, nil, nil, grpc.WithVotes(voteUC))
+	ctx := asUser(9)
+
+	postUC.EXPECT().Posts(ctx, gomock.Any()).Return(&entities.PostPage{Posts: []*entities.Post{
+		{ID: 1, Score: 3, CreatedAt: time.Now()},
+		{ID: 2, CreatedAt: time.Now()},
+	}}, nil)
+	voteUC.EXPECT().UserVotes(ctx, int64(9), "post", []int64{1, 2}).Return(map[int64]int32{1: -1}, nil)
+
+	resp, err := srv.Posts(ctx, &pb.ListPostsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Posts, 2)
+	assert.Equal(t, int64(3), resp.Posts[0].Score)
+	assert.Equal(t, int32(-1), resp.Posts[0].MyVote)
+	assert.Zero(t, resp.Posts[1].MyVote)
+}
+
+func TestForumServer_Polls(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	pollUC := mock_usecase.NewMockPollUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, postUC, nil, nil, grpc.WithPolls(pollUC))
+	ctx := asUser(1)
+
+	pollUC.EXPECT().CastVote(ctx, int64(5), int64(1), "user", []int64{10}).Return(&entities.Poll{
+		PostID:      5,
+		Options:     []*entities.PollOption{{ID: 10, Text: "да", Votes: 1}, {ID: 11, Text: "нет"}},
+		TotalVoters: 1,
+		MyChoices:   []int64{10},
+	}, nil)
+	resp, err := srv.CastPollVote(ctx, &pb.CastPollVoteRequest{PostId: 5, OptionIds: []int64{10}})
+	require.NoError(t, err)
+	require.Len(t, resp.Poll.Options, 2)
+	assert.Equal(t, int64(1), resp.Poll.Options[0].Votes)
+	assert.Equal(t, []int64{10}, resp.Poll.MyChoices)
+
+	pollUC.EXPECT().CastVote(ctx, int64(5), int64(1), "user", []int64{10}).Return(nil, forumErrors.ErrPollAlreadyVoted)
+	_, err = srv.CastPollVote(ctx, &pb.CastPollVoteRequest{PostId: 5, OptionIds: []int64{10}})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	closed := time.Now().Add(-time.Minute)
+	pollUC.EXPECT().Results(gomock.Any(), int64(6), int64(0)).Return(&entities.Poll{PostID: 6, ClosesAt: &closed}, nil)
+	resp, err = srv.GetPollResults(context.Background(), &pb.GetPollResultsRequest{PostId: 6})
+	require.NoError(t, err)
+	assert.True(t, resp.Poll.Closed)
+
+	postUC.EXPECT().CreatePost(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, post *entities.Post) error {
+		require.NotNil(t, post.Poll)
+		assert.Len(t, post.Poll.Options, 2)
+		assert.True(t, post.Poll.MultipleChoice)
+		post.ID = 7
+		return nil
+	})
+	created, err := srv.CreatePost(ctx, &pb.CreatePostRequest{
+		Title:   "title",
+		Content: "content",
+		Poll:    &pb.PollInput{Options: []string{"да", "нет"}, MultipleChoice: true},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.Post.Poll)
+	assert.Len(t, created.Post.Poll.Options, 2)
+}
+
+func TestForumServer_Bookmarks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookmarkUC := mock_usecase.NewMockBookmarkUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, nil, nil, nil, grpc.WithBookmarks(bookmarkUC))
+	ctx := asUser(1)
+	now := time.Now()
+
+	bookmarkUC.EXPECT().AddBookmark(ctx, &entities.Bookmark{UserID: 1, PostID: 5, Note: "позже"}).
+		DoAndReturn(func(_ context.Context, b *entities.Bookmark) error {
+			b.CreatedAt = now
+			b.Post = &entities.Post{ID: 5, Title: "Title", CreatedAt: now}
+			return nil
+		})
+	resp, err := srv.AddBookmark(ctx, &pb.AddBookmarkRequest{PostId: 5, Note: "позже"})
+	require.NoError(t, err)
+	assert.Equal(t, "позже", resp.Bookmark.Note)
+	assert.Equal(t, now.Unix(), resp.Bookmark.CreatedAt)
+	assert.True(t, resp.Bookmark.Post.Bookmarked)
+
+	bookmarkUC.EXPECT().AddBookmark(ctx, gomock.Any()).Return(forumErrors.ErrNoteTooLong)
+	_, err = srv.AddBookmark(ctx, &pb.AddBookmarkRequest{PostId: 5})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	bookmarkUC.EXPECT().RemoveBookmark(ctx, int64(1), int64(6)).Return(forumErrors.ErrBookmarkNotFound)
+	_, err = srv.RemoveBookmark(ctx, &pb.RemoveBookmarkRequest{PostId: 6})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	bookmarkUC.EXPECT().Bookmarks(ctx, int64(1), 10, 0).Return([]*entities.Bookmark{
+		{UserID: 1, PostID: 5, CreatedAt: now, Post: &entities.Post{ID: 5, CreatedAt: now, Bookmarked: true}},
+	}, nil)
+	list, err := srv.ListBookmarks(ctx, &pb.ListBookmarksRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list.Bookmarks, 1)
+	assert.Equal(t, int64(5), list.Bookmarks[0].Post.Id)
+
+	_, err = srv.ListBookmarks(context.Background(), &pb.ListBookmarksRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestForumServer_Posts_Bookmarked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	bookmarkUC := mock_usecase.NewMockBookmarkUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, postUC, nil, nil, grpc.WithBookmarks(bookmarkUC))
+	ctx := asUser(9)
+
+	postUC.EXPECT().Posts(ctx, gomock.Any()).Return(&entities.PostPage{Posts: []*entities.Post{
+		{ID: 1, CreatedAt: time.Now()},
+		{ID: 2, CreatedAt: time.Now()},
+	}}, nil)
+	bookmarkUC.EXPECT().Bookmarked(ctx, int64(9), []int64{1, 2}).Return(map[int64]bool{2: true}, nil)
+
+	resp, err := srv.Posts(ctx, &pb.ListPostsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Posts, 2)
+	assert.False(t, resp.Posts[0].Bookmarked)
+	assert.True(t, resp.Posts[1].Bookmarked)
+}
+
+func TestForumServer_Notifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notifyUC := mock_usecase.NewMockNotificationUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, nil, nil, nil, grpc.WithNotifications(notifyUC))
+	ctx := asUser(3)
+	now := time.Now()
+
+	notifyUC.EXPECT().Follow(ctx, int64(3), int64(2)).Return(nil)
+	follow, err := srv.FollowPost(ctx, &pb.FollowPostRequest{PostId: 2})
+	require.NoError(t, err)
+	assert.True(t, follow.Subscribed)
+
+	notifyUC.EXPECT().Unfollow(ctx, int64(3), int64(4)).Return(forumErrors.ErrPostNotFound)
+	_, err = srv.UnfollowPost(ctx, &pb.FollowPostRequest{PostId: 4})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	notifyUC.EXPECT().Notifications(ctx, int64(3), true, 0, 0).Return([]*entities.Notification{
+		{ID: 1, PostID: 2, PostTitle: "Title", ActorID: 4, ActorName: "bob", Count: 3, CreatedAt: now, UpdatedAt: now},
+	}, nil)
+	notifyUC.EXPECT().UnreadCount(ctx, int64(3)).Return(int64(1), nil)
+	list, err := srv.ListNotifications(ctx, &pb.ListNotificationsRequest{UnreadOnly: true})
+	require.NoError(t, err)
+	require.Len(t, list.Notifications, 1)
+	assert.Equal(t, int32(3), list.Notifications[0].Count)
+	assert.False(t, list.Notifications[0].Read)
+	assert.Equal(t, int64(1), list.UnreadCount)
+
+	notifyUC.EXPECT().MarkAllRead(ctx, int64(3)).Return(nil)
+	notifyUC.EXPECT().UnreadCount(ctx, int64(3)).Return(int64(0), nil)
+	unread, err := srv.MarkAllRead(ctx, &pb.EmptyMessage{})
+	require.NoError(t, err)
+	assert.Zero(t, unread.UnreadCount)
+
+	_, err = srv.GetUnreadCount(context.Background(), &pb.EmptyMessage{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestForumServer_Mentions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mentionUC := mock_usecase.NewMockMentionUsecaseInterface(ctrl)
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, postUC, nil, nil, grpc.WithMentions(mentionUC))
+	ctx := asUser(4)
+	now := time.Now()
+
+	mentionUC.EXPECT().UserMentions(ctx, int64(4), 10, 0).Return([]*entities.Mention{
+		{TargetType: "comment", TargetID: 5, UserID: 4, Username: "alice", AuthorID: 3, CreatedAt: now},
+	}, nil)
+	list, err := srv.ListMentions(ctx, &pb.ListMentionsRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list.Mentions, 1)
+	assert.Equal(t, "comment", list.Mentions[0].TargetType)
+	assert.Equal(t, now.Unix(), list.Mentions[0].CreatedAt)
+
+	_, err = srv.ListMentions(ctx, &pb.ListMentionsRequest{Offset: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	postUC.EXPECT().CreatePost(ctx, gomock.Any()).Return(forumErrors.ErrTooManyMentions)
+	_, err = srv.CreatePost(ctx, &pb.CreatePostRequest{Title: "title", Content: "@a @b"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = grpc.NewForumServer(nil, nil, nil, nil).ListMentions(ctx, &pb.ListMentionsRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestForumServer_Revisions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	revisionUC := mock_usecase.NewMockRevisionUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(auth, nil, nil, nil, grpc.WithRevisions(revisionUC))
+	ctx := asUser(1)
+	auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 1}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil).AnyTimes()
+
+	revisionUC.EXPECT().PostRevisions(ctx, int64(5)).Return([]*entities.Revision{
+		{ID: 1, TargetID: 5, Content: "a", CreatedAt: time.Now()},
+		{ID: 2, TargetID: 5, Content: "b", EditorID: 9, CreatedAt: time.Now(),
+			Diff: []diff.Line{{Op: diff.Delete, Text: "a"}, {Op: diff.Insert, Text: "b"}}},
+	}, nil)
+	resp, err := srv.GetPostRevisions(ctx, &pb.GetRevisionsRequest{TargetId: 5})
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 2)
+	assert.Empty(t, resp.Revisions[0].Diff)
+	require.Len(t, resp.Revisions[1].Diff, 2)
+	assert.Equal(t, pb.DiffOp_DIFF_DELETE, resp.Revisions[1].Diff[0].Op)
+	assert.Equal(t, pb.DiffOp_DIFF_INSERT, resp.Revisions[1].Diff[1].Op)
+
+	revisionUC.EXPECT().RollbackComment(ctx, int64(3), int64(8), int64(1)).Return(nil, forumErrors.ErrRevisionNotFound)
+	_, err = srv.RollbackComment(ctx, &pb.RollbackRequest{TargetId: 3, RevisionId: 8})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	revisionUC.EXPECT().RollbackComment(ctx, int64(3), int64(7), int64(1)).Return(nil, forumErrors.ErrEditHeld)
+	_, err = srv.RollbackComment(ctx, &pb.RollbackRequest{TargetId: 3, RevisionId: 7})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = grpc.NewForumServer(nil, nil, nil, nil).GetCommentRevisions(ctx, &pb.GetRevisionsRequest{TargetId: 3})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	user := asUser(2)
+	auth.EXPECT().CheckAdminStatus(user, &pb.CheckAdminRequest{UserId: 2}).Return(&pb.CheckAdminResponse{}, nil)
+	_, err = srv.RollbackPost(user, &pb.RollbackRequest{TargetId: 5, RevisionId: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestForumServer_Trash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	commentUC := mock_usecase.NewMockCommentUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(auth, postUC, commentUC, nil)
+	ctx := asUser(9)
+	auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 9}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil).AnyTimes()
+	now := time.Now()
+
+	user := asUser(3)
+	auth.EXPECT().CheckAdminStatus(user, &pb.CheckAdminRequest{UserId: 3}).Return(&pb.CheckAdminResponse{}, nil).Times(3)
+	_, err := srv.ListTrash(user, &pb.ListTrashRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.RestorePost(user, &pb.RestoreRequest{TargetId: 3})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.RestoreComment(user, &pb.RestoreRequest{TargetId: 4})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	commentUC.EXPECT().DeletedComments(ctx, 10, 0).Return([]*entities.Comment{{
+		ID: 5, Content: "text", CreatedAt: now, Deleted: true,
+		Deletion: &entities.Deletion{DeletedAt: now, DeletedBy: 2, Reason: "спам"},
+	}}, nil)
+	resp, err := srv.ListTrash(ctx, &pb.ListTrashRequest{Target: pb.TrashTarget_TRASH_COMMENTS, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Posts)
+	require.Len(t, resp.Comments, 1)
+	assert.Equal(t, "text", resp.Comments[0].Content)
+	assert.Equal(t, int64(2), resp.Comments[0].Deletion.DeletedBy)
+	assert.Equal(t, "спам", resp.Comments[0].Deletion.Reason)
+
+	_, err = srv.ListTrash(ctx, &pb.ListTrashRequest{Offset: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	postUC.EXPECT().RestorePost(ctx, int64(3)).Return(&entities.Post{ID: 3, Title: "Title", CreatedAt: now}, nil)
+	post, err := srv.RestorePost(ctx, &pb.RestoreRequest{TargetId: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "Title", post.Post.Title)
+	assert.False(t, post.Post.Deleted)
+	assert.Nil(t, post.Post.Deletion)
+
+	commentUC.EXPECT().RestoreComment(ctx, int64(4)).Return(nil, forumErrors.ErrCommentNotFound)
+	_, err = srv.RestoreComment(ctx, &pb.RestoreRequest{TargetId: 4})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestForumServer_Drafts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(nil, postUC, nil, nil)
+	now := time.Now()
+	draft := func() *entities.Post {
+		return &entities.Post{ID: 7, Title: "Черновик", AuthorID: 1, CreatedAt: now, Status: entities.PostStatusDraft}
+	}
+
+	t.Run("черновик не виден другим", func(t *testing.T) {
+		postUC.EXPECT().GetPostByID(gomock.Any(), int64(7)).Return(draft(), nil)
+		_, err := srv.GetPost(asUser(2), &pb.GetPostRequest{PostId: 7})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("черновик виден автору", func(t *testing.T) {
+		postUC.EXPECT().GetPostByID(gomock.Any(), int64(7)).Return(draft(), nil)
+		resp, err := srv.GetPost(asUser(1), &pb.GetPostRequest{PostId: 7})
+		require.NoError(t, err)
+		assert.Equal(t, pb.PostStatus_POST_STATUS_DRAFT, resp.Post.Status)
+	})
+
+	t.Run("список черновиков", func(t *testing.T) {
+		postUC.EXPECT().Drafts(gomock.Any(), int64(1), 10, 0).Return([]*entities.Post{draft()}, nil)
+		resp, err := srv.ListMyDrafts(asUser(1), &pb.ListMyDraftsRequest{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, resp.Posts, 1)
+		assert.Equal(t, "Черновик", resp.Posts[0].Title)
+	})
+
+	t.Run("планирование публикации", func(t *testing.T) {
+		publishAt := now.Add(time.Hour).Unix()
+		postUC.EXPECT().GetPostByID(gomock.Any(), int64(7)).Return(draft(), nil)
+		postUC.EXPECT().PublishPost(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, post *entities.Post, at *time.Time) error {
+				require.NotNil(t, at)
+				assert.Equal(t, publishAt, at.Unix())
+				post.Status = entities.PostStatusScheduled
+				post.PublishAt = at
+				return nil
+			})
+		resp, err := srv.PublishPost(asUser(1), &pb.PublishPostRequest{PostId: 7, PublishAt: publishAt})
+		require.NoError(t, err)
+		assert.Equal(t, pb.PostStatus_POST_STATUS_SCHEDULED, resp.Post.Status)
+		assert.Equal(t, publishAt, resp.Post.PublishAt)
+	})
+
+	t.Run("повторная публикация", func(t *testing.T) {
+		postUC.EXPECT().GetPostByID(gomock.Any(), int64(7)).Return(draft(), nil)
+		postUC.EXPECT().PublishPost(gomock.Any(), gomock.Any(), nil).Return(forumErrors.ErrPostAlreadyPublished)
+		_, err := srv.PublishPost(asUser(1), &pb.PublishPostRequest{PostId: 7})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("чужой черновик", func(t *testing.T) {
+		postUC.EXPECT().GetPostByID(gomock.Any(), int64(7)).Return(draft(), nil)
+		_, err := srv.PublishPost(asUser(2), &pb.PublishPostRequest{PostId: 7})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestForumServer_PinAndLock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	commentUC := mock_usecase.NewMockCommentUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(auth, postUC, commentUC, nil)
+
+	t.Run("закрепление администратором", func(t *testing.T) {
+		ctx := asUser(9)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 9}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil)
+		postUC.EXPECT().PinPost(ctx, int64(1), true).Return(&entities.Post{ID: 1, IsPinned: true}, nil)
+
+		resp, err := srv.PinPost(ctx, &pb.PinPostRequest{PostId: 1, Pinned: true})
+		require.NoError(t, err)
+		assert.True(t, resp.Post.IsPinned)
+	})
+
+	t.Run("закрытие не администратором", func(t *testing.T) {
+		ctx := asUser(3)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 3}).Return(&pb.CheckAdminResponse{}, nil)
+
+		_, err := srv.LockPost(ctx, &pb.LockPostRequest{PostId: 1, Locked: true})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("анонимное закрепление", func(t *testing.T) {
+		_, err := srv.PinPost(context.Background(), &pb.PinPostRequest{PostId: 1, Pinned: true})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("комментарий к закрытому посту", func(t *testing.T) {
+		commentUC.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Return(forumErrors.ErrPostLocked)
+
+		_, err := srv.CreateComment(asUser(2), &pb.CreateCommentRequest{PostId: 1, Content: "text"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestForumServer_Reports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	reportUC := mock_usecase.NewMockReportUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(auth, nil, nil, nil, grpc.WithReports(reportUC))
+
+	t.Run("жалоба на сообщение чата", func(t *testing.T) {
+		ctx := asUser(3)
+		reportUC.EXPECT().Report(ctx, &entities.Report{
+			TargetType: "chat", TargetID: 40, ReporterID: 3, Reason: "abuse", Details: "оскорбления",
+		}).DoAndReturn(func(_ context.Context, report *entities.Report) error {
+			report.ID = 1
+			report.Status = entities.ReportStatusOpen
+			return nil
+		})
+
+		resp, err := srv.Report(ctx, &pb.ReportRequest{
+			TargetType: pb.ReportTarget_REPORT_TARGET_CHAT_MESSAGE,
+			TargetId:   40,
+			Reason:     pb.ReportReason_REPORT_REASON_ABUSE,
+			Details:    "оскорбления",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.Report.Id)
+		assert.Equal(t, pb.ReportTarget_REPORT_TARGET_CHAT_MESSAGE, resp.Report.TargetType)
+		assert.Equal(t, pb.ReportReason_REPORT_REASON_ABUSE, resp.Report.Reason)
+	})
+
+	t.Run("повторная жалоба", func(t *testing.T) {
+		reportUC.EXPECT().Report(gomock.Any(), gomock.Any()).Return(forumErrors.ErrAlreadyReported)
+
+		_, err := srv.Report(asUser(3), &pb.ReportRequest{
+			TargetType: pb.ReportTarget_REPORT_TARGET_POST, TargetId: 5, Reason: pb.ReportReason_REPORT_REASON_SPAM,
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("жалоба без типа цели", func(t *testing.T) {
+		_, err := srv.Report(asUser(3), &pb.ReportRequest{TargetId: 5, Reason: pb.ReportReason_REPORT_REASON_SPAM})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("очередь не администратору", func(t *testing.T) {
+		ctx := asUser(3)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 3}).Return(&pb.CheckAdminResponse{}, nil)
+
+		_, err := srv.ListReports(ctx, &pb.ListReportsRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("очередь администратора", func(t *testing.T) {
+		ctx := asUser(9)
+		target := pb.ReportTarget_REPORT_TARGET_COMMENT
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 9}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil)
+		reportUC.EXPECT().Reports(ctx, entities.ReportFilter{Status: "removed", TargetType: "comment", Limit: 10}).
+			Return([]*entities.ReportGroup{{
+				TargetType: "comment", TargetID: 7, Count: 2, LastReportedAt: time.Now(),
+				Reports: []*entities.Report{{ID: 2, TargetType: "comment", Status: "removed"}, {ID: 1, TargetType: "comment", Status: "removed"}},
+			}}, nil)
+
+		resp, err := srv.ListReports(ctx, &pb.ListReportsRequest{
+			Status: pb.ReportStatus_REPORT_STATUS_REMOVED, TargetType: &target, Limit: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Groups, 1)
+		assert.Equal(t, int32(2), resp.Groups[0].Count)
+		require.Len(t, resp.Groups[0].Reports, 2)
+		assert.Equal(t, pb.ReportStatus_REPORT_STATUS_REMOVED, resp.Groups[0].Reports[0].Status)
+	})
+
+	t.Run("предупреждение автору", func(t *testing.T) {
+		ctx := asUser(9)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 9}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil)
+		reportUC.EXPECT().Resolve(ctx, int64(2), entities.ReportStatusWarned, int64(9), "без оскорблений").Return(int64(3), nil)
+
+		resp, err := srv.ResolveReport(ctx, &pb.ResolveReportRequest{
+			ReportId: 2, Action: pb.ReportAction_REPORT_ACTION_WARN, Note: "без оскорблений",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), resp.Resolved)
+	})
+
+	t.Run("жалоба уже рассмотрена", func(t *testing.T) {
+		ctx := asUser(9)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 9}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil)
+		reportUC.EXPECT().Resolve(ctx, int64(2), entities.ReportStatusDismissed, int64(9), "").Return(int64(0), forumErrors.ErrReportResolved)
+
+		_, err := srv.ResolveReport(ctx, &pb.ResolveReportRequest{ReportId: 2, Action: pb.ReportAction_REPORT_ACTION_DISMISS})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("решение не указано", func(t *testing.T) {
+		ctx := asUser(9)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 9}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil)
+
+		_, err := srv.ResolveReport(ctx, &pb.ResolveReportRequest{ReportId: 2})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestForumServer_Held(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	moderationUC := mock_usecase.NewMockModerationUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(auth, nil, nil, nil, grpc.WithModeration(moderationUC))
+
+	asAdmin := func(id int64) context.Context {
+		ctx := asUser(id)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: id}).Return(&pb.CheckAdminResponse{IsAdmin: true}, nil)
+		return ctx
+	}
+
+	t.Run("очередь не администратору", func(t *testing.T) {
+		ctx := asUser(3)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 3}).Return(&pb.CheckAdminResponse{}, nil)
+
+		_, err := srv.ListHeld(ctx, &pb.ListHeldRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("очередь администратора", func(t *testing.T) {
+		ctx := asAdmin(9)
+		moderationUC.EXPECT().Held(ctx, 10, 0).Return([]*entities.HeldContent{
+			{ID: 1, TargetType: "comment", PostID: 2, Content: "www.x.example", Reason: "ссылки", CreatedAt: time.Now()},
+			{ID: 2, TargetType: "post", TargetID: 5, PostID: 5, IsEdit: true, Title: "title"},
+		}, nil)
+
+		resp, err := srv.ListHeld(ctx, &pb.ListHeldRequest{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 2)
+		assert.Equal(t, pb.HeldTarget_HELD_TARGET_COMMENT, resp.Items[0].TargetType)
+		assert.Zero(t, resp.Items[0].TargetId)
+		assert.Equal(t, pb.HeldTarget_HELD_TARGET_POST, resp.Items[1].TargetType)
+		assert.True(t, resp.Items[1].IsEdit)
+	})
+
+	t.Run("одобрение", func(t *testing.T) {
+		ctx := asAdmin(9)
+		moderationUC.EXPECT().Approve(ctx, int64(1), int64(9)).
+			Return(&entities.HeldContent{ID: 1, TargetType: "comment", TargetID: 12, PostID: 2}, nil)
+
+		resp, err := srv.ModerateHeld(ctx, &pb.ModerateHeldRequest{HeldId: 1, Decision: pb.HeldDecision_HELD_DECISION_APPROVE})
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), resp.Held.TargetId)
+	})
+
+	t.Run("отказ с причиной", func(t *testing.T) {
+		ctx := asAdmin(9)
+		moderationUC.EXPECT().Reject(ctx, int64(2), int64(9), "реклама").
+			Return(&entities.HeldContent{ID: 2, TargetType: "post", TargetID: 5, PostID: 5}, nil)
+
+		_, err := srv.ModerateHeld(ctx, &pb.ModerateHeldRequest{HeldId: 2, Decision: pb.HeldDecision_HELD_DECISION_REJECT, Reason: "реклама"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("текст уже рассмотрен", func(t *testing.T) {
+		ctx := asAdmin(9)
+		moderationUC.EXPECT().Approve(ctx, int64(3), int64(9)).Return(nil, forumErrors.ErrHeldNotFound)
+
+		_, err := srv.ModerateHeld(ctx, &pb.ModerateHeldRequest{HeldId: 3, Decision: pb.HeldDecision_HELD_DECISION_APPROVE})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("пост закрыт для комментариев", func(t *testing.T) {
+		ctx := asAdmin(9)
+		moderationUC.EXPECT().Approve(ctx, int64(4), int64(9)).Return(nil, forumErrors.ErrPostLocked)
+
+		_, err := srv.ModerateHeld(ctx, &pb.ModerateHeldRequest{HeldId: 4, Decision: pb.HeldDecision_HELD_DECISION_APPROVE})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("решение не указано", func(t *testing.T) {
+		_, err := srv.ModerateHeld(asAdmin(9), &pb.ModerateHeldRequest{HeldId: 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestForumServer_Attachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mock_proto.NewMockAuthServiceClient(ctrl)
+	postUC := mock_usecase.NewMockPostUsecaseInterface(ctrl)
+	attachUC := mock_usecase.NewMockAttachmentUsecaseInterface(ctrl)
+	srv := grpc.NewForumServer(auth, postUC, nil, nil, grpc.WithAttachments(attachUC))
+	ctx := asUser(2)
+
+	t.Run("загрузка к своему посту", func(t *testing.T) {
+		postUC.EXPECT().GetPostByID(ctx, int64(5)).Return(&entities.Post{ID: 5, AuthorID: 2}, nil)
+		attachUC.EXPECT().Upload(ctx, gomock.Any(), []byte("hello")).
+			DoAndReturn(func(_ context.Context, att *entities.Attachment, _ []byte) error {
+				assert.Equal(t, &entities.Attachment{TargetType: "post", TargetID: 5, UploaderID: 2, FileName: "a.txt"}, att)
+				att.ID, att.ContentType = 1, "text/plain"
+				return nil
+			})
+
+		resp, err := srv.UploadAttachment(ctx, &pb.UploadAttachmentRequest{TargetId: 5, FileName: "a.txt", Data: []byte("hello")})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.Attachment.Id)
+		assert.False(t, resp.Attachment.HasThumbnail)
+	})
+
+	t.Run("загрузка к чужому посту", func(t *testing.T) {
+		postUC.EXPECT().GetPostByID(ctx, int64(6)).Return(&entities.Post{ID: 6, AuthorID: 3}, nil)
+		auth.EXPECT().CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: 2}).Return(&pb.CheckAdminResponse{}, nil)
+
+		_, err := srv.UploadAttachment(ctx, &pb.UploadAttachmentRequest{TargetId: 6, Data: []byte("hello")})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("превышение квоты", func(t *testing.T) {
+		postUC.EXPECT().GetPostByID(ctx, int64(5)).Return(&entities.Post{ID: 5, AuthorID: 2}, nil)
+		attachUC.EXPECT().Upload(ctx, gomock.Any(), gomock.Any()).Return(forumErrors.ErrQuotaExceeded)
+
+		_, err := srv.UploadAttachment(ctx, &pb.UploadAttachmentRequest{TargetId: 5, Data: []byte("hello")})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("превью файла без превью", func(t *testing.T) {
+		att := &entities.Attachment{ID: 1, UploaderID: 2}
+		attachUC.EXPECT().Attachment(gomock.Any(), int64(1), int64(0)).Return(att, nil)
+		attachUC.EXPECT().Content(gomock.Any(), att, true).Return(nil, forumErrors.ErrAttachmentNotFound)
+
+		_, err := srv.GetAttachment(context.Background(), &pb.GetAttachmentRequest{Id: 1, Thumbnail: true})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("вложения в выдаче поста", func(t *testing.T) {
+		postUC.EXPECT().GetPostByID(gomock.Any(), int64(5)).Return(&entities.Post{ID: 5, AuthorID: 2}, nil)
+		attachUC.EXPECT().Attachments(gomock.Any(), "post", []int64{5}).Return(map[int64][]*entities.Attachment{
+			5: {{ID: 1, TargetID: 5, ThumbnailKey: "k.thumb", Width: 8}},
+		}, nil)
+
+		resp, err := srv.GetPost(context.Background(), &pb.GetPostRequest{PostId: 5})
+		require.NoError(t, err)
+		require.Len(t, resp.Post.Attachments, 1)
+		assert.True(t, resp.Post.Attachments[0].HasThumbnail)
+	})
+
+	t.Run("удаление загрузившим", func(t *testing.T) {
+		att := &entities.Attachment{ID: 1, UploaderID: 2}
+		attachUC.EXPECT().Attachment(ctx, int64(1), int64(2)).Return(att, nil)
+		attachUC.EXPECT().DeleteAttachment(ctx, att).Return(nil)
+
+		_, err := srv.DeleteAttachment(ctx, &pb.DeleteAttachmentRequest{Id: 1})
+		assert.NoError(t, err)
+	})
+
+	_, err := grpc.NewForumServer(nil, nil, nil, nil).GetAttachment(ctx, &pb.GetAttachmentRequest{Id: 1})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
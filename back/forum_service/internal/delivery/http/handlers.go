@@ -83,12 +83,12 @@ func (h *Handler) GetPostByID(c *gin.Context) {
 }
 
 func (h *Handler) GetAllPosts(c *gin.Context) {
-	posts, err := h.postUC.Posts(c.Request.Context())
+	page, err := h.postUC.Posts(c.Request.Context(), entities.PostFilter{})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, posts)
+	c.JSON(http.StatusOK, page.Posts)
 }
 
 func (h *Handler) UpdatePost(c *gin.Context) {
@@ -109,7 +109,7 @@ func (h *Handler) UpdatePost(c *gin.Context) {
 
 func (h *Handler) DeletePost(c *gin.Context) {
 	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
-	if err := h.postUC.DeletePost(c.Request.Context(), id); err != nil {
+	if err := h.postUC.DeletePost(c.Request.Context(), id, c.GetInt64("userID"), c.Query("reason")); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -178,7 +178,7 @@ func (h *Handler) UpdateComment(c *gin.Context) {
 
 func (h *Handler) DeleteComment(c *gin.Context) {
 	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
-	if err := h.commentUC.DeleteComment(c.Request.Context(), id); err != nil {
+	if err := h.commentUC.DeleteComment(c.Request.Context(), id, c.GetInt64("userID"), c.Query("reason")); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
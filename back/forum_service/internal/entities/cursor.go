@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/netabakovv/forum/back/pkg/errors"
+)
+
+const (
+	pinnedSuffix  = ":p"
+	rankSeparator = ":r"
+)
+
+// PostCursor — позиция в ленте постов для keyset-пагинации.
+// Клиенту отдаётся только в закодированном виде, см. String и ParsePostCursor.
+type PostCursor struct {
+	CreatedAt time.Time // время создания последнего поста страницы, для PostSortActive — время последней активности
+	ID        int64     // ID последнего поста страницы, разрешает равные даты
+	Pinned    bool      // последний пост страницы закреплён: закреплённые идут отдельным блоком в начале ленты
+	Rank      float64   // ранг последнего поста для PostSortTop, PostSortHot и PostSortViews
+}
+
+// NewPostCursor возвращает курсор, продолжающий выдачу после post при сортировке sort
+func NewPostCursor(post *Post, sort string) *PostCursor {
+	cursor := &PostCursor{CreatedAt: post.CreatedAt, ID: post.ID, Pinned: post.IsPinned}
+	switch sort {
+	case PostSortActive:
+		cursor.CreatedAt = post.LastActivityAt()
+	case PostSortTop:
+		cursor.Rank = float64(post.CommentCount)
+	case PostSortHot:
+		cursor.Rank = post.HotRank
+	case PostSortViews:
+		cursor.Rank = float64(post.ViewCount)
+	}
+	return cursor
+}
+
+// String кодирует курсор в непрозрачную строку для передачи клиенту
+func (c *PostCursor) String() string {
+	if c == nil {
+		return ""
+	}
+	raw := fmt.Sprintf("%d:%d", c.CreatedAt.UnixMicro(), c.ID)
+	if c.Rank != 0 {
+		raw += rankSeparator + strconv.FormatFloat(c.Rank, 'g', -1, 64)
+	}
+	if c.Pinned {
+		raw += pinnedSuffix
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParsePostCursor разбирает строку, полученную из PostCursor.String.
+// Пустая строка означает первую страницу и возвращает nil.
+func ParsePostCursor(s string) (*PostCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	position, pinned := strings.CutSuffix(string(raw), pinnedSuffix)
+	position, rankStr, ranked := strings.Cut(position, rankSeparator)
+	var rank float64
+	if ranked {
+		rank, err = strconv.ParseFloat(rankStr, 64)
+		if err != nil || math.IsNaN(rank) || math.IsInf(rank, 0) {
+			return nil, errors.ErrInvalidCursor
+		}
+	}
+
+	var micros, id int64
+	var rest string
+	if n, _ := fmt.Sscanf(position, "%d:%d%s", &micros, &id, &rest); n != 2 || id <= 0 {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	return &PostCursor{
+		CreatedAt: time.UnixMicro(micros).UTC(),
+		ID:        id,
+		Pinned:    pinned,
+		Rank:      rank,
+	}, nil
+}
@@ -1,28 +1,300 @@
 package entities
 
-import "time"
+import (
+	"time"
+
+	"github.com/netabakovv/forum/back/pkg/diff"
+)
+
+// Статусы публикации поста
+const (
+	PostStatusDraft     = "draft"     // черновик, виден только автору
+	PostStatusScheduled = "scheduled" // будет опубликован в PublishAt
+	PostStatusPublished = "published" // виден всем
+	PostStatusHeld      = "held"      // задержан фильтром до решения модератора, виден только автору
+)
+
+// Режимы сортировки ленты постов
+const (
+	PostSortNew    = "new"    // по времени публикации
+	PostSortTop    = "top"    // по числу комментариев
+	PostSortActive = "active" // по времени последнего комментария
+	PostSortHot    = "hot"    // по вовлечённости с поправкой на возраст
+	PostSortViews  = "views"  // по числу просмотров
+)
 
 // @Description Модель поста
 type Post struct {
-	ID           int64      // идентификатор поста
-	Title        string     // заголовок
-	Content      string     // текст поста
-	AuthorID     int64      // ID пользователя, написавшего пост
-	AuthorName   string     // имя автора
-	CreatedAt    time.Time  // время создания
-	UpdatedAt    *time.Time // может быть nil, если не обновлялся
-	CommentCount int32      // количество комментариев
+	ID           int64         // идентификатор поста
+	Title        string        // заголовок
+	Content      string        // текст поста в Markdown
+	ContentHTML  string        // отрендеренный и очищенный HTML текста
+	AuthorID     int64         // ID пользователя, написавшего пост
+	AuthorName   string        // имя автора
+	CreatedAt    time.Time     // время создания
+	UpdatedAt    *time.Time    // может быть nil, если не обновлялся
+	CommentCount int32         // количество комментариев
+	CategoryID   *int64        // nil, если пост вне категорий
+	Tags         []string      // теги поста
+	Score        int64         // сумма голосов
+	MyVote       int32         // голос текущего пользователя: 1, -1 или 0
+	Bookmarked   bool          // пост в закладках текущего пользователя
+	EditorID     int64         // кто вносит правку, учитывается только при обновлении
+	Deleted      bool          // удалён: читателям отдаётся заглушка без заголовка и текста
+	Deletion     *Deletion     // сведения об удалении, заполняются только в корзине
+	Attachments  []*Attachment // вложения, заполняются только при выдаче читателям
+	Poll         *Poll         // опрос: при создании — варианты, при выдаче — результаты
+	Status       string        // см. PostStatus*
+	PublishAt    *time.Time    // время отложенной публикации, только для PostStatusScheduled
+	IsPinned     bool          // закреплён администратором в начале ленты
+	IsLocked     bool          // закрыт для новых комментариев
+	Hold         *Hold         // задержка фильтром: при сохранении — причина, в ответе — что правка ждёт модератора
+	KeepHeldEdit bool          // правка не заменяет задержанную: пока та ждёт модератора, сохранение отказывает
+
+	// Ключи сортировки ленты
+	LastCommentAt *time.Time // время последнего комментария, nil если их нет
+	HotRank       float64    // ранг для PostSortHot
+	ViewCount     int64      // число просмотров, уже записанных в базу
+}
+
+// LastActivityAt — время последнего комментария или публикации, если комментариев нет
+func (p *Post) LastActivityAt() time.Time {
+	if p.LastCommentAt != nil {
+		return *p.LastCommentAt
+	}
+	return p.CreatedAt
+}
+
+// Unpublished сообщает, что пост — черновик, ждёт отложенной публикации или модератора
+func (p *Post) Unpublished() bool {
+	return p.Status == PostStatusDraft || p.Status == PostStatusScheduled || p.Status == PostStatusHeld
+}
+
+// @Description Опрос в посте
+type Poll struct {
+	PostID         int64         // пост, к которому относится опрос
+	Options        []*PollOption // варианты в порядке, заданном автором
+	MultipleChoice bool          // можно выбрать несколько вариантов
+	Public         bool          // видно, кто за что голосовал
+	ClosesAt       *time.Time    // после этого времени голоса не принимаются, nil — не закрывается
+	TotalVoters    int64         // сколько пользователей проголосовало
+	MyChoices      []int64       // варианты, выбранные текущим пользователем
+}
+
+// Closed сообщает, что опрос закрыт к моменту now
+func (p *Poll) Closed(now time.Time) bool {
+	return p.ClosesAt != nil && !now.Before(*p.ClosesAt)
+}
+
+// @Description Вариант ответа в опросе
+type PollOption struct {
+	ID     int64        // идентификатор варианта
+	Text   string       // текст варианта
+	Votes  int64        // число голосов
+	Voters []*PollVoter // кто выбрал вариант, только в открытых опросах и только в GetPollResults
+}
+
+// @Description Пользователь, проголосовавший в открытом опросе
+type PollVoter struct {
+	UserID   int64
+	Username string
+}
+
+// @Description Сведения о мягком удалении поста или комментария
+type Deletion struct {
+	DeletedAt time.Time // время удаления
+	DeletedBy int64     // ID удалившего пользователя
+	Reason    string    // причина удаления
+}
+
+// @Description Задержка текста фильтром до решения модератора
+type Hold struct {
+	Reason string    // почему текст задержан
+	HeldAt time.Time // время задержки
+}
+
+// @Description Текст в очереди модерации: новый пост или комментарий либо правка
+type HeldContent struct {
+	ID          int64     // идентификатор в очереди
+	TargetType  string    // repository.TargetTypePost или TargetTypeComment
+	TargetID    int64     // ID поста или комментария; 0 у нового комментария до одобрения
+	PostID      int64     // пост, к которому относится текст
+	ParentID    *int64    // родитель нового комментария
+	Depth       int32     // глубина нового комментария
+	IsEdit      bool      // правка опубликованного текста, а не новый текст
+	AuthorID    int64     // автор текста
+	AuthorName  string    // имя автора
+	EditorID    int64     // кто внёс правку
+	Title       string    // заголовок поста
+	Content     string    // задержанный текст в Markdown
+	ContentHTML string    // отрендеренный HTML задержанного текста
+	CategoryID  *int64    // категория поста после правки
+	Tags        []string  // теги поста после правки; nil — не меняются
+	PostStatus  string    // статус, который новый пост получит после одобрения
+	Reason      string    // почему текст задержан
+	CreatedAt   time.Time // время задержки
+}
+
+// @Description Категория (подфорум)
+type Category struct {
+	ID          int64     // идентификатор категории
+	Slug        string    // короткое имя для URL
+	Title       string    // название
+	Description string    // описание
+	Position    int32     // порядок вывода
+	CreatedAt   time.Time // время создания
+}
+
+// @Description Параметры выборки ленты постов
+type PostFilter struct {
+	AuthorID    *int64      // только посты указанного автора
+	CreatedFrom *time.Time  // нижняя граница даты создания (включительно)
+	CreatedTo   *time.Time  // верхняя граница даты создания (не включительно)
+	Ascending   bool        // сначала старые посты
+	Limit       int         // размер страницы
+	After       *PostCursor // позиция, с которой продолжается выдача
+	CategoryID  *int64      // только посты категории
+	Tag         string      // только посты с тегом
+	ViewerID    int64       // кроме опубликованных, в выдачу попадают неопубликованные посты этого автора
+
+	Sort   string        // PostSortNew (по умолчанию), PostSortTop, PostSortActive, PostSortHot или PostSortViews
+	Window time.Duration // только посты, опубликованные за это время; 0 — без ограничения
+
+	IgnorePinned bool // закреплённые посты идут на своих местах, а не первыми
+}
+
+// @Description Страница ленты постов
+type PostPage struct {
+	Posts      []*Post     // посты текущей страницы
+	NextCursor *PostCursor // nil, если страниц больше нет
+}
+
+// @Description Параметры полнотекстового поиска
+type SearchQuery struct {
+	Text     string // поисковая строка
+	AuthorID *int64 // только записи указанного автора
+	Limit    int    // размер страницы
+	Offset   int    // смещение от начала выдачи
+}
+
+// @Description Результат полнотекстового поиска
+type SearchHit struct {
+	TargetType string    // "post" или "comment"
+	PostID     int64     // ID поста
+	CommentID  int64     // ID комментария, 0 для постов
+	Title      string    // заголовок поста
+	Snippet    string    // фрагмент с подсвеченными совпадениями
+	AuthorID   int64     // ID автора
+	AuthorName string    // имя автора
+	CreatedAt  time.Time // время создания
+	Rank       float32   // релевантность
+}
+
+// @Description Страница результатов поиска
+type SearchResult struct {
+	Hits       []*SearchHit // найденные посты и комментарии
+	TotalCount int          // общее число совпадений
 }
 
 // @Description Модель комментария
 type Comment struct {
-	ID         int64      // идентификатор комментария
-	PostID     int64      // ID поста, к которому он относится
-	AuthorID   int64      // ID пользователя
-	AuthorName string     // имя пользователя, для фронта
-	Content    string     // текст комментария
-	CreatedAt  time.Time  // время создания
-	UpdatedAt  *time.Time // время изменения
+	ID           int64         // идентификатор комментария
+	PostID       int64         // ID поста, к которому он относится
+	ParentID     *int64        // ID родительского комментария, nil для корневых
+	Depth        int32         // уровень вложенности, 0 для корневых
+	Path         []int64       // ID комментариев от корня ветки до текущего включительно
+	AuthorID     int64         // ID пользователя
+	AuthorName   string        // имя пользователя, для фронта
+	Content      string        // текст комментария в Markdown
+	ContentHTML  string        // отрендеренный и очищенный HTML текста
+	Deleted      bool          // удалён: читателям отдаётся заглушка без текста
+	CreatedAt    time.Time     // время создания
+	UpdatedAt    *time.Time    // время изменения
+	Score        int64         // сумма голосов
+	MyVote       int32         // голос текущего пользователя: 1, -1 или 0
+	EditorID     int64         // кто вносит правку, учитывается только при обновлении
+	Deletion     *Deletion     // сведения об удалении, заполняются только в корзине
+	Replies      []*Comment    // ответы, заполняются только при выдаче дерева
+	Attachments  []*Attachment // вложения, заполняются только при выдаче читателям
+	Hold         *Hold         // задержка фильтром: новый комментарий не получает ID до одобрения
+	KeepHeldEdit bool          // правка не заменяет задержанную: пока та ждёт модератора, сохранение отказывает
+}
+
+// @Description Версия поста или комментария в истории правок
+type Revision struct {
+	ID         int64       // идентификатор версии
+	TargetType string      // repository.TargetTypePost или repository.TargetTypeComment
+	TargetID   int64       // ID поста или комментария
+	Title      string      // заголовок, пусто для комментариев
+	Content    string      // текст версии
+	EditorID   int64       // автор правки
+	CreatedAt  time.Time   // время правки
+	Diff       []diff.Line // разница с предыдущей версией, nil для исходной
+}
+
+// @Description Файл, прикреплённый к посту или комментарию
+type Attachment struct {
+	ID           int64     // идентификатор вложения
+	TargetType   string    // repository.TargetTypePost или repository.TargetTypeComment
+	TargetID     int64     // ID поста или комментария
+	UploaderID   int64     // кто загрузил файл
+	FileName     string    // имя файла при загрузке
+	ContentType  string    // MIME-тип, определённый по содержимому
+	Size         int64     // размер в байтах после обработки
+	BlobKey      string    // ключ содержимого в хранилище
+	ThumbnailKey string    // ключ превью, пусто для не-изображений
+	Width        int32     // ширина изображения, 0 для не-изображений
+	Height       int32     // высота изображения, 0 для не-изображений
+	CreatedAt    time.Time // время загрузки
+}
+
+// @Description Пост в закладках пользователя
+type Bookmark struct {
+	UserID    int64     // владелец закладки
+	PostID    int64     // ID поста
+	Note      string    // личная заметка, видна только владельцу
+	CreatedAt time.Time // время добавления
+	Post      *Post     // сам пост, заполняется при выдаче списка закладок
+}
+
+// @Description Упоминание пользователя (@username) в посте, комментарии или сообщении чата
+type Mention struct {
+	TargetType string    // repository.TargetTypePost, TargetTypeComment или TargetTypeChat
+	TargetID   int64     // ID поста, комментария или сообщения
+	UserID     int64     // упомянутый пользователь
+	Username   string    // имя, как оно написано в тексте
+	AuthorID   int64     // кто упомянул
+	CreatedAt  time.Time // время упоминания
+}
+
+// Типы уведомлений
+const (
+	NotificationComment = "comment" // новые комментарии в обсуждении, на которое подписан пользователь
+)
+
+// @Description Уведомление пользователя. Пока оно не прочитано, однотипные
+// @Description события по одному посту объединяются в одну запись.
+type Notification struct {
+	ID        int64      // идентификатор уведомления
+	UserID    int64      // получатель
+	Type      string     // тип события, см. Notification*
+	PostID    int64      // пост, к которому относятся события
+	PostTitle string     // заголовок поста, для фронта
+	CommentID int64      // последний комментарий, вызвавший уведомление
+	ActorID   int64      // автор последнего события
+	ActorName string     // имя автора последнего события, для фронта
+	Count     int32      // сколько событий объединено
+	CreatedAt time.Time  // время первого события
+	UpdatedAt time.Time  // время последнего события
+	ReadAt    *time.Time // время прочтения, nil для непрочитанных
+}
+
+// @Description Голос пользователя за пост или комментарий
+type Vote struct {
+	UserID     int64  // кто голосует
+	TargetType string // repository.TargetTypePost или repository.TargetTypeComment
+	TargetID   int64  // ID поста или комментария
+	Value      int32  // 1 — за, -1 — против
 }
 
 // @Description Модель сообщения в чате
@@ -33,3 +305,77 @@ type ChatMessage struct {
 	Content   string    // сообщение
 	CreatedAt time.Time // время создания
 }
+
+// Причины жалоб
+const (
+	ReportReasonSpam     = "spam"
+	ReportReasonAbuse    = "abuse"     // оскорбления и травля
+	ReportReasonOffTopic = "off_topic" // не по теме
+	ReportReasonIllegal  = "illegal"   // запрещённый контент
+	ReportReasonOther    = "other"     // требует описания
+)
+
+// Статусы жалоб; все, кроме ReportStatusOpen, — итог рассмотрения
+const (
+	ReportStatusOpen      = "open"
+	ReportStatusDismissed = "dismissed" // жалоба отклонена
+	ReportStatusRemoved   = "removed"   // контент удалён
+	ReportStatusWarned    = "warned"    // автор получил предупреждение
+)
+
+// @Description Жалоба на пост, комментарий или сообщение чата
+type Report struct {
+	ID             int64      // идентификатор жалобы
+	TargetType     string     // repository.TargetTypePost, TargetTypeComment или TargetTypeChat
+	TargetID       int64      // ID поста, комментария или сообщения
+	TargetAuthorID int64      // автор контента
+	TargetContent  string     // текст контента на момент жалобы
+	ReporterID     int64      // кто пожаловался
+	Reason         string     // см. ReportReason*
+	Details        string     // пояснение пользователя
+	Status         string     // см. ReportStatus*
+	ResolvedBy     int64      // администратор, рассмотревший жалобу
+	ResolvedAt     *time.Time // время рассмотрения, nil для открытых
+	ResolutionNote string     // комментарий администратора
+	CreatedAt      time.Time  // время жалобы
+}
+
+// @Description Жалобы с одним статусом на одну цель
+type ReportGroup struct {
+	TargetType     string
+	TargetID       int64
+	TargetAuthorID int64
+	Count          int32     // число жалоб в группе
+	LastReportedAt time.Time // время последней жалобы
+	Reports        []*Report // жалобы, новые первыми
+}
+
+// @Description Фильтр очереди жалоб
+type ReportFilter struct {
+	Status     string // см. ReportStatus*, по умолчанию ReportStatusOpen
+	TargetType string // пусто — любые цели
+	Limit      int    // число групп на странице
+	Offset     int
+}
+
+// @Description Предупреждение автору по итогам рассмотрения жалобы
+type Warning struct {
+	ID        int64     // идентификатор предупреждения
+	UserID    int64     // кому вынесено
+	ReportID  int64     // жалоба, по которой вынесено
+	Reason    string    // причина жалобы, см. ReportReason*
+	Note      string    // комментарий администратора
+	IssuedBy  int64     // администратор
+	CreatedAt time.Time // время предупреждения
+}
+
+// UserEventUpdated — у пользователя изменилось имя (журнал событий auth_service)
+const UserEventUpdated = "user.updated"
+
+// UserEvent — событие из журнала пользователей auth_service
+type UserEvent struct {
+	ID       int64  // позиция в журнале
+	UserID   int64  // пользователь
+	Type     string // см. UserEvent*
+	Username string // имя после изменения
+}
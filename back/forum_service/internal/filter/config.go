@@ -0,0 +1,89 @@
+package filter
+
+import (
+	"fmt"
+	"time"
+)
+
+// RuleConfig — общие настройки правила. Правило без action выключено.
+type RuleConfig struct {
+	Action  string   `mapstructure:"action"`  // mask, hold или reject
+	Targets []string `mapstructure:"targets"` // post, comment, chat; пусто — все
+}
+
+// Config — секция content_filters конфига
+type Config struct {
+	BannedWords struct {
+		RuleConfig `mapstructure:",squash"`
+		Words      []string `mapstructure:"words"`
+	} `mapstructure:"banned_words"`
+
+	Links struct {
+		RuleConfig `mapstructure:",squash"`
+		MaxLinks   int `mapstructure:"max_links"`
+	} `mapstructure:"links"`
+
+	Shouting struct {
+		RuleConfig       `mapstructure:",squash"`
+		MaxRepeatedChars int     `mapstructure:"max_repeated_chars"`
+		MaxCapsRatio     float64 `mapstructure:"max_caps_ratio"`
+		MinLetters       int     `mapstructure:"min_letters"`
+	} `mapstructure:"shouting"`
+
+	NewAccounts struct {
+		RuleConfig `mapstructure:",squash"`
+		MinAge     time.Duration `mapstructure:"min_age"`
+	} `mapstructure:"new_accounts"`
+}
+
+// Rules строит правила цепочки из конфига в порядке: новые аккаунты,
+// запрещённые слова, ссылки, «крик». Ошибка означает, что конфиг
+// некорректен целиком — частично правила не применяются.
+func Rules(cfg Config, accounts AccountAges) ([]Rule, error) {
+	var rules []Rule
+	add := func(rc RuleConfig, f Filter) error {
+		if rc.Action == "" {
+			return nil
+		}
+		action, err := ParseAction(rc.Action)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.Name(), err)
+		}
+		if _, ok := f.(Masker); action == Mask && !ok {
+			return fmt.Errorf("%s: действие mask не поддерживается", f.Name())
+		}
+		for _, target := range rc.Targets {
+			switch target {
+			case "post", "comment", "chat":
+			default:
+				return fmt.Errorf("%s: неизвестный тип текста %q", f.Name(), target)
+			}
+		}
+		rules = append(rules, Rule{Filter: f, Action: action, Targets: rc.Targets})
+		return nil
+	}
+
+	if cfg.NewAccounts.Action != "" && cfg.NewAccounts.MinAge <= 0 {
+		return nil, fmt.Errorf("new_accounts: min_age должен быть положительным")
+	}
+	if err := add(cfg.NewAccounts.RuleConfig, NewNewAccounts(accounts, cfg.NewAccounts.MinAge)); err != nil {
+		return nil, err
+	}
+	if err := add(cfg.BannedWords.RuleConfig, NewBannedWords(cfg.BannedWords.Words)); err != nil {
+		return nil, err
+	}
+	if cfg.Links.MaxLinks < 0 {
+		return nil, fmt.Errorf("links: max_links не может быть отрицательным")
+	}
+	if err := add(cfg.Links.RuleConfig, NewLinks(cfg.Links.MaxLinks)); err != nil {
+		return nil, err
+	}
+	if cfg.Shouting.MaxCapsRatio < 0 || cfg.Shouting.MaxCapsRatio > 1 {
+		return nil, fmt.Errorf("shouting: max_caps_ratio должен быть от 0 до 1")
+	}
+	shouting := NewShouting(cfg.Shouting.MaxRepeatedChars, cfg.Shouting.MaxCapsRatio, cfg.Shouting.MinLetters)
+	if err := add(cfg.Shouting.RuleConfig, shouting); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
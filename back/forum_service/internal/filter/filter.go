@@ -0,0 +1,158 @@
+// Package filter проверяет тексты постов, комментариев и сообщений чата
+// перед сохранением цепочкой настраиваемых правил.
+package filter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/netabakovv/forum/back/pkg/logger"
+)
+
+// Action — что делать с текстом, нарушившим правило. Значения упорядочены
+// по строгости: итог цепочки — самое строгое из сработавших действий.
+type Action int
+
+const (
+	Allow  Action = iota // текст сохраняется как есть
+	Mask                 // нарушение исправляется в тексте, текст сохраняется
+	Hold                 // текст сохраняется, но скрывается до проверки модератором
+	Reject               // текст не сохраняется
+)
+
+// ParseAction разбирает действие из конфига: mask, hold или reject
+func ParseAction(s string) (Action, error) {
+	switch s {
+	case "mask":
+		return Mask, nil
+	case "hold":
+		return Hold, nil
+	case "reject":
+		return Reject, nil
+	default:
+		return Allow, fmt.Errorf("неизвестное действие фильтра %q", s)
+	}
+}
+
+func (a Action) String() string {
+	switch a {
+	case Mask:
+		return "mask"
+	case Hold:
+		return "hold"
+	case Reject:
+		return "reject"
+	default:
+		return "allow"
+	}
+}
+
+// Content — проверяемый текст. Правила с действием Mask исправляют Title и Text на месте.
+type Content struct {
+	Target   string // repository.TargetTypePost, TargetTypeComment или TargetTypeChat
+	AuthorID int64
+	Title    string // только у постов
+	Text     string
+}
+
+// Filter — проверка одного вида нарушений
+type Filter interface {
+	// Name — имя фильтра в конфиге и журналах
+	Name() string
+	// Match сообщает, нарушает ли текст правило, и описывает нарушение для автора
+	Match(ctx context.Context, content *Content) (reason string, matched bool, err error)
+}
+
+// Masker — фильтр, который умеет исправить нарушение вместо отказа
+type Masker interface {
+	Filter
+	Mask(content *Content)
+}
+
+// Rule — фильтр с действием и типами текстов, к которым он применяется
+type Rule struct {
+	Filter  Filter
+	Action  Action
+	Targets []string // пусто — все типы
+}
+
+func (r Rule) appliesTo(target string) bool {
+	return len(r.Targets) == 0 || slices.Contains(r.Targets, target)
+}
+
+// Verdict — итог проверки текста цепочкой
+type Verdict struct {
+	Action Action
+	Filter string // фильтр, определивший итог; пусто для Allow
+	Reason string
+}
+
+// heldOnErrorReason — причина задержки текста, который не удалось проверить
+const heldOnErrorReason = "текст не удалось проверить автоматически, его проверит модератор"
+
+// Chain применяет правила по порядку. Правила можно заменить на лету,
+// например после изменения конфига.
+type Chain struct {
+	logger logger.Logger
+
+	mu    sync.RWMutex
+	rules []Rule
+}
+
+func NewChain(rules []Rule, logger logger.Logger) *Chain {
+	return &Chain{rules: rules, logger: logger}
+}
+
+// SetRules заменяет правила цепочки; идущие проверки доработают со старыми
+func (c *Chain) SetRules(rules []Rule) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rules = rules
+}
+
+// Check прогоняет текст через правила. Reject прерывает проверку сразу,
+// остальные правила проверяются все: замаскированный текст может ещё
+// нарушить следующее правило. Если фильтр не смог проверить текст
+// (например, недоступен auth service), текст не отклоняется, а задерживается
+// до проверки модератором. Ошибка возвращается, только если отменён ctx.
+func (c *Chain) Check(ctx context.Context, content *Content) (Verdict, error) {
+	c.mu.RLock()
+	rules := c.rules
+	c.mu.RUnlock()
+
+	var verdict Verdict
+	for _, rule := range rules {
+		if !rule.appliesTo(content.Target) {
+			continue
+		}
+		reason, matched, err := rule.Filter.Match(ctx, content)
+		if err != nil {
+			if ctx.Err() != nil {
+				return Verdict{}, fmt.Errorf("фильтр %s: %w", rule.Filter.Name(), err)
+			}
+			c.logger.Warn("фильтр не проверил текст, текст задержан до проверки модератором",
+				logger.NewField("filter", rule.Filter.Name()),
+				logger.NewField("author_id", content.AuthorID),
+				logger.NewField("error", err))
+			if Hold > verdict.Action {
+				verdict = Verdict{Action: Hold, Filter: rule.Filter.Name(), Reason: heldOnErrorReason}
+			}
+			continue
+		}
+		if !matched {
+			continue
+		}
+		if rule.Action == Mask {
+			rule.Filter.(Masker).Mask(content)
+		}
+		if rule.Action > verdict.Action {
+			verdict = Verdict{Action: rule.Action, Filter: rule.Filter.Name(), Reason: reason}
+		}
+		if rule.Action == Reject {
+			break
+		}
+	}
+	return verdict, nil
+}
@@ -0,0 +1,260 @@
+package filter_test
+
+import (
+	"context"
+	stdErrors "errors"
+	"testing"
+	"time"
+
+	"github.com/netabakovv/forum/back/forum_service/internal/filter"
+	"github.com/netabakovv/forum/back/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBannedWords(t *testing.T) {
+	f := filter.NewBannedWords([]string{"spam", "казин*"})
+
+	tests := []struct {
+		name    string
+		text    string
+		matched bool
+		masked  string
+	}{
+		{"чистый текст", "обычный текст", false, "обычный текст"},
+		{"точное совпадение", "это spam!", true, "это ****!"},
+		{"регистр", "это SPAM", true, "это ****"},
+		{"кириллица вместо латиницы", "это sраm", true, "это ****"},
+		{"цифра вместо буквы", "sp4m и sp0m", false, "sp4m и sp0m"},
+		{"префикс", "лучшие казино", true, "лучшие ******"},
+		{"латиница вместо кириллицы", "лучшие kaзинo", true, "лучшие ******"},
+		{"часть слова", "spammer", false, "spammer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := &filter.Content{Text: tt.text}
+			_, matched, err := f.Match(context.Background(), content)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matched, matched)
+
+			f.Mask(content)
+			assert.Equal(t, tt.masked, content.Text)
+		})
+	}
+}
+
+func TestLinks(t *testing.T) {
+	f := filter.NewLinks(1)
+	content := &filter.Content{
+		Title: "см. https://a.example",
+		Text:  "и www.b.example, и http://c.example/x",
+	}
+
+	_, matched, err := f.Match(context.Background(), content)
+	require.NoError(t, err)
+	assert.True(t, matched)
+
+	f.Mask(content)
+	assert.Equal(t, "см. https://a.example", content.Title)
+	assert.Equal(t, "и "+maskOf("www.b.example,")+" и "+maskOf("http://c.example/x"), content.Text)
+
+	_, matched, err = f.Match(context.Background(), content)
+	require.NoError(t, err)
+	assert.False(t, matched)
+}
+
+func maskOf(s string) string {
+	out := make([]rune, 0, len(s))
+	for range s {
+		out = append(out, '*')
+	}
+	return string(out)
+}
+
+func TestShouting(t *testing.T) {
+	f := filter.NewShouting(3, 0.5, 10)
+
+	tests := []struct {
+		name    string
+		text    string
+		matched bool
+		masked  string
+	}{
+		{"обычный текст", "Привет, как дела?", false, "Привет, как дела?"},
+		{"повтор символа", "ураааааа!!!!!", true, "урааа!!!"},
+		{"пробелы не считаются", "а      б", false, "а      б"},
+		{"заглавные", "ВСЕМ СРОЧНО ЧИТАТЬ", true, "всем срочно читать"},
+		{"короткий текст", "СРОЧНО", false, "СРОЧНО"},
+		{"код и ссылки не меняются", "СМОТРИТЕ `MAX_SIZE` И [ДОКИ](https://X.example/API) ```\nGET /API\n```", true,
+			"смотрите `MAX_SIZE` и [доки](https://X.example/API) ```\nGET /API\n```"},
+		{"повторы в коде и ссылках не считаются", "флаг `0x0000000000` и https://x.example/aaaaaaaa ```\n=======\n```", false,
+			"флаг `0x0000000000` и https://x.example/aaaaaaaa ```\n=======\n```"},
+		{"разделительная линия", "заголовок\n==========\nтекст", false, "заголовок\n==========\nтекст"},
+		{"повтор рядом с кодом укорачивается", "ооооо `0x0000000000`", true, "ооо `0x0000000000`"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := &filter.Content{Text: tt.text}
+			_, matched, err := f.Match(context.Background(), content)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matched, matched)
+
+			f.Mask(content)
+			assert.Equal(t, tt.masked, content.Text)
+		})
+	}
+}
+
+// accountAges отдаёт время регистрации из карты и считает обращения
+type accountAges struct {
+	created map[int64]time.Time
+	calls   int
+}
+
+func (a *accountAges) CreatedAt(_ context.Context, userID int64) (time.Time, error) {
+	a.calls++
+	createdAt, ok := a.created[userID]
+	if !ok {
+		return time.Time{}, stdErrors.New("пользователь не найден")
+	}
+	return createdAt, nil
+}
+
+func TestNewAccounts(t *testing.T) {
+	accounts := &accountAges{created: map[int64]time.Time{
+		1: time.Now().Add(-time.Hour),
+		2: time.Now().Add(-48 * time.Hour),
+	}}
+	f := filter.NewNewAccounts(accounts, 24*time.Hour)
+	ctx := context.Background()
+
+	_, matched, err := f.Match(ctx, &filter.Content{AuthorID: 1})
+	require.NoError(t, err)
+	assert.True(t, matched)
+
+	_, matched, err = f.Match(ctx, &filter.Content{AuthorID: 2})
+	require.NoError(t, err)
+	assert.False(t, matched)
+
+	// Старый аккаунт запоминается, auth service больше не спрашивается
+	_, matched, err = f.Match(ctx, &filter.Content{AuthorID: 2})
+	require.NoError(t, err)
+	assert.False(t, matched)
+	assert.Equal(t, 2, accounts.calls)
+
+	_, _, err = f.Match(ctx, &filter.Content{AuthorID: 3})
+	assert.Error(t, err)
+}
+
+func TestChain_Check(t *testing.T) {
+	ctx := context.Background()
+	words := filter.NewBannedWords([]string{"spam"})
+	links := filter.NewLinks(0)
+
+	t.Run("без нарушений", func(t *testing.T) {
+		chain := filter.NewChain([]filter.Rule{{Filter: words, Action: filter.Reject}}, logger.NewStdLogger())
+		verdict, err := chain.Check(ctx, &filter.Content{Target: "post", Text: "привет"})
+		require.NoError(t, err)
+		assert.Equal(t, filter.Allow, verdict.Action)
+	})
+
+	t.Run("самое строгое действие", func(t *testing.T) {
+		chain := filter.NewChain([]filter.Rule{
+			{Filter: words, Action: filter.Mask},
+			{Filter: links, Action: filter.Hold},
+		}, logger.NewStdLogger())
+		content := &filter.Content{Target: "comment", Text: "spam https://x.example"}
+		verdict, err := chain.Check(ctx, content)
+		require.NoError(t, err)
+		assert.Equal(t, filter.Hold, verdict.Action)
+		assert.Equal(t, "links", verdict.Filter)
+		assert.Equal(t, "**** https://x.example", content.Text)
+	})
+
+	t.Run("маскировка исправляет нарушение для следующих правил", func(t *testing.T) {
+		chain := filter.NewChain([]filter.Rule{
+			{Filter: links, Action: filter.Mask},
+			{Filter: filter.NewLinks(0), Action: filter.Reject},
+		}, logger.NewStdLogger())
+		verdict, err := chain.Check(ctx, &filter.Content{Target: "chat", Text: "https://x.example"})
+		require.NoError(t, err)
+		assert.Equal(t, filter.Mask, verdict.Action)
+	})
+
+	t.Run("правило не для этого типа текста", func(t *testing.T) {
+		chain := filter.NewChain([]filter.Rule{{Filter: words, Action: filter.Reject, Targets: []string{"post"}}}, logger.NewStdLogger())
+		verdict, err := chain.Check(ctx, &filter.Content{Target: "chat", Text: "spam"})
+		require.NoError(t, err)
+		assert.Equal(t, filter.Allow, verdict.Action)
+	})
+
+	t.Run("замена правил", func(t *testing.T) {
+		chain := filter.NewChain(nil, logger.NewStdLogger())
+		chain.SetRules([]filter.Rule{{Filter: words, Action: filter.Reject}})
+		verdict, err := chain.Check(ctx, &filter.Content{Target: "post", Text: "spam"})
+		require.NoError(t, err)
+		assert.Equal(t, filter.Reject, verdict.Action)
+		assert.Equal(t, "текст содержит запрещённые слова", verdict.Reason)
+	})
+
+	t.Run("ошибка фильтра задерживает текст", func(t *testing.T) {
+		chain := filter.NewChain([]filter.Rule{
+			{Filter: filter.NewNewAccounts(&accountAges{}, time.Hour), Action: filter.Reject},
+			{Filter: words, Action: filter.Mask},
+		}, logger.NewStdLogger())
+		content := &filter.Content{Target: "post", AuthorID: 1, Text: "spam"}
+		verdict, err := chain.Check(ctx, content)
+		require.NoError(t, err)
+		assert.Equal(t, filter.Hold, verdict.Action)
+		assert.Equal(t, "new_accounts", verdict.Filter)
+		// следующие правила всё равно применяются
+		assert.Equal(t, "****", content.Text)
+	})
+
+	t.Run("отменённый запрос", func(t *testing.T) {
+		chain := filter.NewChain([]filter.Rule{
+			{Filter: filter.NewNewAccounts(&accountAges{}, time.Hour), Action: filter.Hold},
+		}, logger.NewStdLogger())
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := chain.Check(canceled, &filter.Content{Target: "post", AuthorID: 1})
+		assert.Error(t, err)
+	})
+}
+
+func TestRules(t *testing.T) {
+	var cfg filter.Config
+	cfg.NewAccounts.Action = "hold"
+	cfg.NewAccounts.MinAge = time.Hour
+	cfg.BannedWords.Action = "mask"
+	cfg.BannedWords.Words = []string{"spam"}
+	cfg.Shouting.Action = "reject"
+	cfg.Shouting.Targets = []string{"chat"}
+
+	rules, err := filter.Rules(cfg, &accountAges{})
+	require.NoError(t, err)
+	require.Len(t, rules, 3)
+	assert.Equal(t, "new_accounts", rules[0].Filter.Name())
+	assert.Equal(t, filter.Mask, rules[1].Action)
+	assert.Equal(t, []string{"chat"}, rules[2].Targets)
+
+	invalid := map[string]func(cfg *filter.Config){
+		"неизвестное действие":   func(cfg *filter.Config) { cfg.Links.Action = "ban" },
+		"mask без поддержки":     func(cfg *filter.Config) { cfg.NewAccounts.Action = "mask" },
+		"неизвестный тип текста": func(cfg *filter.Config) { cfg.BannedWords.Targets = []string{"profile"} },
+		"нет min_age":            func(cfg *filter.Config) { cfg.NewAccounts.MinAge = 0 },
+		"отрицательный лимит":    func(cfg *filter.Config) { cfg.Links.MaxLinks = -1 },
+		"доля больше единицы":    func(cfg *filter.Config) { cfg.Shouting.MaxCapsRatio = 1.5 },
+	}
+	for name, mutate := range invalid {
+		t.Run(name, func(t *testing.T) {
+			broken := cfg
+			mutate(&broken)
+			_, err := filter.Rules(broken, &accountAges{})
+			assert.Error(t, err)
+		})
+	}
+}
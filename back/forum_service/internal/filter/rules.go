@@ -0,0 +1,340 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// homoglyphs сводит кириллические буквы и цифры к похожим латинским буквам,
+// чтобы слово, набранное вперемешку кириллицей и латиницей, совпало со
+// словом из списка. Применяется после перевода в нижний регистр и к тексту,
+// и к списку слов.
+var homoglyphs = map[rune]rune{
+	'а': 'a', 'в': 'b', 'е': 'e', 'ё': 'e', 'к': 'k', 'м': 'm', 'н': 'h',
+	'о': 'o', 'р': 'p', 'с': 'c', 'т': 't', 'у': 'y', 'х': 'x', 'і': 'i',
+	'0': 'o',
+}
+
+// skeleton приводит слово к виду, в котором неразличимы регистр и похожие буквы
+func skeleton(word string) string {
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if h, ok := homoglyphs[r]; ok {
+			return h
+		}
+		return r
+	}, word)
+}
+
+// wordSpan — слово текста и его положение в байтах
+type wordSpan struct {
+	start, end int
+}
+
+func words(text string) []wordSpan {
+	var spans []wordSpan
+	start := -1
+	for i, r := range text {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			spans = append(spans, wordSpan{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, wordSpan{start, len(text)})
+	}
+	return spans
+}
+
+// maskRunes заменяет каждую руну s звёздочкой
+func maskRunes(s string) string {
+	return strings.Repeat("*", utf8.RuneCountInString(s))
+}
+
+// BannedWords находит запрещённые слова с учётом похожих кириллических
+// и латинских букв. Слово со звёздочкой в конце («казин*») совпадает
+// со всеми словами, которые с него начинаются.
+type BannedWords struct {
+	exact    map[string]bool
+	prefixes []string
+}
+
+func NewBannedWords(list []string) *BannedWords {
+	f := &BannedWords{exact: make(map[string]bool)}
+	for _, word := range list {
+		word = strings.TrimSpace(word)
+		if prefix, ok := strings.CutSuffix(word, "*"); ok {
+			if prefix != "" {
+				f.prefixes = append(f.prefixes, skeleton(prefix))
+			}
+			continue
+		}
+		if word != "" {
+			f.exact[skeleton(word)] = true
+		}
+	}
+	return f
+}
+
+func (f *BannedWords) Name() string { return "banned_words" }
+
+func (f *BannedWords) banned(word string) bool {
+	word = skeleton(word)
+	if f.exact[word] {
+		return true
+	}
+	for _, prefix := range f.prefixes {
+		if strings.HasPrefix(word, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *BannedWords) Match(_ context.Context, content *Content) (string, bool, error) {
+	for _, text := range []string{content.Title, content.Text} {
+		for _, span := range words(text) {
+			if f.banned(text[span.start:span.end]) {
+				return "текст содержит запрещённые слова", true, nil
+			}
+		}
+	}
+	return "", false, nil
+}
+
+// Mask заменяет запрещённые слова звёздочками
+func (f *BannedWords) Mask(content *Content) {
+	content.Title = f.mask(content.Title)
+	content.Text = f.mask(content.Text)
+}
+
+func (f *BannedWords) mask(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, span := range words(text) {
+		word := text[span.start:span.end]
+		if !f.banned(word) {
+			continue
+		}
+		b.WriteString(text[last:span.start])
+		b.WriteString(maskRunes(word))
+		last = span.end
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+var linkPattern = regexp.MustCompile(`(?i)(?:https?://|www\.)[^\s<>()\[\]]+`)
+
+// verbatimPattern находит части Markdown, которые нельзя менять при
+// исправлении текста: блоки и фрагменты кода, адреса ссылок, сами ссылки
+// и разделительные линии
+var verbatimPattern = regexp.MustCompile("(?s)```.*?```|`[^`\n]+`|\\]\\([^)]*\\)|" + linkPattern.String() +
+	"|(?m:^ {0,3}(?:-{3,}|={3,}|\\*{3,}|_{3,})[ \t]*$)")
+
+// outsideVerbatim применяет fn к тексту вне кода и ссылок
+func outsideVerbatim(text string, fn func(string) string) string {
+	var b strings.Builder
+	last := 0
+	for _, span := range verbatimPattern.FindAllStringIndex(text, -1) {
+		b.WriteString(fn(text[last:span[0]]))
+		b.WriteString(text[span[0]:span[1]])
+		last = span[1]
+	}
+	b.WriteString(fn(text[last:]))
+	return b.String()
+}
+
+// plainText возвращает текст без кода и ссылок; вырезанные части заменяются
+// переводом строки, чтобы соседние символы не склеивались в один повтор
+func plainText(text string) string {
+	return verbatimPattern.ReplaceAllString(text, "\n")
+}
+
+// Links ограничивает число ссылок в тексте
+type Links struct {
+	max int
+}
+
+func NewLinks(max int) *Links {
+	return &Links{max: max}
+}
+
+func (f *Links) Name() string { return "links" }
+
+func (f *Links) Match(_ context.Context, content *Content) (string, bool, error) {
+	count := len(linkPattern.FindAllStringIndex(content.Title, -1)) +
+		len(linkPattern.FindAllStringIndex(content.Text, -1))
+	if count > f.max {
+		return fmt.Sprintf("слишком много ссылок: не больше %d", f.max), true, nil
+	}
+	return "", false, nil
+}
+
+// Mask заменяет звёздочками ссылки сверх лимита
+func (f *Links) Mask(content *Content) {
+	kept := 0
+	mask := func(text string) string {
+		return linkPattern.ReplaceAllStringFunc(text, func(link string) string {
+			if kept < f.max {
+				kept++
+				return link
+			}
+			return maskRunes(link)
+		})
+	}
+	content.Title = mask(content.Title)
+	content.Text = mask(content.Text)
+}
+
+// Shouting ловит «крик»: длинные повторы одного символа и текст,
+// набранный в основном заглавными буквами. Код и ссылки не проверяются.
+type Shouting struct {
+	maxRepeat  int     // сколько одинаковых символов подряд допустимо
+	maxCaps    float64 // допустимая доля заглавных среди букв
+	minLetters int     // тексты короче не проверяются на заглавные
+}
+
+func NewShouting(maxRepeat int, maxCaps float64, minLetters int) *Shouting {
+	return &Shouting{maxRepeat: maxRepeat, maxCaps: maxCaps, minLetters: minLetters}
+}
+
+func (f *Shouting) Name() string { return "shouting" }
+
+func (f *Shouting) Match(_ context.Context, content *Content) (string, bool, error) {
+	text := plainText(content.Title) + "\n" + plainText(content.Text)
+	if f.maxRepeat > 0 && longestRun(text) > f.maxRepeat {
+		return "слишком много повторяющихся символов", true, nil
+	}
+	if f.tooManyCaps(text) {
+		return "слишком много заглавных букв", true, nil
+	}
+	return "", false, nil
+}
+
+func (f *Shouting) tooManyCaps(text string) bool {
+	if f.maxCaps <= 0 {
+		return false
+	}
+	var letters, upper int
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	return letters >= f.minLetters && letters > 0 && float64(upper)/float64(letters) > f.maxCaps
+}
+
+// longestRun возвращает длину самого длинного повтора одного символа, кроме пробелов
+func longestRun(text string) int {
+	longest, run := 0, 0
+	var prev rune
+	for _, r := range text {
+		if r == prev && !unicode.IsSpace(r) {
+			run++
+		} else {
+			run = 1
+		}
+		prev = r
+		longest = max(longest, run)
+	}
+	return longest
+}
+
+// Mask укорачивает повторы до допустимой длины и переводит «крик» в нижний
+// регистр. Код и ссылки не меняются: от регистра и повторов зависит их смысл.
+func (f *Shouting) Mask(content *Content) {
+	caps := f.tooManyCaps(plainText(content.Title) + "\n" + plainText(content.Text))
+	fix := func(text string) string {
+		if f.maxRepeat > 0 {
+			text = outsideVerbatim(text, f.collapseRepeats)
+		}
+		if caps {
+			text = outsideVerbatim(text, strings.ToLower)
+		}
+		return text
+	}
+	content.Title = fix(content.Title)
+	content.Text = fix(content.Text)
+}
+
+// collapseRepeats укорачивает повторы одного символа, кроме пробелов, до maxRepeat
+func (f *Shouting) collapseRepeats(text string) string {
+	var b strings.Builder
+	run := 0
+	var prev rune
+	for _, r := range text {
+		if r == prev && !unicode.IsSpace(r) {
+			run++
+		} else {
+			run = 1
+		}
+		prev = r
+		if run <= f.maxRepeat {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// AccountAges сообщает время регистрации пользователя
+type AccountAges interface {
+	CreatedAt(ctx context.Context, userID int64) (time.Time, error)
+}
+
+// NewAccounts ограничивает пользователей, зарегистрированных недавно.
+// Пользователи, чей аккаунт уже достаточно стар, запоминаются, и auth
+// service для них больше не спрашивается.
+type NewAccounts struct {
+	accounts AccountAges
+	minAge   time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	mature map[int64]bool
+}
+
+func NewNewAccounts(accounts AccountAges, minAge time.Duration) *NewAccounts {
+	return &NewAccounts{
+		accounts: accounts,
+		minAge:   minAge,
+		now:      time.Now,
+		mature:   make(map[int64]bool),
+	}
+}
+
+func (f *NewAccounts) Name() string { return "new_accounts" }
+
+func (f *NewAccounts) Match(ctx context.Context, content *Content) (string, bool, error) {
+	f.mu.Lock()
+	mature := f.mature[content.AuthorID]
+	f.mu.Unlock()
+	if mature {
+		return "", false, nil
+	}
+
+	createdAt, err := f.accounts.CreatedAt(ctx, content.AuthorID)
+	if err != nil {
+		return "", false, err
+	}
+	if f.now().Sub(createdAt) < f.minAge {
+		return fmt.Sprintf("аккаунт моложе %s", f.minAge), true, nil
+	}
+	f.mu.Lock()
+	f.mature[content.AuthorID] = true
+	f.mu.Unlock()
+	return "", false, nil
+}
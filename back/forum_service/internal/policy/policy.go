@@ -0,0 +1,52 @@
+// Package policy решает, кто может менять и удалять посты и комментарии.
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/netabakovv/forum/back/pkg/errors"
+	pb "github.com/netabakovv/forum/back/proto"
+)
+
+// Policy пускает автора к собственному контенту, администратора — к любому.
+// Права администратора проверяются через auth service.
+type Policy struct {
+	auth pb.AuthServiceClient
+}
+
+func New(auth pb.AuthServiceClient) *Policy {
+	return &Policy{auth: auth}
+}
+
+// CanModify возвращает errors.ErrPermissionDenied, если actorID не может
+// править или удалять контент автора ownerID. Нулевой actorID — аноним.
+func (p *Policy) CanModify(ctx context.Context, actorID, ownerID int64) error {
+	if actorID == 0 {
+		return errors.ErrPermissionDenied
+	}
+	if actorID == ownerID {
+		return nil
+	}
+
+	isAdmin, err := p.IsAdmin(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return errors.ErrPermissionDenied
+	}
+	return nil
+}
+
+// IsAdmin спрашивает у auth service, администратор ли пользователь
+func (p *Policy) IsAdmin(ctx context.Context, userID int64) (bool, error) {
+	if userID == 0 || p.auth == nil {
+		return false, nil
+	}
+	resp, err := p.auth.CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: userID})
+	if err != nil {
+		return false, fmt.Errorf("проверка прав администратора: %w", err)
+	}
+	return resp.IsAdmin, nil
+}
@@ -0,0 +1,72 @@
+package policy_test
+
+import (
+	"context"
+	stdErrors "errors"
+	"testing"
+
+	"github.com/netabakovv/forum/back/forum_service/internal/policy"
+	"github.com/netabakovv/forum/back/pkg/errors"
+	pb "github.com/netabakovv/forum/back/proto"
+	"github.com/netabakovv/forum/back/proto/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy_CanModify(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		actorID int64
+		ownerID int64
+		isAdmin *bool // nil — auth service не вызывается
+		wantErr error
+	}{
+		{name: "автор", actorID: 1, ownerID: 1},
+		{name: "администратор", actorID: 2, ownerID: 1, isAdmin: boolPtr(true)},
+		{name: "чужой пользователь", actorID: 3, ownerID: 1, isAdmin: boolPtr(false), wantErr: errors.ErrPermissionDenied},
+		{name: "аноним", actorID: 0, ownerID: 1, wantErr: errors.ErrPermissionDenied},
+		{name: "аноним и контент без автора", actorID: 0, ownerID: 0, wantErr: errors.ErrPermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			auth := mocks.NewMockAuthServiceClient(ctrl)
+			if tt.isAdmin != nil {
+				auth.EXPECT().
+					CheckAdminStatus(ctx, &pb.CheckAdminRequest{UserId: tt.actorID}).
+					Return(&pb.CheckAdminResponse{IsAdmin: *tt.isAdmin}, nil)
+			}
+
+			err := policy.New(auth).CanModify(ctx, tt.actorID, tt.ownerID)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicy_CanModify_AuthError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	authErr := stdErrors.New("auth service недоступен")
+	auth := mocks.NewMockAuthServiceClient(ctrl)
+	auth.EXPECT().CheckAdminStatus(ctx, gomock.Any()).Return(nil, authErr)
+
+	err := policy.New(auth).CanModify(ctx, 2, 1)
+	assert.ErrorIs(t, err, authErr)
+	assert.NotErrorIs(t, err, errors.ErrPermissionDenied)
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
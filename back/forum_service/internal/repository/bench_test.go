@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/netabakovv/forum/back/forum_service/internal/entities"
+	"github.com/netabakovv/forum/back/forum_service/internal/repository"
+	"github.com/netabakovv/forum/back/pkg/logger"
+
+	_ "github.com/lib/pq"
+)
+
+// benchAuthorID — автор постов бенчмарка, чтобы выборка не задевала остальные данные базы
+const benchAuthorID = 999_999_001
+
+// BenchmarkPosts проверяет, что страница ленты не дорожает с ростом обсуждений.
+// Нужна база с накатанными миграциями, например тестовая из taskFile.yaml:
+//
+//	FORUM_BENCH_DB="postgres://postgres:1@localhost:5555/forum_test?sslmode=disable" \
+//	    go test -run '^$' -bench Posts ./forum_service/internal/repository/
+func BenchmarkPosts(b *testing.B) {
+	dsn := os.Getenv("FORUM_BENCH_DB")
+	if dsn == "" {
+		b.Skip("FORUM_BENCH_DB не задан")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	repo := repository.NewPostRepository(db, logger.NewStdLogger())
+	authorID := int64(benchAuthorID)
+
+	for _, perPost := range []int{0, 100, 1000} {
+		seedBenchPosts(b, db, 50, perPost)
+		if _, err := repo.RecountComments(ctx); err != nil {
+			b.Fatal(err)
+		}
+
+		for _, sort := range []string{entities.PostSortNew, entities.PostSortTop, entities.PostSortActive} {
+			b.Run(fmt.Sprintf("comments=%d/sort=%s", perPost, sort), func(b *testing.B) {
+				filter := entities.PostFilter{AuthorID: &authorID, Sort: sort, Limit: 20}
+				for i := 0; i < b.N; i++ {
+					if _, err := repo.Posts(ctx, filter); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// seedBenchPosts заменяет посты бенчмарка на posts постов с perPost комментариями в каждом
+func seedBenchPosts(b *testing.B, db *sql.DB, posts, perPost int) {
+	b.Helper()
+	cleanup := func() {
+		if _, err := db.Exec(`DELETE FROM posts WHERE author_id = $1`, benchAuthorID); err != nil {
+			b.Fatal(err)
+		}
+	}
+	cleanup()
+	b.Cleanup(cleanup)
+
+	_, err := db.Exec(`
+		WITH p AS (
+			INSERT INTO posts (title, content, author_id, username, created_at)
+			SELECT 'bench ' || n, 'bench', $1, 'bench', NOW() - n * INTERVAL '1 minute'
+			FROM generate_series(1, $2) AS n
+			RETURNING id
+		)
+		INSERT INTO comments (post_id, author_id, username, content, created_at)
+		SELECT p.id, $1, 'bench', 'bench', NOW() - n * INTERVAL '1 second'
+		FROM p, generate_series(1, $3) AS n`,
+		benchAuthorID, posts, perPost)
+	if err != nil {
+		b.Fatal(err)
+	}
+}
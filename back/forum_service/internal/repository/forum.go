@@ -5,35 +5,157 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/netabakovv/forum/back/forum_service/internal/entities"
+	"github.com/netabakovv/forum/back/pkg/archive"
 	e "github.com/netabakovv/forum/back/pkg/errors"
 	"github.com/netabakovv/forum/back/pkg/logger"
+
+	"github.com/lib/pq"
 )
 
 const (
 	DefaultMessagesLimit = 100
 	DefaultPostsLimit    = 20
+	MaxPostsLimit        = 100
+	DefaultSearchLimit   = 20
+	DefaultTrashLimit    = 50
+	MaxTrashLimit        = 100
+	MaxSearchLimit       = 50
+	MaxSearchOffset      = 1000
+)
+
+// Коды ошибок PostgreSQL, которые репозиторий переводит в доменные ошибки
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
 )
 
+// postColumns — колонки выборки поста в порядке, который ожидает scanPost.
+// Заголовок и текст удалённого поста не отдаются.
+const postColumns = `id,
+			CASE WHEN deleted_at IS NULL THEN title ELSE '' END AS title,
+			CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+			CASE WHEN deleted_at IS NULL THEN content_html ELSE '' END AS content_html,
+			author_id, username, created_at, updated_at,
+			comment_count,
+			category_id,
+			ARRAY(SELECT tag FROM post_tags WHERE post_id = p.id ORDER BY tag) as tags,
+			score, deleted_at IS NOT NULL AS deleted,
+			status, publish_at, is_pinned, is_locked,
+			last_comment_at,
+			hot_rank, view_count`
+
+// searchHeadlineOptions — параметры ts_headline для фрагментов поисковой выдачи
+const searchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2"
+
+// headlineEscaper экранирует фрагмент ts_headline, собранный из исходного текста,
+// и возвращает на место только разметку совпадений
+var headlineEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&#34;", "'", "&#39;")
+
+var headlineMarks = strings.NewReplacer("&lt;mark&gt;", "<mark>", "&lt;/mark&gt;", "</mark>")
+
+// safeHeadline превращает фрагмент поисковой выдачи в HTML, где допустим только <mark>
+func safeHeadline(headline string) string {
+	return headlineMarks.Replace(headlineEscaper.Replace(headline))
+}
+
 const (
 	TargetTypePost    = "post"
 	TargetTypeComment = "comment"
+	TargetTypeChat    = "chat" // сообщение чата, только для упоминаний
 )
 
 type ChatRepository interface {
-	SaveMessage(ctx context.Context, userID int64, username, content string) error
+	SaveMessage(ctx context.Context, userID int64, username, content string) (int64, error)
 	DeleteOldMessages(ctx context.Context, before time.Time) error
 	GetMessages(ctx context.Context) ([]*entities.ChatMessage, error)
+	GetMessage(ctx context.Context, id int64) (*entities.ChatMessage, error)
+	DeleteMessage(ctx context.Context, id int64) error
 }
 
 type PostRepository interface {
 	CreatePost(ctx context.Context, post *entities.Post) error
 	GetPostByID(ctx context.Context, id int64) (*entities.Post, error)
 	UpdatePost(ctx context.Context, post *entities.Post) error
-	DeletePost(ctx context.Context, id int64) error
-	Posts(ctx context.Context) ([]*entities.Post, error)
+	DeletePost(ctx context.Context, id, deletedBy int64, reason string) error
+	RestorePost(ctx context.Context, id int64) error
+	DeletedPosts(ctx context.Context, limit, offset int) ([]*entities.Post, error)
+	PurgePosts(ctx context.Context, before time.Time) (int64, error)
+	Posts(ctx context.Context, filter entities.PostFilter) ([]*entities.Post, error)
+	Drafts(ctx context.Context, authorID int64, limit, offset int) ([]*entities.Post, error)
+	SetPostStatus(ctx context.Context, id int64, status string, publishAt *time.Time) error
+	PublishDue(ctx context.Context, now time.Time) (int64, error)
+	SetPostPinned(ctx context.Context, id int64, pinned bool) error
+	SetPostLocked(ctx context.Context, id int64, locked bool) error
+	RefreshHotRanks(ctx context.Context, since time.Time) (int64, error)
+	RecountComments(ctx context.Context) (int64, error)
+	SearchPosts(ctx context.Context, q entities.SearchQuery) ([]*entities.SearchHit, int, error)
+}
+
+type CategoryRepository interface {
+	CreateCategory(ctx context.Context, category *entities.Category) error
+	GetCategoryByID(ctx context.Context, id int64) (*entities.Category, error)
+	UpdateCategory(ctx context.Context, category *entities.Category) error
+	DeleteCategory(ctx context.Context, id int64) error
+	Categories(ctx context.Context) ([]*entities.Category, error)
+}
+
+type VoteRepository interface {
+	Vote(ctx context.Context, vote *entities.Vote) (int64, error)
+	RemoveVote(ctx context.Context, userID int64, targetType string, targetID int64) (int64, error)
+	UserVotes(ctx context.Context, userID int64, targetType string, targetIDs []int64) (map[int64]int32, error)
+}
+
+// PollRepository хранит голоса в опросах и считает результаты.
+// Сам опрос создаётся вместе с постом в CreatePost.
+type PollRepository interface {
+	// CastPollVote записывает бюллетень пользователя: голосовать можно один раз,
+	// только в открытом опросе опубликованного неудалённого поста
+	CastPollVote(ctx context.Context, postID, userID int64, username string, optionIDs []int64) error
+	// Polls возвращает опросы постов с числом голосов и выбором viewerID
+	Polls(ctx context.Context, postIDs []int64, viewerID int64) (map[int64]*entities.Poll, error)
+	// PollVoters возвращает проголосовавших по ID вариантов
+	PollVoters(ctx context.Context, postID int64) (map[int64][]*entities.PollVoter, error)
+}
+
+// ReportRepository хранит жалобы и предупреждения авторам
+type ReportRepository interface {
+	// CreateReport сохраняет жалобу; повторная открытая жалоба того же
+	// пользователя на ту же цель — errors.ErrAlreadyReported
+	CreateReport(ctx context.Context, report *entities.Report) error
+	GetReport(ctx context.Context, id int64) (*entities.Report, error)
+	// ReportGroups возвращает жалобы, сгруппированные по целям; сначала
+	// цели с последней жалобой позже
+	ReportGroups(ctx context.Context, filter entities.ReportFilter) ([]*entities.ReportGroup, error)
+	// ResolveReports закрывает все открытые жалобы на цель report со статусом
+	// status. Если открытых жалоб не осталось — errors.ErrReportResolved, и
+	// больше ничего не меняется. Иначе в той же транзакции для
+	// entities.ReportStatusWarned автору выносится предупреждение, а для
+	// entities.ReportStatusRemoved контент удаляется с причиной deleteReason
+	// (посты и комментарии — в корзину). Возвращает число закрытых жалоб.
+	ResolveReports(ctx context.Context, report *entities.Report, status string, resolvedBy int64, note, deleteReason string) (int64, error)
+	Warnings(ctx context.Context, userID int64, limit, offset int) ([]*entities.Warning, error)
+}
+
+type RevisionRepository interface {
+	Revisions(ctx context.Context, targetType string, targetID int64) ([]*entities.Revision, error)
+	GetRevision(ctx context.Context, id int64) (*entities.Revision, error)
+}
+
+// HeldRepository — очередь модерации текстов, задержанных фильтрами. Тексты
+// попадают в неё при сохранении постов и комментариев с заполненным Hold.
+type HeldRepository interface {
+	// HeldContent возвращает очередь, давно ждущие первыми
+	HeldContent(ctx context.Context, limit, offset int) ([]*entities.HeldContent, error)
+	// ApproveHeld публикует текст и убирает его из очереди. У нового
+	// комментария в ответе проставляется TargetID.
+	ApproveHeld(ctx context.Context, id int64) (*entities.HeldContent, error)
+	// RejectHeld убирает текст из очереди. Новый пост уходит в корзину
+	// черновиком с причиной reason, правки и новые комментарии просто отбрасываются.
+	RejectHeld(ctx context.Context, id, rejectedBy int64, reason string) (*entities.HeldContent, error)
 }
 
 type CommentRepository interface {
@@ -42,7 +164,87 @@ type CommentRepository interface {
 	GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error)
 	GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error)
 	UpdateComment(ctx context.Context, comment *entities.Comment) error
-	DeleteComment(ctx context.Context, id int64) error
+	DeleteComment(ctx context.Context, id, deletedBy int64, reason string) error
+	RestoreComment(ctx context.Context, id int64) error
+	DeletedComments(ctx context.Context, limit, offset int) ([]*entities.Comment, error)
+	PurgeComments(ctx context.Context, before time.Time) (int64, error)
+	SearchComments(ctx context.Context, q entities.SearchQuery) ([]*entities.SearchHit, int, error)
+}
+
+// AttachmentRepository хранит метаданные вложений; содержимое лежит в blobstore
+type AttachmentRepository interface {
+	CreateAttachment(ctx context.Context, att *entities.Attachment, quota int64) error
+	GetAttachment(ctx context.Context, id, viewerID int64) (*entities.Attachment, error)
+	Attachments(ctx context.Context, targetType string, targetIDs []int64) (map[int64][]*entities.Attachment, error)
+	UserAttachmentsSize(ctx context.Context, userID int64) (int64, error)
+	DeleteAttachment(ctx context.Context, id int64) error
+	OrphanedAttachments(ctx context.Context, limit int) ([]*entities.Attachment, error)
+}
+
+// BookmarkRepository хранит закладки пользователей на посты
+type BookmarkRepository interface {
+	AddBookmark(ctx context.Context, bookmark *entities.Bookmark) error
+	RemoveBookmark(ctx context.Context, userID, postID int64) error
+	Bookmarks(ctx context.Context, userID int64, limit, offset int) ([]*entities.Bookmark, error)
+	BookmarkedPosts(ctx context.Context, userID int64, postIDs []int64) (map[int64]bool, error)
+}
+
+// NotificationRepository хранит подписки на обсуждения и уведомления о них
+type NotificationRepository interface {
+	SetSubscription(ctx context.Context, userID, postID int64, subscribed bool) error
+	NotifyComment(ctx context.Context, comment *entities.Comment) (int64, error)
+	Notifications(ctx context.Context, userID int64, unreadOnly bool, limit, offset int) ([]*entities.Notification, error)
+	UnreadCount(ctx context.Context, userID int64) (int64, error)
+	MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error)
+	MarkAllRead(ctx context.Context, userID int64) (int64, error)
+}
+
+// MentionRepository хранит упоминания пользователей в постах, комментариях и чате
+type MentionRepository interface {
+	SaveMentions(ctx context.Context, targetType string, targetID int64, mentions []*entities.Mention) error
+	Mentions(ctx context.Context, targetType string, targetID int64) ([]*entities.Mention, error)
+	UserMentions(ctx context.Context, userID int64, limit, offset int) ([]*entities.Mention, error)
+}
+
+// ViewRepository записывает накопленные просмотры постов
+type ViewRepository interface {
+	AddViews(ctx context.Context, views map[int64]int64) error
+}
+
+// ContentRepository хранит отрендеренный HTML постов и комментариев
+type ContentRepository interface {
+	UnrenderedContent(ctx context.Context, targetType string, afterID int64, limit int) (map[int64]string, error)
+	SetContentHTML(ctx context.Context, targetType string, id int64, contentHTML string) error
+}
+
+// ArchiveRepository выгружает содержимое форума в архив forumctl и загружает его обратно.
+// Выгрузка идёт страницами по возрастанию ID и включает черновики и удалённые записи.
+// Загрузка сохраняет исходные даты и в той же транзакции запоминает, под каким ID
+// загружена каждая запись архива: повторная загрузка по ImportedIDs их пропускает.
+type ArchiveRepository interface {
+	ArchivePosts(ctx context.Context, afterID int64, limit int) ([]*entities.Post, error)
+	ArchiveComments(ctx context.Context, afterID int64, limit int) ([]*entities.Comment, error)
+	ArchiveMessages(ctx context.Context, afterID int64, limit int) ([]*entities.ChatMessage, error)
+	ImportedIDs(ctx context.Context, source, kind string) (map[int64]int64, error)
+	MapImportedID(ctx context.Context, source, kind string, sourceID, targetID int64) error
+	ImportCategory(ctx context.Context, source string, sourceID int64, category *entities.Category) error
+	ImportPost(ctx context.Context, source string, sourceID int64, post *entities.Post) error
+	ImportComment(ctx context.Context, source string, sourceID int64, comment *entities.Comment) error
+	ImportMessage(ctx context.Context, source string, sourceID int64, msg *entities.ChatMessage) error
+}
+
+// AuthorRepository поддерживает имена пользователей, скопированные при записи
+// в посты, комментарии, сообщения чата, голоса в опросах и очередь модерации,
+// в соответствии с auth_service
+type AuthorRepository interface {
+	// AuthorIDs возвращает до limit ID авторов постов, комментариев, сообщений, голосов
+	// и текстов на модерации больше afterID
+	AuthorIDs(ctx context.Context, afterID int64, limit int) ([]int64, error)
+	// RenameAuthor проставляет имя во всех записях автора и возвращает число исправленных записей
+	RenameAuthor(ctx context.Context, userID int64, username string) (int64, error)
+	// EventCursor возвращает ID последнего обработанного события журнала name или 0
+	EventCursor(ctx context.Context, name string) (int64, error)
+	SetEventCursor(ctx context.Context, name string, position int64) error
 }
 
 type Db struct {
@@ -62,85 +264,2306 @@ func NewChatRepository(db *sql.DB, log logger.Logger) ChatRepository {
 	return &Db{db: db, logger: log}
 }
 
+func NewCategoryRepository(db *sql.DB, log logger.Logger) CategoryRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewVoteRepository(db *sql.DB, log logger.Logger) VoteRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewPollRepository(db *sql.DB, log logger.Logger) PollRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewReportRepository(db *sql.DB, log logger.Logger) ReportRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewRevisionRepository(db *sql.DB, log logger.Logger) RevisionRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewAttachmentRepository(db *sql.DB, log logger.Logger) AttachmentRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewBookmarkRepository(db *sql.DB, log logger.Logger) BookmarkRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewNotificationRepository(db *sql.DB, log logger.Logger) NotificationRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewMentionRepository(db *sql.DB, log logger.Logger) MentionRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewViewRepository(db *sql.DB, log logger.Logger) ViewRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewContentRepository(db *sql.DB, log logger.Logger) ContentRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewArchiveRepository(db *sql.DB, log logger.Logger) ArchiveRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewAuthorRepository(db *sql.DB, log logger.Logger) AuthorRepository {
+	return &Db{db: db, logger: log}
+}
+
+func NewHeldRepository(db *sql.DB, log logger.Logger) HeldRepository {
+	return &Db{db: db, logger: log}
+}
+
+// pgErrorCode возвращает код ошибки PostgreSQL или пустую строку
+func pgErrorCode(err error) pq.ErrorCode {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code
+	}
+	return ""
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// extraColumns дочитывает колонки, выбранные после колонок сущности
+type extraColumns struct {
+	row  rowScanner
+	dest []any
+}
+
+// withColumns позволяет переиспользовать scanPost и подобные функции для
+// выборок, где за колонками сущности идут дополнительные
+func withColumns(row rowScanner, dest ...any) rowScanner {
+	return extraColumns{row: row, dest: dest}
+}
+
+func (c extraColumns) Scan(dest ...any) error {
+	return c.row.Scan(append(dest, c.dest...)...)
+}
+
+func scanPost(row rowScanner) (*entities.Post, error) {
+	post := &entities.Post{}
+	err := row.Scan(
+		&post.ID, &post.Title, &post.Content, &post.ContentHTML, &post.AuthorID,
+		&post.AuthorName,
+		&post.CreatedAt, &post.UpdatedAt, &post.CommentCount,
+		&post.CategoryID, pq.Array(&post.Tags),
+		&post.Score, &post.Deleted,
+		&post.Status, &post.PublishAt,
+		&post.IsPinned, &post.IsLocked,
+		&post.LastCommentAt, &post.HotRank, &post.ViewCount,
+	)
+	return post, err
+}
+
+func insertPostTags(ctx context.Context, tx *sql.Tx, postID int64, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	query := `INSERT INTO post_tags (post_id, tag) SELECT $1, unnest($2::text[]) ON CONFLICT DO NOTHING`
+	_, err := tx.ExecContext(ctx, query, postID, pq.Array(tags))
+	return err
+}
+
+// replacePostTags заменяет набор тегов поста внутри транзакции
+func replacePostTags(ctx context.Context, tx *sql.Tx, postID int64, tags []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM post_tags WHERE post_id = $1`, postID); err != nil {
+		return err
+	}
+	return insertPostTags(ctx, tx, postID, tags)
+}
+
+// expectAffected возвращает notFound, если запрос не изменил ни одной строки
+func expectAffected(res sql.Result, notFound error) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
+
+func queryIDs(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]int64, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// purgeTargetRecords удаляет голоса, историю правок, упоминания и задержанные
+// правки целей. Внешних ключей на цели у этих таблиц нет, поэтому каскад их не затронет.
+func purgeTargetRecords(ctx context.Context, tx *sql.Tx, targetType string, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	for _, table := range []string{"votes", "revisions", "mentions", "held_content"} {
+		query := `DELETE FROM ` + table + ` WHERE target_type = $1 AND target_id = ANY($2)`
+		if _, err := tx.ExecContext(ctx, query, targetType, pq.Array(ids)); err != nil {
+			return fmt.Errorf("очистка %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
 // --- Post Repository ---
 
 func (r *Db) CreatePost(ctx context.Context, post *entities.Post) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("создание поста: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := `
-		INSERT INTO posts (title, content, author_id, username, created_at)
-		VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+		INSERT INTO posts (title, content, content_html, author_id, username, category_id, status, publish_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP)
 		RETURNING id, created_at`
-	err := r.db.QueryRowContext(ctx, query, post.Title, post.Content, post.AuthorID, post.AuthorName).
+	err = tx.QueryRowContext(ctx, query, post.Title, post.Content, post.ContentHTML, post.AuthorID, post.AuthorName, post.CategoryID,
+		post.Status, post.PublishAt).
 		Scan(&post.ID, &post.CreatedAt)
+	if pgErrorCode(err) == pgForeignKeyViolation {
+		return e.ErrCategoryNotFound
+	}
 	if err != nil {
 		return fmt.Errorf("создание поста: %w", err)
 	}
+
+	if err := insertPostTags(ctx, tx, post.ID, post.Tags); err != nil {
+		return fmt.Errorf("сохранение тегов поста: %w", err)
+	}
+	if post.Poll != nil {
+		if err := insertPoll(ctx, tx, post.ID, post.Poll); err != nil {
+			return fmt.Errorf("сохранение опроса: %w", err)
+		}
+	}
+	if post.Hold != nil {
+		if err := holdPost(ctx, tx, post, post.Status); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// insertPoll сохраняет опрос поста и проставляет ID его вариантам
+func insertPoll(ctx context.Context, tx *sql.Tx, postID int64, poll *entities.Poll) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO polls (post_id, multiple_choice, public, closes_at)
+		VALUES ($1, $2, $3, $4)`,
+		postID, poll.MultipleChoice, poll.Public, poll.ClosesAt)
+	if err != nil {
+		return err
+	}
+	for i, option := range poll.Options {
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO poll_options (post_id, position, text) VALUES ($1, $2, $3)
+			RETURNING id`, postID, i, option.Text).Scan(&option.ID)
+		if err != nil {
+			return err
+		}
+	}
+	poll.PostID = postID
 	return nil
 }
 
 func (r *Db) GetPostByID(ctx context.Context, id int64) (*entities.Post, error) {
 	query := `
-		SELECT id, title, content, author_id, username, created_at, updated_at,
-			(SELECT COUNT(*) FROM comments WHERE post_id = p.id) as comment_count
+		SELECT ` + postColumns + `
 		FROM posts p WHERE id = $1`
 
-	post := &entities.Post{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&post.ID, &post.Title, &post.Content, &post.AuthorID,
-		&post.AuthorName,
-		&post.CreatedAt, &post.UpdatedAt, &post.CommentCount,
-	)
+	post, err := scanPost(r.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, e.ErrPostNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("получение поста: %w", err)
 	}
 	return post, nil
 }
 
-func (r *Db) UpdatePost(ctx context.Context, post *entities.Post) error {
-	query := `UPDATE posts SET title = $1, content = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $3`
-	_, err := r.db.ExecContext(ctx, query, post.Title, post.Content, post.ID)
-	return err
-}
+// UpdatePost сохраняет заголовок, текст и категорию поста. Теги заменяются,
+// только если post.Tags не nil: пустой срез очищает теги. Изменение заголовка
+// или текста записывается в историю правок.
+//
+// Правка с заполненным Hold у опубликованного поста только ставится в очередь
+// модерации: читатели видят прежнюю версию, пока её не одобрят. Неопубликованный
+// пост правится на месте и ждёт модератора целиком. Правка без Hold отменяет
+// задержанную ранее правку опубликованного поста, а с KeepHeldEdit вместо этого
+// отказывает с ErrEditHeld.
+func (r *Db) UpdatePost(ctx context.Context, post *entities.Post) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := updatePost(ctx, tx, post); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func updatePost(ctx context.Context, tx *sql.Tx, post *entities.Post) error {
+	var (
+		title, content, username, status string
+		authorID                         int64
+	)
+	err := tx.QueryRowContext(ctx, `
+		SELECT title, content, author_id, username, status FROM posts
+		WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, post.ID).
+		Scan(&title, &content, &authorID, &username, &status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return e.ErrPostNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if post.KeepHeldEdit && status == entities.PostStatusPublished {
+		if err := checkHeldEdit(ctx, tx, TargetTypePost, post.ID); err != nil {
+			return err
+		}
+	}
+	editorID := post.EditorID
+	if editorID == 0 {
+		editorID = authorID
+	}
+	if post.Hold != nil && status == entities.PostStatusPublished {
+		held := &entities.HeldContent{
+			TargetType:  TargetTypePost,
+			TargetID:    post.ID,
+			PostID:      post.ID,
+			IsEdit:      true,
+			AuthorID:    authorID,
+			AuthorName:  username,
+			EditorID:    editorID,
+			Title:       post.Title,
+			Content:     post.Content,
+			ContentHTML: post.ContentHTML,
+			CategoryID:  post.CategoryID,
+			Tags:        post.Tags,
+			Reason:      post.Hold.Reason,
+		}
+		if err := holdContent(ctx, tx, held); err != nil {
+			return err
+		}
+		post.Hold.HeldAt = held.CreatedAt
+		return nil
+	}
+	edited := title != post.Title || content != post.Content
+	if edited {
+		if err := recordOriginalRevision(ctx, tx, TargetTypePost, post.ID); err != nil {
+			return err
+		}
+	}
+
+	query := `UPDATE posts SET title = $1, content = $2, content_html = $3, category_id = $4, updated_at = CURRENT_TIMESTAMP WHERE id = $5`
+	_, err = tx.ExecContext(ctx, query, post.Title, post.Content, post.ContentHTML, post.CategoryID, post.ID)
+	if pgErrorCode(err) == pgForeignKeyViolation {
+		return e.ErrCategoryNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if edited {
+		err := recordRevision(ctx, tx, &entities.Revision{
+			TargetType: TargetTypePost,
+			TargetID:   post.ID,
+			Title:      post.Title,
+			Content:    post.Content,
+			EditorID:   editorID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if post.Tags != nil {
+		if err := replacePostTags(ctx, tx, post.ID, post.Tags); err != nil {
+			return fmt.Errorf("сохранение тегов поста: %w", err)
+		}
+	}
+
+	switch {
+	case post.Hold != nil:
+		// Пост уже ждёт модератора: статус, с которым он выйдет, не меняется
+		intended := status
+		if status == entities.PostStatusHeld {
+			intended = ""
+		}
+		post.AuthorID, post.AuthorName = authorID, username
+		return holdPost(ctx, tx, post, intended)
+	case status == entities.PostStatusPublished:
+		return dropHeldEdit(ctx, tx, TargetTypePost, post.ID)
+	}
+	return nil
+}
+
+// holdPost переводит неопубликованный пост в статус held и ставит его в очередь
+// модерации. intended — статус, который пост получит после одобрения; пустой
+// оставляет статус, запомненный раньше.
+func holdPost(ctx context.Context, tx *sql.Tx, post *entities.Post, intended string) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE posts SET status = 'held' WHERE id = $1`, post.ID); err != nil {
+		return fmt.Errorf("задержка поста: %w", err)
+	}
+	held := &entities.HeldContent{
+		TargetType: TargetTypePost,
+		TargetID:   post.ID,
+		PostID:     post.ID,
+		AuthorID:   post.AuthorID,
+		AuthorName: post.AuthorName,
+		PostStatus: intended,
+		Reason:     post.Hold.Reason,
+	}
+	if err := holdContent(ctx, tx, held); err != nil {
+		return err
+	}
+	post.Status = entities.PostStatusHeld
+	post.Hold.HeldAt = held.CreatedAt
+	return nil
+}
+
+// DeletePost переносит пост в корзину. Комментарии остаются на месте и
+// возвращаются вместе с постом при восстановлении.
+func (r *Db) DeletePost(ctx context.Context, id, deletedBy int64, reason string) error {
+	query := `
+		UPDATE posts SET deleted_at = CURRENT_TIMESTAMP, deleted_by = $2, delete_reason = $3
+		WHERE id = $1 AND deleted_at IS NULL`
+	res, err := r.db.ExecContext(ctx, query, id, deletedBy, reason)
+	if err != nil {
+		return fmt.Errorf("удаление поста: %w", err)
+	}
+	return expectAffected(res, e.ErrPostNotFound)
+}
+
+// RestorePost возвращает пост из корзины
+func (r *Db) RestorePost(ctx context.Context, id int64) error {
+	query := `
+		UPDATE posts SET deleted_at = NULL, deleted_by = NULL, delete_reason = ''
+		WHERE id = $1 AND deleted_at IS NOT NULL`
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("восстановление поста: %w", err)
+	}
+	return expectAffected(res, e.ErrPostNotFound)
+}
+
+// DeletedPosts возвращает корзину постов, недавно удалённые первыми
+func (r *Db) DeletedPosts(ctx context.Context, limit, offset int) ([]*entities.Post, error) {
+	query := `
+		SELECT id, title, content, author_id, username, created_at, updated_at,
+			deleted_at, COALESCE(deleted_by, 0), delete_reason
+		FROM posts
+		WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id DESC
+		LIMIT $1 OFFSET $2`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("получение корзины постов: %w", err)
+	}
+	defer rows.Close()
+
+	var posts []*entities.Post
+	for rows.Next() {
+		post := &entities.Post{Deleted: true, Deletion: &entities.Deletion{}}
+		if err := rows.Scan(
+			&post.ID, &post.Title, &post.Content, &post.AuthorID, &post.AuthorName,
+			&post.CreatedAt, &post.UpdatedAt,
+			&post.Deletion.DeletedAt, &post.Deletion.DeletedBy, &post.Deletion.Reason,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования поста: %w", err)
+		}
+		posts = append(posts, post)
+	}
+	return posts, rows.Err()
+}
+
+// PurgePosts окончательно удаляет посты, лежащие в корзине с момента before
+// и раньше, вместе с их комментариями, голосами и историей правок.
+func (r *Db) PurgePosts(ctx context.Context, before time.Time) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	postIDs, err := queryIDs(ctx, tx, `SELECT id FROM posts WHERE deleted_at < $1 FOR UPDATE`, before)
+	if err != nil || len(postIDs) == 0 {
+		return 0, err
+	}
+	commentIDs, err := queryIDs(ctx, tx, `SELECT id FROM comments WHERE post_id = ANY($1)`, pq.Array(postIDs))
+	if err != nil {
+		return 0, err
+	}
+	if err := purgeTargetRecords(ctx, tx, TargetTypeComment, commentIDs); err != nil {
+		return 0, err
+	}
+	if err := purgeTargetRecords(ctx, tx, TargetTypePost, postIDs); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM posts WHERE id = ANY($1)`, pq.Array(postIDs)); err != nil {
+		return 0, fmt.Errorf("очистка корзины постов: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int64(len(postIDs)), nil
+}
+
+// Posts возвращает страницу ленты постов. Закреплённые посты идут первыми,
+// если не задан filter.IgnorePinned; внутри каждого блока пагинация keyset по (ключ сортировки, id): filter.After
+// задаёт последний пост предыдущей страницы, поэтому стоимость запроса не
+// зависит от глубины листания.
+func (r *Db) Posts(ctx context.Context, filter entities.PostFilter) ([]*entities.Post, error) {
+	var (
+		conditions = []string{"deleted_at IS NULL"}
+		args       []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.ViewerID != 0 {
+		conditions = append(conditions, "(status = 'published' OR author_id = "+arg(filter.ViewerID)+")")
+	} else {
+		conditions = append(conditions, "status = 'published'")
+	}
+	if filter.AuthorID != nil {
+		conditions = append(conditions, "author_id = "+arg(*filter.AuthorID))
+	}
+	if filter.CreatedFrom != nil {
+		conditions = append(conditions, "created_at >= "+arg(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		conditions = append(conditions, "created_at < "+arg(*filter.CreatedTo))
+	}
+	if filter.CategoryID != nil {
+		conditions = append(conditions, "category_id = "+arg(*filter.CategoryID))
+	}
+	if filter.Tag != "" {
+		conditions = append(conditions,
+			"EXISTS (SELECT 1 FROM post_tags t WHERE t.post_id = p.id AND t.tag = "+arg(filter.Tag)+")")
+	}
+
+	direction, cmp := "DESC", "<"
+	if filter.Ascending {
+		direction, cmp = "ASC", ">"
+	}
+	sortKey := postSortKey(filter.Sort)
+	if filter.After != nil {
+		position := fmt.Sprintf("(%s, id) %s (%s, %s)",
+			sortKey, cmp, arg(cursorSortKey(filter.Sort, filter.After)), arg(filter.After.ID))
+		switch {
+		case filter.IgnorePinned:
+			conditions = append(conditions, position)
+		case filter.After.Pinned:
+			// после закреплённых идёт вся остальная лента
+			conditions = append(conditions, "(NOT is_pinned OR "+position+")")
+		default:
+			conditions = append(conditions, "NOT is_pinned AND "+position)
+		}
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultPostsLimit
+	}
+
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		WHERE ` + strings.Join(conditions, " AND ")
+	order := fmt.Sprintf("%s %s, id %s", sortKey, direction, direction)
+	if !filter.IgnorePinned {
+		order = "is_pinned DESC, " + order
+	}
+	query += fmt.Sprintf("\n\t\tORDER BY %s\n\t\tLIMIT %s", order, arg(limit))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("получение постов: %w", err)
+	}
+	defer rows.Close()
+
+	var posts []*entities.Post
+	for rows.Next() {
+		post, err := scanPost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования поста: %w", err)
+		}
+		posts = append(posts, post)
+	}
+	return posts, rows.Err()
+}
+
+// Drafts возвращает черновики и запланированные посты автора, недавно изменённые первыми
+func (r *Db) Drafts(ctx context.Context, authorID int64, limit, offset int) ([]*entities.Post, error) {
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		WHERE author_id = $1 AND status <> 'published' AND deleted_at IS NULL
+		ORDER BY COALESCE(updated_at, created_at) DESC, id DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, authorID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("получение черновиков: %w", err)
+	}
+	defer rows.Close()
+
+	var posts []*entities.Post
+	for rows.Next() {
+		post, err := scanPost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования поста: %w", err)
+		}
+		posts = append(posts, post)
+	}
+	return posts, rows.Err()
+}
+
+// SetPostStatus переводит неопубликованный пост в черновик, планирует его
+// публикацию или публикует сразу. Опубликованный пост обратно не возвращается,
+// а пост, ждущий модератора, публикуется только через ApproveHeld.
+func (r *Db) SetPostStatus(ctx context.Context, id int64, status string, publishAt *time.Time) error {
+	query := `
+		UPDATE posts SET status = $2, publish_at = $3,
+			created_at = CASE WHEN $2 = 'published' THEN CURRENT_TIMESTAMP ELSE created_at END
+		WHERE id = $1 AND status IN ('draft', 'scheduled') AND deleted_at IS NULL`
+	res, err := r.db.ExecContext(ctx, query, id, status, publishAt)
+	if err != nil {
+		return fmt.Errorf("смена статуса поста: %w", err)
+	}
+	return expectAffected(res, e.ErrPostAlreadyPublished)
+}
+
+// PublishDue публикует запланированные посты, время которых наступило к now.
+// Пост встаёт в ленту на запланированное время, а не на время срабатывания.
+func (r *Db) PublishDue(ctx context.Context, now time.Time) (int64, error) {
+	query := `
+		UPDATE posts SET status = 'published', created_at = publish_at
+		WHERE status = 'scheduled' AND publish_at <= $1 AND deleted_at IS NULL`
+	res, err := r.db.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("публикация запланированных постов: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// postSortKey возвращает выражение, по которому сортируется лента
+func postSortKey(sort string) string {
+	switch sort {
+	case entities.PostSortTop:
+		return "comment_count"
+	case entities.PostSortActive:
+		return "COALESCE(last_comment_at, created_at)"
+	case entities.PostSortHot:
+		return "hot_rank"
+	case entities.PostSortViews:
+		return "view_count"
+	default:
+		return "created_at"
+	}
+}
+
+// cursorSortKey возвращает значение ключа сортировки из курсора
+func cursorSortKey(sort string, cursor *entities.PostCursor) any {
+	switch sort {
+	case entities.PostSortTop, entities.PostSortViews:
+		return int64(cursor.Rank)
+	case entities.PostSortHot:
+		return cursor.Rank
+	default:
+		return cursor.CreatedAt
+	}
+}
+
+// RefreshHotRanks пересчитывает ранг hot для постов, опубликованных начиная с since.
+// Ранг старых постов уже не догонит новые, поэтому их можно не трогать.
+func (r *Db) RefreshHotRanks(ctx context.Context, since time.Time) (int64, error) {
+	query := `
+		UPDATE posts p SET hot_rank = post_hot_rank(p.score + p.comment_count, p.created_at)
+		WHERE created_at >= $1 AND deleted_at IS NULL`
+	res, err := r.db.ExecContext(ctx, query, since)
+	if err != nil {
+		return 0, fmt.Errorf("пересчёт рангов постов: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// RecountComments пересчитывает число комментариев и время последнего комментария
+// у всех постов и возвращает, у скольких постов счётчики разошлись с комментариями.
+// Нужен после ручных правок в базе; в обычной работе счётчики ведёт сам репозиторий.
+func (r *Db) RecountComments(ctx context.Context) (int64, error) {
+	query := `
+		UPDATE posts p SET comment_count = s.comment_count, last_comment_at = s.last_comment_at
+		FROM (
+			SELECT p.id, COUNT(c.id) AS comment_count, MAX(c.created_at) AS last_comment_at
+			FROM posts p
+			LEFT JOIN comments c ON c.post_id = p.id AND c.deleted_at IS NULL
+			GROUP BY p.id
+		) s
+		WHERE s.id = p.id
+			AND (p.comment_count, p.last_comment_at) IS DISTINCT FROM (s.comment_count, s.last_comment_at)`
+	res, err := r.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("пересчёт комментариев постов: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// SetPostPinned закрепляет пост в начале ленты или снимает закрепление
+func (r *Db) SetPostPinned(ctx context.Context, id int64, pinned bool) error {
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE posts SET is_pinned = $2 WHERE id = $1 AND deleted_at IS NULL`, id, pinned)
+	if err != nil {
+		return fmt.Errorf("закрепление поста: %w", err)
+	}
+	return expectAffected(res, e.ErrPostNotFound)
+}
+
+// SetPostLocked закрывает пост для новых комментариев или открывает снова
+func (r *Db) SetPostLocked(ctx context.Context, id int64, locked bool) error {
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE posts SET is_locked = $2 WHERE id = $1 AND deleted_at IS NULL`, id, locked)
+	if err != nil {
+		return fmt.Errorf("закрытие поста: %w", err)
+	}
+	return expectAffected(res, e.ErrPostNotFound)
+}
+
+// SearchPosts ищет посты по заголовку и тексту. Вторым значением возвращает
+// общее число совпадений без учёта LIMIT/OFFSET.
+func (r *Db) SearchPosts(ctx context.Context, q entities.SearchQuery) ([]*entities.SearchHit, int, error) {
+	query := `
+		SELECT p.id, 0, p.title,
+			ts_headline('russian', p.content, query, $2),
+			p.author_id, p.username, p.created_at,
+			ts_rank(p.search_vector, query) AS rank,
+			COUNT(*) OVER ()
+		FROM posts p, websearch_to_tsquery('russian', $1) query
+		WHERE p.search_vector @@ query AND p.deleted_at IS NULL AND p.status = 'published'
+			AND ($3::bigint IS NULL OR p.author_id = $3)
+		ORDER BY rank DESC, p.created_at DESC, p.id DESC
+		LIMIT $4 OFFSET $5`
+
+	rows, err := r.db.QueryContext(ctx, query, q.Text, searchHeadlineOptions, q.AuthorID, q.Limit, q.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("поиск постов: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSearchHits(rows, TargetTypePost)
+}
+
+// --- Category Repository ---
+
+func (r *Db) CreateCategory(ctx context.Context, category *entities.Category) error {
+	query := `
+		INSERT INTO categories (slug, title, description, position, created_at)
+		VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+		RETURNING id, created_at`
+	err := r.db.QueryRowContext(ctx, query, category.Slug, category.Title, category.Description, category.Position).
+		Scan(&category.ID, &category.CreatedAt)
+	if pgErrorCode(err) == pgUniqueViolation {
+		return e.ErrDuplicateSlug
+	}
+	if err != nil {
+		return fmt.Errorf("создание категории: %w", err)
+	}
+	return nil
+}
+
+func (r *Db) GetCategoryByID(ctx context.Context, id int64) (*entities.Category, error) {
+	query := `
+		SELECT id, slug, title, description, position, created_at
+		FROM categories WHERE id = $1`
+
+	category := &entities.Category{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&category.ID, &category.Slug, &category.Title,
+		&category.Description, &category.Position, &category.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, e.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("получение категории: %w", err)
+	}
+	return category, nil
+}
+
+func (r *Db) UpdateCategory(ctx context.Context, category *entities.Category) error {
+	query := `UPDATE categories SET slug = $1, title = $2, description = $3, position = $4 WHERE id = $5`
+	res, err := r.db.ExecContext(ctx, query,
+		category.Slug, category.Title, category.Description, category.Position, category.ID)
+	if pgErrorCode(err) == pgUniqueViolation {
+		return e.ErrDuplicateSlug
+	}
+	if err != nil {
+		return fmt.Errorf("обновление категории: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return e.ErrCategoryNotFound
+	}
+	return nil
+}
+
+// DeleteCategory удаляет категорию, посты остаются вне категорий (ON DELETE SET NULL)
+func (r *Db) DeleteCategory(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM categories WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("удаление категории: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return e.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (r *Db) Categories(ctx context.Context) ([]*entities.Category, error) {
+	query := `
+		SELECT id, slug, title, description, position, created_at
+		FROM categories ORDER BY position, id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("получение категорий: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*entities.Category
+	for rows.Next() {
+		category := &entities.Category{}
+		if err := rows.Scan(
+			&category.ID, &category.Slug, &category.Title,
+			&category.Description, &category.Position, &category.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования категории: %w", err)
+		}
+		categories = append(categories, category)
+	}
+	return categories, rows.Err()
+}
+
+// --- Vote Repository ---
+
+// voteTargets — таблицы целей голосования и ошибки для отсутствующей цели
+var voteTargets = map[string]struct {
+	table       string
+	errNotFound error
+}{
+	TargetTypePost:    {table: "posts", errNotFound: e.ErrPostNotFound},
+	TargetTypeComment: {table: "comments", errNotFound: e.ErrCommentNotFound},
+}
+
+// adjustScore сдвигает рейтинг цели на delta и возвращает новое значение
+func adjustScore(ctx context.Context, tx *sql.Tx, targetType string, targetID int64, delta int32) (int64, error) {
+	target, ok := voteTargets[targetType]
+	if !ok {
+		return 0, e.ErrInvalidVote
+	}
+
+	var score int64
+	query := `UPDATE ` + target.table + ` SET score = score + $1 WHERE id = $2 RETURNING score`
+	err := tx.QueryRowContext(ctx, query, delta, targetID).Scan(&score)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, target.errNotFound
+	}
+	return score, err
+}
+
+// lockVoteTarget блокирует строку цели голосования до конца транзакции. Голоса
+// одной цели идут по очереди, и прежний голос читается уже после записи
+// конкурирующей транзакции — даже если до неё голоса ещё не было.
+func lockVoteTarget(ctx context.Context, tx *sql.Tx, targetType string, targetID int64) error {
+	target, ok := voteTargets[targetType]
+	if !ok {
+		return e.ErrInvalidVote
+	}
+
+	var id int64
+	err := tx.QueryRowContext(ctx, `SELECT id FROM `+target.table+` WHERE id = $1 FOR UPDATE`, targetID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return target.errNotFound
+	}
+	return err
+}
+
+// Vote ставит или меняет голос пользователя и возвращает новый рейтинг цели
+func (r *Db) Vote(ctx context.Context, vote *entities.Vote) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("голосование: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockVoteTarget(ctx, tx, vote.TargetType, vote.TargetID); err != nil {
+		return 0, err
+	}
+
+	var previous int32
+	err = tx.QueryRowContext(ctx, `
+		SELECT value FROM votes
+		WHERE user_id = $1 AND target_type = $2 AND target_id = $3`,
+		vote.UserID, vote.TargetType, vote.TargetID,
+	).Scan(&previous)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("голосование: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO votes (user_id, target_type, target_id, value)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, target_type, target_id)
+		DO UPDATE SET value = EXCLUDED.value, created_at = CURRENT_TIMESTAMP`,
+		vote.UserID, vote.TargetType, vote.TargetID, vote.Value,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("голосование: %w", err)
+	}
+
+	score, err := adjustScore(ctx, tx, vote.TargetType, vote.TargetID, vote.Value-previous)
+	if err != nil {
+		return 0, err
+	}
+	return score, tx.Commit()
+}
+
+// RemoveVote снимает голос пользователя и возвращает новый рейтинг цели
+func (r *Db) RemoveVote(ctx context.Context, userID int64, targetType string, targetID int64) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("снятие голоса: %w", err)
+	}
+	defer tx.Rollback()
+
+	var previous int32
+	err = tx.QueryRowContext(ctx, `
+		DELETE FROM votes
+		WHERE user_id = $1 AND target_type = $2 AND target_id = $3
+		RETURNING value`,
+		userID, targetType, targetID,
+	).Scan(&previous)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("снятие голоса: %w", err)
+	}
+
+	score, err := adjustScore(ctx, tx, targetType, targetID, -previous)
+	if err != nil {
+		return 0, err
+	}
+	return score, tx.Commit()
+}
+
+// UserVotes возвращает голоса пользователя за перечисленные цели; целей без голоса в ответе нет
+func (r *Db) UserVotes(ctx context.Context, userID int64, targetType string, targetIDs []int64) (map[int64]int32, error) {
+	query := `
+		SELECT target_id, value FROM votes
+		WHERE user_id = $1 AND target_type = $2 AND target_id = ANY($3)`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, targetType, pq.Array(targetIDs))
+	if err != nil {
+		return nil, fmt.Errorf("получение голосов: %w", err)
+	}
+	defer rows.Close()
+
+	votes := make(map[int64]int32, len(targetIDs))
+	for rows.Next() {
+		var (
+			targetID int64
+			value    int32
+		)
+		if err := rows.Scan(&targetID, &value); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования голоса: %w", err)
+		}
+		votes[targetID] = value
+	}
+	return votes, rows.Err()
+}
+
+// --- Poll Repository ---
+
+func (r *Db) CastPollVote(ctx context.Context, postID, userID int64, username string, optionIDs []int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// FOR SHARE не даёт удалить опрос, пока пишется бюллетень
+	var (
+		multiple bool
+		closesAt *time.Time
+	)
+	err = tx.QueryRowContext(ctx, `
+		SELECT pl.multiple_choice, pl.closes_at
+		FROM polls pl JOIN posts p ON p.id = pl.post_id
+		WHERE pl.post_id = $1 AND p.deleted_at IS NULL AND p.status = 'published'
+		FOR SHARE OF pl`, postID).Scan(&multiple, &closesAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return e.ErrPollNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("голосование в опросе: %w", err)
+	}
+	if closesAt != nil && !time.Now().Before(*closesAt) {
+		return e.ErrPollClosed
+	}
+	if !multiple && len(optionIDs) != 1 {
+		return e.ErrInvalidPollChoice
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO poll_votes (post_id, user_id, username) VALUES ($1, $2, $3)`,
+		postID, userID, username)
+	if pgErrorCode(err) == pgUniqueViolation {
+		return e.ErrPollAlreadyVoted
+	}
+	if err != nil {
+		return fmt.Errorf("голосование в опросе: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO poll_choices (post_id, user_id, option_id)
+		SELECT $1, $2, unnest($3::int[])`,
+		postID, userID, pq.Array(optionIDs))
+	// вариант не из этого опроса
+	if pgErrorCode(err) == pgForeignKeyViolation {
+		return e.ErrInvalidPollChoice
+	}
+	if err != nil {
+		return fmt.Errorf("голосование в опросе: %w", err)
+	}
+	return tx.Commit()
+}
+
+func (r *Db) Polls(ctx context.Context, postIDs []int64, viewerID int64) (map[int64]*entities.Poll, error) {
+	polls := make(map[int64]*entities.Poll)
+	if len(postIDs) == 0 {
+		return polls, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT post_id, multiple_choice, public, closes_at,
+			(SELECT count(*) FROM poll_votes v WHERE v.post_id = pl.post_id)
+		FROM polls pl
+		WHERE post_id = ANY($1)`, pq.Array(postIDs))
+	if err != nil {
+		return nil, fmt.Errorf("получение опросов: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		poll := &entities.Poll{}
+		if err := rows.Scan(&poll.PostID, &poll.MultipleChoice, &poll.Public, &poll.ClosesAt, &poll.TotalVoters); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования опроса: %w", err)
+		}
+		polls[poll.PostID] = poll
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(polls) == 0 {
+		return polls, nil
+	}
+
+	options, err := r.db.QueryContext(ctx, `
+		SELECT o.post_id, o.id, o.text, count(c.user_id), COALESCE(bool_or(c.user_id = $2), FALSE)
+		FROM poll_options o
+		LEFT JOIN poll_choices c ON c.option_id = o.id
+		WHERE o.post_id = ANY($1)
+		GROUP BY o.post_id, o.id
+		ORDER BY o.post_id, o.position`, pq.Array(postIDs), viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("получение вариантов опросов: %w", err)
+	}
+	defer options.Close()
+	for options.Next() {
+		var (
+			postID int64
+			chosen bool
+		)
+		option := &entities.PollOption{}
+		if err := options.Scan(&postID, &option.ID, &option.Text, &option.Votes, &chosen); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования варианта опроса: %w", err)
+		}
+		poll, ok := polls[postID]
+		if !ok {
+			continue
+		}
+		poll.Options = append(poll.Options, option)
+		if chosen {
+			poll.MyChoices = append(poll.MyChoices, option.ID)
+		}
+	}
+	return polls, options.Err()
+}
+
+func (r *Db) PollVoters(ctx context.Context, postID int64) (map[int64][]*entities.PollVoter, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.option_id, v.user_id, COALESCE(v.username, '')
+		FROM poll_choices c
+		JOIN poll_votes v ON v.post_id = c.post_id AND v.user_id = c.user_id
+		WHERE c.post_id = $1
+		ORDER BY c.option_id, v.created_at, v.user_id`, postID)
+	if err != nil {
+		return nil, fmt.Errorf("получение проголосовавших: %w", err)
+	}
+	defer rows.Close()
+
+	voters := make(map[int64][]*entities.PollVoter)
+	for rows.Next() {
+		var optionID int64
+		voter := &entities.PollVoter{}
+		if err := rows.Scan(&optionID, &voter.UserID, &voter.Username); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования голоса в опросе: %w", err)
+		}
+		voters[optionID] = append(voters[optionID], voter)
+	}
+	return voters, rows.Err()
+}
+
+// --- Report Repository ---
+
+const reportColumns = `id, target_type, target_id, target_author_id, target_content,
+	reporter_id, reason, details, status, COALESCE(resolved_by, 0), resolved_at,
+	resolution_note, created_at`
+
+func scanReport(row rowScanner) (*entities.Report, error) {
+	report := &entities.Report{}
+	err := row.Scan(&report.ID, &report.TargetType, &report.TargetID, &report.TargetAuthorID,
+		&report.TargetContent, &report.ReporterID, &report.Reason, &report.Details, &report.Status,
+		&report.ResolvedBy, &report.ResolvedAt, &report.ResolutionNote, &report.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (r *Db) CreateReport(ctx context.Context, report *entities.Report) error {
+	query := `
+		INSERT INTO reports (target_type, target_id, target_author_id, target_content, reporter_id, reason, details)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, status, created_at`
+	err := r.db.QueryRowContext(ctx, query, report.TargetType, report.TargetID, report.TargetAuthorID,
+		report.TargetContent, report.ReporterID, report.Reason, report.Details).
+		Scan(&report.ID, &report.Status, &report.CreatedAt)
+	if pgErrorCode(err) == pgUniqueViolation {
+		return e.ErrAlreadyReported
+	}
+	if err != nil {
+		return fmt.Errorf("сохранение жалобы: %w", err)
+	}
+	return nil
+}
+
+func (r *Db) GetReport(ctx context.Context, id int64) (*entities.Report, error) {
+	report, err := scanReport(r.db.QueryRowContext(ctx,
+		`SELECT `+reportColumns+` FROM reports WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, e.ErrReportNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("получение жалобы: %w", err)
+	}
+	return report, nil
+}
+
+func (r *Db) ReportGroups(ctx context.Context, filter entities.ReportFilter) ([]*entities.ReportGroup, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT target_type, target_id, max(target_author_id), count(*), max(created_at)
+		FROM reports
+		WHERE status = $1 AND ($2 = '' OR target_type = $2)
+		GROUP BY target_type, target_id
+		ORDER BY max(created_at) DESC, target_type, target_id
+		LIMIT $3 OFFSET $4`, filter.Status, filter.TargetType, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("получение очереди жалоб: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []*entities.ReportGroup
+	byTarget := make(map[string]*entities.ReportGroup)
+	for rows.Next() {
+		group := &entities.ReportGroup{}
+		if err := rows.Scan(&group.TargetType, &group.TargetID, &group.TargetAuthorID, &group.Count, &group.LastReportedAt); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования группы жалоб: %w", err)
+		}
+		groups = append(groups, group)
+		byTarget[fmt.Sprintf("%s:%d", group.TargetType, group.TargetID)] = group
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return groups, nil
+	}
+
+	types := make([]string, len(groups))
+	ids := make([]int64, len(groups))
+	for i, group := range groups {
+		types[i], ids[i] = group.TargetType, group.TargetID
+	}
+	reports, err := r.db.QueryContext(ctx, `
+		SELECT `+reportColumns+`
+		FROM reports
+		WHERE status = $1 AND (target_type, target_id) IN (
+			SELECT * FROM unnest($2::text[], $3::int[]))
+		ORDER BY created_at DESC, id DESC`, filter.Status, pq.Array(types), pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("получение жалоб: %w", err)
+	}
+	defer reports.Close()
+	for reports.Next() {
+		report, err := scanReport(reports)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования жалобы: %w", err)
+		}
+		if group, ok := byTarget[fmt.Sprintf("%s:%d", report.TargetType, report.TargetID)]; ok {
+			group.Reports = append(group.Reports, report)
+		}
+	}
+	return groups, reports.Err()
+}
+
+func (r *Db) ResolveReports(ctx context.Context, report *entities.Report, status string, resolvedBy int64, note, deleteReason string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("рассмотрение жалобы: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE reports
+		SET status = $3, resolved_by = $4, resolved_at = CURRENT_TIMESTAMP, resolution_note = $5
+		WHERE target_type = $1 AND target_id = $2 AND status = 'open'`,
+		report.TargetType, report.TargetID, status, resolvedBy, note)
+	if err != nil {
+		return 0, fmt.Errorf("рассмотрение жалобы: %w", err)
+	}
+	resolved, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	// Жалобы на цель уже рассмотрел другой администратор
+	if resolved == 0 {
+		return 0, e.ErrReportResolved
+	}
+
+	switch status {
+	case entities.ReportStatusWarned:
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO warnings (user_id, report_id, reason, note, issued_by)
+			VALUES ($1, $2, $3, $4, $5)`,
+			report.TargetAuthorID, report.ID, report.Reason, note, resolvedBy)
+		if err != nil {
+			return 0, fmt.Errorf("предупреждение автору: %w", err)
+		}
+	case entities.ReportStatusRemoved:
+		if err := removeReported(ctx, tx, report, resolvedBy, deleteReason); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return resolved, nil
+}
+
+// removeReported удаляет контент, на который пожаловались. Контент, удалённый
+// раньше, не мешает закрыть жалобы.
+func removeReported(ctx context.Context, tx *sql.Tx, report *entities.Report, deletedBy int64, reason string) error {
+	switch report.TargetType {
+	case TargetTypePost:
+		_, err := tx.ExecContext(ctx, `
+			UPDATE posts SET deleted_at = CURRENT_TIMESTAMP, deleted_by = $2, delete_reason = $3
+			WHERE id = $1 AND deleted_at IS NULL`,
+			report.TargetID, deletedBy, reason)
+		if err != nil {
+			return fmt.Errorf("удаление поста по жалобе: %w", err)
+		}
+	case TargetTypeComment:
+		var postID int64
+		err := tx.QueryRowContext(ctx, `
+			UPDATE comments SET deleted_at = CURRENT_TIMESTAMP, deleted_by = $2, delete_reason = $3
+			WHERE id = $1 AND deleted_at IS NULL
+			RETURNING post_id`,
+			report.TargetID, deletedBy, reason).Scan(&postID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("удаление комментария по жалобе: %w", err)
+		}
+		return updateCommentStats(ctx, tx, postID, -1)
+	case TargetTypeChat:
+		if _, err := tx.ExecContext(ctx, `DELETE FROM chat_messages WHERE id = $1`, report.TargetID); err != nil {
+			return fmt.Errorf("удаление сообщения по жалобе: %w", err)
+		}
+		_, err := tx.ExecContext(ctx,
+			`DELETE FROM mentions WHERE target_type = $1 AND target_id = $2`, TargetTypeChat, report.TargetID)
+		if err != nil {
+			return fmt.Errorf("удаление упоминаний из сообщения: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *Db) Warnings(ctx context.Context, userID int64, limit, offset int) ([]*entities.Warning, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, user_id, report_id, reason, note, issued_by, created_at
+		FROM warnings
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3`, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("получение предупреждений: %w", err)
+	}
+	defer rows.Close()
+
+	var warnings []*entities.Warning
+	for rows.Next() {
+		warning := &entities.Warning{}
+		err := rows.Scan(&warning.ID, &warning.UserID, &warning.ReportID, &warning.Reason,
+			&warning.Note, &warning.IssuedBy, &warning.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования предупреждения: %w", err)
+		}
+		warnings = append(warnings, warning)
+	}
+	return warnings, rows.Err()
+}
+
+// --- Bookmark Repository ---
+
+// AddBookmark добавляет пост в закладки; повторное добавление обновляет заметку
+func (r *Db) AddBookmark(ctx context.Context, bookmark *entities.Bookmark) error {
+	query := `
+		INSERT INTO bookmarks (user_id, post_id, note)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, post_id) DO UPDATE SET note = EXCLUDED.note
+		RETURNING created_at`
+	err := r.db.QueryRowContext(ctx, query, bookmark.UserID, bookmark.PostID, bookmark.Note).
+		Scan(&bookmark.CreatedAt)
+	if pgErrorCode(err) == pgForeignKeyViolation {
+		return e.ErrPostNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("добавление закладки: %w", err)
+	}
+	return nil
+}
+
+func (r *Db) RemoveBookmark(ctx context.Context, userID, postID int64) error {
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM bookmarks WHERE user_id = $1 AND post_id = $2`, userID, postID)
+	if err != nil {
+		return fmt.Errorf("удаление закладки: %w", err)
+	}
+	return expectAffected(res, e.ErrBookmarkNotFound)
+}
+
+// Bookmarks возвращает закладки пользователя, недавно добавленные первыми.
+// Закладки на посты из корзины не показываются, пока пост не восстановят.
+func (r *Db) Bookmarks(ctx context.Context, userID int64, limit, offset int) ([]*entities.Bookmark, error) {
+	query := `
+		SELECT ` + postColumns + `, b.note, b.bookmarked_at
+		FROM posts p
+		JOIN (SELECT post_id, note, created_at AS bookmarked_at FROM bookmarks WHERE user_id = $1) b
+			ON b.post_id = p.id
+		WHERE p.deleted_at IS NULL AND p.status = 'published'
+		ORDER BY b.bookmarked_at DESC, p.id DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("получение закладок: %w", err)
+	}
+	defer rows.Close()
+
+	var bookmarks []*entities.Bookmark
+	for rows.Next() {
+		bookmark := &entities.Bookmark{UserID: userID}
+		post, err := scanPost(withColumns(rows, &bookmark.Note, &bookmark.CreatedAt))
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования закладки: %w", err)
+		}
+		post.Bookmarked = true
+		bookmark.PostID = post.ID
+		bookmark.Post = post
+		bookmarks = append(bookmarks, bookmark)
+	}
+	return bookmarks, rows.Err()
+}
+
+// BookmarkedPosts возвращает, какие из постов postIDs есть в закладках пользователя
+func (r *Db) BookmarkedPosts(ctx context.Context, userID int64, postIDs []int64) (map[int64]bool, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT post_id FROM bookmarks WHERE user_id = $1 AND post_id = ANY($2)`,
+		userID, pq.Array(postIDs))
+	if err != nil {
+		return nil, fmt.Errorf("получение закладок: %w", err)
+	}
+	defer rows.Close()
+
+	bookmarked := make(map[int64]bool, len(postIDs))
+	for rows.Next() {
+		var postID int64
+		if err := rows.Scan(&postID); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования закладки: %w", err)
+		}
+		bookmarked[postID] = true
+	}
+	return bookmarked, rows.Err()
+}
+
+// --- Notification Repository ---
+
+// SetSubscription подписывает пользователя на обсуждение поста или отписывает от него.
+// Отписка запоминается, чтобы автоматическая подписка не вернула пользователя в обсуждение.
+func (r *Db) SetSubscription(ctx context.Context, userID, postID int64, subscribed bool) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO subscriptions (user_id, post_id, subscribed)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, post_id) DO UPDATE SET subscribed = EXCLUDED.subscribed`,
+		userID, postID, subscribed)
+	if pgErrorCode(err) == pgForeignKeyViolation {
+		return e.ErrPostNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("изменение подписки: %w", err)
+	}
+	return nil
+}
+
+// NotifyComment уведомляет подписчиков обсуждения о новом комментарии и подписывает
+// на обсуждение его автора. Непрочитанное уведомление о том же посте не дублируется:
+// в нём увеличивается счётчик событий и обновляется последний комментарий.
+// Возвращает число затронутых уведомлений.
+func (r *Db) NotifyComment(ctx context.Context, comment *entities.Comment) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("создание уведомлений: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Автор поста подписан по умолчанию, пока не отписался явно
+	res, err := tx.ExecContext(ctx, `
+		WITH recipients AS (
+			SELECT user_id FROM subscriptions WHERE post_id = $1 AND subscribed
+			UNION
+			SELECT p.author_id FROM posts p
+			WHERE p.id = $1
+				AND NOT EXISTS (SELECT 1 FROM subscriptions s WHERE s.post_id = p.id AND s.user_id = p.author_id)
+		)
+		INSERT INTO notifications (user_id, type, post_id, comment_id, actor_id, actor_name, created_at, updated_at)
+		SELECT user_id, $2, $1, $3, $4, $5, $6, $6 FROM recipients WHERE user_id <> $4
+		ON CONFLICT (user_id, type, post_id) WHERE read_at IS NULL DO UPDATE SET
+			event_count = notifications.event_count + 1,
+			comment_id = EXCLUDED.comment_id,
+			actor_id = EXCLUDED.actor_id,
+			actor_name = EXCLUDED.actor_name,
+			updated_at = EXCLUDED.updated_at`,
+		comment.PostID, entities.NotificationComment, comment.ID, comment.AuthorID, comment.AuthorName, comment.CreatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("создание уведомлений: %w", err)
+	}
+	notified, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO subscriptions (user_id, post_id)
+		SELECT $1, p.id FROM posts p WHERE p.id = $2 AND p.author_id <> $1
+		ON CONFLICT (user_id, post_id) DO NOTHING`,
+		comment.AuthorID, comment.PostID)
+	if err != nil {
+		return 0, fmt.Errorf("подписка автора комментария: %w", err)
+	}
+	return notified, tx.Commit()
+}
+
+// Notifications возвращает уведомления пользователя, недавно обновлённые первыми.
+// Уведомления о постах в корзине не показываются.
+func (r *Db) Notifications(ctx context.Context, userID int64, unreadOnly bool, limit, offset int) ([]*entities.Notification, error) {
+	query := `
+		SELECT n.id, n.user_id, n.type, n.post_id, p.title, COALESCE(n.comment_id, 0), n.actor_id, n.actor_name,
+			n.event_count, n.created_at, n.updated_at, n.read_at
+		FROM notifications n
+		JOIN posts p ON p.id = n.post_id
+		WHERE n.user_id = $1 AND p.deleted_at IS NULL AND (NOT $2 OR n.read_at IS NULL)
+		ORDER BY n.updated_at DESC, n.id DESC
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("получение уведомлений: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []*entities.Notification
+	for rows.Next() {
+		n := &entities.Notification{}
+		var readAt sql.NullTime
+		if err := rows.Scan(&n.ID, &n.UserID, &n.Type, &n.PostID, &n.PostTitle, &n.CommentID, &n.ActorID, &n.ActorName,
+			&n.Count, &n.CreatedAt, &n.UpdatedAt, &readAt); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования уведомления: %w", err)
+		}
+		if readAt.Valid {
+			n.ReadAt = &readAt.Time
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, rows.Err()
+}
+
+func (r *Db) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM notifications n
+		JOIN posts p ON p.id = n.post_id
+		WHERE n.user_id = $1 AND n.read_at IS NULL AND p.deleted_at IS NULL`,
+		userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("подсчёт непрочитанных уведомлений: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead отмечает прочитанными уведомления пользователя из ids; чужие и уже
+// прочитанные пропускаются. Возвращает число отмеченных.
+func (r *Db) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE notifications SET read_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND id = ANY($2) AND read_at IS NULL`,
+		userID, pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("отметка уведомлений: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+func (r *Db) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE notifications SET read_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND read_at IS NULL`,
+		userID)
+	if err != nil {
+		return 0, fmt.Errorf("отметка уведомлений: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// --- Mention Repository ---
+
+// SaveMentions заменяет упоминания цели на mentions. Упоминания, которые
+// остались после правки, сохраняют исходное время.
+func (r *Db) SaveMentions(ctx context.Context, targetType string, targetID int64, mentions []*entities.Mention) error {
+	userIDs := make([]int64, len(mentions))
+	usernames := make([]string, len(mentions))
+	authorIDs := make([]int64, len(mentions))
+	for i, m := range mentions {
+		userIDs[i], usernames[i], authorIDs[i] = m.UserID, m.Username, m.AuthorID
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("сохранение упоминаний: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM mentions WHERE target_type = $1 AND target_id = $2 AND user_id <> ALL($3)`,
+		targetType, targetID, pq.Array(userIDs))
+	if err != nil {
+		return fmt.Errorf("сохранение упоминаний: %w", err)
+	}
+	if len(mentions) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO mentions (target_type, target_id, user_id, username, author_id)
+			SELECT $1, $2, m.user_id, m.username, m.author_id
+			FROM unnest($3::int[], $4::text[], $5::int[]) AS m(user_id, username, author_id)
+			ON CONFLICT (target_type, target_id, user_id) DO NOTHING`,
+			targetType, targetID, pq.Array(userIDs), pq.Array(usernames), pq.Array(authorIDs))
+		if err != nil {
+			return fmt.Errorf("сохранение упоминаний: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *Db) Mentions(ctx context.Context, targetType string, targetID int64) ([]*entities.Mention, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT target_type, target_id, user_id, username, author_id, created_at
+		FROM mentions
+		WHERE target_type = $1 AND target_id = $2
+		ORDER BY username`,
+		targetType, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("получение упоминаний: %w", err)
+	}
+	return scanMentions(rows)
+}
+
+// UserMentions возвращает упоминания пользователя, новые первыми. Упоминания
+// в черновиках и в удалённых постах и комментариях пропускаются.
+func (r *Db) UserMentions(ctx context.Context, userID int64, limit, offset int) ([]*entities.Mention, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT m.target_type, m.target_id, m.user_id, m.username, m.author_id, m.created_at
+		FROM mentions m
+		WHERE m.user_id = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM posts p
+				WHERE m.target_type = 'post' AND p.id = m.target_id
+					AND (p.deleted_at IS NOT NULL OR p.status <> 'published'))
+			AND NOT EXISTS (
+				SELECT 1 FROM comments c
+				WHERE m.target_type = 'comment' AND c.id = m.target_id AND c.deleted_at IS NOT NULL)
+		ORDER BY m.created_at DESC, m.target_type, m.target_id DESC
+		LIMIT $2 OFFSET $3`,
+		userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("получение упоминаний: %w", err)
+	}
+	return scanMentions(rows)
+}
+
+func scanMentions(rows *sql.Rows) ([]*entities.Mention, error) {
+	defer rows.Close()
+
+	var mentions []*entities.Mention
+	for rows.Next() {
+		m := &entities.Mention{}
+		if err := rows.Scan(&m.TargetType, &m.TargetID, &m.UserID, &m.Username, &m.AuthorID, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования упоминания: %w", err)
+		}
+		mentions = append(mentions, m)
+	}
+	return mentions, rows.Err()
+}
+
+// --- View Repository ---
+
+// AddViews прибавляет к счётчикам просмотров постов накопленные приращения
+// одним запросом. Посты, удалённые за время накопления, пропускаются.
+func (r *Db) AddViews(ctx context.Context, views map[int64]int64) error {
+	if len(views) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(views))
+	counts := make([]int64, 0, len(views))
+	for id, n := range views {
+		ids = append(ids, id)
+		counts = append(counts, n)
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE posts p SET view_count = p.view_count + v.n
+		FROM unnest($1::int[], $2::bigint[]) AS v(id, n)
+		WHERE p.id = v.id`,
+		pq.Array(ids), pq.Array(counts))
+	if err != nil {
+		return fmt.Errorf("запись просмотров: %w", err)
+	}
+	return nil
+}
+
+// --- Content Repository ---
+
+// unrenderedContentQueries выбирают тексты, для которых ещё не построен HTML
+var unrenderedContentQueries = map[string]string{
+	TargetTypePost:    `SELECT id, content FROM posts WHERE content_html = '' AND content <> '' AND id > $1 ORDER BY id LIMIT $2`,
+	TargetTypeComment: `SELECT id, content FROM comments WHERE content_html = '' AND content <> '' AND id > $1 ORDER BY id LIMIT $2`,
+}
+
+var setContentHTMLQueries = map[string]string{
+	TargetTypePost:    `UPDATE posts SET content_html = $1 WHERE id = $2`,
+	TargetTypeComment: `UPDATE comments SET content_html = $1 WHERE id = $2`,
+}
+
+// UnrenderedContent возвращает до limit текстов без HTML с ID больше afterID.
+// Текст, из которого после очистки ничего не осталось, так и останется без HTML,
+// поэтому обход идёт по ID, а не до исчерпания выборки.
+func (r *Db) UnrenderedContent(ctx context.Context, targetType string, afterID int64, limit int) (map[int64]string, error) {
+	query, ok := unrenderedContentQueries[targetType]
+	if !ok {
+		return nil, fmt.Errorf("неизвестный тип цели: %s", targetType)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("получение текстов без HTML: %w", err)
+	}
+	defer rows.Close()
+
+	contents := make(map[int64]string)
+	for rows.Next() {
+		var (
+			id      int64
+			content string
+		)
+		if err := rows.Scan(&id, &content); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования текста: %w", err)
+		}
+		contents[id] = content
+	}
+	return contents, rows.Err()
+}
+
+// SetContentHTML сохраняет HTML, не трогая время изменения и историю правок
+func (r *Db) SetContentHTML(ctx context.Context, targetType string, id int64, contentHTML string) error {
+	query, ok := setContentHTMLQueries[targetType]
+	if !ok {
+		return fmt.Errorf("неизвестный тип цели: %s", targetType)
+	}
+	if _, err := r.db.ExecContext(ctx, query, contentHTML, id); err != nil {
+		return fmt.Errorf("сохранение HTML: %w", err)
+	}
+	return nil
+}
+
+// --- Author Repository ---
+
+func (r *Db) AuthorIDs(ctx context.Context, afterID int64, limit int) ([]int64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id FROM (
+			SELECT author_id AS id FROM posts
+			UNION SELECT author_id FROM comments
+			UNION SELECT user_id FROM chat_messages
+			UNION SELECT user_id FROM poll_votes
+			UNION SELECT author_id FROM held_content
+		) a
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2`, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("список авторов: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// renameAuthorQueries обновляют только записи со старым именем, чтобы число
+// исправленных записей показывало расхождение, а повтор ничего не менял
+var renameAuthorQueries = []string{
+	`UPDATE posts SET username = $2 WHERE author_id = $1 AND username IS DISTINCT FROM $2`,
+	`UPDATE comments SET username = $2 WHERE author_id = $1 AND username IS DISTINCT FROM $2`,
+	`UPDATE chat_messages SET username = $2 WHERE user_id = $1 AND username IS DISTINCT FROM $2`,
+	`UPDATE poll_votes SET username = $2 WHERE user_id = $1 AND username IS DISTINCT FROM $2`,
+	`UPDATE held_content SET username = $2 WHERE author_id = $1 AND username IS DISTINCT FROM $2`,
+}
+
+func (r *Db) RenameAuthor(ctx context.Context, userID int64, username string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var fixed int64
+	for _, query := range renameAuthorQueries {
+		res, err := tx.ExecContext(ctx, query, userID, username)
+		if err != nil {
+			return 0, fmt.Errorf("смена имени автора %d: %w", userID, err)
+		}
+		n, _ := res.RowsAffected()
+		fixed += n
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return fixed, nil
+}
+
+func (r *Db) EventCursor(ctx context.Context, name string) (int64, error) {
+	var position int64
+	err := r.db.QueryRowContext(ctx, `SELECT position FROM event_cursors WHERE name = $1`, name).Scan(&position)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("позиция журнала %s: %w", name, err)
+	}
+	return position, nil
+}
+
+func (r *Db) SetEventCursor(ctx context.Context, name string, position int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO event_cursors (name, position) VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET position = EXCLUDED.position`, name, position)
+	if err != nil {
+		return fmt.Errorf("сохранение позиции журнала %s: %w", name, err)
+	}
+	return nil
+}
+
+// --- Archive Repository ---
+
+// ArchivePosts возвращает до limit постов с ID больше afterID как есть:
+// черновики, удалённые посты и их текст тоже попадают в выгрузку
+func (r *Db) ArchivePosts(ctx context.Context, afterID int64, limit int) ([]*entities.Post, error) {
+	query := `
+		SELECT id, title, content, content_html, author_id, username, category_id,
+			ARRAY(SELECT tag FROM post_tags WHERE post_id = p.id ORDER BY tag),
+			status, publish_at, is_pinned, is_locked, view_count,
+			created_at, updated_at, deleted_at, deleted_by, delete_reason
+		FROM posts p
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("выгрузка постов: %w", err)
+	}
+	defer rows.Close()
+
+	var posts []*entities.Post
+	for rows.Next() {
+		var (
+			post      = &entities.Post{}
+			deletedAt *time.Time
+			deletedBy sql.NullInt64
+			reason    string
+		)
+		err := rows.Scan(
+			&post.ID, &post.Title, &post.Content, &post.ContentHTML, &post.AuthorID, &post.AuthorName,
+			&post.CategoryID, pq.Array(&post.Tags),
+			&post.Status, &post.PublishAt, &post.IsPinned, &post.IsLocked, &post.ViewCount,
+			&post.CreatedAt, &post.UpdatedAt, &deletedAt, &deletedBy, &reason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("выгрузка постов: %w", err)
+		}
+		if deletedAt != nil {
+			post.Deleted = true
+			post.Deletion = &entities.Deletion{DeletedAt: *deletedAt, DeletedBy: deletedBy.Int64, Reason: reason}
+		}
+		posts = append(posts, post)
+	}
+	return posts, rows.Err()
+}
+
+// ArchiveComments возвращает до limit комментариев с ID больше afterID, включая
+// удалённые. Родитель всегда старше ответа, поэтому в выгрузке он идёт раньше.
+func (r *Db) ArchiveComments(ctx context.Context, afterID int64, limit int) ([]*entities.Comment, error) {
+	query := `
+		SELECT id, post_id, parent_id, depth, author_id, username, content, content_html,
+			created_at, updated_at, deleted_at, deleted_by, delete_reason
+		FROM comments
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("выгрузка комментариев: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*entities.Comment
+	for rows.Next() {
+		var (
+			comment   = &entities.Comment{}
+			deletedAt *time.Time
+			deletedBy sql.NullInt64
+			reason    string
+		)
+		err := rows.Scan(
+			&comment.ID, &comment.PostID, &comment.ParentID, &comment.Depth,
+			&comment.AuthorID, &comment.AuthorName, &comment.Content, &comment.ContentHTML,
+			&comment.CreatedAt, &comment.UpdatedAt, &deletedAt, &deletedBy, &reason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("выгрузка комментариев: %w", err)
+		}
+		if deletedAt != nil {
+			comment.Deleted = true
+			comment.Deletion = &entities.Deletion{DeletedAt: *deletedAt, DeletedBy: deletedBy.Int64, Reason: reason}
+		}
+		comments = append(comments, comment)
+	}
+	return comments, rows.Err()
+}
+
+// ArchiveMessages возвращает до limit сообщений чата с ID больше afterID
+func (r *Db) ArchiveMessages(ctx context.Context, afterID int64, limit int) ([]*entities.ChatMessage, error) {
+	query := `
+		SELECT id, user_id, username, content, created_at
+		FROM chat_messages
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("выгрузка сообщений: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*entities.ChatMessage
+	for rows.Next() {
+		msg := &entities.ChatMessage{}
+		if err := rows.Scan(&msg.ID, &msg.UserID, &msg.Username, &msg.Content, &msg.CreatedAt); err != nil {
+			return nil, fmt.Errorf("выгрузка сообщений: %w", err)
+		}
+		messages = append(messages, msg)
+	}
+	return messages, rows.Err()
+}
+
+// ImportedIDs возвращает соответствие ID архива и ID в базе для записей kind,
+// уже загруженных из source
+func (r *Db) ImportedIDs(ctx context.Context, source, kind string) (map[int64]int64, error) {
+	query := `SELECT source_id, target_id FROM archive_imports WHERE source = $1 AND kind = $2`
+	rows, err := r.db.QueryContext(ctx, query, source, kind)
+	if err != nil {
+		return nil, fmt.Errorf("загруженные записи %s: %w", kind, err)
+	}
+	defer rows.Close()
+
+	ids := make(map[int64]int64)
+	for rows.Next() {
+		var sourceID, targetID int64
+		if err := rows.Scan(&sourceID, &targetID); err != nil {
+			return nil, fmt.Errorf("загруженные записи %s: %w", kind, err)
+		}
+		ids[sourceID] = targetID
+	}
+	return ids, rows.Err()
+}
+
+// MapImportedID запоминает, что запись архива соответствует уже существующей записи базы
+func (r *Db) MapImportedID(ctx context.Context, source, kind string, sourceID, targetID int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO archive_imports (source, kind, source_id, target_id) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (source, kind, source_id) DO UPDATE SET target_id = EXCLUDED.target_id`,
+		source, kind, sourceID, targetID)
+	if err != nil {
+		return fmt.Errorf("сохранение соответствия %s %d: %w", kind, sourceID, err)
+	}
+	return nil
+}
+
+// mapImported записывает соответствие ID в той же транзакции, что и саму запись:
+// прерванная загрузка не оставит записей без соответствия и не задвоит их при повторе
+func mapImported(ctx context.Context, tx *sql.Tx, source, kind string, sourceID, targetID int64) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO archive_imports (source, kind, source_id, target_id) VALUES ($1, $2, $3, $4)`,
+		source, kind, sourceID, targetID)
+	if err != nil {
+		return fmt.Errorf("сохранение соответствия %s %d: %w", kind, sourceID, err)
+	}
+	return nil
+}
+
+// deletionColumns раскладывает сведения об удалении по колонкам deleted_at, deleted_by, delete_reason
+func deletionColumns(d *entities.Deletion) (*time.Time, *int64, string) {
+	if d == nil {
+		return nil, nil, ""
+	}
+	var deletedBy *int64
+	if d.DeletedBy != 0 {
+		deletedBy = &d.DeletedBy
+	}
+	return &d.DeletedAt, deletedBy, d.Reason
+}
+
+// ImportCategory создаёт категорию из архива с исходной датой создания
+func (r *Db) ImportCategory(ctx context.Context, source string, sourceID int64, category *entities.Category) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("загрузка категории: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO categories (slug, title, description, position, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`,
+		category.Slug, category.Title, category.Description, category.Position, category.CreatedAt,
+	).Scan(&category.ID)
+	if pgErrorCode(err) == pgUniqueViolation {
+		return e.ErrDuplicateSlug
+	}
+	if err != nil {
+		return fmt.Errorf("загрузка категории: %w", err)
+	}
+	if err := mapImported(ctx, tx, source, archive.KindCategory, sourceID, category.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ImportPost создаёт пост из архива с исходными датами, статусом и сведениями об удалении.
+// Счётчики комментариев не трогает: после загрузки их пересчитывает RecountComments.
+func (r *Db) ImportPost(ctx context.Context, source string, sourceID int64, post *entities.Post) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("загрузка поста: %w", err)
+	}
+	defer tx.Rollback()
+
+	deletedAt, deletedBy, reason := deletionColumns(post.Deletion)
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO posts (title, content, content_html, author_id, username, category_id,
+			status, publish_at, is_pinned, is_locked, view_count,
+			created_at, updated_at, deleted_at, deleted_by, delete_reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		RETURNING id`,
+		post.Title, post.Content, post.ContentHTML, post.AuthorID, post.AuthorName, post.CategoryID,
+		post.Status, post.PublishAt, post.IsPinned, post.IsLocked, post.ViewCount,
+		post.CreatedAt, post.UpdatedAt, deletedAt, deletedBy, reason,
+	).Scan(&post.ID)
+	if pgErrorCode(err) == pgForeignKeyViolation {
+		return e.ErrCategoryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("загрузка поста: %w", err)
+	}
+
+	if err := insertPostTags(ctx, tx, post.ID, post.Tags); err != nil {
+		return fmt.Errorf("сохранение тегов поста: %w", err)
+	}
+	if err := mapImported(ctx, tx, source, archive.KindPost, sourceID, post.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ImportComment создаёт комментарий из архива. Глубина считается по родителю,
+// который к этому моменту уже загружен. Счётчики поста не трогает, как и ImportPost.
+func (r *Db) ImportComment(ctx context.Context, source string, sourceID int64, comment *entities.Comment) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("загрузка комментария: %w", err)
+	}
+	defer tx.Rollback()
+
+	deletedAt, deletedBy, reason := deletionColumns(comment.Deletion)
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO comments (post_id, parent_id, depth, author_id, username, content, content_html,
+			created_at, updated_at, deleted_at, deleted_by, delete_reason)
+		VALUES ($1, $2, COALESCE((SELECT depth + 1 FROM comments WHERE id = $2), 0), $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, depth`,
+		comment.PostID, comment.ParentID, comment.AuthorID, comment.AuthorName, comment.Content, comment.ContentHTML,
+		comment.CreatedAt, comment.UpdatedAt, deletedAt, deletedBy, reason,
+	).Scan(&comment.ID, &comment.Depth)
+	if pgErrorCode(err) == pgForeignKeyViolation {
+		return e.ErrPostNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("загрузка комментария: %w", err)
+	}
+	if err := mapImported(ctx, tx, source, archive.KindComment, sourceID, comment.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ImportMessage сохраняет сообщение чата из архива с исходным временем отправки
+func (r *Db) ImportMessage(ctx context.Context, source string, sourceID int64, msg *entities.ChatMessage) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("загрузка сообщения: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO chat_messages (user_id, username, content, created_at) VALUES ($1, $2, $3, $4) RETURNING id`,
+		msg.UserID, msg.Username, msg.Content, msg.CreatedAt,
+	).Scan(&msg.ID)
+	if err != nil {
+		return fmt.Errorf("загрузка сообщения: %w", err)
+	}
+	if err := mapImported(ctx, tx, source, archive.KindChatMessage, sourceID, msg.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// --- Attachment Repository ---
+
+const attachmentColumns = `id, target_type, target_id, uploader_id, file_name, content_type,
+			size, blob_key, thumbnail_key, width, height, created_at`
+
+// attachmentTargetVisible — условие, что цель вложения a существует, не удалена
+// и видна зрителю $2: файлы черновиков и запланированных постов до публикации
+// отдаются только загрузившему их автору
+const attachmentTargetVisible = `CASE a.target_type
+			WHEN 'post' THEN EXISTS (SELECT 1 FROM posts p WHERE p.id = a.target_id AND p.deleted_at IS NULL
+				AND (p.status = 'published' OR a.uploader_id = $2))
+			ELSE EXISTS (SELECT 1 FROM comments c WHERE c.id = a.target_id AND c.deleted_at IS NULL)
+		END`
+
+func scanAttachment(row rowScanner) (*entities.Attachment, error) {
+	att := &entities.Attachment{}
+	err := row.Scan(
+		&att.ID, &att.TargetType, &att.TargetID, &att.UploaderID, &att.FileName, &att.ContentType,
+		&att.Size, &att.BlobKey, &att.ThumbnailKey, &att.Width, &att.Height, &att.CreatedAt,
+	)
+	return att, err
+}
+
+func scanAttachments(rows *sql.Rows) ([]*entities.Attachment, error) {
+	defer rows.Close()
+
+	var attachments []*entities.Attachment
+	for rows.Next() {
+		att, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования вложения: %w", err)
+		}
+		attachments = append(attachments, att)
+	}
+	return attachments, rows.Err()
+}
+
+// CreateAttachment сохраняет вложение, если вместе с ним файлы пользователя
+// не превысят quota (0 — без ограничения). Загрузки одного пользователя
+// проверяются по очереди под advisory-блокировкой, поэтому параллельные
+// загрузки не обходят квоту.
+func (r *Db) CreateAttachment(ctx context.Context, att *entities.Attachment, quota int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("сохранение вложения: %w", err)
+	}
+	defer tx.Rollback()
+
+	if quota > 0 {
+		if _, err := tx.ExecContext(ctx,
+			`SELECT pg_advisory_xact_lock(hashtextextended('attachments:' || $1::bigint, 0))`, att.UploaderID); err != nil {
+			return fmt.Errorf("блокировка квоты вложений: %w", err)
+		}
+		var used int64
+		if err := tx.QueryRowContext(ctx, userAttachmentsSizeQuery, att.UploaderID).Scan(&used); err != nil {
+			return fmt.Errorf("подсчёт размера вложений: %w", err)
+		}
+		if used+att.Size > quota {
+			return e.ErrQuotaExceeded
+		}
+	}
+
+	query := `
+		INSERT INTO attachments (target_type, target_id, uploader_id, file_name, content_type,
+			size, blob_key, thumbnail_key, width, height)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at`
+
+	err = tx.QueryRowContext(ctx, query,
+		att.TargetType, att.TargetID, att.UploaderID, att.FileName, att.ContentType,
+		att.Size, att.BlobKey, att.ThumbnailKey, att.Width, att.Height,
+	).Scan(&att.ID, &att.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("сохранение вложения: %w", err)
+	}
+	return tx.Commit()
+}
+
+// GetAttachment возвращает вложение, если его пост или комментарий не удалён
+// и виден зрителю viewerID (0 — аноним). Вложения удалённых целей хранятся
+// до очистки корзины, но не отдаются.
+func (r *Db) GetAttachment(ctx context.Context, id, viewerID int64) (*entities.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM attachments a WHERE id = $1 AND ` + attachmentTargetVisible
+
+	att, err := scanAttachment(r.db.QueryRowContext(ctx, query, id, viewerID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, e.ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("получение вложения: %w", err)
+	}
+	return att, nil
+}
+
+// Attachments возвращает вложения перечисленных целей в порядке загрузки
+func (r *Db) Attachments(ctx context.Context, targetType string, targetIDs []int64) (map[int64][]*entities.Attachment, error) {
+	query := `
+		SELECT ` + attachmentColumns + `
+		FROM attachments
+		WHERE target_type = $1 AND target_id = ANY($2)
+		ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query, targetType, pq.Array(targetIDs))
+	if err != nil {
+		return nil, fmt.Errorf("получение вложений: %w", err)
+	}
+	attachments, err := scanAttachments(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byTarget := make(map[int64][]*entities.Attachment, len(targetIDs))
+	for _, att := range attachments {
+		byTarget[att.TargetID] = append(byTarget[att.TargetID], att)
+	}
+	return byTarget, nil
+}
+
+const userAttachmentsSizeQuery = `SELECT COALESCE(SUM(size), 0) FROM attachments WHERE uploader_id = $1`
+
+// UserAttachmentsSize возвращает суммарный размер файлов пользователя вместе с превью
+func (r *Db) UserAttachmentsSize(ctx context.Context, userID int64) (int64, error) {
+	var size int64
+	if err := r.db.QueryRowContext(ctx, userAttachmentsSizeQuery, userID).Scan(&size); err != nil {
+		return 0, fmt.Errorf("подсчёт размера вложений: %w", err)
+	}
+	return size, nil
+}
+
+func (r *Db) DeleteAttachment(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM attachments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("удаление вложения: %w", err)
+	}
+	return expectAffected(res, e.ErrAttachmentNotFound)
+}
+
+// OrphanedAttachments возвращает вложения, чьи посты и комментарии уже
+// окончательно удалены из корзины
+func (r *Db) OrphanedAttachments(ctx context.Context, limit int) ([]*entities.Attachment, error) {
+	query := `
+		SELECT ` + attachmentColumns + `
+		FROM attachments a
+		WHERE CASE a.target_type
+			WHEN 'post' THEN NOT EXISTS (SELECT 1 FROM posts p WHERE p.id = a.target_id)
+			ELSE NOT EXISTS (SELECT 1 FROM comments c WHERE c.id = a.target_id)
+		END
+		ORDER BY id
+		LIMIT $1`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("поиск осиротевших вложений: %w", err)
+	}
+	return scanAttachments(rows)
+}
+
+// --- Revision Repository ---
+
+// originalRevisionQueries сохраняют текущую версию цели как исходную, если
+// истории у неё ещё нет. Так контент, созданный до первой правки, не теряется.
+var originalRevisionQueries = map[string]string{
+	TargetTypePost: `
+		INSERT INTO revisions (target_type, target_id, title, content, editor_id, created_at)
+		SELECT $1, id, title, content, author_id, created_at FROM posts
+		WHERE id = $2 AND NOT EXISTS (SELECT 1 FROM revisions WHERE target_type = $1 AND target_id = $2)`,
+	TargetTypeComment: `
+		INSERT INTO revisions (target_type, target_id, title, content, editor_id, created_at)
+		SELECT $1, id, NULL, content, author_id, created_at FROM comments
+		WHERE id = $2 AND NOT EXISTS (SELECT 1 FROM revisions WHERE target_type = $1 AND target_id = $2)`,
+}
+
+func recordOriginalRevision(ctx context.Context, tx *sql.Tx, targetType string, targetID int64) error {
+	if _, err := tx.ExecContext(ctx, originalRevisionQueries[targetType], targetType, targetID); err != nil {
+		return fmt.Errorf("сохранение исходной версии: %w", err)
+	}
+	return nil
+}
+
+func recordRevision(ctx context.Context, tx *sql.Tx, rev *entities.Revision) error {
+	query := `
+		INSERT INTO revisions (target_type, target_id, title, content, editor_id, created_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, CURRENT_TIMESTAMP)`
+	_, err := tx.ExecContext(ctx, query, rev.TargetType, rev.TargetID, rev.Title, rev.Content, rev.EditorID)
+	if err != nil {
+		return fmt.Errorf("сохранение версии: %w", err)
+	}
+	return nil
+}
+
+const revisionColumns = `id, target_type, target_id, COALESCE(title, ''), content, editor_id, created_at`
 
-func (r *Db) DeletePost(ctx context.Context, id int64) error {
-	query := `DELETE FROM posts WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, id)
-	return err
+func scanRevision(row rowScanner) (*entities.Revision, error) {
+	rev := &entities.Revision{}
+	err := row.Scan(&rev.ID, &rev.TargetType, &rev.TargetID, &rev.Title, &rev.Content, &rev.EditorID, &rev.CreatedAt)
+	return rev, err
 }
 
-func (r *Db) Posts(ctx context.Context) ([]*entities.Post, error) {
+// Revisions возвращает историю правок от исходной версии к текущей
+func (r *Db) Revisions(ctx context.Context, targetType string, targetID int64) ([]*entities.Revision, error) {
 	query := `
-		SELECT id, title, content, author_id, username, created_at, updated_at,
-			(SELECT COUNT(*) FROM comments WHERE post_id = p.id) as comment_count
-		FROM posts p ORDER BY created_at DESC`
+		SELECT ` + revisionColumns + `
+		FROM revisions
+		WHERE target_type = $1 AND target_id = $2
+		ORDER BY id`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.QueryContext(ctx, query, targetType, targetID)
 	if err != nil {
-		return nil, fmt.Errorf("получение постов: %w", err)
+		return nil, fmt.Errorf("получение истории правок: %w", err)
 	}
 	defer rows.Close()
 
-	var posts []*entities.Post
+	var revisions []*entities.Revision
 	for rows.Next() {
-		post := &entities.Post{}
-		err := rows.Scan(
-			&post.ID, &post.Title, &post.Content, &post.AuthorID,
-			&post.AuthorName,
-			&post.CreatedAt, &post.UpdatedAt, &post.CommentCount,
-		)
+		rev, err := scanRevision(rows)
 		if err != nil {
-			return nil, fmt.Errorf("ошибка сканирования поста: %w", err)
+			return nil, fmt.Errorf("ошибка сканирования версии: %w", err)
 		}
-		posts = append(posts, post)
+		revisions = append(revisions, rev)
+	}
+	return revisions, rows.Err()
+}
+
+func (r *Db) GetRevision(ctx context.Context, id int64) (*entities.Revision, error) {
+	query := `SELECT ` + revisionColumns + ` FROM revisions WHERE id = $1`
+
+	rev, err := scanRevision(r.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, e.ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("получение версии: %w", err)
 	}
-	return posts, nil
+	return rev, nil
 }
 
 // --- Chat Repository ---
 
-func (r *Db) SaveMessage(ctx context.Context, userID int64, username, content string) error {
+func (r *Db) SaveMessage(ctx context.Context, userID int64, username, content string) (int64, error) {
 	var id int64
 	query := `INSERT INTO chat_messages (user_id, username, content, created_at) VALUES ($1, $2, $3, NOW()) RETURNING id`
-	return r.db.QueryRowContext(ctx, query, userID, username, content).Scan(&id)
+	err := r.db.QueryRowContext(ctx, query, userID, username, content).Scan(&id)
+	return id, err
 }
 
 func (r *Db) DeleteOldMessages(ctx context.Context, before time.Time) error {
@@ -153,6 +2576,16 @@ func (r *Db) DeleteOldMessages(ctx context.Context, before time.Time) error {
 	}
 
 	affected, _ := result.RowsAffected()
+	if affected > 0 {
+		_, err := r.db.ExecContext(ctx, `
+			DELETE FROM mentions m
+			WHERE m.target_type = $1 AND NOT EXISTS (SELECT 1 FROM chat_messages cm WHERE cm.id = m.target_id)`,
+			TargetTypeChat)
+		if err != nil {
+			r.logger.Error("ошибка удаления упоминаний из старых сообщений", logger.NewField("error", err))
+			return err
+		}
+	}
 	r.logger.Info("удалены старые сообщения",
 		logger.NewField("count", affected),
 		logger.NewField("older_than", before))
@@ -184,48 +2617,190 @@ func (r *Db) GetMessages(ctx context.Context) ([]*entities.ChatMessage, error) {
 	return messages, nil
 }
 
+// GetMessage возвращает сообщение чата; удалённое по сроку давности — errors.ErrMessageNotFound
+func (r *Db) GetMessage(ctx context.Context, id int64) (*entities.ChatMessage, error) {
+	msg := &entities.ChatMessage{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, user_id, username, content, created_at
+		FROM chat_messages WHERE id = $1`, id).
+		Scan(&msg.ID, &msg.UserID, &msg.Username, &msg.Content, &msg.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, e.ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения сообщения: %w", err)
+	}
+	return msg, nil
+}
+
+// DeleteMessage удаляет сообщение чата вместе с его упоминаниями
+func (r *Db) DeleteMessage(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("удаление сообщения: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM chat_messages WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("удаление сообщения: %w", err)
+	}
+	if err := expectAffected(res, e.ErrMessageNotFound); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM mentions WHERE target_type = $1 AND target_id = $2`, TargetTypeChat, id)
+	if err != nil {
+		return fmt.Errorf("удаление упоминаний из сообщения: %w", err)
+	}
+	return tx.Commit()
+}
+
 // ----------------------- CommentRepository
 
+// commentColumns — колонки выборки комментария в порядке, который ожидает scanComment.
+// Текст удалённых комментариев-заглушек не отдаётся.
+const commentColumns = `id, post_id, parent_id, depth, author_id, username,
+			CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+			CASE WHEN deleted_at IS NULL THEN content_html ELSE '' END AS content_html,
+			deleted_at IS NOT NULL AS deleted, created_at, updated_at, score`
+
+// scanComment читает commentColumns и, если переданы, дополнительные колонки после них
+func scanComment(row rowScanner, extra ...any) (*entities.Comment, error) {
+	comment := &entities.Comment{}
+	dest := []any{
+		&comment.ID, &comment.PostID, &comment.ParentID, &comment.Depth,
+		&comment.AuthorID, &comment.AuthorName, &comment.Content, &comment.ContentHTML,
+		&comment.Deleted, &comment.CreatedAt, &comment.UpdatedAt,
+		&comment.Score,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	return comment, err
+}
+
+// commentTargetError объясняет, почему комментарий не вставлен: пост закрыт
+// или его нет среди опубликованных
+func commentTargetError(ctx context.Context, tx *sql.Tx, postID int64) error {
+	var locked bool
+	err := tx.QueryRowContext(ctx,
+		`SELECT is_locked FROM posts WHERE id = $1 AND status = 'published'`, postID).Scan(&locked)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return e.ErrPostNotFound
+	case err != nil:
+		return fmt.Errorf("создание комментария: %w", err)
+	case locked:
+		return e.ErrPostLocked
+	default:
+		return e.ErrPostNotFound
+	}
+}
+
+// CreateComment сохраняет комментарий. Комментарий с заполненным Hold не
+// сохраняется, а ставится в очередь модерации и получает ID после одобрения.
 func (r *Db) CreateComment(ctx context.Context, comment *entities.Comment) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("создание комментария: %w", err)
+	}
+	defer tx.Rollback()
+
+	if comment.Hold != nil {
+		err = holdComment(ctx, tx, comment)
+	} else {
+		err = insertComment(ctx, tx, comment)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// holdComment ставит новый комментарий в очередь модерации. Пост проверяется
+// сразу, чтобы автор узнал об отказе, а не ждал модератора.
+func holdComment(ctx context.Context, tx *sql.Tx, comment *entities.Comment) error {
+	var locked bool
+	err := tx.QueryRowContext(ctx,
+		`SELECT is_locked FROM posts WHERE id = $1 AND status = 'published' FOR SHARE`, comment.PostID).Scan(&locked)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return e.ErrPostNotFound
+	case err != nil:
+		return fmt.Errorf("задержка комментария: %w", err)
+	case locked:
+		return e.ErrPostLocked
+	}
+
+	held := &entities.HeldContent{
+		TargetType:  TargetTypeComment,
+		PostID:      comment.PostID,
+		ParentID:    comment.ParentID,
+		Depth:       comment.Depth,
+		AuthorID:    comment.AuthorID,
+		AuthorName:  comment.AuthorName,
+		Content:     comment.Content,
+		ContentHTML: comment.ContentHTML,
+		Reason:      comment.Hold.Reason,
+	}
+	if err := holdContent(ctx, tx, held); err != nil {
+		return err
+	}
+	comment.CreatedAt = held.CreatedAt
+	comment.Hold.HeldAt = held.CreatedAt
+	return nil
+}
+
+func insertComment(ctx context.Context, tx *sql.Tx, comment *entities.Comment) error {
+	// Комментировать можно только опубликованные и не закрытые посты. Проверка
+	// в самом INSERT не даёт комментарию проскочить в пост, закрытый одновременно
 	query := `
-        INSERT INTO comments (post_id, author_id, username, content, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6)
+        INSERT INTO comments (post_id, parent_id, depth, author_id, username, content, content_html, created_at, updated_at)
+        SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9
+        WHERE EXISTS (SELECT 1 FROM posts WHERE id = $1 AND status = 'published' AND NOT is_locked)
         RETURNING id
     `
 	now := time.Now()
 	comment.CreatedAt = now
 	comment.UpdatedAt = nil // new comment, no update yet
 
-	return r.db.QueryRowContext(
+	err := tx.QueryRowContext(
 		ctx,
 		query,
 		comment.PostID,
+		comment.ParentID,
+		comment.Depth,
 		comment.AuthorID,
 		comment.AuthorName,
 		comment.Content,
+		comment.ContentHTML,
 		comment.CreatedAt,
 		comment.UpdatedAt,
 	).Scan(&comment.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return commentTargetError(ctx, tx, comment.PostID)
+	}
+	if err != nil {
+		return err
+	}
+
+	// GREATEST пропускает NULL, так что первый комментарий просто задаёт время
+	_, err = tx.ExecContext(ctx, `
+        UPDATE posts SET comment_count = comment_count + 1, last_comment_at = GREATEST(last_comment_at, $2)
+        WHERE id = $1
+    `, comment.PostID, comment.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("обновление счётчика комментариев: %w", err)
+	}
+	return nil
 }
 
 func (r *Db) GetCommentByID(ctx context.Context, id int64) (*entities.Comment, error) {
 	query := `
-        SELECT id, post_id, author_id, username, content, created_at, updated_at
+        SELECT ` + commentColumns + `
         FROM comments
         WHERE id = $1
     `
-	row := r.db.QueryRowContext(ctx, query, id)
-
-	var comment entities.Comment
-	err := row.Scan(
-		&comment.ID,
-		&comment.PostID,
-		&comment.AuthorID,
-		&comment.AuthorName,
-		&comment.Content,
-		&comment.CreatedAt,
-		&comment.UpdatedAt,
-	)
+	comment, err := scanComment(r.db.QueryRowContext(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, e.ErrCommentNotFound
@@ -233,15 +2808,31 @@ func (r *Db) GetCommentByID(ctx context.Context, id int64) (*entities.Comment, e
 		return nil, err
 	}
 
-	return &comment, nil
+	return comment, nil
 }
 
+// GetByPostID возвращает комментарии поста плоским списком в порядке обхода
+// дерева в глубину: каждый ответ идёт сразу за своим родителем, соседние
+// ветки — в порядке создания. Удалённый комментарий остаётся заглушкой,
+// только пока под ним есть неудалённые ответы.
 func (r *Db) GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error) {
 	query := `
-        SELECT id, post_id, author_id, username, content, created_at, updated_at
-        FROM comments
-        WHERE post_id = $1
-        ORDER BY created_at ASC
+        WITH RECURSIVE thread AS (
+            SELECT c.*, ARRAY[c.id] AS path
+            FROM comments c
+            WHERE c.post_id = $1 AND c.parent_id IS NULL
+            UNION ALL
+            SELECT c.*, t.path || c.id
+            FROM comments c
+            JOIN thread t ON c.parent_id = t.id
+        )
+        SELECT ` + commentColumns + `, path
+        FROM thread t
+        WHERE t.deleted_at IS NULL OR EXISTS (
+            SELECT 1 FROM thread d
+            WHERE d.deleted_at IS NULL AND d.id <> t.id AND t.id = ANY(d.path)
+        )
+        ORDER BY path
     `
 	rows, err := r.db.QueryContext(ctx, query, postID)
 	if err != nil {
@@ -251,29 +2842,23 @@ func (r *Db) GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment
 
 	var comments []*entities.Comment
 	for rows.Next() {
-		var comment entities.Comment
-		if err := rows.Scan(
-			&comment.ID,
-			&comment.PostID,
-			&comment.AuthorID,
-			&comment.AuthorName,
-			&comment.Content,
-			&comment.CreatedAt,
-			&comment.UpdatedAt,
-		); err != nil {
+		var path pq.Int64Array
+		comment, err := scanComment(rows, &path)
+		if err != nil {
 			return nil, err
 		}
-		comments = append(comments, &comment)
+		comment.Path = path
+		comments = append(comments, comment)
 	}
 
-	return comments, nil
+	return comments, rows.Err()
 }
 
 func (r *Db) GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error) {
 	query := `
-        SELECT id, post_id, author_id, username, content, created_at, updated_at
+        SELECT ` + commentColumns + `
         FROM comments
-        WHERE author_id = $1
+        WHERE author_id = $1 AND deleted_at IS NULL
         ORDER BY created_at DESC
     `
 	rows, err := r.db.QueryContext(ctx, query, userID)
@@ -284,58 +2869,511 @@ func (r *Db) GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment
 
 	var comments []*entities.Comment
 	for rows.Next() {
-		var comment entities.Comment
-		if err := rows.Scan(
-			&comment.ID,
-			&comment.PostID,
-			&comment.AuthorID,
-			&comment.AuthorName,
-			&comment.Content,
-			&comment.CreatedAt,
-			&comment.UpdatedAt,
-		); err != nil {
+		comment, err := scanComment(rows)
+		if err != nil {
 			return nil, err
 		}
-		comments = append(comments, &comment)
+		comments = append(comments, comment)
 	}
 
 	return comments, nil
 }
 
+// SearchComments ищет комментарии по тексту. Вторым значением возвращает
+// общее число совпадений без учёта LIMIT/OFFSET.
+func (r *Db) SearchComments(ctx context.Context, q entities.SearchQuery) ([]*entities.SearchHit, int, error) {
+	query := `
+		SELECT c.post_id, c.id, p.title,
+			ts_headline('russian', c.content, query, $2),
+			c.author_id, c.username, c.created_at,
+			ts_rank(c.search_vector, query) AS rank,
+			COUNT(*) OVER ()
+		FROM comments c
+		JOIN posts p ON p.id = c.post_id,
+			websearch_to_tsquery('russian', $1) query
+		WHERE c.search_vector @@ query AND c.deleted_at IS NULL AND p.deleted_at IS NULL
+			AND ($3::bigint IS NULL OR c.author_id = $3)
+		ORDER BY rank DESC, c.created_at DESC, c.id DESC
+		LIMIT $4 OFFSET $5`
+
+	rows, err := r.db.QueryContext(ctx, query, q.Text, searchHeadlineOptions, q.AuthorID, q.Limit, q.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("поиск комментариев: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSearchHits(rows, TargetTypeComment)
+}
+
+func scanSearchHits(rows *sql.Rows, targetType string) ([]*entities.SearchHit, int, error) {
+	var (
+		hits  []*entities.SearchHit
+		total int
+	)
+	for rows.Next() {
+		hit := &entities.SearchHit{TargetType: targetType}
+		if err := rows.Scan(
+			&hit.PostID, &hit.CommentID, &hit.Title, &hit.Snippet,
+			&hit.AuthorID, &hit.AuthorName, &hit.CreatedAt,
+			&hit.Rank, &total,
+		); err != nil {
+			return nil, 0, fmt.Errorf("ошибка сканирования результата поиска: %w", err)
+		}
+		hit.Snippet = safeHeadline(hit.Snippet)
+		hits = append(hits, hit)
+	}
+	return hits, total, rows.Err()
+}
+
+// UpdateComment меняет текст комментария и записывает правку в историю.
+// Правка с заполненным Hold только ставится в очередь модерации: читатели видят
+// прежний текст, пока её не одобрят. Правка без Hold отменяет задержанную ранее,
+// а с KeepHeldEdit вместо этого отказывает с ErrEditHeld.
 func (r *Db) UpdateComment(ctx context.Context, comment *entities.Comment) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := updateComment(ctx, tx, comment); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func updateComment(ctx context.Context, tx *sql.Tx, comment *entities.Comment) error {
+	var (
+		content, username string
+		authorID, postID  int64
+	)
+	err := tx.QueryRowContext(ctx, `
+        SELECT content, author_id, username, post_id FROM comments
+        WHERE id = $1 AND deleted_at IS NULL
+        FOR UPDATE
+    `, comment.ID).Scan(&content, &authorID, &username, &postID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return e.ErrCommentNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if comment.KeepHeldEdit {
+		if err := checkHeldEdit(ctx, tx, TargetTypeComment, comment.ID); err != nil {
+			return err
+		}
+	}
+	if comment.Hold == nil {
+		if err := dropHeldEdit(ctx, tx, TargetTypeComment, comment.ID); err != nil {
+			return err
+		}
+	}
+	if content == comment.Content {
+		// Опубликованный текст не задерживается: он уже прошёл проверку
+		comment.Hold = nil
+		return nil
+	}
+	editorID := comment.EditorID
+	if editorID == 0 {
+		editorID = authorID
+	}
+	if comment.Hold != nil {
+		held := &entities.HeldContent{
+			TargetType:  TargetTypeComment,
+			TargetID:    comment.ID,
+			PostID:      postID,
+			IsEdit:      true,
+			AuthorID:    authorID,
+			AuthorName:  username,
+			EditorID:    editorID,
+			Content:     comment.Content,
+			ContentHTML: comment.ContentHTML,
+			Reason:      comment.Hold.Reason,
+		}
+		if err := holdContent(ctx, tx, held); err != nil {
+			return err
+		}
+		comment.Hold.HeldAt = held.CreatedAt
+		return nil
+	}
+	if err := recordOriginalRevision(ctx, tx, TargetTypeComment, comment.ID); err != nil {
+		return err
+	}
+
 	now := time.Now()
 	comment.UpdatedAt = &now
 
 	query := `
         UPDATE comments
-        SET content = $1, updated_at = $2
-        WHERE id = $3
+        SET content = $1, content_html = $2, updated_at = $3
+        WHERE id = $4
     `
-	res, err := r.db.ExecContext(ctx, query, comment.Content, comment.UpdatedAt, comment.ID)
-	if err != nil {
+	if _, err := tx.ExecContext(ctx, query, comment.Content, comment.ContentHTML, comment.UpdatedAt, comment.ID); err != nil {
 		return err
 	}
 
-	affected, err := res.RowsAffected()
+	return recordRevision(ctx, tx, &entities.Revision{
+		TargetType: TargetTypeComment,
+		TargetID:   comment.ID,
+		Content:    comment.Content,
+		EditorID:   editorID,
+	})
+}
+
+// DeleteComment переносит комментарий в корзину. В ветке обсуждения он
+// остаётся заглушкой, пока на него есть ответы.
+func (r *Db) DeleteComment(ctx context.Context, id, deletedBy int64, reason string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("удаление комментария: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+        UPDATE comments SET deleted_at = CURRENT_TIMESTAMP, deleted_by = $2, delete_reason = $3
+        WHERE id = $1 AND deleted_at IS NULL
+        RETURNING post_id
+    `
+	var postID int64
+	err = tx.QueryRowContext(ctx, query, id, deletedBy, reason).Scan(&postID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return e.ErrCommentNotFound
+	}
 	if err != nil {
+		r.logger.Error("ошибка удаления комментария", logger.NewField("error", err))
 		return err
 	}
-	if affected == 0 {
-		return fmt.Errorf("no comment found with id %d", comment.ID)
+	if err := updateCommentStats(ctx, tx, postID, -1); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
 	}
 
+	r.logger.Info("комментарий перенесён в корзину", logger.NewField("comment_id", id))
 	return nil
 }
 
-func (r *Db) DeleteComment(ctx context.Context, id int64) error {
-	query := `DELETE FROM comments WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, id)
-	r.logger.Info("YA TUT")
+// RestoreComment возвращает комментарий из корзины
+func (r *Db) RestoreComment(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		r.logger.Error("ошибка удаления комментария", logger.NewField("error", err))
+		return fmt.Errorf("восстановление комментария: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+        UPDATE comments SET deleted_at = NULL, deleted_by = NULL, delete_reason = ''
+        WHERE id = $1 AND deleted_at IS NOT NULL
+        RETURNING post_id
+    `
+	var postID int64
+	err = tx.QueryRowContext(ctx, query, id).Scan(&postID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return e.ErrCommentNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if err := updateCommentStats(ctx, tx, postID, 1); err != nil {
 		return err
 	}
+	return tx.Commit()
+}
+
+// updateCommentStats сдвигает счётчик комментариев поста на delta после удаления
+// или восстановления комментария. Последний живой комментарий при этом мог
+// смениться на любой другой, поэтому его время ищется заново.
+func updateCommentStats(ctx context.Context, tx *sql.Tx, postID int64, delta int) error {
+	_, err := tx.ExecContext(ctx, `
+        UPDATE posts SET comment_count = comment_count + $2,
+            last_comment_at = (SELECT MAX(created_at) FROM comments WHERE post_id = $1 AND deleted_at IS NULL)
+        WHERE id = $1
+    `, postID, delta)
+	if err != nil {
+		return fmt.Errorf("обновление счётчика комментариев: %w", err)
+	}
+	return nil
+}
+
+// DeletedComments возвращает корзину комментариев, недавно удалённые первыми
+func (r *Db) DeletedComments(ctx context.Context, limit, offset int) ([]*entities.Comment, error) {
+	query := `
+        SELECT id, post_id, parent_id, depth, author_id, username, content,
+            created_at, updated_at, deleted_at, COALESCE(deleted_by, 0), delete_reason
+        FROM comments
+        WHERE deleted_at IS NOT NULL
+        ORDER BY deleted_at DESC, id DESC
+        LIMIT $1 OFFSET $2
+    `
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []*entities.Comment
+	for rows.Next() {
+		comment := &entities.Comment{Deleted: true, Deletion: &entities.Deletion{}}
+		if err := rows.Scan(
+			&comment.ID, &comment.PostID, &comment.ParentID, &comment.Depth,
+			&comment.AuthorID, &comment.AuthorName, &comment.Content,
+			&comment.CreatedAt, &comment.UpdatedAt,
+			&comment.Deletion.DeletedAt, &comment.Deletion.DeletedBy, &comment.Deletion.Reason,
+		); err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+	return comments, rows.Err()
+}
+
+// PurgeComments окончательно удаляет комментарии, лежащие в корзине с момента
+// before и раньше. Комментарии с ответами не трогаются: удаление каскадом
+// унесло бы ответы. Они очищаются на следующих проходах, когда ответов не останется.
+func (r *Db) PurgeComments(ctx context.Context, before time.Time) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	ids, err := queryIDs(ctx, tx, `
+        SELECT c.id FROM comments c
+        WHERE c.deleted_at < $1 AND NOT EXISTS (SELECT 1 FROM comments r WHERE r.parent_id = c.id)
+        FOR UPDATE
+    `, before)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	if err := purgeTargetRecords(ctx, tx, TargetTypeComment, ids); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM comments WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+		return 0, fmt.Errorf("очистка корзины комментариев: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int64(len(ids)), nil
+}
+
+// --- Held Repository ---
+
+// heldColumns — колонки очереди модерации в порядке, который ожидает scanHeld.
+// Текст нового поста лежит в самом посте, а у комментария вместо заголовка
+// отдаётся заголовок поста.
+const heldColumns = `h.id, h.target_type, COALESCE(h.target_id, 0), h.post_id, h.parent_id, h.depth, h.is_edit,
+			h.author_id, h.username, COALESCE(h.editor_id, 0),
+			COALESCE(h.title, p.title), COALESCE(h.content, p.content), COALESCE(h.content_html, p.content_html),
+			CASE WHEN h.is_edit THEN h.category_id ELSE p.category_id END, h.tags,
+			COALESCE(h.post_status, ''), h.reason, h.created_at`
+
+func scanHeld(row rowScanner) (*entities.HeldContent, error) {
+	held := &entities.HeldContent{}
+	err := row.Scan(
+		&held.ID, &held.TargetType, &held.TargetID, &held.PostID, &held.ParentID, &held.Depth, &held.IsEdit,
+		&held.AuthorID, &held.AuthorName, &held.EditorID,
+		&held.Title, &held.Content, &held.ContentHTML,
+		&held.CategoryID, pq.Array(&held.Tags),
+		&held.PostStatus, &held.Reason, &held.CreatedAt,
+	)
+	return held, err
+}
+
+// holdContent ставит текст в очередь модерации и проставляет ему ID и время.
+// У поста или комментария в очереди лежит одна версия: новая заменяет прежнюю,
+// но статус, с которым выйдет новый пост, сохраняется, если не передан.
+func holdContent(ctx context.Context, tx *sql.Tx, held *entities.HeldContent) error {
+	query := `
+		INSERT INTO held_content (target_type, target_id, post_id, parent_id, depth, is_edit,
+			author_id, username, editor_id, title, content, content_html, category_id, tags, post_status, reason)
+		VALUES ($1, NULLIF($2, 0), $3, $4, $5, $6, $7, $8, NULLIF($9, 0),
+			NULLIF($10, ''), NULLIF($11, ''), NULLIF($12, ''), $13, $14, NULLIF($15, ''), $16)
+		ON CONFLICT (target_type, target_id) DO UPDATE SET
+			is_edit = EXCLUDED.is_edit, editor_id = EXCLUDED.editor_id,
+			title = EXCLUDED.title, content = EXCLUDED.content, content_html = EXCLUDED.content_html,
+			category_id = EXCLUDED.category_id, tags = EXCLUDED.tags,
+			post_status = COALESCE(EXCLUDED.post_status, held_content.post_status),
+			reason = EXCLUDED.reason, created_at = CURRENT_TIMESTAMP
+		RETURNING id, created_at`
+	err := tx.QueryRowContext(ctx, query,
+		held.TargetType, held.TargetID, held.PostID, held.ParentID, held.Depth, held.IsEdit,
+		held.AuthorID, held.AuthorName, held.EditorID,
+		held.Title, held.Content, held.ContentHTML, held.CategoryID, pq.Array(held.Tags), held.PostStatus, held.Reason,
+	).Scan(&held.ID, &held.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("постановка текста на модерацию: %w", err)
+	}
+	return nil
+}
+
+// dropHeldEdit отбрасывает задержанную правку цели: её заменяет новая правка
+func dropHeldEdit(ctx context.Context, tx *sql.Tx, targetType string, targetID int64) error {
+	_, err := tx.ExecContext(ctx,
+		`DELETE FROM held_content WHERE target_type = $1 AND target_id = $2 AND is_edit`, targetType, targetID)
+	if err != nil {
+		return fmt.Errorf("отмена задержанной правки: %w", err)
+	}
+	return nil
+}
 
-	r.logger.Info("комментарий удален")
+// checkHeldEdit отказывает с ErrEditHeld, если правка цели ждёт модератора
+func checkHeldEdit(ctx context.Context, tx *sql.Tx, targetType string, targetID int64) error {
+	var held bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM held_content WHERE target_type = $1 AND target_id = $2 AND is_edit)`,
+		targetType, targetID).Scan(&held)
+	if err != nil {
+		return fmt.Errorf("проверка задержанной правки: %w", err)
+	}
+	if held {
+		return e.ErrEditHeld
+	}
 	return nil
 }
+
+// takeHeld забирает текст из очереди внутри транзакции решения модератора
+func takeHeld(ctx context.Context, tx *sql.Tx, id int64) (*entities.HeldContent, error) {
+	query := `
+		DELETE FROM held_content h USING posts p
+		WHERE h.id = $1 AND p.id = h.post_id
+		RETURNING ` + heldColumns
+	held, err := scanHeld(tx.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, e.ErrHeldNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("получение текста на модерации: %w", err)
+	}
+	return held, nil
+}
+
+// HeldContent не показывает тексты удалённых постов и комментариев: решение по
+// ним не нужно, а записи очереди уйдут вместе с ними при очистке корзины
+func (r *Db) HeldContent(ctx context.Context, limit, offset int) ([]*entities.HeldContent, error) {
+	query := `
+		SELECT ` + heldColumns + `
+		FROM held_content h
+		JOIN posts p ON p.id = h.post_id
+		WHERE p.deleted_at IS NULL AND NOT EXISTS (
+			SELECT 1 FROM comments c
+			WHERE h.target_type = 'comment' AND c.id = h.target_id AND c.deleted_at IS NOT NULL
+		)
+		ORDER BY h.created_at, h.id
+		LIMIT $1 OFFSET $2`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("получение очереди модерации: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*entities.HeldContent
+	for rows.Next() {
+		held, err := scanHeld(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования очереди модерации: %w", err)
+		}
+		items = append(items, held)
+	}
+	return items, rows.Err()
+}
+
+func (r *Db) ApproveHeld(ctx context.Context, id int64) (*entities.HeldContent, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	held, err := takeHeld(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case held.TargetType == TargetTypePost && !held.IsEdit:
+		err = publishHeldPost(ctx, tx, held)
+	case held.TargetType == TargetTypePost:
+		err = updatePost(ctx, tx, &entities.Post{
+			ID:          held.TargetID,
+			Title:       held.Title,
+			Content:     held.Content,
+			ContentHTML: held.ContentHTML,
+			CategoryID:  held.CategoryID,
+			Tags:        held.Tags,
+			EditorID:    held.EditorID,
+		})
+	case !held.IsEdit:
+		comment := &entities.Comment{
+			PostID:      held.PostID,
+			ParentID:    held.ParentID,
+			Depth:       held.Depth,
+			AuthorID:    held.AuthorID,
+			AuthorName:  held.AuthorName,
+			Content:     held.Content,
+			ContentHTML: held.ContentHTML,
+		}
+		if err = insertComment(ctx, tx, comment); err == nil {
+			held.TargetID = comment.ID
+		}
+	default:
+		err = updateComment(ctx, tx, &entities.Comment{
+			ID:          held.TargetID,
+			Content:     held.Content,
+			ContentHTML: held.ContentHTML,
+			EditorID:    held.EditorID,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return held, nil
+}
+
+// publishHeldPost возвращает новому посту статус, с которым его отправил автор.
+// Опубликованный сразу пост встаёт в начало ленты, как при публикации черновика.
+func publishHeldPost(ctx context.Context, tx *sql.Tx, held *entities.HeldContent) error {
+	status := held.PostStatus
+	if status == "" {
+		status = entities.PostStatusPublished
+	}
+	query := `
+		UPDATE posts SET status = $2,
+			created_at = CASE WHEN $2 = 'published' THEN CURRENT_TIMESTAMP ELSE created_at END
+		WHERE id = $1 AND status = 'held' AND deleted_at IS NULL`
+	res, err := tx.ExecContext(ctx, query, held.PostID, status)
+	if err != nil {
+		return fmt.Errorf("публикация задержанного поста: %w", err)
+	}
+	return expectAffected(res, e.ErrPostNotFound)
+}
+
+func (r *Db) RejectHeld(ctx context.Context, id, rejectedBy int64, reason string) (*entities.HeldContent, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	held, err := takeHeld(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if held.TargetType == TargetTypePost && !held.IsEdit {
+		// Черновиком, чтобы восстановленный из корзины пост не вышел без проверки
+		query := `
+			UPDATE posts SET status = 'draft', deleted_at = CURRENT_TIMESTAMP, deleted_by = $2, delete_reason = $3
+			WHERE id = $1 AND status = 'held' AND deleted_at IS NULL`
+		if _, err := tx.ExecContext(ctx, query, held.PostID, rejectedBy, reason); err != nil {
+			return nil, fmt.Errorf("отклонение задержанного поста: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return held, nil
+}
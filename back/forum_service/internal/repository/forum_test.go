@@ -9,9 +9,11 @@ import (
 
 	"github.com/netabakovv/forum/back/forum_service/internal/entities"
 	"github.com/netabakovv/forum/back/forum_service/internal/repository"
+	forumErrors "github.com/netabakovv/forum/back/pkg/errors"
 	"github.com/netabakovv/forum/back/pkg/logger"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -25,6 +27,12 @@ func setup(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.PostRepository) {
 	return db, mock, repo
 }
 
+var postColumns = []string{
+	"id", "title", "content", "content_html", "author_id", "username", "created_at", "updated_at", "comment_count",
+	"category_id", "tags", "score", "deleted", "status", "publish_at",
+	"is_pinned", "is_locked", "last_comment_at", "hot_rank", "view_count",
+}
+
 func TestCreatePost(t *testing.T) {
 	db, mock, repo := setup(t)
 	defer db.Close()
@@ -34,12 +42,15 @@ func TestCreatePost(t *testing.T) {
 		Content:    "Test Content",
 		AuthorID:   1,
 		AuthorName: "user",
+		Status:     entities.PostStatusPublished,
 	}
 
+	mock.ExpectBegin()
 	mock.ExpectQuery(`INSERT INTO posts`).
-		WithArgs(post.Title, post.Content, post.AuthorID, post.AuthorName).
+		WithArgs(post.Title, post.Content, post.ContentHTML, post.AuthorID, post.AuthorName, nil, post.Status, nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).
 			AddRow(1, time.Now()))
+	mock.ExpectCommit()
 
 	err := repo.CreatePost(context.Background(), post)
 	assert.NoError(t, err)
@@ -47,31 +58,113 @@ func TestCreatePost(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestCreatePost_WithCategoryAndTags(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	categoryID := int64(3)
+	post := &entities.Post{
+		Title:      "Test Title",
+		Content:    "Test Content",
+		AuthorID:   1,
+		AuthorName: "user",
+		CategoryID: &categoryID,
+		Tags:       []string{"go", "sql"},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO posts`).
+		WithArgs(post.Title, post.Content, post.ContentHTML, post.AuthorID, post.AuthorName, &categoryID, post.Status, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).
+			AddRow(7, time.Now()))
+	mock.ExpectExec(`INSERT INTO post_tags`).
+		WithArgs(7, pq.Array(post.Tags)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := repo.CreatePost(context.Background(), post)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), post.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePost_UnknownCategory(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	categoryID := int64(404)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO posts`).
+		WillReturnError(&pq.Error{Code: "23503"})
+	mock.ExpectRollback()
+
+	err := repo.CreatePost(context.Background(), &entities.Post{Title: "t", Content: "c", CategoryID: &categoryID})
+	assert.ErrorIs(t, err, forumErrors.ErrCategoryNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetPostByID(t *testing.T) {
 	db, mock, repo := setup(t)
 	defer db.Close()
 
 	now := time.Now()
 	mock.ExpectQuery(regexp.QuoteMeta(`
-	SELECT id, title, content, author_id, username, created_at, updated_at,
-	       (SELECT COUNT(*) FROM comments WHERE post_id = p.id) as comment_count
+	SELECT id,
+	       CASE WHEN deleted_at IS NULL THEN title ELSE '' END AS title,
+	       CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+	       CASE WHEN deleted_at IS NULL THEN content_html ELSE '' END AS content_html,
+	       author_id, username, created_at, updated_at,
+	       comment_count,
+	       category_id,
+	       ARRAY(SELECT tag FROM post_tags WHERE post_id = p.id ORDER BY tag) as tags,
+	       score, deleted_at IS NOT NULL AS deleted,
+	       status, publish_at, is_pinned, is_locked,
+	       last_comment_at,
+	       hot_rank, view_count
 	FROM posts p
 	WHERE id = $1
 `)).
 		WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "title", "content", "author_id", "username", "created_at", "updated_at", "comment_count",
-		}).AddRow(1, "Title", "Content", 2, "user", now, sql.NullTime{}, 3))
+		WillReturnRows(sqlmock.NewRows(postColumns).
+			AddRow(1, "Title", "Content", "<p>Content</p>", 2, "user", now, sql.NullTime{}, 3, 5, "{go,sql}", 4, false, "published", nil, false, true, now, 1.5, 42))
 
 	post, err := repo.GetPostByID(context.Background(), 1)
-	assert.NoError(t, err)
+	require.NoError(t, err)
 	assert.Equal(t, int64(1), post.ID)
 	assert.Equal(t, "user", post.AuthorName)
 	assert.Equal(t, "Title", post.Title)
 	assert.Equal(t, int32(3), post.CommentCount)
+	require.NotNil(t, post.CategoryID)
+	assert.Equal(t, int64(5), *post.CategoryID)
+	assert.Equal(t, []string{"go", "sql"}, post.Tags)
+	assert.Equal(t, int64(4), post.Score)
+	assert.True(t, post.IsLocked)
+	require.NotNil(t, post.LastCommentAt)
+	assert.Equal(t, now, post.LastActivityAt())
+	assert.Equal(t, 1.5, post.HotRank)
+	assert.Equal(t, int64(42), post.ViewCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPostByID_NotFound(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM posts p WHERE id = \$1`).
+		WithArgs(42).
+		WillReturnError(sql.ErrNoRows)
+
+	post, err := repo.GetPostByID(context.Background(), 42)
+	assert.Nil(t, post)
+	assert.ErrorIs(t, err, forumErrors.ErrPostNotFound)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+// postLock — колонки поста, которые UpdatePost читает под блокировкой
+func postLock() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"title", "content", "author_id", "username", "status"})
+}
+
 func TestUpdatePost(t *testing.T) {
 	db, mock, repo := setup(t)
 	defer db.Close()
@@ -82,227 +175,2120 @@ func TestUpdatePost(t *testing.T) {
 		Content: "Updated content",
 	}
 
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT title, content, author_id, username, status FROM posts WHERE id = \$1 AND deleted_at IS NULL FOR UPDATE`).
+		WithArgs(post.ID).
+		WillReturnRows(postLock().AddRow("Title", "Content", 7, "alice", "published"))
+	mock.ExpectExec(`INSERT INTO revisions .* SELECT \$1, id, title, content, author_id, created_at FROM posts`).
+		WithArgs("post", post.ID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`UPDATE posts SET`).
-		WithArgs(post.Title, post.Content, post.ID).
+		WithArgs(post.Title, post.Content, post.ContentHTML, nil, post.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO revisions .* VALUES`).
+		WithArgs("post", post.ID, post.Title, post.Content, int64(7)).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	// чистая правка заменяет задержанную ранее
+	mock.ExpectExec(`DELETE FROM held_content WHERE target_type = \$1 AND target_id = \$2 AND is_edit`).
+		WithArgs("post", post.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
 	err := repo.UpdatePost(context.Background(), post)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestDeletePost(t *testing.T) {
+func TestUpdatePost_ClearTags(t *testing.T) {
 	db, mock, repo := setup(t)
 	defer db.Close()
 
-	mock.ExpectExec(`DELETE FROM posts`).
-		WithArgs(1).
+	post := &entities.Post{
+		ID:      1,
+		Title:   "Updated",
+		Content: "Updated content",
+		Tags:    []string{},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT title, content, author_id, username, status FROM posts`).
+		WithArgs(post.ID).
+		WillReturnRows(postLock().AddRow(post.Title, post.Content, 7, "alice", "draft"))
+	mock.ExpectExec(`UPDATE posts SET`).
+		WithArgs(post.Title, post.Content, post.ContentHTML, nil, post.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM post_tags`).
+		WithArgs(post.ID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
 
-	err := repo.DeletePost(context.Background(), 1)
+	err := repo.UpdatePost(context.Background(), post)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestPosts(t *testing.T) {
+func TestCreatePost_Held(t *testing.T) {
 	db, mock, repo := setup(t)
 	defer db.Close()
 
-	now := time.Now()
-	mock.ExpectQuery(`SELECT id, title, content, author_id, username, created_at, updated_at,`).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "title", "content", "author_id", "username", "created_at", "updated_at", "comment_count",
-		}).AddRow(1, "Title", "Content", 2, "user", now, sql.NullTime{}, 0))
+	heldAt := time.Now()
+	post := &entities.Post{
+		Title:      "Test Title",
+		Content:    "Test Content",
+		AuthorID:   1,
+		AuthorName: "user",
+		Status:     entities.PostStatusScheduled,
+		Hold:       &entities.Hold{Reason: "слишком много ссылок"},
+	}
 
-	posts, err := repo.Posts(context.Background())
-	assert.NoError(t, err)
-	assert.Len(t, posts, 1)
-	assert.Equal(t, int64(1), posts[0].ID)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO posts`).
+		WithArgs(post.Title, post.Content, post.ContentHTML, post.AuthorID, post.AuthorName, nil, entities.PostStatusScheduled, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, time.Now()))
+	mock.ExpectExec(`UPDATE posts SET status = 'held' WHERE id = \$1`).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// текст нового поста лежит в самом посте, в очереди — только статус, с которым он выйдет
+	mock.ExpectQuery(`INSERT INTO held_content`).
+		WithArgs("post", int64(1), int64(1), nil, int32(0), false, int64(1), "user", int64(0),
+			"", "", "", nil, nil, entities.PostStatusScheduled, "слишком много ссылок").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(5, heldAt))
+	mock.ExpectCommit()
+
+	err := repo.CreatePost(context.Background(), post)
+	require.NoError(t, err)
+	assert.Equal(t, entities.PostStatusHeld, post.Status)
+	assert.True(t, post.Unpublished())
+	assert.Equal(t, heldAt, post.Hold.HeldAt)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func setupComment(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.CommentRepository) {
-	db, mock, err := sqlmock.New()
-	assert.NoError(t, err)
-	repo := repository.NewCommentRepository(db, logger.NewStdLogger())
-	return db, mock, repo
+func TestUpdatePost_KeepHeldEdit(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT title, content, author_id, username, status FROM posts`).
+		WithArgs(int64(1)).
+		WillReturnRows(postLock().AddRow("Title", "Content", 2, "user", "published"))
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM held_content`).
+		WithArgs("post", int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	err := repo.UpdatePost(context.Background(), &entities.Post{ID: 1, Title: "Old", Content: "old", KeepHeldEdit: true})
+	assert.ErrorIs(t, err, forumErrors.ErrEditHeld)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestCreateComment(t *testing.T) {
-	db, mock, repo := setupComment(t)
+func TestUpdatePost_HeldEditKeepsPublishedText(t *testing.T) {
+	db, mock, repo := setup(t)
 	defer db.Close()
 
-	comment := &entities.Comment{
-		PostID:     1,
-		AuthorID:   2,
-		Content:    "Test comment",
-		AuthorName: "user",
+	post := &entities.Post{
+		ID:          1,
+		Title:       "Updated",
+		Content:     "Updated content",
+		ContentHTML: "<p>Updated content</p>",
+		EditorID:    3,
+		Hold:        &entities.Hold{Reason: "слишком много ссылок"},
 	}
 
-	mock.ExpectQuery(`INSERT INTO comments`).
-		WithArgs(comment.PostID, comment.AuthorID, comment.AuthorName, comment.Content, sqlmock.AnyArg(), nil).
-		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT title, content, author_id, username, status FROM posts`).
+		WithArgs(post.ID).
+		WillReturnRows(postLock().AddRow("Title", "Content", 7, "alice", "published"))
+	// пост и история правок не меняются до одобрения
+	mock.ExpectQuery(`INSERT INTO held_content .* ON CONFLICT \(target_type, target_id\) DO UPDATE`).
+		WithArgs("post", post.ID, post.ID, nil, int32(0), true, int64(7), "alice", int64(3),
+			post.Title, post.Content, post.ContentHTML, nil, pq.Array([]string(nil)), "", "слишком много ссылок").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(5, time.Now()))
+	mock.ExpectCommit()
 
-	err := repo.CreateComment(context.Background(), comment)
+	err := repo.UpdatePost(context.Background(), post)
 	assert.NoError(t, err)
-	assert.Equal(t, int64(1), comment.ID)
+	assert.False(t, post.Hold.HeldAt.IsZero())
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestGetCommentByID(t *testing.T) {
-	db, mock, repo := setupComment(t)
+func TestDeletePost(t *testing.T) {
+	db, mock, repo := setup(t)
 	defer db.Close()
 
-	now := time.Now()
-	mock.ExpectQuery(`SELECT id, post_id, author_id, username, content, created_at, updated_at`).
+	mock.ExpectExec(`UPDATE posts SET deleted_at = CURRENT_TIMESTAMP, deleted_by = \$2, delete_reason = \$3 WHERE id = \$1 AND deleted_at IS NULL`).
+		WithArgs(1, 2, "спам").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.DeletePost(context.Background(), 1, 2, "спам")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRestorePost_NotInTrash(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE posts SET deleted_at = NULL`).
 		WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "post_id", "author_id", "username", "content", "created_at", "updated_at",
-		}).AddRow(1, 1, 2, "user", "test", now, nil))
+		WillReturnResult(sqlmock.NewResult(0, 0))
 
-	comment, err := repo.GetCommentByID(context.Background(), 1)
+	err := repo.RestorePost(context.Background(), 1)
+	assert.ErrorIs(t, err, forumErrors.ErrPostNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeletedPosts(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{
+		"id", "title", "content", "author_id", "username", "created_at", "updated_at",
+		"deleted_at", "deleted_by", "delete_reason",
+	}).AddRow(1, "Title", "Content", 3, "user", now, nil, now, 2, "спам")
+	mock.ExpectQuery(`FROM posts WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC, id DESC LIMIT \$1 OFFSET \$2`).
+		WithArgs(50, 0).
+		WillReturnRows(rows)
+
+	posts, err := repo.DeletedPosts(context.Background(), 50, 0)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "Content", posts[0].Content)
+	assert.True(t, posts[0].Deleted)
+	assert.Equal(t, int64(2), posts[0].Deletion.DeletedBy)
+	assert.Equal(t, "спам", posts[0].Deletion.Reason)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurgePosts(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	before := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM posts WHERE deleted_at < \$1 FOR UPDATE`).
+		WithArgs(before).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectQuery(`SELECT id FROM comments WHERE post_id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]int64{1, 2})).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	for _, target := range []struct {
+		targetType string
+		ids        []int64
+	}{{"comment", []int64{10}}, {"post", []int64{1, 2}}} {
+		mock.ExpectExec(`DELETE FROM votes WHERE target_type = \$1 AND target_id = ANY\(\$2\)`).
+			WithArgs(target.targetType, pq.Array(target.ids)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM revisions WHERE target_type = \$1 AND target_id = ANY\(\$2\)`).
+			WithArgs(target.targetType, pq.Array(target.ids)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM mentions WHERE target_type = \$1 AND target_id = ANY\(\$2\)`).
+			WithArgs(target.targetType, pq.Array(target.ids)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DELETE FROM held_content WHERE target_type = \$1 AND target_id = ANY\(\$2\)`).
+			WithArgs(target.targetType, pq.Array(target.ids)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	mock.ExpectExec(`DELETE FROM posts WHERE id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]int64{1, 2})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	purged, err := repo.PurgePosts(context.Background(), before)
 	assert.NoError(t, err)
-	assert.Equal(t, int64(1), comment.ID)
-	assert.Equal(t, int64(1), comment.PostID)
-	assert.Equal(t, int64(2), comment.AuthorID)
-	assert.Equal(t, "user", comment.AuthorName)
-	assert.Equal(t, "test", comment.Content)
+	assert.Equal(t, int64(2), purged)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
-func TestGetByPostID(t *testing.T) {
-	db, mock, repo := setupComment(t)
+
+func TestPosts(t *testing.T) {
+	db, mock, repo := setup(t)
 	defer db.Close()
 
 	now := time.Now()
-	mock.ExpectQuery(`SELECT id, post_id, author_id, username, content, created_at, updated_at`).
-		WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "post_id", "author_id", "username", "content", "created_at", "updated_at",
-		}).AddRow(1, 1, 2, "user", "content1", now, nil).
-			AddRow(2, 1, 3, "user2", "content2", now, nil))
+	mock.ExpectQuery(`FROM posts p\s+WHERE deleted_at IS NULL AND status = 'published'\s+ORDER BY`).
+		WithArgs(repository.DefaultPostsLimit).
+		WillReturnRows(sqlmock.NewRows(postColumns).
+			AddRow(1, "Title", "Content", "<p>Content</p>", 2, "user", now, sql.NullTime{}, 0, nil, "{}", 0, false, "published", nil, false, false, nil, 0.0, 0))
 
-	comments, err := repo.GetByPostID(context.Background(), 1)
+	posts, err := repo.Posts(context.Background(), entities.PostFilter{})
 	assert.NoError(t, err)
-	assert.Len(t, comments, 2)
-	assert.Equal(t, int64(1), comments[0].PostID)
-	assert.Equal(t, "content1", comments[0].Content)
+	assert.Len(t, posts, 1)
+	assert.Equal(t, int64(1), posts[0].ID)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestGetByUserID(t *testing.T) {
-	db, mock, repo := setupComment(t)
+func TestPosts_RowsError(t *testing.T) {
+	db, mock, repo := setup(t)
 	defer db.Close()
 
 	now := time.Now()
-	mock.ExpectQuery(`SELECT id, post_id, author_id, username, content, created_at, updated_at`).
-		WithArgs(2).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "post_id", "author_id", "username", "content", "created_at", "updated_at",
-		}).AddRow(1, 1, 2, "user", "text", now, nil))
+	mock.ExpectQuery(`FROM posts p\s+WHERE deleted_at IS NULL AND status = 'published'\s+ORDER BY`).
+		WithArgs(repository.DefaultPostsLimit).
+		WillReturnRows(sqlmock.NewRows(postColumns).
+			AddRow(1, "Title", "Content", "<p>Content</p>", 2, "user", now, sql.NullTime{}, 0, nil, "{}", 0, false, "published", nil, false, false, nil, 0.0, 0).
+			AddRow(2, "Title", "Content", "<p>Content</p>", 2, "user", now, sql.NullTime{}, 0, nil, "{}", 0, false, "published", nil, false, false, nil, 0.0, 0).
+			RowError(1, sql.ErrConnDone))
 
-	comments, err := repo.GetByUserID(context.Background(), 2)
+	// оборванный список не выдаётся за полный
+	_, err := repo.Posts(context.Background(), entities.PostFilter{})
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPosts_FilterAndCursor(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	authorID := int64(2)
+	from := time.Now().Add(-24 * time.Hour)
+	to := time.Now()
+	after := &entities.PostCursor{CreatedAt: to.Add(-time.Hour), ID: 10}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM posts p
+		WHERE deleted_at IS NULL AND status = 'published' AND author_id = $1 AND created_at >= $2 AND created_at < $3 AND NOT is_pinned AND (created_at, id) > ($4, $5)
+		ORDER BY is_pinned DESC, created_at ASC, id ASC
+		LIMIT $6`)).
+		WithArgs(authorID, from, to, after.CreatedAt, after.ID, 5).
+		WillReturnRows(sqlmock.NewRows(postColumns))
+
+	posts, err := repo.Posts(context.Background(), entities.PostFilter{
+		AuthorID:    &authorID,
+		CreatedFrom: &from,
+		CreatedTo:   &to,
+		Ascending:   true,
+		Limit:       5,
+		After:       after,
+	})
 	assert.NoError(t, err)
-	assert.Len(t, comments, 1)
-	assert.Equal(t, int64(2), comments[0].AuthorID)
-	assert.Equal(t, "user", comments[0].AuthorName)
-	assert.Equal(t, "text", comments[0].Content)
+	assert.Empty(t, posts)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestUpdateComment(t *testing.T) {
-	db, mock, repo := setupComment(t)
+func TestPosts_CategoryAndTag(t *testing.T) {
+	db, mock, repo := setup(t)
 	defer db.Close()
 
-	comment := &entities.Comment{
-		ID:      1,
-		Content: "Updated content",
-	}
+	categoryID := int64(3)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM posts p
+		WHERE deleted_at IS NULL AND status = 'published' AND category_id = $1 AND EXISTS (SELECT 1 FROM post_tags t WHERE t.post_id = p.id AND t.tag = $2)
+		ORDER BY is_pinned DESC, created_at DESC, id DESC
+		LIMIT $3`)).
+		WithArgs(categoryID, "go", 10).
+		WillReturnRows(sqlmock.NewRows(postColumns))
 
-	mock.ExpectExec(`UPDATE comments SET content = \$1, updated_at = \$2 WHERE id = \$3`).
-		WithArgs(comment.Content, sqlmock.AnyArg(), comment.ID).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	posts, err := repo.Posts(context.Background(), entities.PostFilter{
+		CategoryID: &categoryID,
+		Tag:        "go",
+		Limit:      10,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, posts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
 
-	err := repo.UpdateComment(context.Background(), comment)
+var searchColumns = []string{
+	"post_id", "comment_id", "title", "snippet", "author_id", "username", "created_at", "rank", "total",
+}
+
+func TestPosts_ViewerSeesOwnDrafts(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE deleted_at IS NULL AND (status = 'published' OR author_id = $1)`)).
+		WithArgs(int64(4), repository.DefaultPostsLimit).
+		WillReturnRows(sqlmock.NewRows(postColumns))
+
+	_, err := repo.Posts(context.Background(), entities.PostFilter{ViewerID: 4})
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestDeleteComment(t *testing.T) {
-	db, mock, repo := setupComment(t)
+func TestPosts_AfterPinned(t *testing.T) {
+	db, mock, repo := setup(t)
 	defer db.Close()
 
-	mock.ExpectExec(`DELETE FROM comments WHERE id = \$1`).
-		WithArgs(1).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	after := &entities.PostCursor{CreatedAt: time.Now(), ID: 10, Pinned: true}
+	mock.ExpectQuery(regexp.QuoteMeta(`AND (NOT is_pinned OR (created_at, id) < ($1, $2))
+		ORDER BY is_pinned DESC, created_at DESC, id DESC`)).
+		WithArgs(after.CreatedAt, after.ID, repository.DefaultPostsLimit).
+		WillReturnRows(sqlmock.NewRows(postColumns))
 
-	err := repo.DeleteComment(context.Background(), 1)
+	_, err := repo.Posts(context.Background(), entities.PostFilter{After: after})
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func setupChat(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.ChatRepository) {
-	db, mock, err := sqlmock.New()
+func TestPosts_IgnorePinned(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	// без подъёма закреплённых флаг Pinned в курсоре не учитывается
+	after := &entities.PostCursor{CreatedAt: time.Now(), ID: 10, Pinned: true}
+	mock.ExpectQuery(regexp.QuoteMeta(`AND (created_at, id) < ($1, $2)
+		ORDER BY created_at DESC, id DESC`)).
+		WithArgs(after.CreatedAt, after.ID, repository.DefaultPostsLimit).
+		WillReturnRows(sqlmock.NewRows(postColumns))
+
+	_, err := repo.Posts(context.Background(), entities.PostFilter{After: after, IgnorePinned: true})
 	assert.NoError(t, err)
-	repo := repository.NewChatRepository(db, logger.NewStdLogger())
-	return db, mock, repo
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestSaveMessage(t *testing.T) {
-	db, mock, repo := setupChat(t)
+func TestPosts_HotAfterCursor(t *testing.T) {
+	db, mock, repo := setup(t)
 	defer db.Close()
 
-	msg := &entities.ChatMessage{
-		UserID:   1,
-		Content:  "Hello",
-		Username: "user",
-	}
+	after := &entities.PostCursor{ID: 10, Rank: 4.25}
+	mock.ExpectQuery(regexp.QuoteMeta(`AND NOT is_pinned AND (hot_rank, id) < ($1, $2)
+		ORDER BY is_pinned DESC, hot_rank DESC, id DESC`)).
+		WithArgs(4.25, after.ID, repository.DefaultPostsLimit).
+		WillReturnRows(sqlmock.NewRows(postColumns))
 
-	mock.ExpectQuery(`INSERT INTO chat_messages \(user_id, username, content, created_at\) VALUES \(\$1, \$2, \$3, NOW\(\)\) RETURNING id`).
-		WithArgs(msg.UserID, msg.Username, msg.Content).
-		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	_, err := repo.Posts(context.Background(), entities.PostFilter{Sort: entities.PostSortHot, After: after})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPosts_ViewsAfterCursor(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
 
-	err := repo.SaveMessage(context.Background(), msg.UserID, msg.Username, msg.Content)
+	after := &entities.PostCursor{ID: 9, Rank: 150}
+	mock.ExpectQuery(regexp.QuoteMeta(`AND NOT is_pinned AND (view_count, id) < ($1, $2)
+		ORDER BY is_pinned DESC, view_count DESC, id DESC`)).
+		WithArgs(int64(150), int64(9), repository.DefaultPostsLimit).
+		WillReturnRows(sqlmock.NewRows(postColumns))
 
+	_, err := repo.Posts(context.Background(), entities.PostFilter{Sort: entities.PostSortViews, After: after})
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestGetMessages(t *testing.T) {
-	db, mock, repo := setupChat(t)
+func TestPosts_Top(t *testing.T) {
+	db, mock, repo := setup(t)
 	defer db.Close()
 
-	now := time.Now()
-	mock.ExpectQuery(`SELECT cm\.id, cm\.user_id, cm\.username, cm\.content, cm\.created_at FROM chat_messages cm ORDER BY cm\.created_at`).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "user_id", "username", "content", "created_at",
-		}).AddRow(1, 1, "alice", "Hello", now).
-			AddRow(2, 2, "bob", "Hi", now))
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY is_pinned DESC, comment_count DESC, id DESC`)).
+		WithArgs(repository.DefaultPostsLimit).
+		WillReturnRows(sqlmock.NewRows(postColumns))
 
-	messages, err := repo.GetMessages(context.Background())
+	_, err := repo.Posts(context.Background(), entities.PostFilter{Sort: entities.PostSortTop})
 	assert.NoError(t, err)
-	assert.Len(t, messages, 2)
-	assert.Equal(t, "Hello", messages[0].Content)
-	assert.Equal(t, int64(2), messages[1].UserID)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestDeleteOldMessages(t *testing.T) {
-	db, mock, err := sqlmock.New()
+func TestRefreshHotRanks(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	since := time.Now().Add(-72 * time.Hour)
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE posts p SET hot_rank = post_hot_rank(p.score + p.comment_count, p.created_at)`)).
+		WithArgs(since).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+
+	updated, err := repo.RefreshHotRanks(context.Background(), since)
 	require.NoError(t, err)
+	assert.Equal(t, int64(12), updated)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecountComments(t *testing.T) {
+	db, mock, repo := setup(t)
 	defer db.Close()
 
-	log := logger.NewStdLogger()
-	repo := repository.NewChatRepository(db, log)
+	mock.ExpectExec(`UPDATE posts p SET comment_count = s.comment_count, last_comment_at = s.last_comment_at\s+FROM \(`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
 
-	ctx := context.Background()
-	cutoffTime := time.Now().Add(-24 * time.Hour)
+	repaired, err := repo.RecountComments(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), repaired)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
 
-	mock.ExpectExec("DELETE FROM chat_messages WHERE created_at <").
-		WithArgs(cutoffTime).
-		WillReturnResult(sqlmock.NewResult(0, 5)) // допустим, удалено 5 строк
+func TestSetPostLocked_NotFound(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
 
-	err = repo.DeleteOldMessages(ctx, cutoffTime)
+	mock.ExpectExec(`UPDATE posts SET is_locked = \$2`).
+		WithArgs(1, true).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.SetPostLocked(context.Background(), 1, true)
+	assert.ErrorIs(t, err, forumErrors.ErrPostNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDrafts(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	now := time.Now()
+	publishAt := now.Add(time.Hour)
+	mock.ExpectQuery(`WHERE author_id = \$1 AND status <> 'published' AND deleted_at IS NULL`).
+		WithArgs(2, 10, 0).
+		WillReturnRows(sqlmock.NewRows(postColumns).
+			AddRow(1, "Title", "Content", "<p>Content</p>", 2, "user", now, sql.NullTime{}, 0, nil, "{}", 0, false, "scheduled", publishAt, false, false, nil, 0.0, 0))
+
+	posts, err := repo.Drafts(context.Background(), 2, 10, 0)
 	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, entities.PostStatusScheduled, posts[0].Status)
+	require.NotNil(t, posts[0].PublishAt)
+	assert.Equal(t, publishAt, *posts[0].PublishAt)
+	assert.True(t, posts[0].Unpublished())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
 
-	require.NoError(t, mock.ExpectationsWereMet())
+func TestSetPostStatus_AlreadyPublished(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE posts SET status = \$2, publish_at = \$3`).
+		WithArgs(1, entities.PostStatusPublished, nil).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.SetPostStatus(context.Background(), 1, entities.PostStatusPublished, nil)
+	assert.ErrorIs(t, err, forumErrors.ErrPostAlreadyPublished)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPublishDue(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectExec(`UPDATE posts SET status = 'published', created_at = publish_at`).
+		WithArgs(now).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	published, err := repo.PublishDue(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), published)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchPosts(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	now := time.Now()
+	authorID := int64(2)
+	mock.ExpectQuery(`FROM posts p, websearch_to_tsquery\('russian', \$1\) query`).
+		WithArgs("кошки", sqlmock.AnyArg(), &authorID, 10, 0).
+		WillReturnRows(sqlmock.NewRows(searchColumns).
+			AddRow(1, 0, "Про кошек", "<mark>кошки</mark> спят", 2, "user", now, 0.6, 3))
+
+	hits, total, err := repo.SearchPosts(context.Background(), entities.SearchQuery{
+		Text:     "кошки",
+		AuthorID: &authorID,
+		Limit:    10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, hits, 1)
+	assert.Equal(t, repository.TargetTypePost, hits[0].TargetType)
+	assert.Equal(t, "<mark>кошки</mark> спят", hits[0].Snippet)
+	assert.Equal(t, float32(0.6), hits[0].Rank)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchPosts_EscapesSnippet(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM posts p`).
+		WillReturnRows(sqlmock.NewRows(searchColumns).
+			AddRow(1, 0, "XSS", `<img src=x onerror="alert(1)"> <mark>кошки</mark> & <script>`, 2, "user", time.Now(), 0.5, 1))
+
+	hits, _, err := repo.SearchPosts(context.Background(), entities.SearchQuery{Text: "кошки", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, `&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <mark>кошки</mark> &amp; &lt;script&gt;`, hits[0].Snippet)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupComment(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.CommentRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewCommentRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+func TestCreateComment(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	comment := &entities.Comment{
+		PostID:     1,
+		AuthorID:   2,
+		Content:    "Test comment",
+		AuthorName: "user",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO comments`).
+		WithArgs(comment.PostID, nil, int32(0), comment.AuthorID, comment.AuthorName, comment.Content, comment.ContentHTML, sqlmock.AnyArg(), nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE posts SET comment_count = comment_count + 1, last_comment_at = GREATEST(last_comment_at, $2)`)).
+		WithArgs(comment.PostID, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.CreateComment(context.Background(), comment)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), comment.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateComment_LockedPost(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO comments .*AND NOT is_locked`).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`SELECT is_locked FROM posts`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"is_locked"}).AddRow(true))
+	mock.ExpectRollback()
+
+	err := repo.CreateComment(context.Background(), &entities.Comment{PostID: 1, AuthorID: 2, Content: "text"})
+	assert.ErrorIs(t, err, forumErrors.ErrPostLocked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+var commentColumns = []string{
+	"id", "post_id", "parent_id", "depth", "author_id", "username", "content", "content_html", "deleted", "created_at", "updated_at", "score",
+}
+
+func TestGetCommentByID(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`SELECT id, post_id, parent_id, depth, author_id, username`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(commentColumns).
+			AddRow(1, 1, nil, 0, 2, "user", "test", "<p>test</p>", false, now, nil, 0))
+
+	comment, err := repo.GetCommentByID(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), comment.ID)
+	assert.Equal(t, int64(1), comment.PostID)
+	assert.Equal(t, int64(2), comment.AuthorID)
+	assert.Equal(t, "user", comment.AuthorName)
+	assert.Equal(t, "test", comment.Content)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+func TestGetByPostID(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`WITH RECURSIVE thread AS`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(append(commentColumns, "path")).
+			AddRow(1, 1, nil, 0, 2, "user", "", "", true, now, nil, 0, "{1}").
+			AddRow(2, 1, 1, 1, 3, "user2", "content2", "<p>content2</p>", false, now, nil, -2, "{1,2}"))
+
+	comments, err := repo.GetByPostID(context.Background(), 1)
+	assert.NoError(t, err)
+	require.Len(t, comments, 2)
+	assert.Equal(t, int64(1), comments[0].PostID)
+	assert.True(t, comments[0].Deleted)
+	assert.Nil(t, comments[0].ParentID)
+	require.NotNil(t, comments[1].ParentID)
+	assert.Equal(t, int64(1), *comments[1].ParentID)
+	assert.Equal(t, int32(1), comments[1].Depth)
+	assert.Equal(t, []int64{1, 2}, comments[1].Path)
+	assert.Equal(t, int64(-2), comments[1].Score)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetByUserID(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`WHERE author_id = \$1 AND deleted_at IS NULL`).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows(commentColumns).
+			AddRow(1, 1, nil, 0, 2, "user", "text", "<p>text</p>", false, now, nil, 0))
+
+	comments, err := repo.GetByUserID(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+	assert.Equal(t, int64(2), comments[0].AuthorID)
+	assert.Equal(t, "user", comments[0].AuthorName)
+	assert.Equal(t, "text", comments[0].Content)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// commentLock — колонки комментария, которые UpdateComment читает под блокировкой
+func commentLock() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"content", "author_id", "username", "post_id"})
+}
+
+func TestUpdateComment(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	comment := &entities.Comment{
+		ID:       1,
+		Content:  "Updated content",
+		EditorID: 3,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT content, author_id, username, post_id FROM comments WHERE id = \$1 AND deleted_at IS NULL FOR UPDATE`).
+		WithArgs(comment.ID).
+		WillReturnRows(commentLock().AddRow("Old content", 7, "alice", 1))
+	mock.ExpectExec(`DELETE FROM held_content WHERE target_type = \$1 AND target_id = \$2 AND is_edit`).
+		WithArgs("comment", comment.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO revisions .* FROM comments`).
+		WithArgs("comment", comment.ID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE comments SET content = \$1, content_html = \$2, updated_at = \$3 WHERE id = \$4`).
+		WithArgs(comment.Content, comment.ContentHTML, sqlmock.AnyArg(), comment.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO revisions .* VALUES`).
+		WithArgs("comment", comment.ID, "", comment.Content, int64(3)).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	err := repo.UpdateComment(context.Background(), comment)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateComment_NotFound(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT content, author_id, username, post_id FROM comments`).
+		WithArgs(int64(1)).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	err := repo.UpdateComment(context.Background(), &entities.Comment{ID: 1, Content: "text"})
+	assert.ErrorIs(t, err, forumErrors.ErrCommentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateComment_Held(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	parentID := int64(4)
+	comment := &entities.Comment{
+		PostID:      1,
+		ParentID:    &parentID,
+		Depth:       1,
+		AuthorID:    2,
+		AuthorName:  "user",
+		Content:     "Test comment",
+		ContentHTML: "<p>Test comment</p>",
+		Hold:        &entities.Hold{Reason: "слишком много ссылок"},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT is_locked FROM posts WHERE id = \$1 AND status = 'published' FOR SHARE`).
+		WithArgs(comment.PostID).
+		WillReturnRows(sqlmock.NewRows([]string{"is_locked"}).AddRow(false))
+	mock.ExpectQuery(`INSERT INTO held_content`).
+		WithArgs("comment", int64(0), comment.PostID, &parentID, int32(1), false, comment.AuthorID, comment.AuthorName, int64(0),
+			"", comment.Content, comment.ContentHTML, nil, nil, "", "слишком много ссылок").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(5, time.Now()))
+	mock.ExpectCommit()
+
+	err := repo.CreateComment(context.Background(), comment)
+	require.NoError(t, err)
+	// комментарий не сохранён и получит ID после одобрения
+	assert.Zero(t, comment.ID)
+	assert.False(t, comment.Hold.HeldAt.IsZero())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateComment_HeldLockedPost(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT is_locked FROM posts`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"is_locked"}).AddRow(true))
+	mock.ExpectRollback()
+
+	err := repo.CreateComment(context.Background(), &entities.Comment{PostID: 1, Content: "text", Hold: &entities.Hold{}})
+	assert.ErrorIs(t, err, forumErrors.ErrPostLocked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateComment_HeldEdit(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	comment := &entities.Comment{
+		ID:       1,
+		Content:  "Updated content",
+		EditorID: 3,
+		Hold:     &entities.Hold{Reason: "слишком много ссылок"},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT content, author_id, username, post_id FROM comments`).
+		WithArgs(comment.ID).
+		WillReturnRows(commentLock().AddRow("Old content", 7, "alice", 9))
+	mock.ExpectQuery(`INSERT INTO held_content`).
+		WithArgs("comment", comment.ID, int64(9), nil, int32(0), true, int64(7), "alice", int64(3),
+			"", comment.Content, "", nil, nil, "", "слишком много ссылок").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(5, time.Now()))
+	mock.ExpectCommit()
+
+	err := repo.UpdateComment(context.Background(), comment)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateComment_KeepHeldEdit(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT content, author_id, username, post_id FROM comments`).
+		WithArgs(int64(1)).
+		WillReturnRows(commentLock().AddRow("Old content", 7, "alice", 9))
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM held_content WHERE target_type = \$1 AND target_id = \$2 AND is_edit\)`).
+		WithArgs("comment", int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	// задержанная правка автора не отбрасывается молча
+	err := repo.UpdateComment(context.Background(), &entities.Comment{ID: 1, Content: "Rolled back", KeepHeldEdit: true})
+	assert.ErrorIs(t, err, forumErrors.ErrEditHeld)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteComment(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE comments SET deleted_at = CURRENT_TIMESTAMP, deleted_by = \$2, delete_reason = \$3 WHERE id = \$1 AND deleted_at IS NULL RETURNING post_id`).
+		WithArgs(1, 2, "").
+		WillReturnRows(sqlmock.NewRows([]string{"post_id"}).AddRow(7))
+	mock.ExpectExec(`UPDATE posts SET comment_count = comment_count \+ \$2,\s+last_comment_at = \(SELECT MAX\(created_at\) FROM comments WHERE post_id = \$1 AND deleted_at IS NULL\)`).
+		WithArgs(7, -1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteComment(context.Background(), 1, 2, "")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteComment_AlreadyDeleted(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE comments SET deleted_at`).
+		WithArgs(1, 2, "").
+		WillReturnRows(sqlmock.NewRows([]string{"post_id"}))
+	mock.ExpectRollback()
+
+	err := repo.DeleteComment(context.Background(), 1, 2, "")
+	assert.ErrorIs(t, err, forumErrors.ErrCommentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurgeComments_SkipsCommentsWithReplies(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	before := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`WHERE c.deleted_at < \$1 AND NOT EXISTS \(SELECT 1 FROM comments r WHERE r.parent_id = c.id\)`).
+		WithArgs(before).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	purged, err := repo.PurgeComments(context.Background(), before)
+	assert.NoError(t, err)
+	assert.Zero(t, purged)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchComments(t *testing.T) {
+	db, mock, repo := setupComment(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM comments c\s+JOIN posts p ON p.id = c.post_id`).
+		WithArgs("cats", sqlmock.AnyArg(), nil, 5, 0).
+		WillReturnRows(sqlmock.NewRows(searchColumns).
+			AddRow(1, 7, "Pets", "I love <mark>cats</mark>", 3, "bob", now, 0.1, 1))
+
+	hits, total, err := repo.SearchComments(context.Background(), entities.SearchQuery{Text: "cats", Limit: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, hits, 1)
+	assert.Equal(t, repository.TargetTypeComment, hits[0].TargetType)
+	assert.Equal(t, int64(7), hits[0].CommentID)
+	assert.Equal(t, int64(1), hits[0].PostID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupChat(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.ChatRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewChatRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+func setupCategory(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.CategoryRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewCategoryRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+var categoryColumns = []string{"id", "slug", "title", "description", "position", "created_at"}
+
+func TestCreateCategory(t *testing.T) {
+	db, mock, repo := setupCategory(t)
+	defer db.Close()
+
+	category := &entities.Category{Slug: "news", Title: "Новости", Position: 1}
+	mock.ExpectQuery(`INSERT INTO categories`).
+		WithArgs("news", "Новости", "", int32(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, time.Now()))
+
+	err := repo.CreateCategory(context.Background(), category)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), category.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateCategory_DuplicateSlug(t *testing.T) {
+	db, mock, repo := setupCategory(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`INSERT INTO categories`).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err := repo.CreateCategory(context.Background(), &entities.Category{Slug: "news", Title: "Новости"})
+	assert.ErrorIs(t, err, forumErrors.ErrDuplicateSlug)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCategoryByID_NotFound(t *testing.T) {
+	db, mock, repo := setupCategory(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM categories WHERE id = \$1`).
+		WithArgs(9).
+		WillReturnError(sql.ErrNoRows)
+
+	category, err := repo.GetCategoryByID(context.Background(), 9)
+	assert.Nil(t, category)
+	assert.ErrorIs(t, err, forumErrors.ErrCategoryNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteCategory_NotFound(t *testing.T) {
+	db, mock, repo := setupCategory(t)
+	defer db.Close()
+
+	mock.ExpectExec(`DELETE FROM categories`).
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.DeleteCategory(context.Background(), 9)
+	assert.ErrorIs(t, err, forumErrors.ErrCategoryNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCategories(t *testing.T) {
+	db, mock, repo := setupCategory(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM categories ORDER BY position, id`).
+		WillReturnRows(sqlmock.NewRows(categoryColumns).
+			AddRow(1, "news", "Новости", "", 0, now).
+			AddRow(2, "help", "Помощь", "Вопросы", 1, now))
+
+	categories, err := repo.Categories(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, categories, 2)
+	assert.Equal(t, "help", categories[1].Slug)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCategories_RowsError(t *testing.T) {
+	db, mock, repo := setupCategory(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM categories ORDER BY position, id`).
+		WillReturnRows(sqlmock.NewRows(categoryColumns).
+			AddRow(1, "news", "Новости", "", 0, time.Now()).
+			RowError(0, sql.ErrConnDone))
+
+	_, err := repo.Categories(context.Background())
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupVote(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.VoteRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewVoteRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+func TestVote_ChangesExistingVote(t *testing.T) {
+	db, mock, repo := setupVote(t)
+	defer db.Close()
+
+	vote := &entities.Vote{UserID: 1, TargetType: repository.TargetTypePost, TargetID: 5, Value: 1}
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM posts WHERE id = \$1 FOR UPDATE`).
+		WithArgs(vote.TargetID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectQuery(`SELECT value FROM votes`).
+		WithArgs(vote.UserID, vote.TargetType, vote.TargetID).
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow(-1))
+	mock.ExpectExec(`INSERT INTO votes`).
+		WithArgs(vote.UserID, vote.TargetType, vote.TargetID, vote.Value).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE posts SET score = score \+ \$1 WHERE id = \$2 RETURNING score`).
+		WithArgs(int32(2), vote.TargetID).
+		WillReturnRows(sqlmock.NewRows([]string{"score"}).AddRow(7))
+	mock.ExpectCommit()
+
+	score, err := repo.Vote(context.Background(), vote)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), score)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVote_FirstVoteLocksTarget(t *testing.T) {
+	db, mock, repo := setupVote(t)
+	defer db.Close()
+
+	vote := &entities.Vote{UserID: 1, TargetType: repository.TargetTypeComment, TargetID: 9, Value: -1}
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM comments WHERE id = \$1 FOR UPDATE`).
+		WithArgs(vote.TargetID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(9))
+	mock.ExpectQuery(`SELECT value FROM votes`).
+		WithArgs(vote.UserID, vote.TargetType, vote.TargetID).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(`INSERT INTO votes`).
+		WithArgs(vote.UserID, vote.TargetType, vote.TargetID, vote.Value).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE comments SET score`).
+		WithArgs(int32(-1), vote.TargetID).
+		WillReturnRows(sqlmock.NewRows([]string{"score"}).AddRow(-1))
+	mock.ExpectCommit()
+
+	score, err := repo.Vote(context.Background(), vote)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), score)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVote_TargetNotFound(t *testing.T) {
+	db, mock, repo := setupVote(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM posts`).
+		WithArgs(5).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := repo.Vote(context.Background(), &entities.Vote{UserID: 1, TargetType: repository.TargetTypePost, TargetID: 5, Value: 1})
+	assert.ErrorIs(t, err, forumErrors.ErrPostNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemoveVote_TargetNotFound(t *testing.T) {
+	db, mock, repo := setupVote(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM votes`).
+		WithArgs(1, repository.TargetTypeComment, 9).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`UPDATE comments SET score`).
+		WithArgs(int32(0), 9).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := repo.RemoveVote(context.Background(), 1, repository.TargetTypeComment, 9)
+	assert.ErrorIs(t, err, forumErrors.ErrCommentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserVotes(t *testing.T) {
+	db, mock, repo := setupVote(t)
+	defer db.Close()
+
+	ids := []int64{1, 2, 3}
+	mock.ExpectQuery(`FROM votes`).
+		WithArgs(4, repository.TargetTypePost, pq.Array(ids)).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "value"}).
+			AddRow(1, 1).
+			AddRow(3, -1))
+
+	votes, err := repo.UserVotes(context.Background(), 4, repository.TargetTypePost, ids)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int32{1: 1, 3: -1}, votes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePost_WithPoll(t *testing.T) {
+	db, mock, repo := setup(t)
+	defer db.Close()
+
+	post := &entities.Post{
+		Title:      "Test Title",
+		Content:    "Test Content",
+		AuthorID:   1,
+		AuthorName: "user",
+		Status:     entities.PostStatusPublished,
+		Poll: &entities.Poll{
+			Options: []*entities.PollOption{{Text: "да"}, {Text: "нет"}},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO posts`).
+		WithArgs(post.Title, post.Content, post.ContentHTML, post.AuthorID, post.AuthorName, nil, post.Status, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).
+			AddRow(4, time.Now()))
+	mock.ExpectExec(`INSERT INTO polls`).
+		WithArgs(4, false, false, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO poll_options`).
+		WithArgs(4, 0, "да").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectQuery(`INSERT INTO poll_options`).
+		WithArgs(4, 1, "нет").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectCommit()
+
+	err := repo.CreatePost(context.Background(), post)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), post.Poll.PostID)
+	assert.Equal(t, int64(11), post.Poll.Options[1].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupPoll(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.PollRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewPollRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+func TestCastPollVote(t *testing.T) {
+	db, mock, repo := setupPoll(t)
+	defer db.Close()
+
+	ids := []int64{10, 12}
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM polls pl JOIN posts p`).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"multiple_choice", "closes_at"}).AddRow(true, nil))
+	mock.ExpectExec(`INSERT INTO poll_votes`).
+		WithArgs(4, 2, "bob").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO poll_choices`).
+		WithArgs(4, 2, pq.Array(ids)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := repo.CastPollVote(context.Background(), 4, 2, "bob", ids)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCastPollVote_Closed(t *testing.T) {
+	db, mock, repo := setupPoll(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM polls pl JOIN posts p`).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"multiple_choice", "closes_at"}).
+			AddRow(false, time.Now().Add(-time.Hour)))
+	mock.ExpectRollback()
+
+	err := repo.CastPollVote(context.Background(), 4, 2, "bob", []int64{10})
+	assert.ErrorIs(t, err, forumErrors.ErrPollClosed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCastPollVote_AlreadyVoted(t *testing.T) {
+	db, mock, repo := setupPoll(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM polls pl JOIN posts p`).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"multiple_choice", "closes_at"}).AddRow(false, nil))
+	mock.ExpectExec(`INSERT INTO poll_votes`).
+		WithArgs(4, 2, "bob").
+		WillReturnError(&pq.Error{Code: "23505"})
+	mock.ExpectRollback()
+
+	err := repo.CastPollVote(context.Background(), 4, 2, "bob", []int64{10})
+	assert.ErrorIs(t, err, forumErrors.ErrPollAlreadyVoted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCastPollVote_SingleChoice(t *testing.T) {
+	db, mock, repo := setupPoll(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM polls pl JOIN posts p`).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"multiple_choice", "closes_at"}).AddRow(false, nil))
+	mock.ExpectRollback()
+
+	err := repo.CastPollVote(context.Background(), 4, 2, "bob", []int64{10, 11})
+	assert.ErrorIs(t, err, forumErrors.ErrInvalidPollChoice)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPolls(t *testing.T) {
+	db, mock, repo := setupPoll(t)
+	defer db.Close()
+
+	ids := []int64{4, 5}
+	mock.ExpectQuery(`FROM polls pl`).
+		WithArgs(pq.Array(ids)).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "multiple_choice", "public", "closes_at", "count"}).
+			AddRow(4, false, true, nil, 3))
+	mock.ExpectQuery(`FROM poll_options o`).
+		WithArgs(pq.Array(ids), 2).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "id", "text", "count", "chosen"}).
+			AddRow(4, 10, "да", 2, false).
+			AddRow(4, 11, "нет", 1, true))
+
+	polls, err := repo.Polls(context.Background(), ids, 2)
+	assert.NoError(t, err)
+	require.Len(t, polls, 1)
+	poll := polls[4]
+	assert.Equal(t, int64(3), poll.TotalVoters)
+	require.Len(t, poll.Options, 2)
+	assert.Equal(t, int64(2), poll.Options[0].Votes)
+	assert.Equal(t, []int64{11}, poll.MyChoices)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupReport(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.ReportRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewReportRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+var reportColumns = []string{"id", "target_type", "target_id", "target_author_id", "target_content",
+	"reporter_id", "reason", "details", "status", "resolved_by", "resolved_at", "resolution_note", "created_at"}
+
+func TestCreateReport_AlreadyReported(t *testing.T) {
+	db, mock, repo := setupReport(t)
+	defer db.Close()
+
+	report := &entities.Report{TargetType: "post", TargetID: 5, TargetAuthorID: 2, ReporterID: 3, Reason: "spam"}
+	mock.ExpectQuery(`INSERT INTO reports`).
+		WithArgs("post", 5, 2, "", 3, "spam", "").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err := repo.CreateReport(context.Background(), report)
+	assert.ErrorIs(t, err, forumErrors.ErrAlreadyReported)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReportGroups(t *testing.T) {
+	db, mock, repo := setupReport(t)
+	defer db.Close()
+
+	now := time.Now()
+	filter := entities.ReportFilter{Status: "open", Limit: 50}
+	mock.ExpectQuery(`GROUP BY target_type, target_id`).
+		WithArgs("open", "", 50, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"target_type", "target_id", "target_author_id", "count", "max"}).
+			AddRow("comment", 7, 2, 2, now).
+			AddRow("post", 5, 4, 1, now.Add(-time.Hour)))
+	mock.ExpectQuery(`FROM reports`).
+		WithArgs("open", pq.Array([]string{"comment", "post"}), pq.Array([]int64{7, 5})).
+		WillReturnRows(sqlmock.NewRows(reportColumns).
+			AddRow(3, "comment", 7, 2, "текст", 9, "abuse", "", "open", 0, nil, "", now).
+			AddRow(2, "comment", 7, 2, "текст", 8, "spam", "", "open", 0, nil, "", now.Add(-time.Minute)).
+			AddRow(1, "post", 5, 4, "пост", 8, "other", "реклама", "open", 0, nil, "", now.Add(-time.Hour)))
+
+	groups, err := repo.ReportGroups(context.Background(), filter)
+	assert.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, int32(2), groups[0].Count)
+	require.Len(t, groups[0].Reports, 2)
+	assert.Equal(t, int64(3), groups[0].Reports[0].ID)
+	require.Len(t, groups[1].Reports, 1)
+	assert.Equal(t, "реклама", groups[1].Reports[0].Details)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveReports_Warn(t *testing.T) {
+	db, mock, repo := setupReport(t)
+	defer db.Close()
+
+	report := &entities.Report{ID: 4, TargetType: "chat", TargetID: 40, TargetAuthorID: 2, Reason: "abuse"}
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE reports`).
+		WithArgs("chat", 40, "warned", 9, "без оскорблений").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO warnings`).
+		WithArgs(2, 4, "abuse", "без оскорблений", 9).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	resolved, err := repo.ResolveReports(context.Background(), report, "warned", 9, "без оскорблений", "")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), resolved)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveReports_AlreadyResolved(t *testing.T) {
+	db, mock, repo := setupReport(t)
+	defer db.Close()
+
+	report := &entities.Report{ID: 4, TargetType: "post", TargetID: 5}
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE reports`).
+		WithArgs("post", 5, "removed", 9, "").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err := repo.ResolveReports(context.Background(), report, "removed", 9, "", "жалоба: spam")
+	assert.ErrorIs(t, err, forumErrors.ErrReportResolved)
+	// контент не удаляется: ни одного запроса после UPDATE reports
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveReports_RemoveComment(t *testing.T) {
+	db, mock, repo := setupReport(t)
+	defer db.Close()
+
+	report := &entities.Report{ID: 6, TargetType: "comment", TargetID: 70}
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE reports`).
+		WithArgs("comment", 70, "removed", 9, "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE comments SET deleted_at`).
+		WithArgs(70, 9, "жалоба: abuse").
+		WillReturnRows(sqlmock.NewRows([]string{"post_id"}).AddRow(7))
+	mock.ExpectExec(`UPDATE posts SET comment_count`).
+		WithArgs(7, -1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	resolved, err := repo.ResolveReports(context.Background(), report, "removed", 9, "", "жалоба: abuse")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), resolved)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupBookmark(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.BookmarkRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewBookmarkRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+func TestAddBookmark(t *testing.T) {
+	db, mock, repo := setupBookmark(t)
+	defer db.Close()
+
+	now := time.Now()
+	bookmark := &entities.Bookmark{UserID: 1, PostID: 5, Note: "прочитать позже"}
+	mock.ExpectQuery(regexp.QuoteMeta(`ON CONFLICT (user_id, post_id) DO UPDATE SET note = EXCLUDED.note`)).
+		WithArgs(bookmark.UserID, bookmark.PostID, bookmark.Note).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+	err := repo.AddBookmark(context.Background(), bookmark)
+	assert.NoError(t, err)
+	assert.Equal(t, now, bookmark.CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddBookmark_PostNotFound(t *testing.T) {
+	db, mock, repo := setupBookmark(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`INSERT INTO bookmarks`).
+		WithArgs(1, 404, "").
+		WillReturnError(&pq.Error{Code: "23503"})
+
+	err := repo.AddBookmark(context.Background(), &entities.Bookmark{UserID: 1, PostID: 404})
+	assert.ErrorIs(t, err, forumErrors.ErrPostNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemoveBookmark_NotFound(t *testing.T) {
+	db, mock, repo := setupBookmark(t)
+	defer db.Close()
+
+	mock.ExpectExec(`DELETE FROM bookmarks WHERE user_id = \$1 AND post_id = \$2`).
+		WithArgs(1, 5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.RemoveBookmark(context.Background(), 1, 5)
+	assert.ErrorIs(t, err, forumErrors.ErrBookmarkNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBookmarks(t *testing.T) {
+	db, mock, repo := setupBookmark(t)
+	defer db.Close()
+
+	now := time.Now()
+	bookmarkedAt := now.Add(time.Hour)
+	mock.ExpectQuery(`WHERE p.deleted_at IS NULL AND p.status = 'published'\s+ORDER BY b.bookmarked_at DESC, p.id DESC`).
+		WithArgs(1, 20, 0).
+		WillReturnRows(sqlmock.NewRows(append(postColumns, "note", "bookmarked_at")).
+			AddRow(5, "Title", "Content", "<p>Content</p>", 2, "user", now, sql.NullTime{}, 0, nil, "{}", 0, false, "published", nil, false, false, nil, 0.0, 0, "заметка", bookmarkedAt))
+
+	bookmarks, err := repo.Bookmarks(context.Background(), 1, 20, 0)
+	require.NoError(t, err)
+	require.Len(t, bookmarks, 1)
+	assert.Equal(t, int64(5), bookmarks[0].PostID)
+	assert.Equal(t, "заметка", bookmarks[0].Note)
+	assert.Equal(t, bookmarkedAt, bookmarks[0].CreatedAt)
+	assert.Equal(t, "Title", bookmarks[0].Post.Title)
+	assert.True(t, bookmarks[0].Post.Bookmarked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBookmarkedPosts(t *testing.T) {
+	db, mock, repo := setupBookmark(t)
+	defer db.Close()
+
+	ids := []int64{1, 2, 3}
+	mock.ExpectQuery(`FROM bookmarks WHERE user_id = \$1 AND post_id = ANY\(\$2\)`).
+		WithArgs(4, pq.Array(ids)).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id"}).AddRow(2))
+
+	bookmarked, err := repo.BookmarkedPosts(context.Background(), 4, ids)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]bool{2: true}, bookmarked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupNotification(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.NotificationRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewNotificationRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+func TestNotifyComment(t *testing.T) {
+	db, mock, repo := setupNotification(t)
+	defer db.Close()
+
+	comment := &entities.Comment{ID: 10, PostID: 2, AuthorID: 3, AuthorName: "bob", CreatedAt: time.Now()}
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`ON CONFLICT (user_id, type, post_id) WHERE read_at IS NULL DO UPDATE SET event_count = notifications.event_count + 1`)).
+		WithArgs(comment.PostID, entities.NotificationComment, comment.ID, comment.AuthorID, comment.AuthorName, comment.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO subscriptions .+ ON CONFLICT \(user_id, post_id\) DO NOTHING`).
+		WithArgs(comment.AuthorID, comment.PostID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	notified, err := repo.NotifyComment(context.Background(), comment)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), notified)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetSubscription_PostNotFound(t *testing.T) {
+	db, mock, repo := setupNotification(t)
+	defer db.Close()
+
+	mock.ExpectExec(`INSERT INTO subscriptions`).
+		WithArgs(1, 404, false).
+		WillReturnError(&pq.Error{Code: "23503"})
+
+	err := repo.SetSubscription(context.Background(), 1, 404, false)
+	assert.ErrorIs(t, err, forumErrors.ErrPostNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotifications(t *testing.T) {
+	db, mock, repo := setupNotification(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM notifications n\s+JOIN posts p ON p.id = n.post_id`).
+		WithArgs(3, true, 20, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "type", "post_id", "title", "comment_id", "actor_id", "actor_name",
+			"event_count", "created_at", "updated_at", "read_at"}).
+			AddRow(1, 3, "comment", 2, "Title", 10, 4, "bob", 5, now, now, nil))
+
+	notifications, err := repo.Notifications(context.Background(), 3, true, 20, 0)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, int32(5), notifications[0].Count)
+	assert.Equal(t, "Title", notifications[0].PostTitle)
+	assert.Nil(t, notifications[0].ReadAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkRead(t *testing.T) {
+	db, mock, repo := setupNotification(t)
+	defer db.Close()
+
+	ids := []int64{1, 2}
+	mock.ExpectExec(`UPDATE notifications SET read_at = CURRENT_TIMESTAMP\s+WHERE user_id = \$1 AND id = ANY\(\$2\) AND read_at IS NULL`).
+		WithArgs(3, pq.Array(ids)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	marked, err := repo.MarkRead(context.Background(), 3, ids)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), marked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupMention(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.MentionRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewMentionRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+func TestSaveMentions(t *testing.T) {
+	db, mock, repo := setupMention(t)
+	defer db.Close()
+
+	mentions := []*entities.Mention{
+		{UserID: 4, Username: "alice", AuthorID: 3},
+		{UserID: 5, Username: "bob", AuthorID: 3},
+	}
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM mentions WHERE target_type = \$1 AND target_id = \$2 AND user_id <> ALL\(\$3\)`).
+		WithArgs("comment", 10, pq.Array([]int64{4, 5})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`FROM unnest($3::int[], $4::text[], $5::int[])`)).
+		WithArgs("comment", 10, pq.Array([]int64{4, 5}), pq.Array([]string{"alice", "bob"}), pq.Array([]int64{3, 3})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := repo.SaveMentions(context.Background(), "comment", 10, mentions)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveMentions_RemovesAll(t *testing.T) {
+	db, mock, repo := setupMention(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM mentions`).
+		WithArgs("post", 1, pq.Array([]int64{})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := repo.SaveMentions(context.Background(), "post", 1, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserMentions(t *testing.T) {
+	db, mock, repo := setupMention(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM mentions m\s+WHERE m.user_id = \$1`).
+		WithArgs(4, 20, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"target_type", "target_id", "user_id", "username", "author_id", "created_at"}).
+			AddRow("chat", 7, 4, "alice", 3, now).
+			AddRow("post", 1, 4, "alice", 5, now.Add(-time.Hour)))
+
+	mentions, err := repo.UserMentions(context.Background(), 4, 20, 0)
+	require.NoError(t, err)
+	require.Len(t, mentions, 2)
+	assert.Equal(t, "chat", mentions[0].TargetType)
+	assert.Equal(t, int64(5), mentions[1].AuthorID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddViews(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := repository.NewViewRepository(db, logger.NewStdLogger())
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE posts p SET view_count = p.view_count + v.n
+		FROM unnest($1::int[], $2::bigint[]) AS v(id, n)`)).
+		WithArgs(pq.Array([]int64{3}), pq.Array([]int64{7})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.AddViews(context.Background(), map[int64]int64{3: 7}))
+	// пустой буфер не доходит до базы
+	require.NoError(t, repo.AddViews(context.Background(), nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContentHTML(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := repository.NewContentRepository(db, logger.NewStdLogger())
+
+	mock.ExpectQuery(`SELECT id, content FROM comments WHERE content_html = '' AND content <> '' AND id > \$1`).
+		WithArgs(0, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "content"}).AddRow(3, "**текст**"))
+	contents, err := repo.UnrenderedContent(context.Background(), repository.TargetTypeComment, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]string{3: "**текст**"}, contents)
+
+	mock.ExpectExec(`UPDATE comments SET content_html = \$1 WHERE id = \$2`).
+		WithArgs("<p><strong>текст</strong></p>", 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	err = repo.SetContentHTML(context.Background(), repository.TargetTypeComment, 3, "<p><strong>текст</strong></p>")
+	require.NoError(t, err)
+
+	_, err = repo.UnrenderedContent(context.Background(), "chat", 0, 100)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveMessage(t *testing.T) {
+	db, mock, repo := setupChat(t)
+	defer db.Close()
+
+	msg := &entities.ChatMessage{
+		UserID:   1,
+		Content:  "Hello",
+		Username: "user",
+	}
+
+	mock.ExpectQuery(`INSERT INTO chat_messages \(user_id, username, content, created_at\) VALUES \(\$1, \$2, \$3, NOW\(\)\) RETURNING id`).
+		WithArgs(msg.UserID, msg.Username, msg.Content).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	id, err := repo.SaveMessage(context.Background(), msg.UserID, msg.Username, msg.Content)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetMessages(t *testing.T) {
+	db, mock, repo := setupChat(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`SELECT cm\.id, cm\.user_id, cm\.username, cm\.content, cm\.created_at FROM chat_messages cm ORDER BY cm\.created_at`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user_id", "username", "content", "created_at",
+		}).AddRow(1, 1, "alice", "Hello", now).
+			AddRow(2, 2, "bob", "Hi", now))
+
+	messages, err := repo.GetMessages(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, "Hello", messages[0].Content)
+	assert.Equal(t, int64(2), messages[1].UserID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteMessage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := repository.NewChatRepository(db, logger.NewStdLogger())
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM chat_messages WHERE id = \$1`).
+		WithArgs(40).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM mentions`).
+		WithArgs(repository.TargetTypeChat, 40).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.DeleteMessage(context.Background(), 40))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteOldMessages(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	log := logger.NewStdLogger()
+	repo := repository.NewChatRepository(db, log)
+
+	ctx := context.Background()
+	cutoffTime := time.Now().Add(-24 * time.Hour)
+
+	mock.ExpectExec("DELETE FROM chat_messages WHERE created_at <").
+		WithArgs(cutoffTime).
+		WillReturnResult(sqlmock.NewResult(0, 5)) // допустим, удалено 5 строк
+	mock.ExpectExec(`DELETE FROM mentions m\s+WHERE m\.target_type = \$1 AND NOT EXISTS`).
+		WithArgs("chat").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.DeleteOldMessages(ctx, cutoffTime)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupRevision(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.RevisionRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewRevisionRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+func TestRevisions(t *testing.T) {
+	db, mock, repo := setupRevision(t)
+	defer db.Close()
+
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "target_type", "target_id", "title", "content", "editor_id", "created_at"}).
+		AddRow(1, "post", 5, "Title", "v1", 7, now).
+		AddRow(2, "post", 5, "Title", "v2", 9, now)
+	mock.ExpectQuery(`FROM revisions WHERE target_type = \$1 AND target_id = \$2 ORDER BY id`).
+		WithArgs("post", int64(5)).
+		WillReturnRows(rows)
+
+	revisions, err := repo.Revisions(context.Background(), repository.TargetTypePost, 5)
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, "v2", revisions[1].Content)
+	assert.Equal(t, int64(9), revisions[1].EditorID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRevision_NotFound(t *testing.T) {
+	db, mock, repo := setupRevision(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM revisions WHERE id = \$1`).
+		WithArgs(int64(3)).
+		WillReturnError(sql.ErrNoRows)
+
+	rev, err := repo.GetRevision(context.Background(), 3)
+	assert.Nil(t, rev)
+	assert.ErrorIs(t, err, forumErrors.ErrRevisionNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupAttachment(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.AttachmentRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	repo := repository.NewAttachmentRepository(db, logger.NewStdLogger())
+	return db, mock, repo
+}
+
+var attachmentColumns = []string{
+	"id", "target_type", "target_id", "uploader_id", "file_name", "content_type",
+	"size", "blob_key", "thumbnail_key", "width", "height", "created_at",
+}
+
+func TestCreateAttachment(t *testing.T) {
+	db, mock, repo := setupAttachment(t)
+	defer db.Close()
+
+	att := &entities.Attachment{
+		TargetType: repository.TargetTypePost, TargetID: 5, UploaderID: 7,
+		FileName: "cat.png", ContentType: "image/png", Size: 1024,
+		BlobKey: "key", ThumbnailKey: "key.thumb", Width: 640, Height: 480,
+	}
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(size\), 0\) FROM attachments`).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(1000))
+	mock.ExpectQuery(`INSERT INTO attachments`).
+		WithArgs("post", int64(5), int64(7), "cat.png", "image/png", int64(1024), "key", "key.thumb", int32(640), int32(480)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(3, now))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.CreateAttachment(context.Background(), att, 4096))
+	assert.Equal(t, int64(3), att.ID)
+	assert.Equal(t, now, att.CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateAttachment_QuotaExceeded(t *testing.T) {
+	db, mock, repo := setupAttachment(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(size\), 0\) FROM attachments`).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(4000))
+	mock.ExpectRollback()
+
+	err := repo.CreateAttachment(context.Background(), &entities.Attachment{UploaderID: 7, Size: 1024}, 4096)
+	assert.ErrorIs(t, err, forumErrors.ErrQuotaExceeded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAttachment_DeletedTarget(t *testing.T) {
+	db, mock, repo := setupAttachment(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM attachments a WHERE id = \$1 AND CASE a.target_type .*deleted_at IS NULL`).
+		WithArgs(int64(3), int64(0)).
+		WillReturnError(sql.ErrNoRows)
+
+	att, err := repo.GetAttachment(context.Background(), 3, 0)
+	assert.Nil(t, att)
+	assert.ErrorIs(t, err, forumErrors.ErrAttachmentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAttachment_UnpublishedPost(t *testing.T) {
+	db, mock, repo := setupAttachment(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`p.status = 'published' OR a.uploader_id = \$2`).
+		WithArgs(int64(3), int64(7)).
+		WillReturnRows(sqlmock.NewRows(attachmentColumns).
+			AddRow(3, "post", 5, 7, "plan.pdf", "application/pdf", 10, "key", "", 0, 0, time.Now()))
+
+	att, err := repo.GetAttachment(context.Background(), 3, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), att.UploaderID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAttachments(t *testing.T) {
+	db, mock, repo := setupAttachment(t)
+	defer db.Close()
+
+	ids := []int64{5, 6}
+	now := time.Now()
+	mock.ExpectQuery(`FROM attachments WHERE target_type = \$1 AND target_id = ANY\(\$2\) ORDER BY id`).
+		WithArgs("comment", pq.Array(ids)).
+		WillReturnRows(sqlmock.NewRows(attachmentColumns).
+			AddRow(1, "comment", 5, 7, "a.txt", "text/plain", 10, "k1", "", 0, 0, now).
+			AddRow(2, "comment", 6, 7, "b.png", "image/png", 20, "k2", "k2.thumb", 8, 8, now).
+			AddRow(3, "comment", 5, 7, "c.pdf", "application/pdf", 30, "k3", "", 0, 0, now))
+
+	byTarget, err := repo.Attachments(context.Background(), repository.TargetTypeComment, ids)
+	require.NoError(t, err)
+	require.Len(t, byTarget[5], 2)
+	assert.Equal(t, "c.pdf", byTarget[5][1].FileName)
+	require.Len(t, byTarget[6], 1)
+	assert.Equal(t, "k2.thumb", byTarget[6][0].ThumbnailKey)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAttachment_NotFound(t *testing.T) {
+	db, mock, repo := setupAttachment(t)
+	defer db.Close()
+
+	mock.ExpectExec(`DELETE FROM attachments WHERE id = \$1`).
+		WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.DeleteAttachment(context.Background(), 3)
+	assert.ErrorIs(t, err, forumErrors.ErrAttachmentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOrphanedAttachments(t *testing.T) {
+	db, mock, repo := setupAttachment(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM attachments a WHERE CASE a.target_type .* NOT EXISTS .* LIMIT \$1`).
+		WithArgs(100).
+		WillReturnRows(sqlmock.NewRows(attachmentColumns).
+			AddRow(1, "post", 5, 7, "a.txt", "text/plain", 10, "k1", "", 0, 0, time.Now()))
+
+	orphans, err := repo.OrphanedAttachments(context.Background(), 100)
+	require.NoError(t, err)
+	require.Len(t, orphans, 1)
+	assert.Equal(t, "k1", orphans[0].BlobKey)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupAuthors(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.AuthorRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	return db, mock, repository.NewAuthorRepository(db, logger.NewStdLogger())
+}
+
+func TestAuthorIDs(t *testing.T) {
+	db, mock, repo := setupAuthors(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UNION SELECT user_id FROM chat_messages`)).
+		WithArgs(int64(5), 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7).AddRow(9))
+
+	ids, err := repo.AuthorIDs(context.Background(), 5, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{7, 9}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRenameAuthor(t *testing.T) {
+	db, mock, repo := setupAuthors(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE posts SET username = $2 WHERE author_id = $1 AND username IS DISTINCT FROM $2`)).
+		WithArgs(int64(7), "alice2").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE comments SET username = $2`)).
+		WithArgs(int64(7), "alice2").WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE chat_messages SET username = $2 WHERE user_id = $1`)).
+		WithArgs(int64(7), "alice2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE poll_votes SET username = $2 WHERE user_id = $1`)).
+		WithArgs(int64(7), "alice2").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE held_content SET username = $2 WHERE author_id = $1`)).
+		WithArgs(int64(7), "alice2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	fixed, err := repo.RenameAuthor(context.Background(), 7, "alice2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), fixed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventCursor(t *testing.T) {
+	db, mock, repo := setupAuthors(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	// журнал ещё не читали
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT position FROM event_cursors WHERE name = $1`)).
+		WithArgs("user_events").WillReturnError(sql.ErrNoRows)
+	position, err := repo.EventCursor(ctx, "user_events")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), position)
+
+	mock.ExpectExec(regexp.QuoteMeta(`ON CONFLICT (name) DO UPDATE SET position = EXCLUDED.position`)).
+		WithArgs("user_events", int64(12)).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.SetEventCursor(ctx, "user_events", 12))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupArchive(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.ArchiveRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	return db, mock, repository.NewArchiveRepository(db, logger.NewStdLogger())
+}
+
+func TestArchivePosts(t *testing.T) {
+	db, mock, repo := setupArchive(t)
+	defer db.Close()
+
+	now := time.Now()
+	deletedAt := now.Add(time.Hour)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM posts p WHERE id > $1 ORDER BY id LIMIT $2`)).
+		WithArgs(10, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "content", "content_html", "author_id", "username", "category_id",
+			"tags", "status", "publish_at", "is_pinned", "is_locked", "view_count",
+			"created_at", "updated_at", "deleted_at", "deleted_by", "delete_reason"}).
+			AddRow(11, "Draft", "text", "", 2, "user", nil, "{go}", "draft", nil, false, false, 0, now, nil, nil, nil, "").
+			AddRow(12, "Spam", "spam", "<p>spam</p>", 3, "spammer", 4, "{}", "published", nil, false, false, 7, now, nil, deletedAt, 1, "спам"))
+
+	posts, err := repo.ArchivePosts(context.Background(), 10, 2)
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+	assert.Equal(t, entities.PostStatusDraft, posts[0].Status)
+	assert.Equal(t, []string{"go"}, posts[0].Tags)
+	assert.Nil(t, posts[0].Deletion)
+
+	// текст удалённого поста выгружается целиком
+	assert.Equal(t, "spam", posts[1].Content)
+	require.NotNil(t, posts[1].Deletion)
+	assert.Equal(t, int64(1), posts[1].Deletion.DeletedBy)
+	assert.Equal(t, "спам", posts[1].Deletion.Reason)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportPost(t *testing.T) {
+	db, mock, repo := setupArchive(t)
+	defer db.Close()
+
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	post := &entities.Post{
+		Title: "Title", Content: "Content", AuthorID: 2, AuthorName: "user",
+		Status: entities.PostStatusPublished, CreatedAt: created, Tags: []string{"go"},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO posts`).
+		WithArgs("Title", "Content", "", 2, "user", nil, "published", nil, false, false, 0, created, nil, nil, nil, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+	mock.ExpectExec(`INSERT INTO post_tags`).
+		WithArgs(100, pq.Array([]string{"go"})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO archive_imports`).
+		WithArgs("src", "post", 7, 100).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.ImportPost(context.Background(), "src", 7, post))
+	assert.Equal(t, int64(100), post.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportComment_PostGone(t *testing.T) {
+	db, mock, repo := setupArchive(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO comments`).
+		WillReturnError(&pq.Error{Code: "23503"})
+	mock.ExpectRollback()
+
+	err := repo.ImportComment(context.Background(), "src", 7, &entities.Comment{PostID: 100, AuthorID: 2})
+	assert.ErrorIs(t, err, forumErrors.ErrPostNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportedIDs(t *testing.T) {
+	db, mock, repo := setupArchive(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM archive_imports WHERE source = $1 AND kind = $2`)).
+		WithArgs("src", "comment").
+		WillReturnRows(sqlmock.NewRows([]string{"source_id", "target_id"}).AddRow(1, 101).AddRow(2, 102))
+
+	ids, err := repo.ImportedIDs(context.Background(), "src", "comment")
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int64{1: 101, 2: 102}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func setupHeld(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.HeldRepository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	return db, mock, repository.NewHeldRepository(db, logger.NewStdLogger())
+}
+
+var heldColumns = []string{
+	"id", "target_type", "target_id", "post_id", "parent_id", "depth", "is_edit",
+	"author_id", "username", "editor_id", "title", "content", "content_html",
+	"category_id", "tags", "post_status", "reason", "created_at",
+}
+
+func TestHeldContent(t *testing.T) {
+	db, mock, repo := setupHeld(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM held_content h\s+JOIN posts p ON p.id = h.post_id\s+WHERE p.deleted_at IS NULL`).
+		WithArgs(50, 0).
+		WillReturnRows(sqlmock.NewRows(heldColumns).
+			AddRow(5, "post", 1, 1, nil, 0, true, 7, "alice", 7, "Title", "text", "<p>text</p>", nil, "{go}", "", "ссылки", time.Now()))
+
+	items, err := repo.HeldContent(context.Background(), 50, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.True(t, items[0].IsEdit)
+	assert.Equal(t, []string{"go"}, items[0].Tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApproveHeld_NewComment(t *testing.T) {
+	db, mock, repo := setupHeld(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM held_content h USING posts p WHERE h.id = \$1 AND p.id = h.post_id RETURNING`).
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(heldColumns).
+			AddRow(5, "comment", 0, 1, 4, 1, false, 2, "user", 0, "Title", "text", "<p>text</p>", nil, nil, "", "ссылки", time.Now()))
+	mock.ExpectQuery(`INSERT INTO comments`).
+		WithArgs(int64(1), int64(4), int32(1), int64(2), "user", "text", "<p>text</p>", sqlmock.AnyArg(), nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+	mock.ExpectExec(`UPDATE posts SET comment_count = comment_count \+ 1`).
+		WithArgs(int64(1), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	held, err := repo.ApproveHeld(context.Background(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), held.TargetID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApproveHeld_NewPost(t *testing.T) {
+	db, mock, repo := setupHeld(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM held_content h`).
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(heldColumns).
+			AddRow(5, "post", 1, 1, nil, 0, false, 2, "user", 0, "Title", "text", "<p>text</p>", nil, nil, "published", "ссылки", time.Now()))
+	mock.ExpectExec(`UPDATE posts SET status = \$2, .* WHERE id = \$1 AND status = 'held' AND deleted_at IS NULL`).
+		WithArgs(int64(1), entities.PostStatusPublished).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	held, err := repo.ApproveHeld(context.Background(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, "Title", held.Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApproveHeld_NotFound(t *testing.T) {
+	db, mock, repo := setupHeld(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM held_content h`).
+		WithArgs(int64(5)).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := repo.ApproveHeld(context.Background(), 5)
+	assert.ErrorIs(t, err, forumErrors.ErrHeldNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRejectHeld_NewPost(t *testing.T) {
+	db, mock, repo := setupHeld(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM held_content h`).
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(heldColumns).
+			AddRow(5, "post", 1, 1, nil, 0, false, 2, "user", 0, "Title", "text", "<p>text</p>", nil, nil, "published", "ссылки", time.Now()))
+	mock.ExpectExec(`UPDATE posts SET status = 'draft', deleted_at = CURRENT_TIMESTAMP, deleted_by = \$2, delete_reason = \$3`).
+		WithArgs(int64(1), int64(9), "реклама").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	_, err := repo.RejectHeld(context.Background(), 5, 9, "реклама")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
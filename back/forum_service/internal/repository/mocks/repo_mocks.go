@@ -11,7 +11,7 @@ package mocks
 
 import (
 	context "context"
-	entities "github.
+	entities "github.com/netabakovv/forum/back/forum_service/internal/entities"
 	reflect "reflect"
 	time "time"
 
@@ -42,6 +42,20 @@ func (m *MockChatRepository) EXPECT() *MockChatRepositoryMockRecorder {
 	return m.recorder
 }
 
+// DeleteMessage mocks base method.
+func (m *MockChatRepository) DeleteMessage(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockChatRepositoryMockRecorder) DeleteMessage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockChatRepository)(nil).DeleteMessage), ctx, id)
+}
+
 // DeleteOldMessages mocks base method.
 func (m *MockChatRepository) DeleteOldMessages(ctx context.Context, before time.Time) error {
 	m.ctrl.T.Helper()
@@ -56,6 +70,21 @@ func (mr *MockChatRepositoryMockRecorder) DeleteOldMessages(ctx, before any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldMessages", reflect.TypeOf((*MockChatRepository)(nil).DeleteOldMessages), ctx, before)
 }
 
+// GetMessage mocks base method.
+func (m *MockChatRepository) GetMessage(ctx context.Context, id int64) (*entities.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessage", ctx, id)
+	ret0, _ := ret[0].(*entities.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessage indicates an expected call of GetMessage.
+func (mr *MockChatRepositoryMockRecorder) GetMessage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockChatRepository)(nil).GetMessage), ctx, id)
+}
+
 // GetMessages mocks base method.
 func (m *MockChatRepository) GetMessages(ctx context.Context) ([]*entities.ChatMessage, error) {
 	m.ctrl.T.Helper()
@@ -72,11 +101,12 @@ func (mr *MockChatRepositoryMockRecorder) GetMessages(ctx any) *gomock.Call {
 }
 
 // SaveMessage mocks base method.
-func (m *MockChatRepository) SaveMessage(ctx context.Context, userID int64, username, content string) error {
+func (m *MockChatRepository) SaveMessage(ctx context.Context, userID int64, username, content string) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveMessage", ctx, userID, username, content)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SaveMessage indicates an expected call of SaveMessage.
@@ -124,17 +154,47 @@ func (mr *MockPostRepositoryMockRecorder) CreatePost(ctx, post any) *gomock.Call
 }
 
 // DeletePost mocks base method.
-func (m *MockPostRepository) DeletePost(ctx context.Context, id int64) error {
+func (m *MockPostRepository) DeletePost(ctx context.Context, id, deletedBy int64, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePost", ctx, id)
+	ret := m.ctrl.Call(m, "DeletePost", ctx, id, deletedBy, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePost indicates an expected call of DeletePost.
-func (mr *MockPostRepositoryMockRecorder) DeletePost(ctx, id any) *gomock.Call {
+func (mr *MockPostRepositoryMockRecorder) DeletePost(ctx, id, deletedBy, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockPostRepository)(nil).DeletePost), ctx, id, deletedBy, reason)
+}
+
+// DeletedPosts mocks base method.
+func (m *MockPostRepository) DeletedPosts(ctx context.Context, limit, offset int) ([]*entities.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletedPosts", ctx, limit, offset)
+	ret0, _ := ret[0].([]*entities.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletedPosts indicates an expected call of DeletedPosts.
+func (mr *MockPostRepositoryMockRecorder) DeletedPosts(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletedPosts", reflect.TypeOf((*MockPostRepository)(nil).DeletedPosts), ctx, limit, offset)
+}
+
+// Drafts mocks base method.
+func (m *MockPostRepository) Drafts(ctx context.Context, authorID int64, limit, offset int) ([]*entities.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drafts", ctx, authorID, limit, offset)
+	ret0, _ := ret[0].([]*entities.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drafts indicates an expected call of Drafts.
+func (mr *MockPostRepositoryMockRecorder) Drafts(ctx, authorID, limit, offset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockPostRepository)(nil).DeletePost), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drafts", reflect.TypeOf((*MockPostRepository)(nil).Drafts), ctx, authorID, limit, offset)
 }
 
 // GetPostByID mocks base method.
@@ -153,18 +213,150 @@ func (mr *MockPostRepositoryMockRecorder) GetPostByID(ctx, id any) *gomock.Call
 }
 
 // Posts mocks base method.
-func (m *MockPostRepository) Posts(ctx context.Context) ([]*entities.Post, error) {
+func (m *MockPostRepository) Posts(ctx context.Context, filter entities.PostFilter) ([]*entities.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Posts", ctx)
+	ret := m.ctrl.Call(m, "Posts", ctx, filter)
 	ret0, _ := ret[0].([]*entities.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Posts indicates an expected call of Posts.
-func (mr *MockPostRepositoryMockRecorder) Posts(ctx any) *gomock.Call {
+func (mr *MockPostRepositoryMockRecorder) Posts(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Posts", reflect.TypeOf((*MockPostRepository)(nil).Posts), ctx, filter)
+}
+
+// PublishDue mocks base method.
+func (m *MockPostRepository) PublishDue(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDue", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDue indicates an expected call of PublishDue.
+func (mr *MockPostRepositoryMockRecorder) PublishDue(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDue", reflect.TypeOf((*MockPostRepository)(nil).PublishDue), ctx, now)
+}
+
+// PurgePosts mocks base method.
+func (m *MockPostRepository) PurgePosts(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgePosts", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgePosts indicates an expected call of PurgePosts.
+func (mr *MockPostRepositoryMockRecorder) PurgePosts(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePosts", reflect.TypeOf((*MockPostRepository)(nil).PurgePosts), ctx, before)
+}
+
+// RecountComments mocks base method.
+func (m *MockPostRepository) RecountComments(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecountComments", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecountComments indicates an expected call of RecountComments.
+func (mr *MockPostRepositoryMockRecorder) RecountComments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecountComments", reflect.TypeOf((*MockPostRepository)(nil).RecountComments), ctx)
+}
+
+// RefreshHotRanks mocks base method.
+func (m *MockPostRepository) RefreshHotRanks(ctx context.Context, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshHotRanks", ctx, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshHotRanks indicates an expected call of RefreshHotRanks.
+func (mr *MockPostRepositoryMockRecorder) RefreshHotRanks(ctx, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshHotRanks", reflect.TypeOf((*MockPostRepository)(nil).RefreshHotRanks), ctx, since)
+}
+
+// RestorePost mocks base method.
+func (m *MockPostRepository) RestorePost(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePost", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePost indicates an expected call of RestorePost.
+func (mr *MockPostRepositoryMockRecorder) RestorePost(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePost", reflect.TypeOf((*MockPostRepository)(nil).RestorePost), ctx, id)
+}
+
+// SearchPosts mocks base method.
+func (m *MockPostRepository) SearchPosts(ctx context.Context, q entities.SearchQuery) ([]*entities.SearchHit, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPosts", ctx, q)
+	ret0, _ := ret[0].([]*entities.SearchHit)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchPosts indicates an expected call of SearchPosts.
+func (mr *MockPostRepositoryMockRecorder) SearchPosts(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPosts", reflect.TypeOf((*MockPostRepository)(nil).SearchPosts), ctx, q)
+}
+
+// SetPostLocked mocks base method.
+func (m *MockPostRepository) SetPostLocked(ctx context.Context, id int64, locked bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPostLocked", ctx, id, locked)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPostLocked indicates an expected call of SetPostLocked.
+func (mr *MockPostRepositoryMockRecorder) SetPostLocked(ctx, id, locked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPostLocked", reflect.TypeOf((*MockPostRepository)(nil).SetPostLocked), ctx, id, locked)
+}
+
+// SetPostPinned mocks base method.
+func (m *MockPostRepository) SetPostPinned(ctx context.Context, id int64, pinned bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPostPinned", ctx, id, pinned)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPostPinned indicates an expected call of SetPostPinned.
+func (mr *MockPostRepositoryMockRecorder) SetPostPinned(ctx, id, pinned any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPostPinned", reflect.TypeOf((*MockPostRepository)(nil).SetPostPinned), ctx, id, pinned)
+}
+
+// SetPostStatus mocks base method.
+func (m *MockPostRepository) SetPostStatus(ctx context.Context, id int64, status string, publishAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPostStatus", ctx, id, status, publishAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPostStatus indicates an expected call of SetPostStatus.
+func (mr *MockPostRepositoryMockRecorder) SetPostStatus(ctx, id, status, publishAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Posts", reflect.TypeOf((*MockPostRepository)(nil).Posts), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPostStatus", reflect.TypeOf((*MockPostRepository)(nil).SetPostStatus), ctx, id, status, publishAt)
 }
 
 // UpdatePost mocks base method.
@@ -181,113 +373,1372 @@ func (mr *MockPostRepositoryMockRecorder) UpdatePost(ctx, post any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockPostRepository)(nil).UpdatePost), ctx, post)
 }
 
-// MockCommentRepository is a mock of CommentRepository interface.
-type MockCommentRepository struct {
+// MockCategoryRepository is a mock of CategoryRepository interface.
+type MockCategoryRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockCommentRepositoryMockRecorder
+	recorder *MockCategoryRepositoryMockRecorder
 	isgomock struct{}
 }
 
-// MockCommentRepositoryMockRecorder is the mock recorder for MockCommentRepository.
-type MockCommentRepositoryMockRecorder struct {
-	mock *MockCommentRepository
+// MockCategoryRepositoryMockRecorder is the mock recorder for MockCategoryRepository.
+type MockCategoryRepositoryMockRecorder struct {
+	mock *MockCategoryRepository
 }
 
-// NewMockCommentRepository creates a new mock instance.
-func NewMockCommentRepository(ctrl *gomock.Controller) *MockCommentRepository {
-	mock := &MockCommentRepository{ctrl: ctrl}
-	mock.recorder = &MockCommentRepositoryMockRecorder{mock}
+// NewMockCategoryRepository creates a new mock instance.
+func NewMockCategoryRepository(ctrl *gomock.Controller) *MockCategoryRepository {
+	mock := &MockCategoryRepository{ctrl: ctrl}
+	mock.recorder = &MockCategoryRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
+func (m *MockCategoryRepository) EXPECT() *MockCategoryRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateComment mocks base method.
-func (m *MockCommentRepository) CreateComment(ctx context.Context, comment *entities.Comment) error {
+// Categories mocks base method.
+func (m *MockCategoryRepository) Categories(ctx context.Context) ([]*entities.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateComment", ctx, comment)
+	ret := m.ctrl.Call(m, "Categories", ctx)
+	ret0, _ := ret[0].([]*entities.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Categories indicates an expected call of Categories.
+func (mr *MockCategoryRepositoryMockRecorder) Categories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Categories", reflect.TypeOf((*MockCategoryRepository)(nil).Categories), ctx)
+}
+
+// CreateCategory mocks base method.
+func (m *MockCategoryRepository) CreateCategory(ctx context.Context, category *entities.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, category)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateComment indicates an expected call of CreateComment.
-func (mr *MockCommentRepositoryMockRecorder) CreateComment(ctx, comment any) *gomock.Call {
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoryRepositoryMockRecorder) CreateCategory(ctx, category any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCommentRepository)(nil).CreateComment), ctx, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoryRepository)(nil).CreateCategory), ctx, category)
 }
 
-// DeleteComment mocks base method.
-func (m *MockCommentRepository) DeleteComment(ctx context.Context, id int64) error {
+// DeleteCategory mocks base method.
+func (m *MockCategoryRepository) DeleteCategory(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteComment", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteComment indicates an expected call of DeleteComment.
-func (mr *MockCommentRepositoryMockRecorder) DeleteComment(ctx, id any) *gomock.Call {
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockCategoryRepositoryMockRecorder) DeleteCategory(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentRepository)(nil).DeleteComment), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryRepository)(nil).DeleteCategory), ctx, id)
 }
 
-// GetByPostID mocks base method.
-func (m *MockCommentRepository) GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error) {
+// GetCategoryByID mocks base method.
+func (m *MockCategoryRepository) GetCategoryByID(ctx context.Context, id int64) (*entities.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByPostID", ctx, postID)
-	ret0, _ := ret[0].([]*entities.Comment)
+	ret := m.ctrl.Call(m, "GetCategoryByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByPostID indicates an expected call of GetByPostID.
-func (mr *MockCommentRepositoryMockRecorder) GetByPostID(ctx, postID any) *gomock.Call {
+// GetCategoryByID indicates an expected call of GetCategoryByID.
+func (mr *MockCategoryRepositoryMockRecorder) GetCategoryByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPostID", reflect.TypeOf((*MockCommentRepository)(nil).GetByPostID), ctx, postID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockCategoryRepository)(nil).GetCategoryByID), ctx, id)
 }
 
-// GetByUserID mocks base method.
-func (m *MockCommentRepository) GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error) {
+// UpdateCategory mocks base method.
+func (m *MockCategoryRepository) UpdateCategory(ctx context.Context, category *entities.Category) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
-	ret0, _ := ret[0].([]*entities.Comment)
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockCategoryRepositoryMockRecorder) UpdateCategory(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryRepository)(nil).UpdateCategory), ctx, category)
+}
+
+// MockVoteRepository is a mock of VoteRepository interface.
+type MockVoteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockVoteRepositoryMockRecorder is the mock recorder for MockVoteRepository.
+type MockVoteRepositoryMockRecorder struct {
+	mock *MockVoteRepository
+}
+
+// NewMockVoteRepository creates a new mock instance.
+func NewMockVoteRepository(ctrl *gomock.Controller) *MockVoteRepository {
+	mock := &MockVoteRepository{ctrl: ctrl}
+	mock.recorder = &MockVoteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoteRepository) EXPECT() *MockVoteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// RemoveVote mocks base method.
+func (m *MockVoteRepository) RemoveVote(ctx context.Context, userID int64, targetType string, targetID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVote", ctx, userID, targetType, targetID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByUserID indicates an expected call of GetByUserID.
-func (mr *MockCommentRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+// RemoveVote indicates an expected call of RemoveVote.
+func (mr *MockVoteRepositoryMockRecorder) RemoveVote(ctx, userID, targetType, targetID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockCommentRepository)(nil).GetByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVote", reflect.TypeOf((*MockVoteRepository)(nil).RemoveVote), ctx, userID, targetType, targetID)
 }
 
-// GetCommentByID mocks base method.
-func (m *MockCommentRepository) GetCommentByID(ctx context.Context, id int64) (*entities.Comment, error) {
+// UserVotes mocks base method.
+func (m *MockVoteRepository) UserVotes(ctx context.Context, userID int64, targetType string, targetIDs []int64) (map[int64]int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCommentByID", ctx, id)
-	ret0, _ := ret[0].(*entities.Comment)
+	ret := m.ctrl.Call(m, "UserVotes", ctx, userID, targetType, targetIDs)
+	ret0, _ := ret[0].(map[int64]int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCommentByID indicates an expected call of GetCommentByID.
-func (mr *MockCommentRepositoryMockRecorder) GetCommentByID(ctx, id any) *gomock.Call {
+// UserVotes indicates an expected call of UserVotes.
+func (mr *MockVoteRepositoryMockRecorder) UserVotes(ctx, userID, targetType, targetIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockCommentRepository)(nil).GetCommentByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserVotes", reflect.TypeOf((*MockVoteRepository)(nil).UserVotes), ctx, userID, targetType, targetIDs)
 }
 
-// UpdateComment mocks base method.
-func (m *MockCommentRepository) UpdateComment(ctx context.Context, comment *entities.Comment) error {
+// Vote mocks base method.
+func (m *MockVoteRepository) Vote(ctx context.Context, vote *entities.Vote) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateComment", ctx, comment)
+	ret := m.ctrl.Call(m, "Vote", ctx, vote)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockVoteRepositoryMockRecorder) Vote(ctx, vote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockVoteRepository)(nil).Vote), ctx, vote)
+}
+
+// MockPollRepository is a mock of PollRepository interface.
+type MockPollRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPollRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPollRepositoryMockRecorder is the mock recorder for MockPollRepository.
+type MockPollRepositoryMockRecorder struct {
+	mock *MockPollRepository
+}
+
+// NewMockPollRepository creates a new mock instance.
+func NewMockPollRepository(ctrl *gomock.Controller) *MockPollRepository {
+	mock := &MockPollRepository{ctrl: ctrl}
+	mock.recorder = &MockPollRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPollRepository) EXPECT() *MockPollRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CastPollVote mocks base method.
+func (m *MockPollRepository) CastPollVote(ctx context.Context, postID, userID int64, username string, optionIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CastPollVote", ctx, postID, userID, username, optionIDs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateComment indicates an expected call of UpdateComment.
-func (mr *MockCommentRepositoryMockRecorder) UpdateComment(ctx, comment any) *gomock.Call {
+// CastPollVote indicates an expected call of CastPollVote.
+func (mr *MockPollRepositoryMockRecorder) CastPollVote(ctx, postID, userID, username, optionIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockCommentRepository)(nil).UpdateComment), ctx, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CastPollVote", reflect.TypeOf((*MockPollRepository)(nil).CastPollVote), ctx, postID, userID, username, optionIDs)
+}
+
+// PollVoters mocks base method.
+func (m *MockPollRepository) PollVoters(ctx context.Context, postID int64) (map[int64][]*entities.PollVoter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PollVoters", ctx, postID)
+	ret0, _ := ret[0].(map[int64][]*entities.PollVoter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PollVoters indicates an expected call of PollVoters.
+func (mr *MockPollRepositoryMockRecorder) PollVoters(ctx, postID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollVoters", reflect.TypeOf((*MockPollRepository)(nil).PollVoters), ctx, postID)
+}
+
+// Polls mocks base method.
+func (m *MockPollRepository) Polls(ctx context.Context, postIDs []int64, viewerID int64) (map[int64]*entities.Poll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Polls", ctx, postIDs, viewerID)
+	ret0, _ := ret[0].(map[int64]*entities.Poll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Polls indicates an expected call of Polls.
+func (mr *MockPollRepositoryMockRecorder) Polls(ctx, postIDs, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Polls", reflect.TypeOf((*MockPollRepository)(nil).Polls), ctx, postIDs, viewerID)
+}
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateReport mocks base method.
+func (m *MockReportRepository) CreateReport(ctx context.Context, report *entities.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockReportRepositoryMockRecorder) CreateReport(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockReportRepository)(nil).CreateReport), ctx, report)
+}
+
+// GetReport mocks base method.
+func (m *MockReportRepository) GetReport(ctx context.Context, id int64) (*entities.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", ctx, id)
+	ret0, _ := ret[0].(*entities.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockReportRepositoryMockRecorder) GetReport(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockReportRepository)(nil).GetReport), ctx, id)
+}
+
+// ReportGroups mocks base method.
+func (m *MockReportRepository) ReportGroups(ctx context.Context, filter entities.ReportFilter) ([]*entities.ReportGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportGroups", ctx, filter)
+	ret0, _ := ret[0].([]*entities.ReportGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportGroups indicates an expected call of ReportGroups.
+func (mr *MockReportRepositoryMockRecorder) ReportGroups(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportGroups", reflect.TypeOf((*MockReportRepository)(nil).ReportGroups), ctx, filter)
+}
+
+// ResolveReports mocks base method.
+func (m *MockReportRepository) ResolveReports(ctx context.Context, report *entities.Report, status string, resolvedBy int64, note, deleteReason string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReports", ctx, report, status, resolvedBy, note, deleteReason)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveReports indicates an expected call of ResolveReports.
+func (mr *MockReportRepositoryMockRecorder) ResolveReports(ctx, report, status, resolvedBy, note, deleteReason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReports", reflect.TypeOf((*MockReportRepository)(nil).ResolveReports), ctx, report, status, resolvedBy, note, deleteReason)
+}
+
+// Warnings mocks base method.
+func (m *MockReportRepository) Warnings(ctx context.Context, userID int64, limit, offset int) ([]*entities.Warning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Warnings", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]*entities.Warning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Warnings indicates an expected call of Warnings.
+func (mr *MockReportRepositoryMockRecorder) Warnings(ctx, userID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warnings", reflect.TypeOf((*MockReportRepository)(nil).Warnings), ctx, userID, limit, offset)
+}
+
+// MockRevisionRepository is a mock of RevisionRepository interface.
+type MockRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRevisionRepositoryMockRecorder is the mock recorder for MockRevisionRepository.
+type MockRevisionRepositoryMockRecorder struct {
+	mock *MockRevisionRepository
+}
+
+// NewMockRevisionRepository creates a new mock instance.
+func NewMockRevisionRepository(ctrl *gomock.Controller) *MockRevisionRepository {
+	mock := &MockRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevisionRepository) EXPECT() *MockRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRevision mocks base method.
+func (m *MockRevisionRepository) GetRevision(ctx context.Context, id int64) (*entities.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, id)
+	ret0, _ := ret[0].(*entities.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockRevisionRepositoryMockRecorder) GetRevision(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockRevisionRepository)(nil).GetRevision), ctx, id)
+}
+
+// Revisions mocks base method.
+func (m *MockRevisionRepository) Revisions(ctx context.Context, targetType string, targetID int64) ([]*entities.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revisions", ctx, targetType, targetID)
+	ret0, _ := ret[0].([]*entities.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revisions indicates an expected call of Revisions.
+func (mr *MockRevisionRepositoryMockRecorder) Revisions(ctx, targetType, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revisions", reflect.TypeOf((*MockRevisionRepository)(nil).Revisions), ctx, targetType, targetID)
+}
+
+// MockHeldRepository is a mock of HeldRepository interface.
+type MockHeldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHeldRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockHeldRepositoryMockRecorder is the mock recorder for MockHeldRepository.
+type MockHeldRepositoryMockRecorder struct {
+	mock *MockHeldRepository
+}
+
+// NewMockHeldRepository creates a new mock instance.
+func NewMockHeldRepository(ctrl *gomock.Controller) *MockHeldRepository {
+	mock := &MockHeldRepository{ctrl: ctrl}
+	mock.recorder = &MockHeldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHeldRepository) EXPECT() *MockHeldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ApproveHeld mocks base method.
+func (m *MockHeldRepository) ApproveHeld(ctx context.Context, id int64) (*entities.HeldContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveHeld", ctx, id)
+	ret0, _ := ret[0].(*entities.HeldContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveHeld indicates an expected call of ApproveHeld.
+func (mr *MockHeldRepositoryMockRecorder) ApproveHeld(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveHeld", reflect.TypeOf((*MockHeldRepository)(nil).ApproveHeld), ctx, id)
+}
+
+// HeldContent mocks base method.
+func (m *MockHeldRepository) HeldContent(ctx context.Context, limit, offset int) ([]*entities.HeldContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeldContent", ctx, limit, offset)
+	ret0, _ := ret[0].([]*entities.HeldContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeldContent indicates an expected call of HeldContent.
+func (mr *MockHeldRepositoryMockRecorder) HeldContent(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeldContent", reflect.TypeOf((*MockHeldRepository)(nil).HeldContent), ctx, limit, offset)
+}
+
+// RejectHeld mocks base method.
+func (m *MockHeldRepository) RejectHeld(ctx context.Context, id, rejectedBy int64, reason string) (*entities.HeldContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectHeld", ctx, id, rejectedBy, reason)
+	ret0, _ := ret[0].(*entities.HeldContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectHeld indicates an expected call of RejectHeld.
+func (mr *MockHeldRepositoryMockRecorder) RejectHeld(ctx, id, rejectedBy, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectHeld", reflect.TypeOf((*MockHeldRepository)(nil).RejectHeld), ctx, id, rejectedBy, reason)
+}
+
+// MockCommentRepository is a mock of CommentRepository interface.
+type MockCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentRepositoryMockRecorder is the mock recorder for MockCommentRepository.
+type MockCommentRepositoryMockRecorder struct {
+	mock *MockCommentRepository
+}
+
+// NewMockCommentRepository creates a new mock instance.
+func NewMockCommentRepository(ctrl *gomock.Controller) *MockCommentRepository {
+	mock := &MockCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateComment mocks base method.
+func (m *MockCommentRepository) CreateComment(ctx context.Context, comment *entities.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockCommentRepositoryMockRecorder) CreateComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCommentRepository)(nil).CreateComment), ctx, comment)
+}
+
+// DeleteComment mocks base method.
+func (m *MockCommentRepository) DeleteComment(ctx context.Context, id, deletedBy int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, id, deletedBy, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockCommentRepositoryMockRecorder) DeleteComment(ctx, id, deletedBy, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentRepository)(nil).DeleteComment), ctx, id, deletedBy, reason)
+}
+
+// DeletedComments mocks base method.
+func (m *MockCommentRepository) DeletedComments(ctx context.Context, limit, offset int) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletedComments", ctx, limit, offset)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletedComments indicates an expected call of DeletedComments.
+func (mr *MockCommentRepositoryMockRecorder) DeletedComments(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletedComments", reflect.TypeOf((*MockCommentRepository)(nil).DeletedComments), ctx, limit, offset)
+}
+
+// GetByPostID mocks base method.
+func (m *MockCommentRepository) GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPostID", ctx, postID)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPostID indicates an expected call of GetByPostID.
+func (mr *MockCommentRepositoryMockRecorder) GetByPostID(ctx, postID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPostID", reflect.TypeOf((*MockCommentRepository)(nil).GetByPostID), ctx, postID)
+}
+
+// GetByUserID mocks base method.
+func (m *MockCommentRepository) GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockCommentRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockCommentRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// GetCommentByID mocks base method.
+func (m *MockCommentRepository) GetCommentByID(ctx context.Context, id int64) (*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentByID indicates an expected call of GetCommentByID.
+func (mr *MockCommentRepositoryMockRecorder) GetCommentByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockCommentRepository)(nil).GetCommentByID), ctx, id)
+}
+
+// PurgeComments mocks base method.
+func (m *MockCommentRepository) PurgeComments(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeComments", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeComments indicates an expected call of PurgeComments.
+func (mr *MockCommentRepositoryMockRecorder) PurgeComments(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeComments", reflect.TypeOf((*MockCommentRepository)(nil).PurgeComments), ctx, before)
+}
+
+// RestoreComment mocks base method.
+func (m *MockCommentRepository) RestoreComment(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreComment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreComment indicates an expected call of RestoreComment.
+func (mr *MockCommentRepositoryMockRecorder) RestoreComment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreComment", reflect.TypeOf((*MockCommentRepository)(nil).RestoreComment), ctx, id)
+}
+
+// SearchComments mocks base method.
+func (m *MockCommentRepository) SearchComments(ctx context.Context, q entities.SearchQuery) ([]*entities.SearchHit, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchComments", ctx, q)
+	ret0, _ := ret[0].([]*entities.SearchHit)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchComments indicates an expected call of SearchComments.
+func (mr *MockCommentRepositoryMockRecorder) SearchComments(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchComments", reflect.TypeOf((*MockCommentRepository)(nil).SearchComments), ctx, q)
+}
+
+// UpdateComment mocks base method.
+func (m *MockCommentRepository) UpdateComment(ctx context.Context, comment *entities.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockCommentRepositoryMockRecorder) UpdateComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockCommentRepository)(nil).UpdateComment), ctx, comment)
+}
+
+// MockAttachmentRepository is a mock of AttachmentRepository interface.
+type MockAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentRepositoryMockRecorder is the mock recorder for MockAttachmentRepository.
+type MockAttachmentRepositoryMockRecorder struct {
+	mock *MockAttachmentRepository
+}
+
+// NewMockAttachmentRepository creates a new mock instance.
+func NewMockAttachmentRepository(ctrl *gomock.Controller) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRepository) EXPECT() *MockAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Attachments mocks base method.
+func (m *MockAttachmentRepository) Attachments(ctx context.Context, targetType string, targetIDs []int64) (map[int64][]*entities.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attachments", ctx, targetType, targetIDs)
+	ret0, _ := ret[0].(map[int64][]*entities.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attachments indicates an expected call of Attachments.
+func (mr *MockAttachmentRepositoryMockRecorder) Attachments(ctx, targetType, targetIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attachments", reflect.TypeOf((*MockAttachmentRepository)(nil).Attachments), ctx, targetType, targetIDs)
+}
+
+// CreateAttachment mocks base method.
+func (m *MockAttachmentRepository) CreateAttachment(ctx context.Context, att *entities.Attachment, quota int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", ctx, att, quota)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockAttachmentRepositoryMockRecorder) CreateAttachment(ctx, att, quota any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockAttachmentRepository)(nil).CreateAttachment), ctx, att, quota)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockAttachmentRepository) DeleteAttachment(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockAttachmentRepositoryMockRecorder) DeleteAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockAttachmentRepository)(nil).DeleteAttachment), ctx, id)
+}
+
+// GetAttachment mocks base method.
+func (m *MockAttachmentRepository) GetAttachment(ctx context.Context, id, viewerID int64) (*entities.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, id, viewerID)
+	ret0, _ := ret[0].(*entities.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockAttachmentRepositoryMockRecorder) GetAttachment(ctx, id, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockAttachmentRepository)(nil).GetAttachment), ctx, id, viewerID)
+}
+
+// OrphanedAttachments mocks base method.
+func (m *MockAttachmentRepository) OrphanedAttachments(ctx context.Context, limit int) ([]*entities.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrphanedAttachments", ctx, limit)
+	ret0, _ := ret[0].([]*entities.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrphanedAttachments indicates an expected call of OrphanedAttachments.
+func (mr *MockAttachmentRepositoryMockRecorder) OrphanedAttachments(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrphanedAttachments", reflect.TypeOf((*MockAttachmentRepository)(nil).OrphanedAttachments), ctx, limit)
+}
+
+// UserAttachmentsSize mocks base method.
+func (m *MockAttachmentRepository) UserAttachmentsSize(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAttachmentsSize", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserAttachmentsSize indicates an expected call of UserAttachmentsSize.
+func (mr *MockAttachmentRepositoryMockRecorder) UserAttachmentsSize(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAttachmentsSize", reflect.TypeOf((*MockAttachmentRepository)(nil).UserAttachmentsSize), ctx, userID)
+}
+
+// MockBookmarkRepository is a mock of BookmarkRepository interface.
+type MockBookmarkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarkRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBookmarkRepositoryMockRecorder is the mock recorder for MockBookmarkRepository.
+type MockBookmarkRepositoryMockRecorder struct {
+	mock *MockBookmarkRepository
+}
+
+// NewMockBookmarkRepository creates a new mock instance.
+func NewMockBookmarkRepository(ctrl *gomock.Controller) *MockBookmarkRepository {
+	mock := &MockBookmarkRepository{ctrl: ctrl}
+	mock.recorder = &MockBookmarkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarkRepository) EXPECT() *MockBookmarkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddBookmark mocks base method.
+func (m *MockBookmarkRepository) AddBookmark(ctx context.Context, bookmark *entities.Bookmark) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBookmark", ctx, bookmark)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBookmark indicates an expected call of AddBookmark.
+func (mr *MockBookmarkRepositoryMockRecorder) AddBookmark(ctx, bookmark any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookmark", reflect.TypeOf((*MockBookmarkRepository)(nil).AddBookmark), ctx, bookmark)
+}
+
+// BookmarkedPosts mocks base method.
+func (m *MockBookmarkRepository) BookmarkedPosts(ctx context.Context, userID int64, postIDs []int64) (map[int64]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BookmarkedPosts", ctx, userID, postIDs)
+	ret0, _ := ret[0].(map[int64]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BookmarkedPosts indicates an expected call of BookmarkedPosts.
+func (mr *MockBookmarkRepositoryMockRecorder) BookmarkedPosts(ctx, userID, postIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookmarkedPosts", reflect.TypeOf((*MockBookmarkRepository)(nil).BookmarkedPosts), ctx, userID, postIDs)
+}
+
+// Bookmarks mocks base method.
+func (m *MockBookmarkRepository) Bookmarks(ctx context.Context, userID int64, limit, offset int) ([]*entities.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bookmarks", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]*entities.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bookmarks indicates an expected call of Bookmarks.
+func (mr *MockBookmarkRepositoryMockRecorder) Bookmarks(ctx, userID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bookmarks", reflect.TypeOf((*MockBookmarkRepository)(nil).Bookmarks), ctx, userID, limit, offset)
+}
+
+// RemoveBookmark mocks base method.
+func (m *MockBookmarkRepository) RemoveBookmark(ctx context.Context, userID, postID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBookmark", ctx, userID, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBookmark indicates an expected call of RemoveBookmark.
+func (mr *MockBookmarkRepositoryMockRecorder) RemoveBookmark(ctx, userID, postID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBookmark", reflect.TypeOf((*MockBookmarkRepository)(nil).RemoveBookmark), ctx, userID, postID)
+}
+
+// MockNotificationRepository is a mock of NotificationRepository interface.
+type MockNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationRepositoryMockRecorder is the mock recorder for MockNotificationRepository.
+type MockNotificationRepositoryMockRecorder struct {
+	mock *MockNotificationRepository
+}
+
+// NewMockNotificationRepository creates a new mock instance.
+func NewMockNotificationRepository(ctrl *gomock.Controller) *MockNotificationRepository {
+	mock := &MockNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// MarkAllRead mocks base method.
+func (m *MockNotificationRepository) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockNotificationRepositoryMockRecorder) MarkAllRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkAllRead), ctx, userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotificationRepository) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, userID, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationRepositoryMockRecorder) MarkRead(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkRead), ctx, userID, ids)
+}
+
+// Notifications mocks base method.
+func (m *MockNotificationRepository) Notifications(ctx context.Context, userID int64, unreadOnly bool, limit, offset int) ([]*entities.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notifications", ctx, userID, unreadOnly, limit, offset)
+	ret0, _ := ret[0].([]*entities.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Notifications indicates an expected call of Notifications.
+func (mr *MockNotificationRepositoryMockRecorder) Notifications(ctx, userID, unreadOnly, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notifications", reflect.TypeOf((*MockNotificationRepository)(nil).Notifications), ctx, userID, unreadOnly, limit, offset)
+}
+
+// NotifyComment mocks base method.
+func (m *MockNotificationRepository) NotifyComment(ctx context.Context, comment *entities.Comment) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyComment", ctx, comment)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyComment indicates an expected call of NotifyComment.
+func (mr *MockNotificationRepositoryMockRecorder) NotifyComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyComment", reflect.TypeOf((*MockNotificationRepository)(nil).NotifyComment), ctx, comment)
+}
+
+// SetSubscription mocks base method.
+func (m *MockNotificationRepository) SetSubscription(ctx context.Context, userID, postID int64, subscribed bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSubscription", ctx, userID, postID, subscribed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSubscription indicates an expected call of SetSubscription.
+func (mr *MockNotificationRepositoryMockRecorder) SetSubscription(ctx, userID, postID, subscribed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubscription", reflect.TypeOf((*MockNotificationRepository)(nil).SetSubscription), ctx, userID, postID, subscribed)
+}
+
+// UnreadCount mocks base method.
+func (m *MockNotificationRepository) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnreadCount", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnreadCount indicates an expected call of UnreadCount.
+func (mr *MockNotificationRepositoryMockRecorder) UnreadCount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnreadCount", reflect.TypeOf((*MockNotificationRepository)(nil).UnreadCount), ctx, userID)
+}
+
+// MockMentionRepository is a mock of MentionRepository interface.
+type MockMentionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMentionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMentionRepositoryMockRecorder is the mock recorder for MockMentionRepository.
+type MockMentionRepositoryMockRecorder struct {
+	mock *MockMentionRepository
+}
+
+// NewMockMentionRepository creates a new mock instance.
+func NewMockMentionRepository(ctrl *gomock.Controller) *MockMentionRepository {
+	mock := &MockMentionRepository{ctrl: ctrl}
+	mock.recorder = &MockMentionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMentionRepository) EXPECT() *MockMentionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Mentions mocks base method.
+func (m *MockMentionRepository) Mentions(ctx context.Context, targetType string, targetID int64) ([]*entities.Mention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mentions", ctx, targetType, targetID)
+	ret0, _ := ret[0].([]*entities.Mention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mentions indicates an expected call of Mentions.
+func (mr *MockMentionRepositoryMockRecorder) Mentions(ctx, targetType, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mentions", reflect.TypeOf((*MockMentionRepository)(nil).Mentions), ctx, targetType, targetID)
+}
+
+// SaveMentions mocks base method.
+func (m *MockMentionRepository) SaveMentions(ctx context.Context, targetType string, targetID int64, mentions []*entities.Mention) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMentions", ctx, targetType, targetID, mentions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMentions indicates an expected call of SaveMentions.
+func (mr *MockMentionRepositoryMockRecorder) SaveMentions(ctx, targetType, targetID, mentions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMentions", reflect.TypeOf((*MockMentionRepository)(nil).SaveMentions), ctx, targetType, targetID, mentions)
+}
+
+// UserMentions mocks base method.
+func (m *MockMentionRepository) UserMentions(ctx context.Context, userID int64, limit, offset int) ([]*entities.Mention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserMentions", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]*entities.Mention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserMentions indicates an expected call of UserMentions.
+func (mr *MockMentionRepositoryMockRecorder) UserMentions(ctx, userID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserMentions", reflect.TypeOf((*MockMentionRepository)(nil).UserMentions), ctx, userID, limit, offset)
+}
+
+// MockViewRepository is a mock of ViewRepository interface.
+type MockViewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockViewRepositoryMockRecorder is the mock recorder for MockViewRepository.
+type MockViewRepositoryMockRecorder struct {
+	mock *MockViewRepository
+}
+
+// NewMockViewRepository creates a new mock instance.
+func NewMockViewRepository(ctrl *gomock.Controller) *MockViewRepository {
+	mock := &MockViewRepository{ctrl: ctrl}
+	mock.recorder = &MockViewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewRepository) EXPECT() *MockViewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddViews mocks base method.
+func (m *MockViewRepository) AddViews(ctx context.Context, views map[int64]int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddViews", ctx, views)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddViews indicates an expected call of AddViews.
+func (mr *MockViewRepositoryMockRecorder) AddViews(ctx, views any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddViews", reflect.TypeOf((*MockViewRepository)(nil).AddViews), ctx, views)
+}
+
+// MockContentRepository is a mock of ContentRepository interface.
+type MockContentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockContentRepositoryMockRecorder is the mock recorder for MockContentRepository.
+type MockContentRepositoryMockRecorder struct {
+	mock *MockContentRepository
+}
+
+// NewMockContentRepository creates a new mock instance.
+func NewMockContentRepository(ctrl *gomock.Controller) *MockContentRepository {
+	mock := &MockContentRepository{ctrl: ctrl}
+	mock.recorder = &MockContentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentRepository) EXPECT() *MockContentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SetContentHTML mocks base method.
+func (m *MockContentRepository) SetContentHTML(ctx context.Context, targetType string, id int64, contentHTML string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContentHTML", ctx, targetType, id, contentHTML)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetContentHTML indicates an expected call of SetContentHTML.
+func (mr *MockContentRepositoryMockRecorder) SetContentHTML(ctx, targetType, id, contentHTML any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContentHTML", reflect.TypeOf((*MockContentRepository)(nil).SetContentHTML), ctx, targetType, id, contentHTML)
+}
+
+// UnrenderedContent mocks base method.
+func (m *MockContentRepository) UnrenderedContent(ctx context.Context, targetType string, afterID int64, limit int) (map[int64]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnrenderedContent", ctx, targetType, afterID, limit)
+	ret0, _ := ret[0].(map[int64]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnrenderedContent indicates an expected call of UnrenderedContent.
+func (mr *MockContentRepositoryMockRecorder) UnrenderedContent(ctx, targetType, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnrenderedContent", reflect.TypeOf((*MockContentRepository)(nil).UnrenderedContent), ctx, targetType, afterID, limit)
+}
+
+// MockArchiveRepository is a mock of ArchiveRepository interface.
+type MockArchiveRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockArchiveRepositoryMockRecorder is the mock recorder for MockArchiveRepository.
+type MockArchiveRepositoryMockRecorder struct {
+	mock *MockArchiveRepository
+}
+
+// NewMockArchiveRepository creates a new mock instance.
+func NewMockArchiveRepository(ctrl *gomock.Controller) *MockArchiveRepository {
+	mock := &MockArchiveRepository{ctrl: ctrl}
+	mock.recorder = &MockArchiveRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveRepository) EXPECT() *MockArchiveRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ArchiveComments mocks base method.
+func (m *MockArchiveRepository) ArchiveComments(ctx context.Context, afterID int64, limit int) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveComments", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveComments indicates an expected call of ArchiveComments.
+func (mr *MockArchiveRepositoryMockRecorder) ArchiveComments(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveComments", reflect.TypeOf((*MockArchiveRepository)(nil).ArchiveComments), ctx, afterID, limit)
+}
+
+// ArchiveMessages mocks base method.
+func (m *MockArchiveRepository) ArchiveMessages(ctx context.Context, afterID int64, limit int) ([]*entities.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveMessages", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entities.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveMessages indicates an expected call of ArchiveMessages.
+func (mr *MockArchiveRepositoryMockRecorder) ArchiveMessages(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveMessages", reflect.TypeOf((*MockArchiveRepository)(nil).ArchiveMessages), ctx, afterID, limit)
+}
+
+// ArchivePosts mocks base method.
+func (m *MockArchiveRepository) ArchivePosts(ctx context.Context, afterID int64, limit int) ([]*entities.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivePosts", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entities.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchivePosts indicates an expected call of ArchivePosts.
+func (mr *MockArchiveRepositoryMockRecorder) ArchivePosts(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivePosts", reflect.TypeOf((*MockArchiveRepository)(nil).ArchivePosts), ctx, afterID, limit)
+}
+
+// ImportCategory mocks base method.
+func (m *MockArchiveRepository) ImportCategory(ctx context.Context, source string, sourceID int64, category *entities.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCategory", ctx, source, sourceID, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportCategory indicates an expected call of ImportCategory.
+func (mr *MockArchiveRepositoryMockRecorder) ImportCategory(ctx, source, sourceID, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCategory", reflect.TypeOf((*MockArchiveRepository)(nil).ImportCategory), ctx, source, sourceID, category)
+}
+
+// ImportComment mocks base method.
+func (m *MockArchiveRepository) ImportComment(ctx context.Context, source string, sourceID int64, comment *entities.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportComment", ctx, source, sourceID, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportComment indicates an expected call of ImportComment.
+func (mr *MockArchiveRepositoryMockRecorder) ImportComment(ctx, source, sourceID, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportComment", reflect.TypeOf((*MockArchiveRepository)(nil).ImportComment), ctx, source, sourceID, comment)
+}
+
+// ImportMessage mocks base method.
+func (m *MockArchiveRepository) ImportMessage(ctx context.Context, source string, sourceID int64, msg *entities.ChatMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportMessage", ctx, source, sourceID, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportMessage indicates an expected call of ImportMessage.
+func (mr *MockArchiveRepositoryMockRecorder) ImportMessage(ctx, source, sourceID, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMessage", reflect.TypeOf((*MockArchiveRepository)(nil).ImportMessage), ctx, source, sourceID, msg)
+}
+
+// ImportPost mocks base method.
+func (m *MockArchiveRepository) ImportPost(ctx context.Context, source string, sourceID int64, post *entities.Post) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPost", ctx, source, sourceID, post)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportPost indicates an expected call of ImportPost.
+func (mr *MockArchiveRepositoryMockRecorder) ImportPost(ctx, source, sourceID, post any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPost", reflect.TypeOf((*MockArchiveRepository)(nil).ImportPost), ctx, source, sourceID, post)
+}
+
+// ImportedIDs mocks base method.
+func (m *MockArchiveRepository) ImportedIDs(ctx context.Context, source, kind string) (map[int64]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportedIDs", ctx, source, kind)
+	ret0, _ := ret[0].(map[int64]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportedIDs indicates an expected call of ImportedIDs.
+func (mr *MockArchiveRepositoryMockRecorder) ImportedIDs(ctx, source, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportedIDs", reflect.TypeOf((*MockArchiveRepository)(nil).ImportedIDs), ctx, source, kind)
+}
+
+// MapImportedID mocks base method.
+func (m *MockArchiveRepository) MapImportedID(ctx context.Context, source, kind string, sourceID, targetID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MapImportedID", ctx, source, kind, sourceID, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MapImportedID indicates an expected call of MapImportedID.
+func (mr *MockArchiveRepositoryMockRecorder) MapImportedID(ctx, source, kind, sourceID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MapImportedID", reflect.TypeOf((*MockArchiveRepository)(nil).MapImportedID), ctx, source, kind, sourceID, targetID)
+}
+
+// MockAuthorRepository is a mock of AuthorRepository interface.
+type MockAuthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorRepositoryMockRecorder is the mock recorder for MockAuthorRepository.
+type MockAuthorRepositoryMockRecorder struct {
+	mock *MockAuthorRepository
+}
+
+// NewMockAuthorRepository creates a new mock instance.
+func NewMockAuthorRepository(ctrl *gomock.Controller) *MockAuthorRepository {
+	mock := &MockAuthorRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorRepository) EXPECT() *MockAuthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AuthorIDs mocks base method.
+func (m *MockAuthorRepository) AuthorIDs(ctx context.Context, afterID int64, limit int) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorIDs", ctx, afterID, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorIDs indicates an expected call of AuthorIDs.
+func (mr *MockAuthorRepositoryMockRecorder) AuthorIDs(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorIDs", reflect.TypeOf((*MockAuthorRepository)(nil).AuthorIDs), ctx, afterID, limit)
+}
+
+// EventCursor mocks base method.
+func (m *MockAuthorRepository) EventCursor(ctx context.Context, name string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventCursor", ctx, name)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventCursor indicates an expected call of EventCursor.
+func (mr *MockAuthorRepositoryMockRecorder) EventCursor(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventCursor", reflect.TypeOf((*MockAuthorRepository)(nil).EventCursor), ctx, name)
+}
+
+// RenameAuthor mocks base method.
+func (m *MockAuthorRepository) RenameAuthor(ctx context.Context, userID int64, username string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameAuthor", ctx, userID, username)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameAuthor indicates an expected call of RenameAuthor.
+func (mr *MockAuthorRepositoryMockRecorder) RenameAuthor(ctx, userID, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameAuthor", reflect.TypeOf((*MockAuthorRepository)(nil).RenameAuthor), ctx, userID, username)
+}
+
+// SetEventCursor mocks base method.
+func (m *MockAuthorRepository) SetEventCursor(ctx context.Context, name string, position int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventCursor", ctx, name, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEventCursor indicates an expected call of SetEventCursor.
+func (mr *MockAuthorRepositoryMockRecorder) SetEventCursor(ctx, name, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventCursor", reflect.TypeOf((*MockAuthorRepository)(nil).SetEventCursor), ctx, name, position)
+}
+
+// MockrowScanner is a mock of rowScanner interface.
+type MockrowScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowScannerMockRecorder
+	isgomock struct{}
+}
+
+// MockrowScannerMockRecorder is the mock recorder for MockrowScanner.
+type MockrowScannerMockRecorder struct {
+	mock *MockrowScanner
+}
+
+// NewMockrowScanner creates a new mock instance.
+func NewMockrowScanner(ctrl *gomock.Controller) *MockrowScanner {
+	mock := &MockrowScanner{ctrl: ctrl}
+	mock.recorder = &MockrowScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowScanner) EXPECT() *MockrowScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockrowScanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockrowScannerMockRecorder) Scan(dest ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockrowScanner)(nil).Scan), dest...)
 }
@@ -2,13 +2,30 @@ package usecase
 
 import (
 	"context"
+	stdErrors "errors"
 	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/netabakovv/forum/back/forum_service/internal/blobstore"
 	"github.com/netabakovv/forum/back/forum_service/internal/entities"
+	"github.com/netabakovv/forum/back/forum_service/internal/filter"
 	"github.com/netabakovv/forum/back/forum_service/internal/repository"
+	"github.com/netabakovv/forum/back/pkg/archive"
+	"github.com/netabakovv/forum/back/pkg/diff"
 	"github.com/netabakovv/forum/back/pkg/errors"
+	"github.com/netabakovv/forum/back/pkg/imaging"
 	"github.com/netabakovv/forum/back/pkg/logger"
+	"github.com/netabakovv/forum/back/pkg/mention"
 	pb "github.com/netabakovv/forum/back/proto"
 )
 
@@ -20,14 +37,20 @@ type ChatUsecaseInterface interface {
 
 type ChatUsecase struct {
 	repo            repository.ChatRepository
+	mentions        MentionUsecaseInterface
+	filter          ContentFilter
 	logger          logger.Logger
 	maxMessageLen   int
 	messageLifetime time.Duration
 }
 
-func NewChatUsecase(repo repository.ChatRepository, logger logger.Logger, config *pb.ChatConfig) *ChatUsecase {
+// NewChatUsecase создаёт usecase чата; mentions и filter могут быть nil,
+// тогда упоминания не сохраняются, а сообщения не проверяются фильтрами
+func NewChatUsecase(repo repository.ChatRepository, mentions MentionUsecaseInterface, filter ContentFilter, logger logger.Logger, config *pb.ChatConfig) *ChatUsecase {
 	return &ChatUsecase{
 		repo:            repo,
+		mentions:        mentions,
+		filter:          filter,
 		logger:          logger,
 		maxMessageLen:   int(config.MaxMessageLength),
 		messageLifetime: time.Duration(config.MessageLifetimeMinutes) * time.Minute,
@@ -41,12 +64,36 @@ func (u *ChatUsecase) SendMessage(ctx context.Context, msg *entities.ChatMessage
 	if msg.Content == "" {
 		return errors.ErrEmptyMessage
 	}
+	// Модерировать чат некому: сообщение, задержанное фильтром, не отправляется
+	content := &filter.Content{Target: repository.TargetTypeChat, AuthorID: msg.UserID, Text: msg.Content}
+	verdict, err := checkContent(ctx, u.filter, content)
+	if err != nil {
+		return err
+	}
+	if verdict.Action == filter.Hold {
+		return fmt.Errorf("%w: %s", errors.ErrContentRejected, verdict.Reason)
+	}
+	msg.Content = content.Text
+
+	// Сообщения чата — простой текст: упоминания не превращаются в ссылки, а только запоминаются
+	var mentioned map[string]int64
+	if u.mentions != nil {
+		if mentioned, err = u.mentions.Resolve(ctx, mention.Parse(msg.Content)); err != nil {
+			return err
+		}
+	}
 
 	u.logger.Info("отправка сообщения в чат",
 		logger.NewField("user_id", msg.UserID),
 		logger.NewField("content_len", len(msg.Content)),
 	)
-	return u.repo.SaveMessage(ctx, msg.UserID, msg.Username, msg.Content)
+	id, err := u.repo.SaveMessage(ctx, msg.UserID, msg.Username, msg.Content)
+	if err != nil {
+		return err
+	}
+	msg.ID = id
+	saveMentions(ctx, u.mentions, u.logger, repository.TargetTypeChat, msg.ID, msg.UserID, mentioned)
+	return nil
 }
 
 func (u *ChatUsecase) GetMessages(ctx context.Context) ([]*entities.ChatMessage, error) {
@@ -118,32 +165,491 @@ func (s *CleanupService) Cleanup(messageLifetime time.Duration) error {
 	return nil
 }
 
+// TrashPurgeService периодически очищает корзину от записей старше срока хранения
+type TrashPurgeService struct {
+	postUC       PostUsecaseInterface
+	commentUC    CommentUsecaseInterface
+	attachmentUC AttachmentUsecaseInterface
+	logger       logger.Logger
+	ticker       *time.Ticker
+	done         chan bool
+	timeout      time.Duration
+}
+
+func NewTrashPurgeService(postUC PostUsecaseInterface, commentUC CommentUsecaseInterface, attachmentUC AttachmentUsecaseInterface, logger logger.Logger) *TrashPurgeService {
+	return &TrashPurgeService{
+		postUC:       postUC,
+		commentUC:    commentUC,
+		attachmentUC: attachmentUC,
+		logger:       logger,
+		done:         make(chan bool),
+		timeout:      time.Minute,
+	}
+}
+
+func (s *TrashPurgeService) Start(interval time.Duration, retention time.Duration) {
+	s.ticker = time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-s.ticker.C:
+				if err := s.Purge(retention); err != nil {
+					s.logger.Error("ошибка очистки корзины",
+						logger.NewField("error", err))
+				}
+			case <-s.done:
+				s.ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (s *TrashPurgeService) Stop() {
+	s.done <- true
+}
+
+// Purge окончательно удаляет комментарии и посты, пролежавшие в корзине дольше
+// retention, а затем файлы их вложений
+func (s *TrashPurgeService) Purge(retention time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	cutoff := time.Now().Add(-retention)
+
+	comments, err := s.commentUC.PurgeDeleted(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	posts, err := s.postUC.PurgeDeleted(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	attachments, err := s.attachmentUC.PurgeOrphans(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("корзина очищена",
+		logger.NewField("cutoff", cutoff),
+		logger.NewField("posts", posts),
+		logger.NewField("comments", comments),
+		logger.NewField("attachments", attachments),
+	)
+	return nil
+}
+
 type PostUsecaseInterface interface {
 	CreatePost(ctx context.Context, post *entities.Post) error
 	GetPostByID(ctx context.Context, id int64) (*entities.Post, error)
 	UpdatePost(ctx context.Context, post *entities.Post) error
-	DeletePost(ctx context.Context, id int64) error
-	Posts(ctx context.Context) ([]*entities.Post, error)
+	DeletePost(ctx context.Context, id, deletedBy int64, reason string) error
+	RestorePost(ctx context.Context, id int64) (*entities.Post, error)
+	DeletedPosts(ctx context.Context, limit, offset int) ([]*entities.Post, error)
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+	Posts(ctx context.Context, filter entities.PostFilter) (*entities.PostPage, error)
+	Drafts(ctx context.Context, authorID int64, limit, offset int) ([]*entities.Post, error)
+	PublishPost(ctx context.Context, post *entities.Post, publishAt *time.Time) error
+	PublishDue(ctx context.Context, now time.Time) (int64, error)
+	PinPost(ctx context.Context, id int64, pinned bool) (*entities.Post, error)
+	LockPost(ctx context.Context, id int64, locked bool) (*entities.Post, error)
+	RefreshHotRanks(ctx context.Context, since time.Time) (int64, error)
 }
 
 type PostUsecase struct {
-	repo   repository.PostRepository
-	logger logger.Logger
+	repo     repository.PostRepository
+	renderer ContentRenderer
+	mentions MentionUsecaseInterface
+	filter   ContentFilter
+	logger   logger.Logger
 }
 
-func NewPostUsecase(repo repository.PostRepository, logger logger.Logger) *PostUsecase {
+// NewPostUsecase создаёт usecase постов; mentions и filter могут быть nil,
+// тогда упоминания остаются текстом, а посты не проверяются фильтрами
+func NewPostUsecase(repo repository.PostRepository, renderer ContentRenderer, mentions MentionUsecaseInterface, filter ContentFilter, logger logger.Logger) *PostUsecase {
 	return &PostUsecase{
-		repo:   repo,
-		logger: logger,
+		repo:     repo,
+		renderer: renderer,
+		mentions: mentions,
+		filter:   filter,
+		logger:   logger,
+	}
+}
+
+// ContentRenderer переводит Markdown поста или комментария в безопасный HTML
+type ContentRenderer interface {
+	Render(src string) string
+}
+
+// renderBatchSize — сколько текстов RenderMissingContent обрабатывает за один запрос
+const renderBatchSize = 100
+
+// RenderMissingContent строит HTML для постов и комментариев, у которых его ещё нет:
+// сохранённых до появления рендера или после сброса кэша при смене настроек.
+func RenderMissingContent(ctx context.Context, repo repository.ContentRepository, renderer ContentRenderer, mentions MentionUsecaseInterface, log logger.Logger) error {
+	for _, targetType := range []string{repository.TargetTypePost, repository.TargetTypeComment} {
+		rendered := 0
+		var afterID int64
+		for {
+			contents, err := repo.UnrenderedContent(ctx, targetType, afterID, renderBatchSize)
+			if err != nil {
+				return err
+			}
+			if len(contents) == 0 {
+				break
+			}
+			for id, content := range contents {
+				contentHTML := renderer.Render(content)
+				if mentions != nil {
+					// Имена берутся из сохранённых упоминаний, чтобы не спрашивать auth service
+					// о каждом тексте; ссылки не теряются при перестроении кэша
+					if contentHTML, err = mentions.Relink(ctx, targetType, id, contentHTML); err != nil {
+						return err
+					}
+				}
+				if err := repo.SetContentHTML(ctx, targetType, id, contentHTML); err != nil {
+					return err
+				}
+				afterID = max(afterID, id)
+				rendered++
+			}
+		}
+		if rendered > 0 {
+			log.Info("построен HTML для сохранённых текстов",
+				logger.NewField("target_type", targetType),
+				logger.NewField("count", rendered))
+		}
+	}
+	return nil
+}
+
+// MaxMentions — сколько разных пользователей можно упомянуть в одном посте, комментарии или сообщении
+const MaxMentions = 10
+
+// DefaultMentionProfileURL — шаблон ссылки на профиль упомянутого пользователя
+const DefaultMentionProfileURL = "/users/%d"
+
+// UserResolver находит id пользователей по именам; неизвестные имена в ответ не попадают
+type UserResolver interface {
+	ResolveUsernames(ctx context.Context, usernames []string) (map[string]int64, error)
+}
+
+type MentionUsecaseInterface interface {
+	Resolve(ctx context.Context, usernames []string) (map[string]int64, error)
+	Link(contentHTML string, users map[string]int64) string
+	Relink(ctx context.Context, targetType string, targetID int64, contentHTML string) (string, error)
+	Save(ctx context.Context, targetType string, targetID, authorID int64, users map[string]int64) error
+	Mentions(ctx context.Context, targetType string, targetID int64) ([]*entities.Mention, error)
+	UserMentions(ctx context.Context, userID int64, limit, offset int) ([]*entities.Mention, error)
+}
+
+type MentionUsecase struct {
+	repo       repository.MentionRepository
+	resolver   UserResolver
+	profileURL string
+	logger     logger.Logger
+}
+
+// NewMentionUsecase создаёт usecase упоминаний; profileURL — шаблон fmt с одним %d для id
+// пользователя, пустая строка означает DefaultMentionProfileURL
+func NewMentionUsecase(repo repository.MentionRepository, resolver UserResolver, profileURL string, logger logger.Logger) *MentionUsecase {
+	if profileURL == "" {
+		profileURL = DefaultMentionProfileURL
+	}
+	return &MentionUsecase{
+		repo:       repo,
+		resolver:   resolver,
+		profileURL: profileURL,
+		logger:     logger,
+	}
+}
+
+// Resolve находит id упомянутых пользователей. Больше MaxMentions разных имён в одном
+// тексте не допускается, чтобы упоминания нельзя было использовать для рассылки.
+func (u *MentionUsecase) Resolve(ctx context.Context, usernames []string) (map[string]int64, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+	if len(usernames) > MaxMentions {
+		return nil, errors.ErrTooManyMentions
+	}
+	users, err := u.resolver.ResolveUsernames(ctx, usernames)
+	if err != nil {
+		u.logger.Error("ошибка поиска упомянутых пользователей",
+			logger.NewField("usernames", len(usernames)),
+			logger.NewField("error", err))
+		return nil, err
+	}
+	return users, nil
+}
+
+func (u *MentionUsecase) Link(contentHTML string, users map[string]int64) string {
+	return mention.Link(contentHTML, users, u.href)
+}
+
+// Relink заново расставляет ссылки по сохранённым упоминаниям текста, не обращаясь к auth service
+func (u *MentionUsecase) Relink(ctx context.Context, targetType string, targetID int64, contentHTML string) (string, error) {
+	mentions, err := u.repo.Mentions(ctx, targetType, targetID)
+	if err != nil {
+		return "", err
+	}
+	users := make(map[string]int64, len(mentions))
+	for _, m := range mentions {
+		users[m.Username] = m.UserID
+	}
+	return u.Link(contentHTML, users), nil
+}
+
+// Save заменяет упоминания текста на users; пользователи, которых больше не упоминают, удаляются
+func (u *MentionUsecase) Save(ctx context.Context, targetType string, targetID, authorID int64, users map[string]int64) error {
+	mentions := make([]*entities.Mention, 0, len(users))
+	for username, userID := range users {
+		mentions = append(mentions, &entities.Mention{
+			TargetType: targetType,
+			TargetID:   targetID,
+			UserID:     userID,
+			Username:   username,
+			AuthorID:   authorID,
+		})
+	}
+	return u.repo.SaveMentions(ctx, targetType, targetID, mentions)
+}
+
+func (u *MentionUsecase) Mentions(ctx context.Context, targetType string, targetID int64) ([]*entities.Mention, error) {
+	return u.repo.Mentions(ctx, targetType, targetID)
+}
+
+// UserMentions возвращает упоминания пользователя, новые первыми
+func (u *MentionUsecase) UserMentions(ctx context.Context, userID int64, limit, offset int) ([]*entities.Mention, error) {
+	if limit <= 0 {
+		limit = repository.DefaultPostsLimit
+	}
+	if limit > repository.MaxPostsLimit {
+		limit = repository.MaxPostsLimit
+	}
+	return u.repo.UserMentions(ctx, userID, limit, offset)
+}
+
+func (u *MentionUsecase) href(userID int64) string {
+	return fmt.Sprintf(u.profileURL, userID)
+}
+
+// ContentFilter проверяет тексты перед сохранением; реализация — filter.Chain
+type ContentFilter interface {
+	Check(ctx context.Context, content *filter.Content) (filter.Verdict, error)
+}
+
+// checkContent прогоняет текст через фильтры, если они настроены. Отказ фильтра
+// возвращается как errors.ErrContentRejected с причиной для автора.
+func checkContent(ctx context.Context, contentFilter ContentFilter, content *filter.Content) (filter.Verdict, error) {
+	if contentFilter == nil {
+		return filter.Verdict{}, nil
+	}
+	verdict, err := contentFilter.Check(ctx, content)
+	if err != nil {
+		return verdict, err
+	}
+	if verdict.Action == filter.Reject {
+		return verdict, fmt.Errorf("%w: %s", errors.ErrContentRejected, verdict.Reason)
+	}
+	return verdict, nil
+}
+
+// holdFor возвращает задержку текста до решения модератора или nil, если
+// фильтры пропустили текст
+func holdFor(verdict filter.Verdict) *entities.Hold {
+	if verdict.Action != filter.Hold {
+		return nil
+	}
+	return &entities.Hold{Reason: verdict.Reason}
+}
+
+// logHeld записывает, какой фильтр задержал текст до решения модератора
+func logHeld(log logger.Logger, targetType string, targetID int64, verdict filter.Verdict) {
+	log.Info("текст задержан фильтром до решения модератора",
+		logger.NewField("target_type", targetType),
+		logger.NewField("target_id", targetID),
+		logger.NewField("filter", verdict.Filter))
+}
+
+// renderContent строит HTML текста и превращает в ссылки упоминания существующих пользователей.
+// Вместе с HTML возвращаются найденные пользователи, чтобы сохранить их после записи текста.
+func renderContent(ctx context.Context, renderer ContentRenderer, mentions MentionUsecaseInterface, src string) (string, map[string]int64, error) {
+	contentHTML := renderer.Render(src)
+	if mentions == nil {
+		return contentHTML, nil, nil
+	}
+	users, err := mentions.Resolve(ctx, mention.ParseHTML(contentHTML))
+	if err != nil {
+		return "", nil, err
+	}
+	return mentions.Link(contentHTML, users), users, nil
+}
+
+// saveMentions сохраняет упоминания уже записанного текста. Ошибка только логируется:
+// текст сохранён, а ссылки в нём уже построены.
+func saveMentions(ctx context.Context, mentions MentionUsecaseInterface, log logger.Logger, targetType string, targetID, authorID int64, users map[string]int64) {
+	if mentions == nil {
+		return
+	}
+	if err := mentions.Save(ctx, targetType, targetID, authorID, users); err != nil {
+		log.Error("ошибка сохранения упоминаний",
+			logger.NewField("target_type", targetType),
+			logger.NewField("target_id", targetID),
+			logger.NewField("error", err))
+	}
+}
+
+// MaxPostTags — максимальное число тегов у одного поста
+const MaxPostTags = 10
+
+var (
+	tagPattern  = regexp.MustCompile(`^[\p{L}\p{N}_-]{1,32}$`)
+	slugPattern = regexp.MustCompile(`^[a-z0-9-]{1,64}$`)
+)
+
+// normalizeTag приводит тег к каноничному виду: без пробелов и '#', в нижнем регистре
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if !tagPattern.MatchString(tag) {
+		return "", errors.ErrInvalidTag
+	}
+	return tag, nil
+}
+
+// normalizeTags нормализует теги и убирает дубликаты с сохранением порядка.
+// nil остаётся nil, чтобы при обновлении можно было отличить «не менять» от «очистить».
+func normalizeTags(tags []string) ([]string, error) {
+	if tags == nil {
+		return nil, nil
+	}
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag, err := normalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	if len(result) > MaxPostTags {
+		return nil, errors.ErrTooManyTags
+	}
+	return result, nil
+}
+
+// normalizeStatus проверяет статус нового поста: без статуса пост публикуется
+// сразу, запланированному нужно время публикации в будущем
+func normalizeStatus(post *entities.Post, now time.Time) error {
+	switch post.Status {
+	case "":
+		post.Status = entities.PostStatusPublished
+		post.PublishAt = nil
+	case entities.PostStatusDraft, entities.PostStatusPublished:
+		post.PublishAt = nil
+	case entities.PostStatusScheduled:
+		if post.PublishAt == nil || !post.PublishAt.After(now) {
+			return errors.ErrInvalidPublishTime
+		}
+	default:
+		return errors.ErrInvalidPostStatus
+	}
+	return nil
+}
+
+const (
+	MinPollOptions      = 2
+	MaxPollOptions      = 10
+	MaxPollOptionLength = 200 // poll_options.text VARCHAR(200)
+)
+
+// normalizePoll проверяет опрос нового поста: варианты обрезаются по краям,
+// пустые и повторяющиеся не допускаются. Опрос должен закрываться после публикации.
+func normalizePoll(post *entities.Post, now time.Time) error {
+	poll := post.Poll
+	if poll == nil {
+		return nil
+	}
+	if len(poll.Options) < MinPollOptions || len(poll.Options) > MaxPollOptions {
+		return errors.ErrInvalidPoll
 	}
+	seen := make(map[string]bool, len(poll.Options))
+	for _, option := range poll.Options {
+		option.Text = strings.TrimSpace(option.Text)
+		if option.Text == "" || seen[option.Text] {
+			return errors.ErrInvalidPoll
+		}
+		if utf8.RuneCountInString(option.Text) > MaxPollOptionLength {
+			return errors.ErrPollOptionTooLong
+		}
+		seen[option.Text] = true
+	}
+	if poll.ClosesAt != nil {
+		published := now
+		if post.PublishAt != nil {
+			published = *post.PublishAt
+		}
+		if !poll.ClosesAt.After(published) {
+			return errors.ErrInvalidPollClose
+		}
+	}
+	return nil
 }
 
 func (u *PostUsecase) CreatePost(ctx context.Context, post *entities.Post) error {
+	tags, err := normalizeTags(post.Tags)
+	if err != nil {
+		return err
+	}
+	post.Tags = tags
+	now := time.Now()
+	if err := normalizeStatus(post, now); err != nil {
+		return err
+	}
+	if err := normalizePoll(post, now); err != nil {
+		return err
+	}
+	verdict, err := u.checkPost(ctx, post)
+	if err != nil {
+		return err
+	}
+	var mentioned map[string]int64
+	if post.ContentHTML, mentioned, err = renderContent(ctx, u.renderer, u.mentions, post.Content); err != nil {
+		return err
+	}
+
 	u.logger.Info("создание нового поста",
 		logger.NewField("title", post.Title),
-		logger.NewField("author_id", post.AuthorID))
+		logger.NewField("author_id", post.AuthorID),
+		logger.NewField("status", post.Status))
+
+	// Задержанный пост сохраняется со статусом held, упоминания — после одобрения
+	post.Hold = holdFor(verdict)
+	if err := u.repo.CreatePost(ctx, post); err != nil {
+		return err
+	}
+	if post.Hold != nil {
+		logHeld(u.logger, repository.TargetTypePost, post.ID, verdict)
+		return nil
+	}
+	saveMentions(ctx, u.mentions, u.logger, repository.TargetTypePost, post.ID, post.AuthorID, mentioned)
+	return nil
+}
 
-	return u.repo.CreatePost(ctx, post)
+// checkPost прогоняет заголовок и текст поста через фильтры; замаскированный текст
+// записывается обратно в пост
+func (u *PostUsecase) checkPost(ctx context.Context, post *entities.Post) (filter.Verdict, error) {
+	content := &filter.Content{Target: repository.TargetTypePost, AuthorID: post.AuthorID, Title: post.Title, Text: post.Content}
+	verdict, err := checkContent(ctx, u.filter, content)
+	if err != nil {
+		return verdict, err
+	}
+	post.Title, post.Content = content.Title, content.Text
+	return verdict, nil
 }
 
 func (u *PostUsecase) GetPostByID(ctx context.Context, id int64) (*entities.Post, error) {
@@ -154,76 +660,2516 @@ func (u *PostUsecase) GetPostByID(ctx context.Context, id int64) (*entities.Post
 }
 
 func (u *PostUsecase) UpdatePost(ctx context.Context, post *entities.Post) error {
+	tags, err := normalizeTags(post.Tags)
+	if err != nil {
+		return err
+	}
+	post.Tags = tags
+	verdict, err := u.checkPost(ctx, post)
+	if err != nil {
+		return err
+	}
+	var mentioned map[string]int64
+	if post.ContentHTML, mentioned, err = renderContent(ctx, u.renderer, u.mentions, post.Content); err != nil {
+		return err
+	}
+
 	u.logger.Info("обновление поста",
 		logger.NewField("post_id", post.ID))
-	return u.repo.UpdatePost(ctx, post)
+	// Задержанная правка опубликованного поста ждёт модератора, а читатели видят прежнюю версию
+	post.Hold = holdFor(verdict)
+	if err := u.repo.UpdatePost(ctx, post); err != nil {
+		return err
+	}
+	if post.Hold != nil {
+		logHeld(u.logger, repository.TargetTypePost, post.ID, verdict)
+		return nil
+	}
+	saveMentions(ctx, u.mentions, u.logger, repository.TargetTypePost, post.ID, post.AuthorID, mentioned)
+	return nil
+}
+
+// MaxDeleteReasonLength — максимальная длина причины удаления в символах
+const MaxDeleteReasonLength = 500
+
+func normalizeReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > MaxDeleteReasonLength {
+		return "", errors.ErrReasonTooLong
+	}
+	return reason, nil
 }
 
-func (u *PostUsecase) DeletePost(ctx context.Context, id int64) error {
+// trashPage приводит параметры страницы корзины к допустимым значениям
+func trashPage(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = repository.DefaultTrashLimit
+	}
+	if limit > repository.MaxTrashLimit {
+		limit = repository.MaxTrashLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// DeletePost переносит пост в корзину, откуда его можно восстановить до очистки
+func (u *PostUsecase) DeletePost(ctx context.Context, id, deletedBy int64, reason string) error {
+	reason, err := normalizeReason(reason)
+	if err != nil {
+		return err
+	}
+
 	u.logger.Info("удаление поста по ID",
-		logger.NewField("post_id", id))
-	return u.repo.DeletePost(ctx, id)
+		logger.NewField("post_id", id),
+		logger.NewField("deleted_by", deletedBy))
+	return u.repo.DeletePost(ctx, id, deletedBy, reason)
 }
 
-func (u *PostUsecase) Posts(ctx context.Context) ([]*entities.Post, error) {
-	return u.repo.Posts(ctx)
+func (u *PostUsecase) RestorePost(ctx context.Context, id int64) (*entities.Post, error) {
+	u.logger.Info("восстановление поста из корзины",
+		logger.NewField("post_id", id))
+	if err := u.repo.RestorePost(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.repo.GetPostByID(ctx, id)
 }
 
-type CommentUsecaseInterface interface {
-	CreateComment(ctx context.Context, comment *entities.Comment) error
-	GetCommentByID(ctx context.Context, id int64) (*entities.Comment, error)
-	UpdateComment(ctx context.Context, comment *entities.Comment) error
-	DeleteComment(ctx context.Context, id int64) error
-	GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error)
-	GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error)
+func (u *PostUsecase) DeletedPosts(ctx context.Context, limit, offset int) ([]*entities.Post, error) {
+	limit, offset = trashPage(limit, offset)
+	return u.repo.DeletedPosts(ctx, limit, offset)
 }
 
-type CommentUsecase struct {
-	repo   repository.CommentRepository
-	logger logger.Logger
+// PurgeDeleted окончательно удаляет посты, попавшие в корзину раньше before
+func (u *PostUsecase) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	return u.repo.PurgePosts(ctx, before)
 }
 
-func NewCommentUsecase(repo repository.CommentRepository, logger logger.Logger) *CommentUsecase {
-	return &CommentUsecase{
-		repo:   repo,
-		logger: logger,
+// Posts возвращает страницу ленты. Из репозитория запрашивается на одну запись
+// больше лимита: её наличие означает, что у ленты есть следующая страница.
+func (u *PostUsecase) Posts(ctx context.Context, filter entities.PostFilter) (*entities.PostPage, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = repository.DefaultPostsLimit
+	}
+	if filter.Limit > repository.MaxPostsLimit {
+		filter.Limit = repository.MaxPostsLimit
 	}
+	if filter.Tag != "" {
+		tag, err := normalizeTag(filter.Tag)
+		if err != nil {
+			return nil, err
+		}
+		filter.Tag = tag
+	}
+	switch filter.Sort {
+	case "":
+		filter.Sort = entities.PostSortNew
+	case entities.PostSortNew, entities.PostSortTop, entities.PostSortActive, entities.PostSortHot, entities.PostSortViews:
+	default:
+		return nil, errors.ErrInvalidSort
+	}
+	if filter.Window > 0 {
+		from := time.Now().Add(-filter.Window)
+		if filter.CreatedFrom == nil || filter.CreatedFrom.Before(from) {
+			filter.CreatedFrom = &from
+		}
+	}
+	limit := filter.Limit
+	filter.Limit++
+
+	posts, err := u.repo.Posts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &entities.PostPage{Posts: posts}
+	if len(posts) > limit {
+		page.Posts = posts[:limit]
+		last := page.Posts[limit-1]
+		page.NextCursor = entities.NewPostCursor(last, filter.Sort)
+	}
+	return page, nil
 }
 
-func (u *CommentUsecase) CreateComment(ctx context.Context, comment *entities.Comment) error {
-	u.logger.Info("создание нового комментария",
-		logger.NewField("comment_id", comment.ID))
-	return u.repo.CreateComment(ctx, comment)
+// Drafts возвращает черновики и запланированные посты автора
+func (u *PostUsecase) Drafts(ctx context.Context, authorID int64, limit, offset int) ([]*entities.Post, error) {
+	if limit <= 0 {
+		limit = repository.DefaultPostsLimit
+	}
+	if limit > repository.MaxPostsLimit {
+		limit = repository.MaxPostsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return u.repo.Drafts(ctx, authorID, limit, offset)
 }
 
-func (u *CommentUsecase) GetCommentByID(ctx context.Context, id int64) (*entities.Comment, error) {
-	u.logger.Info("получение комментария по ID",
-		logger.NewField("comment_id", id))
-	return u.repo.GetCommentByID(ctx, id)
+// PublishPost публикует черновик сразу или, если задано publishAt, планирует
+// публикацию на это время. Запланированный пост можно перепланировать или
+// опубликовать досрочно.
+func (u *PostUsecase) PublishPost(ctx context.Context, post *entities.Post, publishAt *time.Time) error {
+	if post.Status == entities.PostStatusHeld {
+		return errors.ErrPostHeld
+	}
+	if !post.Unpublished() {
+		return errors.ErrPostAlreadyPublished
+	}
+	status := entities.PostStatusPublished
+	if publishAt != nil {
+		if !publishAt.After(time.Now()) {
+			return errors.ErrInvalidPublishTime
+		}
+		status = entities.PostStatusScheduled
+	}
+
+	u.logger.Info("публикация поста",
+		logger.NewField("post_id", post.ID),
+		logger.NewField("status", status))
+	if err := u.repo.SetPostStatus(ctx, post.ID, status, publishAt); err != nil {
+		return err
+	}
+	post.Status = status
+	post.PublishAt = publishAt
+	if status == entities.PostStatusPublished {
+		post.CreatedAt = time.Now()
+	}
+	return nil
 }
 
-func (u *CommentUsecase) GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error) {
-	u.logger.Info("получение комментариев по ID поста",
-		logger.NewField("post_id", postID))
-	return u.repo.GetByPostID(ctx, postID)
+// PublishDue публикует запланированные посты, время которых наступило
+func (u *PostUsecase) PublishDue(ctx context.Context, now time.Time) (int64, error) {
+	return u.repo.PublishDue(ctx, now)
 }
 
-func (u *CommentUsecase) GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error) {
-	u.logger.Info("получение комментариев по ID пользователя",
-		logger.NewField("user_id", userID))
-	return u.repo.GetByUserID(ctx, userID)
+// PinPost закрепляет пост в начале ленты или снимает закрепление
+func (u *PostUsecase) PinPost(ctx context.Context, id int64, pinned bool) (*entities.Post, error) {
+	u.logger.Info("закрепление поста",
+		logger.NewField("post_id", id),
+		logger.NewField("pinned", pinned))
+	if err := u.repo.SetPostPinned(ctx, id, pinned); err != nil {
+		return nil, err
+	}
+	return u.repo.GetPostByID(ctx, id)
 }
 
-func (u *CommentUsecase) UpdateComment(ctx context.Context, comment *entities.Comment) error {
-	u.logger.Info("обновление комментария",
-		logger.NewField("comment_id", comment.ID))
-	return u.repo.UpdateComment(ctx, comment)
+// LockPost закрывает пост для новых комментариев или открывает снова
+func (u *PostUsecase) LockPost(ctx context.Context, id int64, locked bool) (*entities.Post, error) {
+	u.logger.Info("закрытие поста для комментариев",
+		logger.NewField("post_id", id),
+		logger.NewField("locked", locked))
+	if err := u.repo.SetPostLocked(ctx, id, locked); err != nil {
+		return nil, err
+	}
+	return u.repo.GetPostByID(ctx, id)
 }
 
-func (u *CommentUsecase) DeleteComment(ctx context.Context, commentId int64) error {
-	u.logger.Info("удаление комментария",
-		logger.NewField("comment_id", commentId))
+// RefreshHotRanks пересчитывает ранг hot для постов, опубликованных начиная с since
+func (u *PostUsecase) RefreshHotRanks(ctx context.Context, since time.Time) (int64, error) {
+	return u.repo.RefreshHotRanks(ctx, since)
+}
 
-	u.logger.Info("YA TUT")
-	return u.repo.DeleteComment(ctx, commentId)
+// HotRankService периодически пересчитывает ранг hot недавних постов,
+// чтобы он учитывал новые голоса и комментарии
+type HotRankService struct {
+	postUC  PostUsecaseInterface
+	logger  logger.Logger
+	ticker  *time.Ticker
+	done    chan bool
+	timeout time.Duration
+}
+
+func NewHotRankService(postUC PostUsecaseInterface, logger logger.Logger) *HotRankService {
+	return &HotRankService{
+		postUC:  postUC,
+		logger:  logger,
+		done:    make(chan bool),
+		timeout: time.Minute,
+	}
+}
+
+func (s *HotRankService) Start(interval, window time.Duration) {
+	s.ticker = time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-s.ticker.C:
+				if err := s.Refresh(window); err != nil {
+					s.logger.Error("ошибка пересчёта рангов постов",
+						logger.NewField("error", err))
+				}
+			case <-s.done:
+				s.ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (s *HotRankService) Stop() {
+	s.done <- true
+}
+
+// Refresh пересчитывает ранг постов, опубликованных за последний window
+func (s *HotRankService) Refresh(window time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	updated, err := s.postUC.RefreshHotRanks(ctx, time.Now().Add(-window))
+	if err != nil {
+		return err
+	}
+	s.logger.Debug("пересчитаны ранги постов",
+		logger.NewField("count", updated))
+	return nil
+}
+
+// ScheduledPublisher периодически публикует запланированные посты
+type ScheduledPublisher struct {
+	postUC  PostUsecaseInterface
+	logger  logger.Logger
+	ticker  *time.Ticker
+	done    chan bool
+	timeout time.Duration
+}
+
+func NewScheduledPublisher(postUC PostUsecaseInterface, logger logger.Logger) *ScheduledPublisher {
+	return &ScheduledPublisher{
+		postUC:  postUC,
+		logger:  logger,
+		done:    make(chan bool),
+		timeout: 30 * time.Second,
+	}
+}
+
+func (s *ScheduledPublisher) Start(interval time.Duration) {
+	s.ticker = time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-s.ticker.C:
+				if err := s.Publish(); err != nil {
+					s.logger.Error("ошибка публикации запланированных постов",
+						logger.NewField("error", err))
+				}
+			case <-s.done:
+				s.ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (s *ScheduledPublisher) Stop() {
+	s.done <- true
+}
+
+// Publish публикует посты, запланированные на текущий момент или раньше
+func (s *ScheduledPublisher) Publish() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	published, err := s.postUC.PublishDue(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if published > 0 {
+		s.logger.Info("опубликованы запланированные посты",
+			logger.NewField("count", published))
+	}
+	return nil
+}
+
+// ViewUsecaseInterface учитывает просмотры постов
+type ViewUsecaseInterface interface {
+	RecordView(postID int64, viewer string) bool
+}
+
+// viewKey — просмотр поста одним зрителем
+type viewKey struct {
+	postID int64
+	viewer string
+}
+
+// maxSeenViewers — сколько пар «пост, зритель» ViewCounter помнит одновременно.
+// Когда память заполнена свежими зрителями, новые просмотры учитываются без
+// запоминания, чтобы поток запросов с разных адресов не раздувал её.
+const maxSeenViewers = 100000
+
+// ViewCounter копит просмотры постов в памяти и периодически записывает их
+// в базу одним запросом, чтобы просмотр не стоил отдельного UPDATE. Повторные
+// просмотры одного зрителя в пределах окна не учитываются. Просмотры, накопленные
+// после последней записи, теряются только при аварийном завершении: Stop записывает их.
+type ViewCounter struct {
+	repo    repository.ViewRepository
+	window  time.Duration
+	logger  logger.Logger
+	ticker  *time.Ticker
+	done    chan bool
+	timeout time.Duration
+
+	mu      sync.Mutex
+	pending map[int64]int64       // приращения, ещё не записанные в базу
+	seen    map[viewKey]time.Time // когда зритель последний раз засчитан
+}
+
+// NewViewCounter создаёт счётчик; window — окно, в котором повторные просмотры
+// одного зрителя не учитываются
+func NewViewCounter(repo repository.ViewRepository, window time.Duration, logger logger.Logger) *ViewCounter {
+	return &ViewCounter{
+		repo:    repo,
+		window:  window,
+		logger:  logger,
+		done:    make(chan bool),
+		timeout: 30 * time.Second,
+		pending: make(map[int64]int64),
+		seen:    make(map[viewKey]time.Time),
+	}
+}
+
+// RecordView учитывает просмотр поста зрителем viewer (пользователь или IP) и
+// сообщает, засчитан ли он. Зритель без идентификатора засчитывается всегда.
+func (c *ViewCounter) RecordView(postID int64, viewer string) bool {
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if viewer != "" {
+		key := viewKey{postID: postID, viewer: viewer}
+		last, ok := c.seen[key]
+		if ok && now.Sub(last) < c.window {
+			return false
+		}
+		if !ok && len(c.seen) >= maxSeenViewers {
+			c.forgetExpired(now)
+		}
+		if ok || len(c.seen) < maxSeenViewers {
+			c.seen[key] = now
+		}
+	}
+	c.pending[postID]++
+	return true
+}
+
+// forgetExpired забывает зрителей, чьё окно истекло; вызывается под c.mu
+func (c *ViewCounter) forgetExpired(now time.Time) {
+	for key, last := range c.seen {
+		if now.Sub(last) >= c.window {
+			delete(c.seen, key)
+		}
+	}
+}
+
+func (c *ViewCounter) Start(interval time.Duration) {
+	c.ticker = time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-c.ticker.C:
+				if err := c.Flush(); err != nil {
+					c.logger.Error("ошибка записи просмотров",
+						logger.NewField("error", err))
+				}
+			case <-c.done:
+				c.ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+// Stop останавливает периодическую запись и записывает накопленные просмотры.
+// Без Start только записывает накопленное.
+func (c *ViewCounter) Stop() {
+	if c.ticker != nil {
+		c.done <- true
+	}
+	if err := c.Flush(); err != nil {
+		c.logger.Error("ошибка записи просмотров при остановке",
+			logger.NewField("error", err))
+	}
+}
+
+// Flush записывает накопленные просмотры и забывает зрителей, чьё окно истекло.
+// Если запись не удалась, просмотры остаются в буфере до следующей попытки.
+func (c *ViewCounter) Flush() error {
+	now := time.Now()
+
+	c.mu.Lock()
+	views := c.pending
+	c.pending = make(map[int64]int64)
+	c.forgetExpired(now)
+	c.mu.Unlock()
+
+	if len(views) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	if err := c.repo.AddViews(ctx, views); err != nil {
+		c.mu.Lock()
+		for postID, n := range views {
+			c.pending[postID] += n
+		}
+		c.mu.Unlock()
+		return err
+	}
+	c.logger.Debug("записаны просмотры постов",
+		logger.NewField("posts", len(views)))
+	return nil
+}
+
+type CategoryUsecaseInterface interface {
+	CreateCategory(ctx context.Context, category *entities.Category) error
+	GetCategoryByID(ctx context.Context, id int64) (*entities.Category, error)
+	UpdateCategory(ctx context.Context, category *entities.Category) error
+	DeleteCategory(ctx context.Context, id int64) error
+	Categories(ctx context.Context) ([]*entities.Category, error)
+}
+
+type CategoryUsecase struct {
+	repo   repository.CategoryRepository
+	logger logger.Logger
+}
+
+func NewCategoryUsecase(repo repository.CategoryRepository, logger logger.Logger) *CategoryUsecase {
+	return &CategoryUsecase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func validateCategory(category *entities.Category) error {
+	category.Slug = strings.ToLower(strings.TrimSpace(category.Slug))
+	category.Title = strings.TrimSpace(category.Title)
+	if !slugPattern.MatchString(category.Slug) {
+		return errors.ErrInvalidSlug
+	}
+	if category.Title == "" {
+		return errors.ErrEmptyTitle
+	}
+	return nil
+}
+
+func (u *CategoryUsecase) CreateCategory(ctx context.Context, category *entities.Category) error {
+	if err := validateCategory(category); err != nil {
+		return err
+	}
+
+	u.logger.Info("создание категории",
+		logger.NewField("slug", category.Slug))
+	return u.repo.CreateCategory(ctx, category)
+}
+
+func (u *CategoryUsecase) GetCategoryByID(ctx context.Context, id int64) (*entities.Category, error) {
+	return u.repo.GetCategoryByID(ctx, id)
+}
+
+func (u *CategoryUsecase) UpdateCategory(ctx context.Context, category *entities.Category) error {
+	if err := validateCategory(category); err != nil {
+		return err
+	}
+
+	u.logger.Info("обновление категории",
+		logger.NewField("category_id", category.ID))
+	return u.repo.UpdateCategory(ctx, category)
+}
+
+func (u *CategoryUsecase) DeleteCategory(ctx context.Context, id int64) error {
+	u.logger.Info("удаление категории",
+		logger.NewField("category_id", id))
+	return u.repo.DeleteCategory(ctx, id)
+}
+
+func (u *CategoryUsecase) Categories(ctx context.Context) ([]*entities.Category, error) {
+	return u.repo.Categories(ctx)
+}
+
+type CommentUsecaseInterface interface {
+	CreateComment(ctx context.Context, comment *entities.Comment) error
+	GetCommentByID(ctx context.Context, id int64) (*entities.Comment, error)
+	UpdateComment(ctx context.Context, comment *entities.Comment) error
+	DeleteComment(ctx context.Context, id, deletedBy int64, reason string) error
+	RestoreComment(ctx context.Context, id int64) (*entities.Comment, error)
+	DeletedComments(ctx context.Context, limit, offset int) ([]*entities.Comment, error)
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+	GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error)
+	GetTreeByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error)
+	GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error)
+}
+
+// MaxCommentDepth — максимальная вложенность ответов, у корневых комментариев глубина 0
+const MaxCommentDepth = 8
+
+// CommentNotifier узнаёт о новых комментариях, чтобы уведомить участников обсуждения
+type CommentNotifier interface {
+	CommentCreated(ctx context.Context, comment *entities.Comment) error
+}
+
+type CommentUsecase struct {
+	repo     repository.CommentRepository
+	postRepo repository.PostRepository
+	renderer ContentRenderer
+	mentions MentionUsecaseInterface
+	filter   ContentFilter
+	notifier CommentNotifier
+	logger   logger.Logger
+}
+
+// NewCommentUsecase создаёт usecase комментариев; mentions, filter и notifier могут быть nil,
+// тогда упоминания остаются текстом, комментарии не проверяются фильтрами, а уведомления не рассылаются
+func NewCommentUsecase(repo repository.CommentRepository, postRepo repository.PostRepository, renderer ContentRenderer, mentions MentionUsecaseInterface, filter ContentFilter, notifier CommentNotifier, logger logger.Logger) *CommentUsecase {
+	return &CommentUsecase{
+		repo:     repo,
+		postRepo: postRepo,
+		renderer: renderer,
+		mentions: mentions,
+		filter:   filter,
+		notifier: notifier,
+		logger:   logger,
+	}
+}
+
+// CreateComment создаёт комментарий. Закрытый пост не принимает комментарии,
+// ответ проверяется на принадлежность тому же посту и на предельную глубину ветки.
+func (u *CommentUsecase) CreateComment(ctx context.Context, comment *entities.Comment) error {
+	post, err := u.postRepo.GetPostByID(ctx, comment.PostID)
+	if err != nil {
+		return err
+	}
+	if post.Deleted || post.Unpublished() {
+		return errors.ErrPostNotFound
+	}
+	if post.IsLocked {
+		return errors.ErrPostLocked
+	}
+
+	comment.Depth = 0
+	if comment.ParentID != nil {
+		parent, err := u.repo.GetCommentByID(ctx, *comment.ParentID)
+		if stdErrors.Is(err, errors.ErrCommentNotFound) {
+			return errors.ErrParentNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if parent.PostID != comment.PostID {
+			return errors.ErrReplyPostMismatch
+		}
+		if parent.Deleted {
+			return errors.ErrReplyToDeleted
+		}
+		if parent.Depth >= MaxCommentDepth {
+			return errors.ErrCommentTooDeep
+		}
+		comment.Depth = parent.Depth + 1
+	}
+	verdict, err := u.checkComment(ctx, comment)
+	if err != nil {
+		return err
+	}
+	var mentioned map[string]int64
+	if comment.ContentHTML, mentioned, err = renderContent(ctx, u.renderer, u.mentions, comment.Content); err != nil {
+		return err
+	}
+
+	u.logger.Info("создание нового комментария",
+		logger.NewField("post_id", comment.PostID),
+		logger.NewField("depth", comment.Depth))
+	// Задержанный комментарий попадает только в очередь модерации: упоминания
+	// и уведомления отправляются после одобрения
+	comment.Hold = holdFor(verdict)
+	if err := u.repo.CreateComment(ctx, comment); err != nil {
+		return err
+	}
+	if comment.Hold != nil {
+		logHeld(u.logger, repository.TargetTypeComment, comment.ID, verdict)
+		return nil
+	}
+	saveMentions(ctx, u.mentions, u.logger, repository.TargetTypeComment, comment.ID, comment.AuthorID, mentioned)
+
+	// Комментарий уже сохранён: сбой уведомлений не должен превращаться в ошибку для автора
+	if u.notifier != nil {
+		if err := u.notifier.CommentCreated(ctx, comment); err != nil {
+			u.logger.Error("ошибка рассылки уведомлений о комментарии",
+				logger.NewField("comment_id", comment.ID),
+				logger.NewField("error", err))
+		}
+	}
+	return nil
+}
+
+// checkComment прогоняет текст комментария через фильтры; замаскированный текст
+// записывается обратно в комментарий
+func (u *CommentUsecase) checkComment(ctx context.Context, comment *entities.Comment) (filter.Verdict, error) {
+	content := &filter.Content{Target: repository.TargetTypeComment, AuthorID: comment.AuthorID, Text: comment.Content}
+	verdict, err := checkContent(ctx, u.filter, content)
+	if err != nil {
+		return verdict, err
+	}
+	comment.Content = content.Text
+	return verdict, nil
+}
+
+func (u *CommentUsecase) GetCommentByID(ctx context.Context, id int64) (*entities.Comment, error) {
+	u.logger.Info("получение комментария по ID",
+		logger.NewField("comment_id", id))
+	return u.repo.GetCommentByID(ctx, id)
+}
+
+func (u *CommentUsecase) GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error) {
+	u.logger.Info("получение комментариев по ID поста",
+		logger.NewField("post_id", postID))
+	return u.repo.GetByPostID(ctx, postID)
+}
+
+// GetTreeByPostID возвращает корневые комментарии поста с вложенными ответами
+func (u *CommentUsecase) GetTreeByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error) {
+	comments, err := u.repo.GetByPostID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	return buildCommentTree(comments), nil
+}
+
+// buildCommentTree раскладывает плоский список по родителям. Порядок ответов
+// внутри ветки сохраняется, поэтому список должен идти от родителей к детям.
+func buildCommentTree(comments []*entities.Comment) []*entities.Comment {
+	byID := make(map[int64]*entities.Comment, len(comments))
+	roots := make([]*entities.Comment, 0)
+	for _, comment := range comments {
+		byID[comment.ID] = comment
+		if comment.ParentID == nil {
+			roots = append(roots, comment)
+			continue
+		}
+		if parent, ok := byID[*comment.ParentID]; ok {
+			parent.Replies = append(parent.Replies, comment)
+		}
+	}
+	return roots
+}
+
+func (u *CommentUsecase) GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error) {
+	u.logger.Info("получение комментариев по ID пользователя",
+		logger.NewField("user_id", userID))
+	return u.repo.GetByUserID(ctx, userID)
+}
+
+func (u *CommentUsecase) UpdateComment(ctx context.Context, comment *entities.Comment) error {
+	var (
+		mentioned map[string]int64
+		err       error
+	)
+	verdict, err := u.checkComment(ctx, comment)
+	if err != nil {
+		return err
+	}
+	if comment.ContentHTML, mentioned, err = renderContent(ctx, u.renderer, u.mentions, comment.Content); err != nil {
+		return err
+	}
+	u.logger.Info("обновление комментария",
+		logger.NewField("comment_id", comment.ID))
+	comment.Hold = holdFor(verdict)
+	if err := u.repo.UpdateComment(ctx, comment); err != nil {
+		return err
+	}
+	if comment.Hold != nil {
+		logHeld(u.logger, repository.TargetTypeComment, comment.ID, verdict)
+		return nil
+	}
+	saveMentions(ctx, u.mentions, u.logger, repository.TargetTypeComment, comment.ID, comment.AuthorID, mentioned)
+	return nil
+}
+
+// DeleteComment переносит комментарий в корзину, откуда его можно восстановить до очистки
+func (u *CommentUsecase) DeleteComment(ctx context.Context, commentId, deletedBy int64, reason string) error {
+	reason, err := normalizeReason(reason)
+	if err != nil {
+		return err
+	}
+
+	u.logger.Info("удаление комментария",
+		logger.NewField("comment_id", commentId),
+		logger.NewField("deleted_by", deletedBy))
+	return u.repo.DeleteComment(ctx, commentId, deletedBy, reason)
+}
+
+func (u *CommentUsecase) RestoreComment(ctx context.Context, id int64) (*entities.Comment, error) {
+	u.logger.Info("восстановление комментария из корзины",
+		logger.NewField("comment_id", id))
+	if err := u.repo.RestoreComment(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.repo.GetCommentByID(ctx, id)
+}
+
+func (u *CommentUsecase) DeletedComments(ctx context.Context, limit, offset int) ([]*entities.Comment, error) {
+	limit, offset = trashPage(limit, offset)
+	return u.repo.DeletedComments(ctx, limit, offset)
+}
+
+// PurgeDeleted окончательно удаляет комментарии, попавшие в корзину раньше before
+func (u *CommentUsecase) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	return u.repo.PurgeComments(ctx, before)
+}
+
+type VoteUsecaseInterface interface {
+	Vote(ctx context.Context, vote *entities.Vote) (int64, error)
+	RemoveVote(ctx context.Context, userID int64, targetType string, targetID int64) (int64, error)
+	UserVotes(ctx context.Context, userID int64, targetType string, targetIDs []int64) (map[int64]int32, error)
+}
+
+type VoteUsecase struct {
+	repo        repository.VoteRepository
+	postRepo    repository.PostRepository
+	commentRepo repository.CommentRepository
+	logger      logger.Logger
+}
+
+func NewVoteUsecase(repo repository.VoteRepository, postRepo repository.PostRepository, commentRepo repository.CommentRepository, logger logger.Logger) *VoteUsecase {
+	return &VoteUsecase{
+		repo:        repo,
+		postRepo:    postRepo,
+		commentRepo: commentRepo,
+		logger:      logger,
+	}
+}
+
+// targetAuthor возвращает автора поста или комментария, за который голосуют
+func (u *VoteUsecase) targetAuthor(ctx context.Context, targetType string, targetID int64) (int64, error) {
+	switch targetType {
+	case repository.TargetTypePost:
+		post, err := u.postRepo.GetPostByID(ctx, targetID)
+		if err != nil {
+			return 0, err
+		}
+		if post.Deleted || post.Unpublished() {
+			return 0, errors.ErrPostNotFound
+		}
+		return post.AuthorID, nil
+	case repository.TargetTypeComment:
+		comment, err := u.commentRepo.GetCommentByID(ctx, targetID)
+		if err != nil {
+			return 0, err
+		}
+		if comment.Deleted {
+			return 0, errors.ErrCommentNotFound
+		}
+		return comment.AuthorID, nil
+	default:
+		return 0, errors.ErrInvalidVote
+	}
+}
+
+// Vote ставит голос и возвращает новый рейтинг. За свой контент голосовать нельзя.
+func (u *VoteUsecase) Vote(ctx context.Context, vote *entities.Vote) (int64, error) {
+	if vote.TargetID == 0 {
+		return 0, errors.ErrEmptyTargetID
+	}
+	if vote.Value != 1 && vote.Value != -1 {
+		return 0, errors.ErrInvalidVote
+	}
+
+	authorID, err := u.targetAuthor(ctx, vote.TargetType, vote.TargetID)
+	if err != nil {
+		return 0, err
+	}
+	if authorID == vote.UserID {
+		return 0, errors.ErrSelfVote
+	}
+
+	u.logger.Info("голосование",
+		logger.NewField("user_id", vote.UserID),
+		logger.NewField("target_type", vote.TargetType),
+		logger.NewField("target_id", vote.TargetID),
+		logger.NewField("value", vote.Value))
+	return u.repo.Vote(ctx, vote)
+}
+
+func (u *VoteUsecase) RemoveVote(ctx context.Context, userID int64, targetType string, targetID int64) (int64, error) {
+	if targetID == 0 {
+		return 0, errors.ErrEmptyTargetID
+	}
+	if targetType != repository.TargetTypePost && targetType != repository.TargetTypeComment {
+		return 0, errors.ErrInvalidVote
+	}
+
+	u.logger.Info("снятие голоса",
+		logger.NewField("user_id", userID),
+		logger.NewField("target_type", targetType),
+		logger.NewField("target_id", targetID))
+	return u.repo.RemoveVote(ctx, userID, targetType, targetID)
+}
+
+// UserVotes возвращает голоса пользователя за цели; для анонима — пустой результат без запроса в БД
+func (u *VoteUsecase) UserVotes(ctx context.Context, userID int64, targetType string, targetIDs []int64) (map[int64]int32, error) {
+	if userID == 0 || len(targetIDs) == 0 {
+		return map[int64]int32{}, nil
+	}
+	return u.repo.UserVotes(ctx, userID, targetType, targetIDs)
+}
+
+type PollUsecaseInterface interface {
+	CastVote(ctx context.Context, postID, userID int64, username string, optionIDs []int64) (*entities.Poll, error)
+	Results(ctx context.Context, postID, viewerID int64) (*entities.Poll, error)
+	Polls(ctx context.Context, postIDs []int64, viewerID int64) (map[int64]*entities.Poll, error)
+}
+
+type PollUsecase struct {
+	repo     repository.PollRepository
+	postRepo repository.PostRepository
+	logger   logger.Logger
+}
+
+func NewPollUsecase(repo repository.PollRepository, postRepo repository.PostRepository, logger logger.Logger) *PollUsecase {
+	return &PollUsecase{
+		repo:     repo,
+		postRepo: postRepo,
+		logger:   logger,
+	}
+}
+
+// CastVote записывает голос пользователя и возвращает обновлённые результаты.
+// Переголосовать нельзя; в закрытом опросе голос отклоняется с errors.ErrPollClosed.
+func (u *PollUsecase) CastVote(ctx context.Context, postID, userID int64, username string, optionIDs []int64) (*entities.Poll, error) {
+	choices := slices.Compact(slices.Sorted(slices.Values(optionIDs)))
+	if len(choices) == 0 {
+		return nil, errors.ErrInvalidPollChoice
+	}
+	if err := u.repo.CastPollVote(ctx, postID, userID, username, choices); err != nil {
+		return nil, err
+	}
+
+	u.logger.Info("голос в опросе",
+		logger.NewField("post_id", postID),
+		logger.NewField("user_id", userID))
+	return u.Results(ctx, postID, userID)
+}
+
+// Results возвращает результаты опроса поста; в открытом опросе — вместе со списком проголосовавших
+func (u *PollUsecase) Results(ctx context.Context, postID, viewerID int64) (*entities.Poll, error) {
+	post, err := u.postRepo.GetPostByID(ctx, postID)
+	if stdErrors.Is(err, errors.ErrPostNotFound) {
+		return nil, errors.ErrPollNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if post.Deleted || (post.Unpublished() && post.AuthorID != viewerID) {
+		return nil, errors.ErrPollNotFound
+	}
+
+	polls, err := u.repo.Polls(ctx, []int64{postID}, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	poll, ok := polls[postID]
+	if !ok {
+		return nil, errors.ErrPollNotFound
+	}
+	if poll.Public {
+		voters, err := u.repo.PollVoters(ctx, postID)
+		if err != nil {
+			return nil, err
+		}
+		for _, option := range poll.Options {
+			option.Voters = voters[option.ID]
+		}
+	}
+	return poll, nil
+}
+
+// Polls возвращает опросы постов без списков проголосовавших, для выдачи постов
+func (u *PollUsecase) Polls(ctx context.Context, postIDs []int64, viewerID int64) (map[int64]*entities.Poll, error) {
+	return u.repo.Polls(ctx, postIDs, viewerID)
+}
+
+type BookmarkUsecaseInterface interface {
+	AddBookmark(ctx context.Context, bookmark *entities.Bookmark) error
+	RemoveBookmark(ctx context.Context, userID, postID int64) error
+	Bookmarks(ctx context.Context, userID int64, limit, offset int) ([]*entities.Bookmark, error)
+	Bookmarked(ctx context.Context, userID int64, postIDs []int64) (map[int64]bool, error)
+}
+
+// MaxBookmarkNoteLength — максимальная длина личной заметки к закладке в символах
+const MaxBookmarkNoteLength = 1000
+
+type BookmarkUsecase struct {
+	repo     repository.BookmarkRepository
+	postRepo repository.PostRepository
+	logger   logger.Logger
+}
+
+func NewBookmarkUsecase(repo repository.BookmarkRepository, postRepo repository.PostRepository, logger logger.Logger) *BookmarkUsecase {
+	return &BookmarkUsecase{
+		repo:     repo,
+		postRepo: postRepo,
+		logger:   logger,
+	}
+}
+
+// AddBookmark добавляет опубликованный пост в закладки. Повторный вызов
+// не создаёт дубликат, а заменяет заметку.
+func (u *BookmarkUsecase) AddBookmark(ctx context.Context, bookmark *entities.Bookmark) error {
+	bookmark.Note = strings.TrimSpace(bookmark.Note)
+	if utf8.RuneCountInString(bookmark.Note) > MaxBookmarkNoteLength {
+		return errors.ErrNoteTooLong
+	}
+
+	post, err := u.postRepo.GetPostByID(ctx, bookmark.PostID)
+	if err != nil {
+		return err
+	}
+	if post.Deleted || post.Unpublished() {
+		return errors.ErrPostNotFound
+	}
+
+	if err := u.repo.AddBookmark(ctx, bookmark); err != nil {
+		return err
+	}
+	bookmark.Post = post
+	return nil
+}
+
+func (u *BookmarkUsecase) RemoveBookmark(ctx context.Context, userID, postID int64) error {
+	return u.repo.RemoveBookmark(ctx, userID, postID)
+}
+
+func (u *BookmarkUsecase) Bookmarks(ctx context.Context, userID int64, limit, offset int) ([]*entities.Bookmark, error) {
+	if limit <= 0 {
+		limit = repository.DefaultPostsLimit
+	}
+	if limit > repository.MaxPostsLimit {
+		limit = repository.MaxPostsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return u.repo.Bookmarks(ctx, userID, limit, offset)
+}
+
+// Bookmarked возвращает, какие из постов в закладках пользователя; для анонима — пустой результат без запроса в БД
+func (u *BookmarkUsecase) Bookmarked(ctx context.Context, userID int64, postIDs []int64) (map[int64]bool, error) {
+	if userID == 0 || len(postIDs) == 0 {
+		return map[int64]bool{}, nil
+	}
+	return u.repo.BookmarkedPosts(ctx, userID, postIDs)
+}
+
+type NotificationUsecaseInterface interface {
+	Follow(ctx context.Context, userID, postID int64) error
+	Unfollow(ctx context.Context, userID, postID int64) error
+	Notifications(ctx context.Context, userID int64, unreadOnly bool, limit, offset int) ([]*entities.Notification, error)
+	UnreadCount(ctx context.Context, userID int64) (int64, error)
+	MarkRead(ctx context.Context, userID int64, ids []int64) error
+	MarkAllRead(ctx context.Context, userID int64) error
+}
+
+type NotificationUsecase struct {
+	repo     repository.NotificationRepository
+	postRepo repository.PostRepository
+	logger   logger.Logger
+}
+
+func NewNotificationUsecase(repo repository.NotificationRepository, postRepo repository.PostRepository, logger logger.Logger) *NotificationUsecase {
+	return &NotificationUsecase{
+		repo:     repo,
+		postRepo: postRepo,
+		logger:   logger,
+	}
+}
+
+// livePost проверяет, что на обсуждение поста можно подписаться
+func (u *NotificationUsecase) livePost(ctx context.Context, postID int64) error {
+	post, err := u.postRepo.GetPostByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if post.Deleted || post.Unpublished() {
+		return errors.ErrPostNotFound
+	}
+	return nil
+}
+
+// Follow подписывает пользователя на новые комментарии к посту
+func (u *NotificationUsecase) Follow(ctx context.Context, userID, postID int64) error {
+	if err := u.livePost(ctx, postID); err != nil {
+		return err
+	}
+	return u.repo.SetSubscription(ctx, userID, postID, true)
+}
+
+// Unfollow отписывает пользователя от обсуждения. Отписка сохраняется:
+// новые комментарии пользователя в этом посте не подпишут его снова.
+func (u *NotificationUsecase) Unfollow(ctx context.Context, userID, postID int64) error {
+	if err := u.livePost(ctx, postID); err != nil {
+		return err
+	}
+	return u.repo.SetSubscription(ctx, userID, postID, false)
+}
+
+// CommentCreated реализует CommentNotifier
+func (u *NotificationUsecase) CommentCreated(ctx context.Context, comment *entities.Comment) error {
+	notified, err := u.repo.NotifyComment(ctx, comment)
+	if err != nil {
+		return err
+	}
+	u.logger.Debug("уведомления о комментарии",
+		logger.NewField("comment_id", comment.ID),
+		logger.NewField("notified", notified))
+	return nil
+}
+
+func (u *NotificationUsecase) Notifications(ctx context.Context, userID int64, unreadOnly bool, limit, offset int) ([]*entities.Notification, error) {
+	if limit <= 0 {
+		limit = repository.DefaultPostsLimit
+	}
+	if limit > repository.MaxPostsLimit {
+		limit = repository.MaxPostsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return u.repo.Notifications(ctx, userID, unreadOnly, limit, offset)
+}
+
+func (u *NotificationUsecase) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	return u.repo.UnreadCount(ctx, userID)
+}
+
+// MarkRead отмечает уведомления прочитанными; после этого новые события
+// по тем же постам начнут собираться в новые уведомления
+func (u *NotificationUsecase) MarkRead(ctx context.Context, userID int64, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := u.repo.MarkRead(ctx, userID, ids)
+	return err
+}
+
+func (u *NotificationUsecase) MarkAllRead(ctx context.Context, userID int64) error {
+	_, err := u.repo.MarkAllRead(ctx, userID)
+	return err
+}
+
+// MaxReportDetailsLength — предельная длина описания жалобы и комментария администратора
+const MaxReportDetailsLength = 1000
+
+// reportReasons — допустимые причины жалоб
+var reportReasons = []string{
+	entities.ReportReasonSpam,
+	entities.ReportReasonAbuse,
+	entities.ReportReasonOffTopic,
+	entities.ReportReasonIllegal,
+	entities.ReportReasonOther,
+}
+
+type ReportUsecaseInterface interface {
+	Report(ctx context.Context, report *entities.Report) error
+	Reports(ctx context.Context, filter entities.ReportFilter) ([]*entities.ReportGroup, error)
+	Resolve(ctx context.Context, reportID int64, status string, resolvedBy int64, note string) (int64, error)
+	Warnings(ctx context.Context, userID int64, limit, offset int) ([]*entities.Warning, error)
+}
+
+type ReportUsecase struct {
+	repo        repository.ReportRepository
+	postRepo    repository.PostRepository
+	commentRepo repository.CommentRepository
+	chatRepo    repository.ChatRepository
+	logger      logger.Logger
+}
+
+func NewReportUsecase(repo repository.ReportRepository, postRepo repository.PostRepository, commentRepo repository.CommentRepository, chatRepo repository.ChatRepository, logger logger.Logger) *ReportUsecase {
+	return &ReportUsecase{
+		repo:        repo,
+		postRepo:    postRepo,
+		commentRepo: commentRepo,
+		chatRepo:    chatRepo,
+		logger:      logger,
+	}
+}
+
+// normalizeReportText обрезает пробелы и проверяет длину описания жалобы
+func normalizeReportText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) > MaxReportDetailsLength {
+		return "", errors.ErrReportDetailsTooLong
+	}
+	return text, nil
+}
+
+// Report сохраняет жалобу вместе с автором и текстом цели на момент жалобы.
+// Пожаловаться можно только на видимый контент.
+func (u *ReportUsecase) Report(ctx context.Context, report *entities.Report) error {
+	if !slices.Contains(reportReasons, report.Reason) {
+		return errors.ErrInvalidReportReason
+	}
+	details, err := normalizeReportText(report.Details)
+	if err != nil {
+		return err
+	}
+	if details == "" && report.Reason == entities.ReportReasonOther {
+		return errors.ErrReportDetailsMissing
+	}
+	report.Details = details
+
+	switch report.TargetType {
+	case repository.TargetTypePost:
+		post, err := u.postRepo.GetPostByID(ctx, report.TargetID)
+		if err != nil {
+			return err
+		}
+		if post.Deleted || post.Unpublished() {
+			return errors.ErrPostNotFound
+		}
+		report.TargetAuthorID = post.AuthorID
+		report.TargetContent = post.Title + "\n\n" + post.Content
+	case repository.TargetTypeComment:
+		comment, err := u.commentRepo.GetCommentByID(ctx, report.TargetID)
+		if err != nil {
+			return err
+		}
+		if comment.Deleted {
+			return errors.ErrCommentNotFound
+		}
+		report.TargetAuthorID = comment.AuthorID
+		report.TargetContent = comment.Content
+	case repository.TargetTypeChat:
+		msg, err := u.chatRepo.GetMessage(ctx, report.TargetID)
+		if err != nil {
+			return err
+		}
+		report.TargetAuthorID = msg.UserID
+		report.TargetContent = msg.Content
+	default:
+		return errors.ErrInvalidReportTarget
+	}
+
+	if err := u.repo.CreateReport(ctx, report); err != nil {
+		return err
+	}
+	u.logger.Info("жалоба на контент",
+		logger.NewField("report_id", report.ID),
+		logger.NewField("target_type", report.TargetType),
+		logger.NewField("target_id", report.TargetID),
+		logger.NewField("reason", report.Reason))
+	return nil
+}
+
+// Reports возвращает очередь жалоб, сгруппированных по целям; по умолчанию — открытые
+func (u *ReportUsecase) Reports(ctx context.Context, filter entities.ReportFilter) ([]*entities.ReportGroup, error) {
+	if filter.Status == "" {
+		filter.Status = entities.ReportStatusOpen
+	}
+	switch filter.Status {
+	case entities.ReportStatusOpen, entities.ReportStatusDismissed,
+		entities.ReportStatusRemoved, entities.ReportStatusWarned:
+	default:
+		return nil, errors.ErrInvalidReportStatus
+	}
+	filter.Limit, filter.Offset = trashPage(filter.Limit, filter.Offset)
+	return u.repo.ReportGroups(ctx, filter)
+}
+
+// Resolve рассматривает жалобу: status — итог рассмотрения. Итог применяется
+// ко всем открытым жалобам на ту же цель. Для entities.ReportStatusRemoved
+// контент удаляется (посты и комментарии — в корзину), для
+// entities.ReportStatusWarned автор получает предупреждение.
+// Возвращает число закрытых жалоб.
+func (u *ReportUsecase) Resolve(ctx context.Context, reportID int64, status string, resolvedBy int64, note string) (int64, error) {
+	switch status {
+	case entities.ReportStatusDismissed, entities.ReportStatusRemoved, entities.ReportStatusWarned:
+	default:
+		return 0, errors.ErrInvalidReportStatus
+	}
+	note, err := normalizeReportText(note)
+	if err != nil {
+		return 0, err
+	}
+
+	report, err := u.repo.GetReport(ctx, reportID)
+	if err != nil {
+		return 0, err
+	}
+	if report.Status != entities.ReportStatusOpen {
+		return 0, errors.ErrReportResolved
+	}
+
+	var deleteReason string
+	if status == entities.ReportStatusRemoved {
+		if deleteReason, err = removalReason(report, note); err != nil {
+			return 0, err
+		}
+	}
+
+	// Контент удаляется в одной транзакции с закрытием жалоб и только если
+	// их ещё никто не закрыл
+	resolved, err := u.repo.ResolveReports(ctx, report, status, resolvedBy, note, deleteReason)
+	if err != nil {
+		return 0, err
+	}
+	u.logger.Info("жалобы рассмотрены",
+		logger.NewField("report_id", reportID),
+		logger.NewField("target_type", report.TargetType),
+		logger.NewField("target_id", report.TargetID),
+		logger.NewField("status", status),
+		logger.NewField("resolved", resolved),
+		logger.NewField("resolved_by", resolvedBy))
+	return resolved, nil
+}
+
+// removalReason — причина удаления контента по жалобе: заметка модератора
+// или, без неё, причина жалобы
+func removalReason(report *entities.Report, note string) (string, error) {
+	if note == "" {
+		note = "жалоба: " + report.Reason
+	}
+	return normalizeReason(note)
+}
+
+// Warnings возвращает предупреждения пользователя, новые первыми
+func (u *ReportUsecase) Warnings(ctx context.Context, userID int64, limit, offset int) ([]*entities.Warning, error) {
+	limit, offset = trashPage(limit, offset)
+	return u.repo.Warnings(ctx, userID, limit, offset)
+}
+
+// ModerationUsecaseInterface — очередь текстов, задержанных фильтрами
+type ModerationUsecaseInterface interface {
+	Held(ctx context.Context, limit, offset int) ([]*entities.HeldContent, error)
+	Approve(ctx context.Context, id, moderatorID int64) (*entities.HeldContent, error)
+	Reject(ctx context.Context, id, moderatorID int64, reason string) (*entities.HeldContent, error)
+}
+
+type ModerationUsecase struct {
+	repo     repository.HeldRepository
+	renderer ContentRenderer
+	mentions MentionUsecaseInterface
+	notifier CommentNotifier
+	logger   logger.Logger
+}
+
+// NewModerationUsecase создаёт usecase очереди модерации; mentions и notifier могут быть nil,
+// тогда упоминания в одобренных текстах не сохраняются, а уведомления не рассылаются
+func NewModerationUsecase(repo repository.HeldRepository, renderer ContentRenderer, mentions MentionUsecaseInterface, notifier CommentNotifier, logger logger.Logger) *ModerationUsecase {
+	return &ModerationUsecase{
+		repo:     repo,
+		renderer: renderer,
+		mentions: mentions,
+		notifier: notifier,
+		logger:   logger,
+	}
+}
+
+// Held возвращает очередь модерации, давно ждущие первыми
+func (u *ModerationUsecase) Held(ctx context.Context, limit, offset int) ([]*entities.HeldContent, error) {
+	limit, offset = trashPage(limit, offset)
+	return u.repo.HeldContent(ctx, limit, offset)
+}
+
+// Approve публикует задержанный текст. Упоминания и уведомления о новом
+// комментарии, отложенные при задержке, отправляются сейчас; их сбой только
+// логируется, потому что текст уже опубликован.
+func (u *ModerationUsecase) Approve(ctx context.Context, id, moderatorID int64) (*entities.HeldContent, error) {
+	held, err := u.repo.ApproveHeld(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Info("задержанный текст одобрен",
+		logger.NewField("held_id", id),
+		logger.NewField("target_type", held.TargetType),
+		logger.NewField("target_id", held.TargetID),
+		logger.NewField("moderator_id", moderatorID))
+
+	if u.mentions != nil {
+		_, mentioned, err := renderContent(ctx, u.renderer, u.mentions, held.Content)
+		if err != nil {
+			u.logger.Error("ошибка поиска упоминаний в одобренном тексте",
+				logger.NewField("held_id", id),
+				logger.NewField("error", err))
+		} else {
+			saveMentions(ctx, u.mentions, u.logger, held.TargetType, held.TargetID, held.AuthorID, mentioned)
+		}
+	}
+	if held.TargetType == repository.TargetTypeComment && !held.IsEdit && u.notifier != nil {
+		comment := &entities.Comment{
+			ID:         held.TargetID,
+			PostID:     held.PostID,
+			ParentID:   held.ParentID,
+			Depth:      held.Depth,
+			AuthorID:   held.AuthorID,
+			AuthorName: held.AuthorName,
+			Content:    held.Content,
+			CreatedAt:  time.Now(),
+		}
+		if err := u.notifier.CommentCreated(ctx, comment); err != nil {
+			u.logger.Error("ошибка рассылки уведомлений о комментарии",
+				logger.NewField("comment_id", comment.ID),
+				logger.NewField("error", err))
+		}
+	}
+	return held, nil
+}
+
+// Reject отклоняет задержанный текст. Новый пост уходит в корзину с причиной
+// reason или, без неё, с пометкой об отказе модератора.
+func (u *ModerationUsecase) Reject(ctx context.Context, id, moderatorID int64, reason string) (*entities.HeldContent, error) {
+	reason, err := normalizeReason(reason)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		reason = "отклонено модератором"
+	}
+	held, err := u.repo.RejectHeld(ctx, id, moderatorID, reason)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Info("задержанный текст отклонён",
+		logger.NewField("held_id", id),
+		logger.NewField("target_type", held.TargetType),
+		logger.NewField("target_id", held.TargetID),
+		logger.NewField("moderator_id", moderatorID))
+	return held, nil
+}
+
+type RevisionUsecaseInterface interface {
+	PostRevisions(ctx context.Context, postID int64) ([]*entities.Revision, error)
+	CommentRevisions(ctx context.Context, commentID int64) ([]*entities.Revision, error)
+	RollbackPost(ctx context.Context, postID, revisionID, editorID int64) (*entities.Post, error)
+	RollbackComment(ctx context.Context, commentID, revisionID, editorID int64) (*entities.Comment, error)
+}
+
+type RevisionUsecase struct {
+	repo        repository.RevisionRepository
+	postRepo    repository.PostRepository
+	commentRepo repository.CommentRepository
+	renderer    ContentRenderer
+	mentions    MentionUsecaseInterface
+	logger      logger.Logger
+}
+
+func NewRevisionUsecase(repo repository.RevisionRepository, postRepo repository.PostRepository, commentRepo repository.CommentRepository, renderer ContentRenderer, mentions MentionUsecaseInterface, logger logger.Logger) *RevisionUsecase {
+	return &RevisionUsecase{
+		repo:        repo,
+		postRepo:    postRepo,
+		commentRepo: commentRepo,
+		renderer:    renderer,
+		mentions:    mentions,
+		logger:      logger,
+	}
+}
+
+func (u *RevisionUsecase) PostRevisions(ctx context.Context, postID int64) ([]*entities.Revision, error) {
+	return u.revisions(ctx, repository.TargetTypePost, postID)
+}
+
+func (u *RevisionUsecase) CommentRevisions(ctx context.Context, commentID int64) ([]*entities.Revision, error) {
+	return u.revisions(ctx, repository.TargetTypeComment, commentID)
+}
+
+// revisions загружает историю и считает разницу каждой версии с предыдущей
+func (u *RevisionUsecase) revisions(ctx context.Context, targetType string, targetID int64) ([]*entities.Revision, error) {
+	revisions, err := u.repo.Revisions(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(revisions); i++ {
+		revisions[i].Diff = diff.Lines(revisions[i-1].Content, revisions[i].Content)
+	}
+	return revisions, nil
+}
+
+// revision возвращает версию, только если она относится к указанной цели
+func (u *RevisionUsecase) revision(ctx context.Context, targetType string, targetID, revisionID int64) (*entities.Revision, error) {
+	rev, err := u.repo.GetRevision(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if rev.TargetType != targetType || rev.TargetID != targetID {
+		return nil, errors.ErrRevisionNotFound
+	}
+	return rev, nil
+}
+
+// RollbackPost возвращает посту заголовок и текст выбранной версии. Откат —
+// обычная правка, поэтому он сам попадает в историю, и её ничто не стирает.
+func (u *RevisionUsecase) RollbackPost(ctx context.Context, postID, revisionID, editorID int64) (*entities.Post, error) {
+	rev, err := u.revision(ctx, repository.TargetTypePost, postID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	post, err := u.postRepo.GetPostByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	// Удалённый пост сначала восстанавливается из корзины, откат его не возвращает
+	if post.Deleted {
+		return nil, errors.ErrPostNotFound
+	}
+
+	u.logger.Info("откат поста к версии",
+		logger.NewField("post_id", postID),
+		logger.NewField("revision_id", revisionID),
+		logger.NewField("editor_id", editorID))
+
+	contentHTML, mentioned, err := renderContent(ctx, u.renderer, u.mentions, rev.Content)
+	if err != nil {
+		return nil, err
+	}
+	tags := post.Tags
+	post.Title = rev.Title
+	post.Content = rev.Content
+	post.ContentHTML = contentHTML
+	post.EditorID = editorID
+	post.Tags = nil
+	// Откат не отменяет правку автора, которая ждёт модератора
+	post.KeepHeldEdit = true
+	if err := u.postRepo.UpdatePost(ctx, post); err != nil {
+		return nil, err
+	}
+	post.Tags = tags
+	saveMentions(ctx, u.mentions, u.logger, repository.TargetTypePost, post.ID, post.AuthorID, mentioned)
+	return post, nil
+}
+
+func (u *RevisionUsecase) RollbackComment(ctx context.Context, commentID, revisionID, editorID int64) (*entities.Comment, error) {
+	rev, err := u.revision(ctx, repository.TargetTypeComment, commentID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := u.commentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Deleted {
+		return nil, errors.ErrCommentNotFound
+	}
+
+	u.logger.Info("откат комментария к версии",
+		logger.NewField("comment_id", commentID),
+		logger.NewField("revision_id", revisionID),
+		logger.NewField("editor_id", editorID))
+
+	contentHTML, mentioned, err := renderContent(ctx, u.renderer, u.mentions, rev.Content)
+	if err != nil {
+		return nil, err
+	}
+	comment.Content = rev.Content
+	comment.ContentHTML = contentHTML
+	comment.EditorID = editorID
+	comment.KeepHeldEdit = true
+	if err := u.commentRepo.UpdateComment(ctx, comment); err != nil {
+		return nil, err
+	}
+	saveMentions(ctx, u.mentions, u.logger, repository.TargetTypeComment, comment.ID, comment.AuthorID, mentioned)
+	return comment, nil
+}
+
+type SearchUsecaseInterface interface {
+	Search(ctx context.Context, q entities.SearchQuery) (*entities.SearchResult, error)
+}
+
+type SearchUsecase struct {
+	postRepo    repository.PostRepository
+	commentRepo repository.CommentRepository
+	logger      logger.Logger
+}
+
+func NewSearchUsecase(postRepo repository.PostRepository, commentRepo repository.CommentRepository, logger logger.Logger) *SearchUsecase {
+	return &SearchUsecase{
+		postRepo:    postRepo,
+		commentRepo: commentRepo,
+		logger:      logger,
+	}
+}
+
+// Search ищет по постам и комментариям и сливает обе выдачи по релевантности.
+// Для страницы [offset, offset+limit) из каждого источника берутся первые
+// offset+limit совпадений, поэтому глубина листания ограничена MaxSearchOffset.
+func (u *SearchUsecase) Search(ctx context.Context, q entities.SearchQuery) (*entities.SearchResult, error) {
+	q.Text = strings.TrimSpace(q.Text)
+	if q.Text == "" {
+		return nil, errors.ErrEmptySearchQuery
+	}
+	if q.Offset < 0 || q.Offset > repository.MaxSearchOffset {
+		return nil, errors.ErrSearchTooDeep
+	}
+	if q.Limit <= 0 {
+		q.Limit = repository.DefaultSearchLimit
+	}
+	if q.Limit > repository.MaxSearchLimit {
+		q.Limit = repository.MaxSearchLimit
+	}
+
+	u.logger.Info("полнотекстовый поиск",
+		logger.NewField("query", q.Text),
+		logger.NewField("offset", q.Offset))
+
+	window := q
+	window.Limit = q.Offset + q.Limit
+	window.Offset = 0
+
+	posts, postsTotal, err := u.postRepo.SearchPosts(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+	comments, commentsTotal, err := u.commentRepo.SearchComments(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := append(posts, comments...)
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Rank != hits[j].Rank {
+			return hits[i].Rank > hits[j].Rank
+		}
+		return hits[i].CreatedAt.After(hits[j].CreatedAt)
+	})
+
+	result := &entities.SearchResult{TotalCount: postsTotal + commentsTotal}
+	if q.Offset < len(hits) {
+		hits = hits[q.Offset:]
+		if len(hits) > q.Limit {
+			hits = hits[:q.Limit]
+		}
+		result.Hits = hits
+	}
+	return result, nil
+}
+
+// AttachmentLimits — ограничения на загружаемые файлы
+type AttachmentLimits struct {
+	MaxFileSize   int64    // максимальный размер одного файла в байтах
+	UserQuota     int64    // суммарный размер файлов одного пользователя, 0 — без ограничения
+	AllowedTypes  []string // допустимые MIME-типы, определяемые по содержимому
+	ThumbnailSize int      // большая сторона превью в пикселях
+}
+
+// DefaultAttachmentLimits — ограничения по умолчанию
+func DefaultAttachmentLimits() AttachmentLimits {
+	return AttachmentLimits{
+		MaxFileSize:   10 << 20,
+		UserQuota:     200 << 20,
+		AllowedTypes:  []string{"image/jpeg", "image/png", "image/gif", "application/pdf", "text/plain"},
+		ThumbnailSize: 320,
+	}
+}
+
+// MaxFileNameLength — сколько символов имени файла сохраняется
+const MaxFileNameLength = 255
+
+// orphanBatchSize — сколько осиротевших вложений PurgeOrphans удаляет за проход
+const orphanBatchSize = 100
+
+type AttachmentUsecaseInterface interface {
+	Upload(ctx context.Context, att *entities.Attachment, data []byte) error
+	Attachment(ctx context.Context, id, viewerID int64) (*entities.Attachment, error)
+	Content(ctx context.Context, att *entities.Attachment, thumbnail bool) ([]byte, error)
+	Attachments(ctx context.Context, targetType string, targetIDs []int64) (map[int64][]*entities.Attachment, error)
+	DeleteAttachment(ctx context.Context, att *entities.Attachment) error
+	PurgeOrphans(ctx context.Context) (int64, error)
+}
+
+type AttachmentUsecase struct {
+	repo   repository.AttachmentRepository
+	store  blobstore.BlobStore
+	limits AttachmentLimits
+	logger logger.Logger
+}
+
+func NewAttachmentUsecase(repo repository.AttachmentRepository, store blobstore.BlobStore, limits AttachmentLimits, logger logger.Logger) *AttachmentUsecase {
+	return &AttachmentUsecase{
+		repo:   repo,
+		store:  store,
+		limits: limits,
+		logger: logger,
+	}
+}
+
+// detectContentType определяет MIME-тип по содержимому, а не по имени файла
+// или заголовку клиента, и отбрасывает параметры вроде charset
+func detectContentType(data []byte) string {
+	contentType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	return contentType
+}
+
+func (u *AttachmentUsecase) allowed(contentType string) bool {
+	for _, t := range u.limits.AllowedTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanFileName оставляет от имени файла только последний элемент пути без
+// управляющих символов, чтобы его можно было безопасно отдать в Content-Disposition
+func cleanFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == "/" {
+		return "file"
+	}
+	if utf8.RuneCountInString(name) > MaxFileNameLength {
+		name = string([]rune(name)[:MaxFileNameLength])
+	}
+	return name
+}
+
+// Upload проверяет файл, очищает изображения от метаданных, строит превью и
+// сохраняет содержимое в хранилище, а метаданные — в БД. Цель и права на неё
+// проверяет вызывающий.
+func (u *AttachmentUsecase) Upload(ctx context.Context, att *entities.Attachment, data []byte) error {
+	if len(data) == 0 {
+		return errors.ErrEmptyFile
+	}
+	if int64(len(data)) > u.limits.MaxFileSize {
+		return errors.ErrFileTooLarge
+	}
+	att.ContentType = detectContentType(data)
+	if !u.allowed(att.ContentType) {
+		return errors.ErrUnsupportedFileType
+	}
+	att.FileName = cleanFileName(att.FileName)
+
+	var thumbnail []byte
+	if imaging.Supported(att.ContentType) {
+		img, err := imaging.Process(data, att.ContentType, u.limits.ThumbnailSize)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrInvalidImage, err)
+		}
+		data, thumbnail = img.Data, img.Thumbnail
+		att.Width, att.Height = int32(img.Width), int32(img.Height)
+	}
+	att.Size = int64(len(data) + len(thumbnail))
+
+	// Быстрая проверка до записи файлов; окончательно квота проверяется
+	// в транзакции сохранения вложения
+	if u.limits.UserQuota > 0 {
+		used, err := u.repo.UserAttachmentsSize(ctx, att.UploaderID)
+		if err != nil {
+			return err
+		}
+		if used+att.Size > u.limits.UserQuota {
+			return errors.ErrQuotaExceeded
+		}
+	}
+
+	key, err := blobstore.NewKey()
+	if err != nil {
+		return err
+	}
+	att.BlobKey = key
+	if err := u.store.Put(ctx, att.BlobKey, data); err != nil {
+		return fmt.Errorf("сохранение файла: %w", err)
+	}
+	if thumbnail != nil {
+		att.ThumbnailKey = blobstore.ThumbnailKey(key)
+		if err := u.store.Put(ctx, att.ThumbnailKey, thumbnail); err != nil {
+			u.deleteBlobs(ctx, att)
+			return fmt.Errorf("сохранение превью: %w", err)
+		}
+	}
+	if err := u.repo.CreateAttachment(ctx, att, u.limits.UserQuota); err != nil {
+		u.deleteBlobs(ctx, att)
+		return err
+	}
+
+	u.logger.Info("загружено вложение",
+		logger.NewField("attachment_id", att.ID),
+		logger.NewField("target_type", att.TargetType),
+		logger.NewField("target_id", att.TargetID),
+		logger.NewField("content_type", att.ContentType),
+		logger.NewField("size", att.Size))
+	return nil
+}
+
+// deleteBlobs удаляет файл и превью вложения; ошибки только логируются,
+// чтобы не скрыть исходную ошибку вызывающего
+func (u *AttachmentUsecase) deleteBlobs(ctx context.Context, att *entities.Attachment) {
+	if err := u.removeBlobs(ctx, att); err != nil {
+		u.logger.Error("не удалось удалить файлы вложения",
+			logger.NewField("blob_key", att.BlobKey),
+			logger.NewField("error", err))
+	}
+}
+
+func (u *AttachmentUsecase) removeBlobs(ctx context.Context, att *entities.Attachment) error {
+	if att.ThumbnailKey != "" {
+		if err := u.store.Delete(ctx, att.ThumbnailKey); err != nil {
+			return err
+		}
+	}
+	return u.store.Delete(ctx, att.BlobKey)
+}
+
+// Attachment возвращает метаданные вложения, если его цель не удалена и видна зрителю
+func (u *AttachmentUsecase) Attachment(ctx context.Context, id, viewerID int64) (*entities.Attachment, error) {
+	return u.repo.GetAttachment(ctx, id, viewerID)
+}
+
+// Content возвращает содержимое файла или его превью
+func (u *AttachmentUsecase) Content(ctx context.Context, att *entities.Attachment, thumbnail bool) ([]byte, error) {
+	key := att.BlobKey
+	if thumbnail {
+		if att.ThumbnailKey == "" {
+			return nil, errors.ErrAttachmentNotFound
+		}
+		key = att.ThumbnailKey
+	}
+	data, err := u.store.Get(ctx, key)
+	if stdErrors.Is(err, blobstore.ErrNotFound) {
+		return nil, errors.ErrAttachmentNotFound
+	}
+	return data, err
+}
+
+// Attachments возвращает вложения перечисленных целей, сгруппированные по ID цели
+func (u *AttachmentUsecase) Attachments(ctx context.Context, targetType string, targetIDs []int64) (map[int64][]*entities.Attachment, error) {
+	if len(targetIDs) == 0 {
+		return map[int64][]*entities.Attachment{}, nil
+	}
+	return u.repo.Attachments(ctx, targetType, targetIDs)
+}
+
+// DeleteAttachment удаляет вложение. Сначала удаляется строка: если файл
+// удалить не получится, он останется лишь мусором в хранилище, а не битой ссылкой.
+func (u *AttachmentUsecase) DeleteAttachment(ctx context.Context, att *entities.Attachment) error {
+	if err := u.repo.DeleteAttachment(ctx, att.ID); err != nil {
+		return err
+	}
+	u.deleteBlobs(ctx, att)
+
+	u.logger.Info("удалено вложение",
+		logger.NewField("attachment_id", att.ID))
+	return nil
+}
+
+// PurgeOrphans удаляет файлы и метаданные вложений постов и комментариев,
+// окончательно удалённых из корзины. Строка удаляется только после файлов,
+// поэтому при сбое хранилища очистка повторится на следующем проходе.
+func (u *AttachmentUsecase) PurgeOrphans(ctx context.Context) (int64, error) {
+	var purged int64
+	for {
+		orphans, err := u.repo.OrphanedAttachments(ctx, orphanBatchSize)
+		if err != nil {
+			return purged, err
+		}
+		for _, att := range orphans {
+			if err := u.removeBlobs(ctx, att); err != nil {
+				return purged, fmt.Errorf("удаление файлов вложения %d: %w", att.ID, err)
+			}
+			if err := u.repo.DeleteAttachment(ctx, att.ID); err != nil && !stdErrors.Is(err, errors.ErrAttachmentNotFound) {
+				return purged, err
+			}
+			purged++
+		}
+		if len(orphans) < orphanBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// archivePageSize — сколько записей читается из базы за один запрос при выгрузке
+const archivePageSize = 500
+
+// UserArchive выгружает и загружает пользователей. Их хранит auth_service,
+// поэтому реализация (users.Archive) ходит в него по gRPC. ImportUser
+// возвращает 0, если пользователя загрузить нельзя: его записи пропускаются.
+type UserArchive interface {
+	ExportUsers(ctx context.Context, w *archive.Writer, withPasswords bool) (int, error)
+	ImportUser(ctx context.Context, user *archive.User, report *archive.Report) (int64, error)
+}
+
+// ExportOptions — параметры выгрузки архива
+type ExportOptions struct {
+	Source        string // имя источника в заголовке; по нему загрузка узнаёт уже загруженные записи
+	WithPasswords bool   // выгружать хеши паролей
+}
+
+// ArchiveUsecase выгружает форум в архив и загружает архив обратно. Работает
+// только через интерфейсы репозиториев, поэтому не зависит от хранилища.
+// HTML постов и комментариев из архива не загружается: он мог пройти мимо
+// санитайзера, а ссылки упоминаний в нём ведут на ID исходной базы. Его строит
+// renderer, а упоминания находятся заново по именам в этой базе. Без renderer
+// HTML остаётся пустым и строится при запуске forum_service (RenderMissingContent).
+type ArchiveUsecase struct {
+	users      UserArchive
+	categories repository.CategoryRepository
+	posts      repository.PostRepository
+	repo       repository.ArchiveRepository
+	renderer   ContentRenderer
+	mentions   MentionUsecaseInterface
+	logger     logger.Logger
+}
+
+func NewArchiveUsecase(users UserArchive, categories repository.CategoryRepository, posts repository.PostRepository, repo repository.ArchiveRepository, renderer ContentRenderer, mentions MentionUsecaseInterface, logger logger.Logger) *ArchiveUsecase {
+	return &ArchiveUsecase{
+		users:      users,
+		categories: categories,
+		posts:      posts,
+		repo:       repo,
+		renderer:   renderer,
+		mentions:   mentions,
+		logger:     logger,
+	}
+}
+
+// Export пишет в w архив: пользователей, категории, посты, комментарии и сообщения
+// чата, в порядке загрузки. Возвращает число выгруженных записей по типам.
+func (u *ArchiveUsecase) Export(ctx context.Context, w io.Writer, opts ExportOptions) (map[string]int, error) {
+	if opts.Source == "" {
+		return nil, fmt.Errorf("не указан источник архива")
+	}
+	aw, err := archive.NewWriter(w, archive.Header{
+		Source:        opts.Source,
+		CreatedAt:     time.Now().UTC(),
+		WithPasswords: opts.WithPasswords,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	if counts[archive.KindUser], err = u.users.ExportUsers(ctx, aw, opts.WithPasswords); err != nil {
+		return counts, err
+	}
+
+	categories, err := u.categories.Categories(ctx)
+	if err != nil {
+		return counts, fmt.Errorf("выгрузка категорий: %w", err)
+	}
+	for _, c := range categories {
+		rec := archive.Category{
+			ID:          c.ID,
+			Slug:        c.Slug,
+			Title:       c.Title,
+			Description: c.Description,
+			Position:    c.Position,
+			CreatedAt:   c.CreatedAt,
+		}
+		if err := aw.Write(archive.KindCategory, rec); err != nil {
+			return counts, err
+		}
+		counts[archive.KindCategory]++
+	}
+
+	for afterID := int64(0); ; {
+		posts, err := u.repo.ArchivePosts(ctx, afterID, archivePageSize)
+		if err != nil {
+			return counts, err
+		}
+		for _, p := range posts {
+			if err := aw.Write(archive.KindPost, archivePost(p)); err != nil {
+				return counts, err
+			}
+			afterID = p.ID
+			counts[archive.KindPost]++
+		}
+		if len(posts) < archivePageSize {
+			break
+		}
+	}
+
+	for afterID := int64(0); ; {
+		comments, err := u.repo.ArchiveComments(ctx, afterID, archivePageSize)
+		if err != nil {
+			return counts, err
+		}
+		for _, c := range comments {
+			if err := aw.Write(archive.KindComment, archiveComment(c)); err != nil {
+				return counts, err
+			}
+			afterID = c.ID
+			counts[archive.KindComment]++
+		}
+		if len(comments) < archivePageSize {
+			break
+		}
+	}
+
+	for afterID := int64(0); ; {
+		messages, err := u.repo.ArchiveMessages(ctx, afterID, archivePageSize)
+		if err != nil {
+			return counts, err
+		}
+		for _, m := range messages {
+			rec := archive.ChatMessage{
+				ID:        m.ID,
+				UserID:    m.UserID,
+				Username:  m.Username,
+				Content:   m.Content,
+				CreatedAt: m.CreatedAt,
+			}
+			if err := aw.Write(archive.KindChatMessage, rec); err != nil {
+				return counts, err
+			}
+			afterID = m.ID
+			counts[archive.KindChatMessage]++
+		}
+		if len(messages) < archivePageSize {
+			break
+		}
+	}
+	return counts, nil
+}
+
+func archiveDeletion(d *entities.Deletion) *archive.Deletion {
+	if d == nil {
+		return nil
+	}
+	return &archive.Deletion{DeletedAt: d.DeletedAt, DeletedBy: d.DeletedBy, Reason: d.Reason}
+}
+
+func archivePost(p *entities.Post) archive.Post {
+	return archive.Post{
+		ID:          p.ID,
+		Title:       p.Title,
+		Content:     p.Content,
+		ContentHTML: p.ContentHTML,
+		AuthorID:    p.AuthorID,
+		AuthorName:  p.AuthorName,
+		CategoryID:  p.CategoryID,
+		Tags:        p.Tags,
+		Status:      p.Status,
+		PublishAt:   p.PublishAt,
+		IsPinned:    p.IsPinned,
+		IsLocked:    p.IsLocked,
+		ViewCount:   p.ViewCount,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		Deletion:    archiveDeletion(p.Deletion),
+	}
+}
+
+func archiveComment(c *entities.Comment) archive.Comment {
+	return archive.Comment{
+		ID:          c.ID,
+		PostID:      c.PostID,
+		ParentID:    c.ParentID,
+		AuthorID:    c.AuthorID,
+		AuthorName:  c.AuthorName,
+		Content:     c.Content,
+		ContentHTML: c.ContentHTML,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+		Deletion:    archiveDeletion(c.Deletion),
+	}
+}
+
+// archiveKinds — порядок типов записей в архиве: ссылки идут только на записи раньше
+var archiveKinds = []string{archive.KindUser, archive.KindCategory, archive.KindPost, archive.KindComment, archive.KindChatMessage}
+
+// archiveImport — состояние одной загрузки: соответствие ID архива и ID в базе
+type archiveImport struct {
+	source string
+	report *archive.Report
+	users  map[int64]int64
+	ids    map[string]map[int64]int64 // по типам записей, кроме пользователей
+	slugs  map[string]int64           // категории, уже существующие в базе
+}
+
+// mapUser возвращает новый ID пользователя или 0, если его нет в архиве
+func (s *archiveImport) mapUser(id int64) int64 {
+	return s.users[id]
+}
+
+// deletion переносит сведения об удалении, заменяя ID удалившего. Если его нет
+// в архиве, удаление сохраняется без автора.
+func (s *archiveImport) deletion(d *archive.Deletion) *entities.Deletion {
+	if d == nil {
+		return nil
+	}
+	return &entities.Deletion{DeletedAt: d.DeletedAt, DeletedBy: s.mapUser(d.DeletedBy), Reason: d.Reason}
+}
+
+// Import загружает архив из r. ID записей заменяются новыми, ссылки между
+// записями — соответственно. Записи, уже загруженные из того же источника,
+// пропускаются, поэтому прерванную загрузку можно просто повторить. Записи,
+// которые нельзя загрузить как есть, попадают в отчёт о конфликтах.
+func (u *ArchiveUsecase) Import(ctx context.Context, r io.Reader) (*archive.Report, error) {
+	reader, header, err := archive.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	if header.Source == "" {
+		return nil, fmt.Errorf("в заголовке архива нет источника")
+	}
+
+	s := &archiveImport{
+		source: header.Source,
+		report: archive.NewReport(),
+		users:  make(map[int64]int64),
+		ids:    make(map[string]map[int64]int64),
+		slugs:  make(map[string]int64),
+	}
+	for _, kind := range archiveKinds[1:] {
+		if s.ids[kind], err = u.repo.ImportedIDs(ctx, s.source, kind); err != nil {
+			return nil, err
+		}
+	}
+	categories, err := u.categories.Categories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("получение категорий: %w", err)
+	}
+	for _, c := range categories {
+		s.slugs[c.Slug] = c.ID
+	}
+
+	order := 0
+	for {
+		kind, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return s.report, err
+		}
+
+		pos := slices.Index(archiveKinds, kind)
+		if pos < 0 {
+			s.report.Conflict(kind, 0, "строка %d: неизвестный тип записи, пропущена", reader.Line())
+			continue
+		}
+		if pos < order {
+			return s.report, fmt.Errorf("строка %d: запись %s после %s", reader.Line(), kind, archiveKinds[order])
+		}
+		order = pos
+
+		if err := u.importRecord(ctx, s, reader, kind); err != nil {
+			return s.report, err
+		}
+	}
+
+	if s.report.Imported[archive.KindComment] > 0 {
+		if _, err := u.posts.RecountComments(ctx); err != nil {
+			return s.report, fmt.Errorf("пересчёт комментариев: %w", err)
+		}
+	}
+	return s.report, nil
+}
+
+// importRecord загружает текущую запись архива
+func (u *ArchiveUsecase) importRecord(ctx context.Context, s *archiveImport, reader *archive.Reader, kind string) error {
+	switch kind {
+	case archive.KindUser:
+		var rec archive.User
+		if err := reader.Decode(&rec); err != nil {
+			return err
+		}
+		id, err := u.users.ImportUser(ctx, &rec, s.report)
+		if err != nil {
+			return err
+		}
+		s.users[rec.ID] = id
+		return nil
+
+	case archive.KindCategory:
+		var rec archive.Category
+		if err := reader.Decode(&rec); err != nil {
+			return err
+		}
+		return u.importCategory(ctx, s, &rec)
+
+	case archive.KindPost:
+		var rec archive.Post
+		if err := reader.Decode(&rec); err != nil {
+			return err
+		}
+		return u.importPost(ctx, s, &rec)
+
+	case archive.KindComment:
+		var rec archive.Comment
+		if err := reader.Decode(&rec); err != nil {
+			return err
+		}
+		return u.importComment(ctx, s, &rec)
+
+	default:
+		var rec archive.ChatMessage
+		if err := reader.Decode(&rec); err != nil {
+			return err
+		}
+		return u.importMessage(ctx, s, &rec)
+	}
+}
+
+// importCategory загружает категорию. Slug уникален: если он занят, посты
+// архива привязываются к существующей категории.
+func (u *ArchiveUsecase) importCategory(ctx context.Context, s *archiveImport, rec *archive.Category) error {
+	ids := s.ids[archive.KindCategory]
+	if _, ok := ids[rec.ID]; ok {
+		s.report.Skipped[archive.KindCategory]++
+		return nil
+	}
+	if existing, ok := s.slugs[rec.Slug]; ok {
+		s.report.Conflict(archive.KindCategory, rec.ID, "slug %q уже занят категорией %d, посты привязаны к ней", rec.Slug, existing)
+		ids[rec.ID] = existing
+		return u.repo.MapImportedID(ctx, s.source, archive.KindCategory, rec.ID, existing)
+	}
+
+	category := &entities.Category{
+		Slug:        rec.Slug,
+		Title:       rec.Title,
+		Description: rec.Description,
+		Position:    rec.Position,
+		CreatedAt:   rec.CreatedAt,
+	}
+	if err := u.repo.ImportCategory(ctx, s.source, rec.ID, category); err != nil {
+		return err
+	}
+	ids[rec.ID] = category.ID
+	s.slugs[rec.Slug] = category.ID
+	s.report.Imported[archive.KindCategory]++
+	return nil
+}
+
+// render строит HTML текста из архива. Если упоминаний больше MaxMentions,
+// текст загружается без ссылок на пользователей, и это попадает в отчёт.
+func (u *ArchiveUsecase) render(ctx context.Context, s *archiveImport, kind string, sourceID int64, src string) (string, map[string]int64, error) {
+	if u.renderer == nil {
+		return "", nil, nil
+	}
+	contentHTML, mentioned, err := renderContent(ctx, u.renderer, u.mentions, src)
+	if stdErrors.Is(err, errors.ErrTooManyMentions) {
+		s.report.Conflict(kind, sourceID, "больше %d упоминаний, ссылки на пользователей не построены", MaxMentions)
+		return u.renderer.Render(src), nil, nil
+	}
+	return contentHTML, mentioned, err
+}
+
+func (u *ArchiveUsecase) importPost(ctx context.Context, s *archiveImport, rec *archive.Post) error {
+	ids := s.ids[archive.KindPost]
+	if _, ok := ids[rec.ID]; ok {
+		s.report.Skipped[archive.KindPost]++
+		return nil
+	}
+	authorID := s.mapUser(rec.AuthorID)
+	if authorID == 0 {
+		s.report.Conflict(archive.KindPost, rec.ID, "автор %d не загружен, пост пропущен", rec.AuthorID)
+		return nil
+	}
+	contentHTML, mentioned, err := u.render(ctx, s, archive.KindPost, rec.ID, rec.Content)
+	if err != nil {
+		return err
+	}
+
+	post := &entities.Post{
+		Title:       rec.Title,
+		Content:     rec.Content,
+		ContentHTML: contentHTML,
+		AuthorID:    authorID,
+		AuthorName:  rec.AuthorName,
+		Tags:        rec.Tags,
+		Status:      rec.Status,
+		PublishAt:   rec.PublishAt,
+		IsPinned:    rec.IsPinned,
+		IsLocked:    rec.IsLocked,
+		ViewCount:   rec.ViewCount,
+		CreatedAt:   rec.CreatedAt,
+		UpdatedAt:   rec.UpdatedAt,
+		Deletion:    s.deletion(rec.Deletion),
+	}
+	if rec.CategoryID != nil {
+		if id, ok := s.ids[archive.KindCategory][*rec.CategoryID]; ok {
+			post.CategoryID = &id
+		} else {
+			s.report.Conflict(archive.KindPost, rec.ID, "категории %d нет в архиве, пост загружен без категории", *rec.CategoryID)
+		}
+	}
+
+	err = u.repo.ImportPost(ctx, s.source, rec.ID, post)
+	if stdErrors.Is(err, errors.ErrCategoryNotFound) {
+		// категорию удалили после прошлой загрузки архива
+		s.report.Conflict(archive.KindPost, rec.ID, "категория %d удалена, пост загружен без категории", *post.CategoryID)
+		post.CategoryID = nil
+		err = u.repo.ImportPost(ctx, s.source, rec.ID, post)
+	}
+	if err != nil {
+		return err
+	}
+	ids[rec.ID] = post.ID
+	saveMentions(ctx, u.mentions, u.logger, repository.TargetTypePost, post.ID, post.AuthorID, mentioned)
+	s.report.Imported[archive.KindPost]++
+	return nil
+}
+
+func (u *ArchiveUsecase) importComment(ctx context.Context, s *archiveImport, rec *archive.Comment) error {
+	ids := s.ids[archive.KindComment]
+	if _, ok := ids[rec.ID]; ok {
+		s.report.Skipped[archive.KindComment]++
+		return nil
+	}
+	postID, ok := s.ids[archive.KindPost][rec.PostID]
+	if !ok {
+		s.report.Conflict(archive.KindComment, rec.ID, "пост %d не загружен, комментарий пропущен", rec.PostID)
+		return nil
+	}
+	authorID := s.mapUser(rec.AuthorID)
+	if authorID == 0 {
+		s.report.Conflict(archive.KindComment, rec.ID, "автор %d не загружен, комментарий пропущен", rec.AuthorID)
+		return nil
+	}
+
+	comment := &entities.Comment{
+		PostID:     postID,
+		AuthorID:   authorID,
+		AuthorName: rec.AuthorName,
+		Content:    rec.Content,
+		CreatedAt:  rec.CreatedAt,
+		UpdatedAt:  rec.UpdatedAt,
+		Deletion:   s.deletion(rec.Deletion),
+	}
+	if rec.ParentID != nil {
+		parentID, ok := ids[*rec.ParentID]
+		if !ok {
+			s.report.Conflict(archive.KindComment, rec.ID, "родительский комментарий %d не загружен, ответ пропущен", *rec.ParentID)
+			return nil
+		}
+		comment.ParentID = &parentID
+	}
+
+	contentHTML, mentioned, err := u.render(ctx, s, archive.KindComment, rec.ID, rec.Content)
+	if err != nil {
+		return err
+	}
+	comment.ContentHTML = contentHTML
+
+	err = u.repo.ImportComment(ctx, s.source, rec.ID, comment)
+	if stdErrors.Is(err, errors.ErrPostNotFound) {
+		// пост окончательно удалили из корзины после прошлой загрузки
+		s.report.Conflict(archive.KindComment, rec.ID, "пост %d удалён, комментарий пропущен", rec.PostID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ids[rec.ID] = comment.ID
+	saveMentions(ctx, u.mentions, u.logger, repository.TargetTypeComment, comment.ID, comment.AuthorID, mentioned)
+	s.report.Imported[archive.KindComment]++
+	return nil
+}
+
+func (u *ArchiveUsecase) importMessage(ctx context.Context, s *archiveImport, rec *archive.ChatMessage) error {
+	ids := s.ids[archive.KindChatMessage]
+	if _, ok := ids[rec.ID]; ok {
+		s.report.Skipped[archive.KindChatMessage]++
+		return nil
+	}
+	userID := s.mapUser(rec.UserID)
+	if userID == 0 {
+		s.report.Conflict(archive.KindChatMessage, rec.ID, "автор %d не загружен, сообщение пропущено", rec.UserID)
+		return nil
+	}
+
+	msg := &entities.ChatMessage{
+		UserID:    userID,
+		Username:  rec.Username,
+		Content:   rec.Content,
+		CreatedAt: rec.CreatedAt,
+	}
+	if err := u.repo.ImportMessage(ctx, s.source, rec.ID, msg); err != nil {
+		return err
+	}
+	ids[rec.ID] = msg.ID
+	s.report.Imported[archive.KindChatMessage]++
+	return nil
+}
+
+// UserEventsLog — имя журнала событий пользователей auth_service в event_cursors
+const UserEventsLog = "user_events"
+
+const (
+	userEventsPageSize = 100
+	authorsPageSize    = 500
+)
+
+// UserDirectory читает пользователей и журнал их событий из auth_service
+type UserDirectory interface {
+	Username(ctx context.Context, userID int64) (string, error)
+	UserEvents(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error)
+}
+
+// UserCache — кеш проверенных токенов; после смены имени записи пользователя
+// в нём сбрасываются, чтобы новые посты не получали старое имя
+type UserCache interface {
+	ForgetUser(userID int64)
+}
+
+// ResyncResult — итог сверки имён авторов с auth_service
+type ResyncResult struct {
+	Authors int   // сколько авторов проверено
+	Missing int   // сколько авторов нет в auth_service, их записи не менялись
+	Fixed   int64 // сколько записей исправлено
+}
+
+// AuthorNameSync поддерживает имена авторов в постах, комментариях, сообщениях
+// чата и голосах в опросах: они копируются при записи и устаревают, когда
+// пользователь меняет имя.
+// ApplyEvents читает журнал событий auth_service с сохранённой позиции; событие
+// может быть применено повторно, это безопасно. Resync сверяет всех авторов
+// с auth_service и исправляет расхождения, накопившиеся в обход журнала.
+type AuthorNameSync struct {
+	repo    repository.AuthorRepository
+	users   UserDirectory
+	cache   UserCache
+	logger  logger.Logger
+	ticker  *time.Ticker
+	done    chan bool
+	timeout time.Duration
+}
+
+// NewAuthorNameSync создаёт синхронизацию имён; cache может быть nil,
+// если сервис не кеширует токены (например, в утилите сверки)
+func NewAuthorNameSync(repo repository.AuthorRepository, users UserDirectory, cache UserCache, logger logger.Logger) *AuthorNameSync {
+	return &AuthorNameSync{
+		repo:    repo,
+		users:   users,
+		cache:   cache,
+		logger:  logger,
+		done:    make(chan bool),
+		timeout: time.Minute,
+	}
+}
+
+func (s *AuthorNameSync) Start(interval time.Duration) {
+	s.ticker = time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-s.ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+				if _, err := s.ApplyEvents(ctx); err != nil {
+					s.logger.Error("ошибка обработки событий пользователей",
+						logger.NewField("error", err))
+				}
+				cancel()
+			case <-s.done:
+				s.ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (s *AuthorNameSync) Stop() {
+	s.done <- true
+}
+
+// ApplyEvents применяет новые события журнала и возвращает их число.
+// Позиция сохраняется после каждой страницы, поэтому после сбоя
+// повторяются только события недочитанной страницы.
+func (s *AuthorNameSync) ApplyEvents(ctx context.Context) (int, error) {
+	position, err := s.repo.EventCursor(ctx, UserEventsLog)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for {
+		last, n, err := s.applyPage(ctx, position)
+		applied += n
+		if last > position {
+			if err := s.repo.SetEventCursor(ctx, UserEventsLog, last); err != nil {
+				return applied, err
+			}
+			position = last
+		}
+		if err != nil || n < userEventsPageSize {
+			return applied, err
+		}
+	}
+}
+
+// applyPage применяет страницу событий после afterID и возвращает ID
+// последнего применённого события и их число
+func (s *AuthorNameSync) applyPage(ctx context.Context, afterID int64) (int64, int, error) {
+	events, err := s.users.UserEvents(ctx, afterID, userEventsPageSize)
+	if err != nil {
+		return afterID, 0, err
+	}
+
+	last := afterID
+	for i, event := range events {
+		if event.Type == entities.UserEventUpdated {
+			// Кеш сбрасывается до переименования: иначе запрос с закешированным
+			// старым именем мог бы записать его уже после исправления
+			if s.cache != nil {
+				s.cache.ForgetUser(event.UserID)
+			}
+			fixed, err := s.repo.RenameAuthor(ctx, event.UserID, event.Username)
+			if err != nil {
+				return last, i, err
+			}
+			if fixed > 0 {
+				s.logger.Info("обновлено имя автора",
+					logger.NewField("user_id", event.UserID),
+					logger.NewField("username", event.Username),
+					logger.NewField("records", fixed))
+			}
+		}
+		last = event.ID
+	}
+	return last, len(events), nil
+}
+
+// Resync сверяет имена всех авторов с auth_service и исправляет расхождения.
+// События, пришедшие во время сверки, затем применяются ещё раз: иначе имя,
+// прочитанное до смены, могло бы затереть уже применённое новое.
+func (s *AuthorNameSync) Resync(ctx context.Context) (ResyncResult, error) {
+	var result ResyncResult
+	start, err := s.repo.EventCursor(ctx, UserEventsLog)
+	if err != nil {
+		return result, err
+	}
+
+	var afterID int64
+	for {
+		ids, err := s.repo.AuthorIDs(ctx, afterID, authorsPageSize)
+		if err != nil {
+			return result, err
+		}
+		for _, id := range ids {
+			afterID = id
+			result.Authors++
+
+			username, err := s.users.Username(ctx, id)
+			if stdErrors.Is(err, errors.ErrUserNotFound) {
+				result.Missing++
+				s.logger.Warn("автора нет в auth_service", logger.NewField("user_id", id))
+				continue
+			}
+			if err != nil {
+				return result, err
+			}
+			fixed, err := s.repo.RenameAuthor(ctx, id, username)
+			if err != nil {
+				return result, err
+			}
+			result.Fixed += fixed
+		}
+		if len(ids) < authorsPageSize {
+			break
+		}
+	}
+
+	for position := start; ; {
+		last, n, err := s.applyPage(ctx, position)
+		if err != nil {
+			return result, err
+		}
+		if n < userEventsPageSize {
+			return result, nil
+		}
+		position = last
+	}
 }
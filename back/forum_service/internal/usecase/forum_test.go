@@ -3,14 +3,24 @@ package usecase_test
 import (
 	"fmt"
 
+	"github.com/netabakovv/forum/back/forum_service/internal/blobstore"
 	"github.com/netabakovv/forum/back/forum_service/internal/entities"
+	"github.com/netabakovv/forum/back/forum_service/internal/filter"
+	"github.com/netabakovv/forum/back/forum_service/internal/repository"
 	"github.com/netabakovv/forum/back/forum_service/internal/repository/mocks"
 	"github.com/netabakovv/forum/back/forum_service/internal/usecase"
 	uc_mocks "github.com/netabakovv/forum/back/forum_service/internal/usecase/mocks"
+	"github.com/netabakovv/forum/back/pkg/archive"
+	"github.com/netabakovv/forum/back/pkg/diff"
 	"github.com/netabakovv/forum/back/pkg/errors"
+	"github.com/netabakovv/forum/back/pkg/markdown"
 	pb "github.com/netabakovv/forum/back/proto"
 
+	"bytes"
 	"context"
+	"image"
+	"image/png"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +29,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type MockChatRepo struct {
@@ -54,7 +65,7 @@ func TestChatUsecase(t *testing.T) {
 		MessageLifetimeMinutes: 60,
 	}
 
-	chat := usecase.NewChatUsecase(mockRepo, log, config)
+	chat := usecase.NewChatUsecase(mockRepo, nil, nil, log, config)
 
 	t.Run("SendMessage - success", func(t *testing.T) {
 		msg := &entities.ChatMessage{UserID: 1, Content: "Hello"}
@@ -62,7 +73,7 @@ func TestChatUsecase(t *testing.T) {
 		mockRepo.
 			EXPECT().
 			SaveMessage(ctx, msg.UserID, msg.Username, msg.Content).
-			Return(nil)
+			Return(int64(1), nil)
 
 		err := chat.SendMessage(ctx, msg)
 		assert.NoError(t, err)
@@ -213,6 +224,120 @@ func TestCleanupService_Cleanup(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestViewCounter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockViewRepository(ctrl)
+	counter := usecase.NewViewCounter(repo, 50*time.Millisecond, logger.NewStdLogger())
+
+	assert.True(t, counter.RecordView(1, "user:3"))
+	assert.False(t, counter.RecordView(1, "user:3"), "повтор в пределах окна")
+	assert.True(t, counter.RecordView(1, "ip:10.0.0.1"))
+	assert.True(t, counter.RecordView(2, "user:3"))
+	assert.True(t, counter.RecordView(2, ""))
+	assert.True(t, counter.RecordView(2, ""))
+
+	// неудачная запись не теряет просмотры
+	repo.EXPECT().AddViews(gomock.Any(), map[int64]int64{1: 2, 2: 3}).Return(fmt.Errorf("db down"))
+	assert.Error(t, counter.Flush())
+	repo.EXPECT().AddViews(gomock.Any(), map[int64]int64{1: 2, 2: 3}).Return(nil)
+	require.NoError(t, counter.Flush())
+
+	// пустой буфер не записывается
+	require.NoError(t, counter.Flush())
+
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, counter.RecordView(1, "user:3"), "окно истекло")
+	repo.EXPECT().AddViews(gomock.Any(), map[int64]int64{1: 1}).Return(nil)
+	counter.Start(time.Hour)
+	counter.Stop()
+}
+
+func TestViewCounter_SeenLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockViewRepository(ctrl)
+	counter := usecase.NewViewCounter(repo, time.Hour, logger.NewStdLogger())
+
+	const limit = 100000
+	for i := 0; i < limit; i++ {
+		require.True(t, counter.RecordView(1, fmt.Sprintf("ip:%d", i)))
+	}
+	// память заполнена свежими зрителями: новый зритель учитывается без запоминания
+	assert.True(t, counter.RecordView(1, "ip:new"))
+	assert.True(t, counter.RecordView(1, "ip:new"))
+	// уже запомненные по-прежнему не учитываются повторно
+	assert.False(t, counter.RecordView(1, "ip:0"))
+
+	// Stop без Start не блокируется и записывает накопленное
+	repo.EXPECT().AddViews(gomock.Any(), map[int64]int64{1: limit + 2}).Return(nil)
+	counter.Stop()
+}
+
+func TestTrashPurgeService_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := uc_mocks.NewMockPostUsecaseInterface(ctrl)
+	commentUC := uc_mocks.NewMockCommentUsecaseInterface(ctrl)
+	attachmentUC := uc_mocks.NewMockAttachmentUsecaseInterface(ctrl)
+	service := usecase.NewTrashPurgeService(postUC, commentUC, attachmentUC, logger.NewStdLogger())
+
+	retention := 24 * time.Hour
+	var commentCutoff time.Time
+	gomock.InOrder(
+		commentUC.EXPECT().PurgeDeleted(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, cutoff time.Time) (int64, error) {
+				commentCutoff = cutoff
+				return 3, nil
+			}),
+		postUC.EXPECT().PurgeDeleted(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, cutoff time.Time) (int64, error) {
+				assert.Equal(t, commentCutoff, cutoff)
+				return 1, nil
+			}),
+		attachmentUC.EXPECT().PurgeOrphans(gomock.Any()).Return(int64(2), nil),
+	)
+
+	assert.NoError(t, service.Purge(retention))
+	assert.WithinDuration(t, time.Now().Add(-retention), commentCutoff, time.Minute)
+}
+
+func TestScheduledPublisher_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := uc_mocks.NewMockPostUsecaseInterface(ctrl)
+	publisher := usecase.NewScheduledPublisher(postUC, logger.NewStdLogger())
+
+	postUC.EXPECT().PublishDue(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, now time.Time) (int64, error) {
+			assert.WithinDuration(t, time.Now(), now, time.Minute)
+			return 2, nil
+		})
+
+	assert.NoError(t, publisher.Publish())
+}
+
+func TestHotRankService_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postUC := uc_mocks.NewMockPostUsecaseInterface(ctrl)
+	service := usecase.NewHotRankService(postUC, logger.NewStdLogger())
+
+	window := 72 * time.Hour
+	postUC.EXPECT().RefreshHotRanks(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, since time.Time) (int64, error) {
+			assert.WithinDuration(t, time.Now().Add(-window), since, time.Minute)
+			return 5, nil
+		})
+
+	assert.NoError(t, service.Refresh(window))
+}
+
 func TestCleanupService_ErrorLogged(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -236,21 +361,42 @@ func TestCleanupService_ErrorLogged(t *testing.T) {
 	time.Sleep(50 * time.Millisecond)
 }
 
+func TestRenderMissingContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockContentRepository(ctrl)
+	ctx := context.Background()
+
+	// Пост 2 после очистки остаётся без HTML, поэтому следующая выборка идёт после его ID
+	repo.EXPECT().UnrenderedContent(ctx, repository.TargetTypePost, int64(0), gomock.Any()).
+		Return(map[int64]string{1: "*да*", 2: "<script>x</script>"}, nil)
+	repo.EXPECT().SetContentHTML(ctx, repository.TargetTypePost, int64(1), "<p><em>да</em></p>").Return(nil)
+	repo.EXPECT().SetContentHTML(ctx, repository.TargetTypePost, int64(2), "").Return(nil)
+	repo.EXPECT().UnrenderedContent(ctx, repository.TargetTypePost, int64(2), gomock.Any()).Return(map[int64]string{}, nil)
+	repo.EXPECT().UnrenderedContent(ctx, repository.TargetTypeComment, int64(0), gomock.Any()).Return(nil, nil)
+
+	err := usecase.RenderMissingContent(ctx, repo, markdown.New(markdown.DefaultConfig()), nil, logger.NewStdLogger())
+	assert.NoError(t, err)
+}
+
 func TestPostUsecase(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	repo := mocks.NewMockPostRepository(ctrl)
 	logger := logger.NewStdLogger()
-	uc := usecase.NewPostUsecase(repo, logger)
+	uc := usecase.NewPostUsecase(repo, markdown.New(markdown.DefaultConfig()), nil, nil, logger)
 
 	ctx := context.Background()
 	post := &entities.Post{ID: 1, Title: "title", AuthorID: 1}
 
 	t.Run("CreatePost", func(t *testing.T) {
+		post.Content = "**важно**"
 		repo.EXPECT().CreatePost(ctx, post).Return(nil)
 		err := uc.CreatePost(ctx, post)
 		assert.NoError(t, err)
+		assert.Equal(t, "<p><strong>важно</strong></p>", post.ContentHTML)
 	})
 
 	t.Run("GetPostByID", func(t *testing.T) {
@@ -267,16 +413,245 @@ func TestPostUsecase(t *testing.T) {
 	})
 
 	t.Run("DeletePost", func(t *testing.T) {
-		repo.EXPECT().DeletePost(ctx, int64(1)).Return(nil)
-		err := uc.DeletePost(ctx, 1)
+		repo.EXPECT().DeletePost(ctx, int64(1), int64(2), "спам").Return(nil)
+		err := uc.DeletePost(ctx, 1, 2, "  спам ")
+		assert.NoError(t, err)
+	})
+
+	t.Run("DeletePost - reason too long", func(t *testing.T) {
+		err := uc.DeletePost(ctx, 1, 2, strings.Repeat("я", usecase.MaxDeleteReasonLength+1))
+		assert.ErrorIs(t, err, errors.ErrReasonTooLong)
+	})
+
+	t.Run("RestorePost", func(t *testing.T) {
+		repo.EXPECT().RestorePost(ctx, int64(1)).Return(nil)
+		repo.EXPECT().GetPostByID(ctx, int64(1)).Return(post, nil)
+		res, err := uc.RestorePost(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, post, res)
+	})
+
+	t.Run("DeletedPosts - limit clamped", func(t *testing.T) {
+		repo.EXPECT().DeletedPosts(ctx, repository.MaxTrashLimit, 0).Return(nil, nil)
+		_, err := uc.DeletedPosts(ctx, 1000, -5)
 		assert.NoError(t, err)
 	})
 
 	t.Run("Posts", func(t *testing.T) {
-		repo.EXPECT().Posts(ctx).Return([]*entities.Post{post}, nil)
-		res, err := uc.Posts(ctx)
+		repo.EXPECT().
+			Posts(ctx, entities.PostFilter{Limit: repository.DefaultPostsLimit + 1, Sort: entities.PostSortNew}).
+			Return([]*entities.Post{post}, nil)
+		res, err := uc.Posts(ctx, entities.PostFilter{})
 		assert.NoError(t, err)
-		assert.Len(t, res, 1)
+		assert.Len(t, res.Posts, 1)
+		assert.Nil(t, res.NextCursor)
+	})
+
+	t.Run("Posts - next page", func(t *testing.T) {
+		now := time.Now()
+		page := []*entities.Post{
+			{ID: 3, CreatedAt: now},
+			{ID: 2, CreatedAt: now.Add(-time.Minute)},
+			{ID: 1, CreatedAt: now.Add(-2 * time.Minute)},
+		}
+		repo.EXPECT().
+			Posts(ctx, entities.PostFilter{Limit: 3, Sort: entities.PostSortNew}).
+			Return(page, nil)
+
+		res, err := uc.Posts(ctx, entities.PostFilter{Limit: 2})
+		assert.NoError(t, err)
+		assert.Len(t, res.Posts, 2)
+		assert.Equal(t, &entities.PostCursor{CreatedAt: page[1].CreatedAt, ID: 2}, res.NextCursor)
+	})
+
+	t.Run("Posts - limit capped", func(t *testing.T) {
+		repo.EXPECT().
+			Posts(ctx, entities.PostFilter{Limit: repository.MaxPostsLimit + 1, Sort: entities.PostSortNew}).
+			Return(nil, nil)
+
+		res, err := uc.Posts(ctx, entities.PostFilter{Limit: 1000})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Posts)
+	})
+
+	t.Run("Posts - tag normalized", func(t *testing.T) {
+		repo.EXPECT().
+			Posts(ctx, entities.PostFilter{Tag: "golang", Limit: repository.DefaultPostsLimit + 1, Sort: entities.PostSortNew}).
+			Return(nil, nil)
+
+		_, err := uc.Posts(ctx, entities.PostFilter{Tag: " #GoLang "})
+		assert.NoError(t, err)
+	})
+
+	t.Run("CreatePost - tags normalized", func(t *testing.T) {
+		tagged := &entities.Post{Title: "title", Tags: []string{"Go", "#go", " sql "}}
+		repo.EXPECT().CreatePost(ctx, tagged).Return(nil)
+
+		err := uc.CreatePost(ctx, tagged)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"go", "sql"}, tagged.Tags)
+	})
+
+	t.Run("CreatePost - invalid tag", func(t *testing.T) {
+		err := uc.CreatePost(ctx, &entities.Post{Title: "title", Tags: []string{"два слова"}})
+		assert.ErrorIs(t, err, errors.ErrInvalidTag)
+	})
+
+	t.Run("UpdatePost - too many tags", func(t *testing.T) {
+		tags := make([]string, usecase.MaxPostTags+1)
+		for i := range tags {
+			tags[i] = fmt.Sprintf("tag%d", i)
+		}
+		err := uc.UpdatePost(ctx, &entities.Post{ID: 1, Tags: tags})
+		assert.ErrorIs(t, err, errors.ErrTooManyTags)
+	})
+
+	t.Run("CreatePost - scheduled in the past", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		err := uc.CreatePost(ctx, &entities.Post{Title: "title", Status: entities.PostStatusScheduled, PublishAt: &past})
+		assert.ErrorIs(t, err, errors.ErrInvalidPublishTime)
+	})
+
+	t.Run("CreatePost - unknown status", func(t *testing.T) {
+		err := uc.CreatePost(ctx, &entities.Post{Title: "title", Status: "archived"})
+		assert.ErrorIs(t, err, errors.ErrInvalidPostStatus)
+	})
+
+	t.Run("CreatePost - duplicate poll options", func(t *testing.T) {
+		poll := &entities.Poll{Options: []*entities.PollOption{{Text: "да"}, {Text: " да "}}}
+		err := uc.CreatePost(ctx, &entities.Post{Title: "title", Poll: poll})
+		assert.ErrorIs(t, err, errors.ErrInvalidPoll)
+	})
+
+	t.Run("CreatePost - poll closes before publish", func(t *testing.T) {
+		publishAt := time.Now().Add(2 * time.Hour)
+		closesAt := time.Now().Add(time.Hour)
+		poll := &entities.Poll{
+			Options:  []*entities.PollOption{{Text: "да"}, {Text: "нет"}},
+			ClosesAt: &closesAt,
+		}
+		err := uc.CreatePost(ctx, &entities.Post{Title: "title", Status: entities.PostStatusScheduled, PublishAt: &publishAt, Poll: poll})
+		assert.ErrorIs(t, err, errors.ErrInvalidPollClose)
+	})
+
+	t.Run("CreatePost - draft drops publish time", func(t *testing.T) {
+		future := time.Now().Add(time.Hour)
+		draft := &entities.Post{Title: "title", Status: entities.PostStatusDraft, PublishAt: &future}
+		repo.EXPECT().CreatePost(ctx, draft).Return(nil)
+
+		assert.NoError(t, uc.CreatePost(ctx, draft))
+		assert.Nil(t, draft.PublishAt)
+	})
+
+	t.Run("PublishPost - now", func(t *testing.T) {
+		draft := &entities.Post{ID: 5, Status: entities.PostStatusDraft}
+		repo.EXPECT().SetPostStatus(ctx, int64(5), entities.PostStatusPublished, nil).Return(nil)
+
+		assert.NoError(t, uc.PublishPost(ctx, draft, nil))
+		assert.Equal(t, entities.PostStatusPublished, draft.Status)
+		assert.WithinDuration(t, time.Now(), draft.CreatedAt, time.Minute)
+	})
+
+	t.Run("PublishPost - scheduled", func(t *testing.T) {
+		draft := &entities.Post{ID: 5, Status: entities.PostStatusDraft}
+		future := time.Now().Add(time.Hour)
+		repo.EXPECT().SetPostStatus(ctx, int64(5), entities.PostStatusScheduled, &future).Return(nil)
+
+		assert.NoError(t, uc.PublishPost(ctx, draft, &future))
+		assert.Equal(t, entities.PostStatusScheduled, draft.Status)
+	})
+
+	t.Run("PublishPost - already published", func(t *testing.T) {
+		err := uc.PublishPost(ctx, &entities.Post{ID: 5, Status: entities.PostStatusPublished}, nil)
+		assert.ErrorIs(t, err, errors.ErrPostAlreadyPublished)
+	})
+
+	t.Run("PinPost", func(t *testing.T) {
+		repo.EXPECT().SetPostPinned(ctx, int64(1), true).Return(nil)
+		repo.EXPECT().GetPostByID(ctx, int64(1)).Return(&entities.Post{ID: 1, IsPinned: true}, nil)
+		res, err := uc.PinPost(ctx, 1, true)
+		assert.NoError(t, err)
+		assert.True(t, res.IsPinned)
+	})
+
+	t.Run("LockPost - not found", func(t *testing.T) {
+		repo.EXPECT().SetPostLocked(ctx, int64(9), true).Return(errors.ErrPostNotFound)
+		_, err := uc.LockPost(ctx, 9, true)
+		assert.ErrorIs(t, err, errors.ErrPostNotFound)
+	})
+
+	t.Run("Posts - cursor after pinned post", func(t *testing.T) {
+		now := time.Now()
+		page := []*entities.Post{{ID: 3, CreatedAt: now, IsPinned: true}, {ID: 2, CreatedAt: now}}
+		repo.EXPECT().Posts(ctx, entities.PostFilter{Limit: 2, Sort: entities.PostSortNew}).Return(page, nil)
+
+		res, err := uc.Posts(ctx, entities.PostFilter{Limit: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, &entities.PostCursor{CreatedAt: now, ID: 3, Pinned: true}, res.NextCursor)
+	})
+
+	t.Run("Posts - top within window", func(t *testing.T) {
+		page := []*entities.Post{{ID: 3, CommentCount: 9}, {ID: 2, CommentCount: 4}}
+		repo.EXPECT().Posts(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, filter entities.PostFilter) ([]*entities.Post, error) {
+				assert.Equal(t, entities.PostSortTop, filter.Sort)
+				require.NotNil(t, filter.CreatedFrom)
+				assert.WithinDuration(t, time.Now().Add(-24*time.Hour), *filter.CreatedFrom, time.Minute)
+				return page, nil
+			})
+
+		res, err := uc.Posts(ctx, entities.PostFilter{Sort: entities.PostSortTop, Window: 24 * time.Hour, Limit: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, &entities.PostCursor{ID: 3, Rank: 9}, res.NextCursor)
+	})
+
+	t.Run("Posts - unknown sort", func(t *testing.T) {
+		_, err := uc.Posts(ctx, entities.PostFilter{Sort: "random"})
+		assert.ErrorIs(t, err, errors.ErrInvalidSort)
+	})
+
+	t.Run("Drafts - limit clamped", func(t *testing.T) {
+		repo.EXPECT().Drafts(ctx, int64(1), repository.MaxPostsLimit, 0).Return(nil, nil)
+		_, err := uc.Drafts(ctx, 1, 1000, -1)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCategoryUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockCategoryRepository(ctrl)
+	uc := usecase.NewCategoryUsecase(repo, logger.NewStdLogger())
+	ctx := context.Background()
+
+	t.Run("CreateCategory", func(t *testing.T) {
+		category := &entities.Category{Slug: " News ", Title: " Новости "}
+		repo.EXPECT().CreateCategory(ctx, category).Return(nil)
+
+		err := uc.CreateCategory(ctx, category)
+		assert.NoError(t, err)
+		assert.Equal(t, "news", category.Slug)
+		assert.Equal(t, "Новости", category.Title)
+	})
+
+	t.Run("CreateCategory - invalid slug", func(t *testing.T) {
+		err := uc.CreateCategory(ctx, &entities.Category{Slug: "новости", Title: "Новости"})
+		assert.ErrorIs(t, err, errors.ErrInvalidSlug)
+	})
+
+	t.Run("UpdateCategory - empty title", func(t *testing.T) {
+		err := uc.UpdateCategory(ctx, &entities.Category{ID: 1, Slug: "news", Title: "  "})
+		assert.ErrorIs(t, err, errors.ErrEmptyTitle)
+	})
+
+	t.Run("Categories", func(t *testing.T) {
+		categories := []*entities.Category{{ID: 1, Slug: "news"}}
+		repo.EXPECT().Categories(ctx).Return(categories, nil)
+
+		res, err := uc.Categories(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, categories, res)
 	})
 }
 
@@ -285,18 +660,32 @@ func TestCommentUsecase(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mocks.NewMockCommentRepository(ctrl)
+	postRepo := mocks.NewMockPostRepository(ctrl)
 	logger := logger.NewStdLogger()
-	uc := usecase.NewCommentUsecase(repo, logger)
+	uc := usecase.NewCommentUsecase(repo, postRepo, markdown.New(markdown.DefaultConfig()), nil, nil, nil, logger)
 
 	ctx := context.Background()
 	comment := &entities.Comment{ID: 1, AuthorID: 1, PostID: 2, Content: "text"}
 
 	t.Run("CreateComment", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(2)).Return(&entities.Post{ID: 2}, nil)
 		repo.EXPECT().CreateComment(ctx, comment).Return(nil)
 		err := uc.CreateComment(ctx, comment)
 		assert.NoError(t, err)
 	})
 
+	t.Run("CreateComment - locked post", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(2)).Return(&entities.Post{ID: 2, IsLocked: true}, nil)
+		err := uc.CreateComment(ctx, &entities.Comment{PostID: 2, Content: "text"})
+		assert.ErrorIs(t, err, errors.ErrPostLocked)
+	})
+
+	t.Run("CreateComment - draft post", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(2)).Return(&entities.Post{ID: 2, Status: entities.PostStatusDraft}, nil)
+		err := uc.CreateComment(ctx, &entities.Comment{PostID: 2, Content: "text"})
+		assert.ErrorIs(t, err, errors.ErrPostNotFound)
+	})
+
 	t.Run("GetCommentByID", func(t *testing.T) {
 		repo.EXPECT().GetCommentByID(ctx, int64(1)).Return(comment, nil)
 		res, err := uc.GetCommentByID(ctx, 1)
@@ -325,8 +714,1058 @@ func TestCommentUsecase(t *testing.T) {
 	})
 
 	t.Run("DeleteComment", func(t *testing.T) {
-		repo.EXPECT().DeleteComment(ctx, int64(1)).Return(nil)
-		err := uc.DeleteComment(ctx, 1)
+		repo.EXPECT().DeleteComment(ctx, int64(1), int64(3), "").Return(nil)
+		err := uc.DeleteComment(ctx, 1, 3, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("RestoreComment - not in trash", func(t *testing.T) {
+		repo.EXPECT().RestoreComment(ctx, int64(1)).Return(errors.ErrCommentNotFound)
+		_, err := uc.RestoreComment(ctx, 1)
+		assert.ErrorIs(t, err, errors.ErrCommentNotFound)
+	})
+
+	t.Run("DeletedComments - default limit", func(t *testing.T) {
+		repo.EXPECT().DeletedComments(ctx, repository.DefaultTrashLimit, 10).Return([]*entities.Comment{comment}, nil)
+		res, err := uc.DeletedComments(ctx, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+	})
+}
+
+func TestCommentUsecase_Replies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockCommentRepository(ctrl)
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	uc := usecase.NewCommentUsecase(repo, postRepo, markdown.New(markdown.DefaultConfig()), nil, nil, nil, logger.NewStdLogger())
+	ctx := context.Background()
+	parentID := int64(10)
+	postRepo.EXPECT().GetPostByID(ctx, int64(2)).Return(&entities.Post{ID: 2}, nil).AnyTimes()
+
+	t.Run("reply gets parent depth + 1", func(t *testing.T) {
+		repo.EXPECT().GetCommentByID(ctx, parentID).Return(&entities.Comment{ID: parentID, PostID: 2, Depth: 3}, nil)
+		reply := &entities.Comment{PostID: 2, ParentID: &parentID, Content: "reply"}
+		repo.EXPECT().CreateComment(ctx, reply).Return(nil)
+
+		err := uc.CreateComment(ctx, reply)
 		assert.NoError(t, err)
+		assert.Equal(t, int32(4), reply.Depth)
+	})
+
+	t.Run("parent not found", func(t *testing.T) {
+		repo.EXPECT().GetCommentByID(ctx, parentID).Return(nil, errors.ErrCommentNotFound)
+		err := uc.CreateComment(ctx, &entities.Comment{PostID: 2, ParentID: &parentID})
+		assert.ErrorIs(t, err, errors.ErrParentNotFound)
+	})
+
+	t.Run("parent from another post", func(t *testing.T) {
+		repo.EXPECT().GetCommentByID(ctx, parentID).Return(&entities.Comment{ID: parentID, PostID: 3}, nil)
+		err := uc.CreateComment(ctx, &entities.Comment{PostID: 2, ParentID: &parentID})
+		assert.ErrorIs(t, err, errors.ErrReplyPostMismatch)
+	})
+
+	t.Run("deleted parent", func(t *testing.T) {
+		repo.EXPECT().GetCommentByID(ctx, parentID).Return(&entities.Comment{ID: parentID, PostID: 2, Deleted: true}, nil)
+		err := uc.CreateComment(ctx, &entities.Comment{PostID: 2, ParentID: &parentID})
+		assert.ErrorIs(t, err, errors.ErrReplyToDeleted)
+	})
+
+	t.Run("too deep", func(t *testing.T) {
+		repo.EXPECT().GetCommentByID(ctx, parentID).
+			Return(&entities.Comment{ID: parentID, PostID: 2, Depth: usecase.MaxCommentDepth}, nil)
+		err := uc.CreateComment(ctx, &entities.Comment{PostID: 2, ParentID: &parentID})
+		assert.ErrorIs(t, err, errors.ErrCommentTooDeep)
+	})
+
+	t.Run("GetTreeByPostID", func(t *testing.T) {
+		one, two := int64(1), int64(2)
+		repo.EXPECT().GetByPostID(ctx, int64(2)).Return([]*entities.Comment{
+			{ID: 1, PostID: 2},
+			{ID: 2, PostID: 2, ParentID: &one, Depth: 1},
+			{ID: 4, PostID: 2, ParentID: &two, Depth: 2},
+			{ID: 3, PostID: 2, ParentID: &one, Depth: 1},
+			{ID: 5, PostID: 2},
+		}, nil)
+
+		roots, err := uc.GetTreeByPostID(ctx, 2)
+		assert.NoError(t, err)
+		assert.Len(t, roots, 2)
+		assert.Len(t, roots[0].Replies, 2)
+		assert.Equal(t, int64(3), roots[0].Replies[1].ID)
+		assert.Equal(t, int64(4), roots[0].Replies[0].Replies[0].ID)
+		assert.Empty(t, roots[1].Replies)
+	})
+}
+
+func TestContentFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	log := logger.NewStdLogger()
+	renderer := markdown.New(markdown.DefaultConfig())
+	chain := filter.NewChain([]filter.Rule{
+		{Filter: filter.NewBannedWords([]string{"spam"}), Action: filter.Mask},
+		{Filter: filter.NewLinks(0), Action: filter.Hold, Targets: []string{"post", "comment", "chat"}},
+		{Filter: filter.NewBannedWords([]string{"казин*"}), Action: filter.Reject},
+	}, log)
+
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	commentRepo := mocks.NewMockCommentRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	postUC := usecase.NewPostUsecase(postRepo, renderer, nil, chain, log)
+	// уведомления о задержанном комментарии не отправляются: у мока нет ожиданий
+	notifier := uc_mocks.NewMockCommentNotifier(ctrl)
+	commentUC := usecase.NewCommentUsecase(commentRepo, postRepo, renderer, nil, chain, notifier, log)
+	chatUC := usecase.NewChatUsecase(chatRepo, nil, chain, log, &pb.ChatConfig{MaxMessageLength: 1000})
+
+	t.Run("CreatePost - rejected", func(t *testing.T) {
+		err := postUC.CreatePost(ctx, &entities.Post{Title: "Лучшие казино", AuthorID: 1})
+		assert.ErrorIs(t, err, errors.ErrContentRejected)
+	})
+
+	t.Run("CreatePost - masked", func(t *testing.T) {
+		post := &entities.Post{Title: "title", Content: "это sраm", AuthorID: 1}
+		postRepo.EXPECT().CreatePost(ctx, post).Return(nil)
+
+		assert.NoError(t, postUC.CreatePost(ctx, post))
+		assert.Equal(t, "это ****", post.Content)
+		assert.Equal(t, "<p>это ****</p>", post.ContentHTML)
+		assert.Nil(t, post.Deletion)
+		assert.Nil(t, post.Hold)
+	})
+
+	t.Run("CreatePost - held", func(t *testing.T) {
+		post := &entities.Post{Title: "title", Content: "https://x.example", AuthorID: 1}
+		// пост сохраняется вместе с задержкой одним вызовом, без удаления в корзину
+		postRepo.EXPECT().CreatePost(ctx, post).DoAndReturn(func(_ context.Context, p *entities.Post) error {
+			if assert.NotNil(t, p.Hold) {
+				assert.Equal(t, "слишком много ссылок: не больше 0", p.Hold.Reason)
+			}
+			p.ID = 7
+			p.Status = entities.PostStatusHeld
+			return nil
+		})
+
+		assert.NoError(t, postUC.CreatePost(ctx, post))
+		assert.Nil(t, post.Deletion)
+	})
+
+	t.Run("UpdatePost - held", func(t *testing.T) {
+		post := &entities.Post{ID: 7, Title: "title", Content: "https://x.example", AuthorID: 1}
+		postRepo.EXPECT().UpdatePost(ctx, post).DoAndReturn(func(_ context.Context, p *entities.Post) error {
+			assert.NotNil(t, p.Hold)
+			return nil
+		})
+
+		assert.NoError(t, postUC.UpdatePost(ctx, post))
+		assert.Nil(t, post.Deletion)
+	})
+
+	t.Run("PublishPost - held", func(t *testing.T) {
+		err := postUC.PublishPost(ctx, &entities.Post{ID: 7, Status: entities.PostStatusHeld}, nil)
+		assert.ErrorIs(t, err, errors.ErrPostHeld)
 	})
+
+	t.Run("CreateComment - held", func(t *testing.T) {
+		comment := &entities.Comment{PostID: 2, AuthorID: 1, Content: "www.x.example"}
+		postRepo.EXPECT().GetPostByID(ctx, int64(2)).Return(&entities.Post{ID: 2}, nil)
+		commentRepo.EXPECT().CreateComment(ctx, comment).DoAndReturn(func(_ context.Context, c *entities.Comment) error {
+			assert.NotNil(t, c.Hold)
+			return nil
+		})
+
+		assert.NoError(t, commentUC.CreateComment(ctx, comment))
+		assert.Nil(t, comment.Deletion)
+	})
+
+	t.Run("UpdateComment - held", func(t *testing.T) {
+		comment := &entities.Comment{ID: 5, AuthorID: 1, Content: "www.x.example"}
+		commentRepo.EXPECT().UpdateComment(ctx, comment).DoAndReturn(func(_ context.Context, c *entities.Comment) error {
+			assert.NotNil(t, c.Hold)
+			return nil
+		})
+
+		assert.NoError(t, commentUC.UpdateComment(ctx, comment))
+	})
+
+	t.Run("UpdateComment - rejected", func(t *testing.T) {
+		err := commentUC.UpdateComment(ctx, &entities.Comment{ID: 5, AuthorID: 1, Content: "казино"})
+		assert.ErrorIs(t, err, errors.ErrContentRejected)
+	})
+
+	t.Run("SendMessage - masked", func(t *testing.T) {
+		msg := &entities.ChatMessage{UserID: 1, Content: "spam"}
+		chatRepo.EXPECT().SaveMessage(ctx, int64(1), "", "****").Return(int64(1), nil)
+
+		assert.NoError(t, chatUC.SendMessage(ctx, msg))
+		assert.Equal(t, "****", msg.Content)
+	})
+
+	t.Run("SendMessage - held is rejected", func(t *testing.T) {
+		err := chatUC.SendMessage(ctx, &entities.ChatMessage{UserID: 1, Content: "https://x.example"})
+		assert.ErrorIs(t, err, errors.ErrContentRejected)
+	})
+}
+
+func TestModerationUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	repo := mocks.NewMockHeldRepository(ctrl)
+	notifier := uc_mocks.NewMockCommentNotifier(ctrl)
+	uc := usecase.NewModerationUsecase(repo, markdown.New(markdown.DefaultConfig()), nil, notifier, logger.NewStdLogger())
+
+	t.Run("Held - default page", func(t *testing.T) {
+		repo.EXPECT().HeldContent(ctx, repository.DefaultTrashLimit, 0).Return([]*entities.HeldContent{{ID: 1}}, nil)
+
+		items, err := uc.Held(ctx, 0, -1)
+		assert.NoError(t, err)
+		assert.Len(t, items, 1)
+	})
+
+	t.Run("Approve - new comment notifies", func(t *testing.T) {
+		held := &entities.HeldContent{ID: 3, TargetType: "comment", TargetID: 11, PostID: 2, AuthorID: 1, Content: "текст"}
+		repo.EXPECT().ApproveHeld(ctx, int64(3)).Return(held, nil)
+		notifier.EXPECT().CommentCreated(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, c *entities.Comment) error {
+			assert.Equal(t, int64(11), c.ID)
+			assert.Equal(t, int64(2), c.PostID)
+			return nil
+		})
+
+		got, err := uc.Approve(ctx, 3, 9)
+		assert.NoError(t, err)
+		assert.Equal(t, held, got)
+	})
+
+	t.Run("Approve - edit does not notify", func(t *testing.T) {
+		repo.EXPECT().ApproveHeld(ctx, int64(4)).Return(&entities.HeldContent{ID: 4, TargetType: "comment", TargetID: 11, IsEdit: true}, nil)
+
+		_, err := uc.Approve(ctx, 4, 9)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Approve - not found", func(t *testing.T) {
+		repo.EXPECT().ApproveHeld(ctx, int64(5)).Return(nil, errors.ErrHeldNotFound)
+
+		_, err := uc.Approve(ctx, 5, 9)
+		assert.ErrorIs(t, err, errors.ErrHeldNotFound)
+	})
+
+	t.Run("Reject - default reason", func(t *testing.T) {
+		repo.EXPECT().RejectHeld(ctx, int64(6), int64(9), "отклонено модератором").Return(&entities.HeldContent{ID: 6, TargetType: "post", TargetID: 7}, nil)
+
+		_, err := uc.Reject(ctx, 6, 9, "  ")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Reject - reason too long", func(t *testing.T) {
+		_, err := uc.Reject(ctx, 6, 9, strings.Repeat("a", 1000))
+		assert.ErrorIs(t, err, errors.ErrReasonTooLong)
+	})
+}
+
+func TestSearchUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	commentRepo := mocks.NewMockCommentRepository(ctrl)
+	uc := usecase.NewSearchUsecase(postRepo, commentRepo, logger.NewStdLogger())
+
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("merges by rank", func(t *testing.T) {
+		window := entities.SearchQuery{Text: "go", Limit: 3}
+		postRepo.EXPECT().SearchPosts(ctx, window).Return([]*entities.SearchHit{
+			{TargetType: repository.TargetTypePost, PostID: 1, Rank: 0.9, CreatedAt: now},
+			{TargetType: repository.TargetTypePost, PostID: 2, Rank: 0.2, CreatedAt: now},
+		}, 2, nil)
+		commentRepo.EXPECT().SearchComments(ctx, window).Return([]*entities.SearchHit{
+			{TargetType: repository.TargetTypeComment, CommentID: 5, Rank: 0.5, CreatedAt: now},
+		}, 4, nil)
+
+		res, err := uc.Search(ctx, entities.SearchQuery{Text: "  go ", Limit: 2, Offset: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 6, res.TotalCount)
+		assert.Len(t, res.Hits, 2)
+		assert.Equal(t, int64(5), res.Hits[0].CommentID)
+		assert.Equal(t, int64(2), res.Hits[1].PostID)
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		_, err := uc.Search(ctx, entities.SearchQuery{Text: "   "})
+		assert.ErrorIs(t, err, errors.ErrEmptySearchQuery)
+	})
+
+	t.Run("too deep", func(t *testing.T) {
+		_, err := uc.Search(ctx, entities.SearchQuery{Text: "go", Offset: repository.MaxSearchOffset + 1})
+		assert.ErrorIs(t, err, errors.ErrSearchTooDeep)
+	})
+}
+
+func TestVoteUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	voteRepo := mocks.NewMockVoteRepository(ctrl)
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	commentRepo := mocks.NewMockCommentRepository(ctrl)
+	uc := usecase.NewVoteUsecase(voteRepo, postRepo, commentRepo, logger.NewStdLogger())
+	ctx := context.Background()
+
+	t.Run("Vote", func(t *testing.T) {
+		vote := &entities.Vote{UserID: 1, TargetType: repository.TargetTypePost, TargetID: 5, Value: -1}
+		postRepo.EXPECT().GetPostByID(ctx, int64(5)).Return(&entities.Post{ID: 5, AuthorID: 2}, nil)
+		voteRepo.EXPECT().Vote(ctx, vote).Return(int64(-1), nil)
+
+		score, err := uc.Vote(ctx, vote)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1), score)
+	})
+
+	t.Run("Vote - own comment", func(t *testing.T) {
+		commentRepo.EXPECT().GetCommentByID(ctx, int64(7)).Return(&entities.Comment{ID: 7, AuthorID: 1}, nil)
+
+		_, err := uc.Vote(ctx, &entities.Vote{UserID: 1, TargetType: repository.TargetTypeComment, TargetID: 7, Value: 1})
+		assert.ErrorIs(t, err, errors.ErrSelfVote)
+	})
+
+	t.Run("Vote - deleted comment", func(t *testing.T) {
+		commentRepo.EXPECT().GetCommentByID(ctx, int64(8)).Return(&entities.Comment{ID: 8, AuthorID: 2, Deleted: true}, nil)
+
+		_, err := uc.Vote(ctx, &entities.Vote{UserID: 1, TargetType: repository.TargetTypeComment, TargetID: 8, Value: 1})
+		assert.ErrorIs(t, err, errors.ErrCommentNotFound)
+	})
+
+	t.Run("Vote - invalid value", func(t *testing.T) {
+		_, err := uc.Vote(ctx, &entities.Vote{UserID: 1, TargetType: repository.TargetTypePost, TargetID: 5, Value: 2})
+		assert.ErrorIs(t, err, errors.ErrInvalidVote)
+	})
+
+	t.Run("Vote - empty target", func(t *testing.T) {
+		_, err := uc.Vote(ctx, &entities.Vote{UserID: 1, TargetType: repository.TargetTypePost, Value: 1})
+		assert.ErrorIs(t, err, errors.ErrEmptyTargetID)
+	})
+
+	t.Run("RemoveVote", func(t *testing.T) {
+		voteRepo.EXPECT().RemoveVote(ctx, int64(1), repository.TargetTypePost, int64(5)).Return(int64(0), nil)
+
+		score, err := uc.RemoveVote(ctx, 1, repository.TargetTypePost, 5)
+		assert.NoError(t, err)
+		assert.Zero(t, score)
+	})
+
+	t.Run("UserVotes - anonymous", func(t *testing.T) {
+		votes, err := uc.UserVotes(ctx, 0, repository.TargetTypePost, []int64{1, 2})
+		assert.NoError(t, err)
+		assert.Empty(t, votes)
+	})
+}
+
+func TestPollUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pollRepo := mocks.NewMockPollRepository(ctrl)
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	uc := usecase.NewPollUsecase(pollRepo, postRepo, logger.NewStdLogger())
+	ctx := context.Background()
+
+	t.Run("CastVote", func(t *testing.T) {
+		pollRepo.EXPECT().CastPollVote(ctx, int64(5), int64(1), "alice", []int64{10, 12}).Return(nil)
+		postRepo.EXPECT().GetPostByID(ctx, int64(5)).Return(&entities.Post{ID: 5, AuthorID: 2}, nil)
+		pollRepo.EXPECT().Polls(ctx, []int64{5}, int64(1)).Return(map[int64]*entities.Poll{
+			5: {PostID: 5, MultipleChoice: true, TotalVoters: 1, MyChoices: []int64{10, 12}},
+		}, nil)
+
+		poll, err := uc.CastVote(ctx, 5, 1, "alice", []int64{12, 10, 12})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{10, 12}, poll.MyChoices)
+	})
+
+	t.Run("CastVote - no options", func(t *testing.T) {
+		_, err := uc.CastVote(ctx, 5, 1, "alice", nil)
+		assert.ErrorIs(t, err, errors.ErrInvalidPollChoice)
+	})
+
+	t.Run("Results - public poll", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(6)).Return(&entities.Post{ID: 6, AuthorID: 2}, nil)
+		pollRepo.EXPECT().Polls(ctx, []int64{6}, int64(0)).Return(map[int64]*entities.Poll{
+			6: {PostID: 6, Public: true, Options: []*entities.PollOption{{ID: 20}, {ID: 21}}},
+		}, nil)
+		pollRepo.EXPECT().PollVoters(ctx, int64(6)).Return(map[int64][]*entities.PollVoter{
+			21: {{UserID: 3, Username: "bob"}},
+		}, nil)
+
+		poll, err := uc.Results(ctx, 6, 0)
+		assert.NoError(t, err)
+		assert.Empty(t, poll.Options[0].Voters)
+		require.Len(t, poll.Options[1].Voters, 1)
+		assert.Equal(t, "bob", poll.Options[1].Voters[0].Username)
+	})
+
+	t.Run("Results - post without poll", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(7)).Return(&entities.Post{ID: 7, AuthorID: 2}, nil)
+		pollRepo.EXPECT().Polls(ctx, []int64{7}, int64(1)).Return(map[int64]*entities.Poll{}, nil)
+
+		_, err := uc.Results(ctx, 7, 1)
+		assert.ErrorIs(t, err, errors.ErrPollNotFound)
+	})
+
+	t.Run("Results - someone else's draft", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(8)).Return(&entities.Post{ID: 8, AuthorID: 2, Status: entities.PostStatusDraft}, nil)
+
+		_, err := uc.Results(ctx, 8, 1)
+		assert.ErrorIs(t, err, errors.ErrPollNotFound)
+	})
+}
+
+func TestReportUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reportRepo := mocks.NewMockReportRepository(ctrl)
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	commentRepo := mocks.NewMockCommentRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	uc := usecase.NewReportUsecase(reportRepo, postRepo, commentRepo, chatRepo, logger.NewStdLogger())
+	ctx := context.Background()
+
+	t.Run("Report - chat message", func(t *testing.T) {
+		chatRepo.EXPECT().GetMessage(ctx, int64(40)).Return(&entities.ChatMessage{ID: 40, UserID: 2, Content: "грубость"}, nil)
+		reportRepo.EXPECT().CreateReport(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, r *entities.Report) error {
+			assert.Equal(t, int64(2), r.TargetAuthorID)
+			assert.Equal(t, "грубость", r.TargetContent)
+			assert.Equal(t, "оскорбления", r.Details)
+			return nil
+		})
+
+		report := &entities.Report{TargetType: repository.TargetTypeChat, TargetID: 40, ReporterID: 3, Reason: entities.ReportReasonAbuse, Details: "  оскорбления "}
+		assert.NoError(t, uc.Report(ctx, report))
+	})
+
+	t.Run("Report - other without details", func(t *testing.T) {
+		err := uc.Report(ctx, &entities.Report{TargetType: repository.TargetTypePost, TargetID: 5, Reason: entities.ReportReasonOther})
+		assert.ErrorIs(t, err, errors.ErrReportDetailsMissing)
+	})
+
+	t.Run("Report - unknown reason", func(t *testing.T) {
+		err := uc.Report(ctx, &entities.Report{TargetType: repository.TargetTypePost, TargetID: 5})
+		assert.ErrorIs(t, err, errors.ErrInvalidReportReason)
+	})
+
+	t.Run("Report - deleted comment", func(t *testing.T) {
+		commentRepo.EXPECT().GetCommentByID(ctx, int64(7)).Return(&entities.Comment{ID: 7, AuthorID: 2, Deleted: true}, nil)
+
+		err := uc.Report(ctx, &entities.Report{TargetType: repository.TargetTypeComment, TargetID: 7, Reason: entities.ReportReasonSpam})
+		assert.ErrorIs(t, err, errors.ErrCommentNotFound)
+	})
+
+	t.Run("Reports - unknown status", func(t *testing.T) {
+		_, err := uc.Reports(ctx, entities.ReportFilter{Status: "closed"})
+		assert.ErrorIs(t, err, errors.ErrInvalidReportStatus)
+	})
+
+	t.Run("Reports - defaults", func(t *testing.T) {
+		reportRepo.EXPECT().ReportGroups(ctx, entities.ReportFilter{Status: entities.ReportStatusOpen, Limit: repository.DefaultTrashLimit}).Return(nil, nil)
+
+		_, err := uc.Reports(ctx, entities.ReportFilter{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Resolve - delete post", func(t *testing.T) {
+		report := &entities.Report{ID: 1, TargetType: repository.TargetTypePost, TargetID: 5, Reason: entities.ReportReasonSpam, Status: entities.ReportStatusOpen}
+		reportRepo.EXPECT().GetReport(ctx, int64(1)).Return(report, nil)
+		reportRepo.EXPECT().ResolveReports(ctx, report, entities.ReportStatusRemoved, int64(9), "", "жалоба: spam").Return(int64(2), nil)
+
+		resolved, err := uc.Resolve(ctx, 1, entities.ReportStatusRemoved, 9, "")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), resolved)
+	})
+
+	t.Run("Resolve - resolved concurrently", func(t *testing.T) {
+		// жалобы успел закрыть другой модератор: контент не трогается
+		report := &entities.Report{ID: 2, TargetType: repository.TargetTypeChat, TargetID: 40, Status: entities.ReportStatusOpen}
+		reportRepo.EXPECT().GetReport(ctx, int64(2)).Return(report, nil)
+		reportRepo.EXPECT().ResolveReports(ctx, report, entities.ReportStatusRemoved, int64(9), "спам", "спам").Return(int64(0), errors.ErrReportResolved)
+
+		_, err := uc.Resolve(ctx, 2, entities.ReportStatusRemoved, 9, "спам")
+		assert.ErrorIs(t, err, errors.ErrReportResolved)
+	})
+
+	t.Run("Resolve - already resolved", func(t *testing.T) {
+		reportRepo.EXPECT().GetReport(ctx, int64(3)).Return(&entities.Report{ID: 3, Status: entities.ReportStatusDismissed}, nil)
+
+		_, err := uc.Resolve(ctx, 3, entities.ReportStatusWarned, 9, "")
+		assert.ErrorIs(t, err, errors.ErrReportResolved)
+	})
+}
+
+func TestBookmarkUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookmarkRepo := mocks.NewMockBookmarkRepository(ctrl)
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	uc := usecase.NewBookmarkUsecase(bookmarkRepo, postRepo, logger.NewStdLogger())
+	ctx := context.Background()
+
+	t.Run("AddBookmark", func(t *testing.T) {
+		post := &entities.Post{ID: 5, Title: "Title"}
+		postRepo.EXPECT().GetPostByID(ctx, int64(5)).Return(post, nil)
+		bookmarkRepo.EXPECT().AddBookmark(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, b *entities.Bookmark) error {
+			assert.Equal(t, "прочитать", b.Note)
+			return nil
+		})
+
+		bookmark := &entities.Bookmark{UserID: 1, PostID: 5, Note: "  прочитать \n"}
+		err := uc.AddBookmark(ctx, bookmark)
+		assert.NoError(t, err)
+		assert.Same(t, post, bookmark.Post)
+	})
+
+	t.Run("AddBookmark - note too long", func(t *testing.T) {
+		err := uc.AddBookmark(ctx, &entities.Bookmark{UserID: 1, PostID: 5, Note: strings.Repeat("я", usecase.MaxBookmarkNoteLength+1)})
+		assert.ErrorIs(t, err, errors.ErrNoteTooLong)
+	})
+
+	t.Run("AddBookmark - draft", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(6)).Return(&entities.Post{ID: 6, Status: entities.PostStatusDraft}, nil)
+
+		err := uc.AddBookmark(ctx, &entities.Bookmark{UserID: 1, PostID: 6})
+		assert.ErrorIs(t, err, errors.ErrPostNotFound)
+	})
+
+	t.Run("AddBookmark - deleted post", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(7)).Return(&entities.Post{ID: 7, Deleted: true}, nil)
+
+		err := uc.AddBookmark(ctx, &entities.Bookmark{UserID: 1, PostID: 7})
+		assert.ErrorIs(t, err, errors.ErrPostNotFound)
+	})
+
+	t.Run("Bookmarks - clamps page", func(t *testing.T) {
+		bookmarkRepo.EXPECT().Bookmarks(ctx, int64(1), repository.MaxPostsLimit, 0).Return(nil, nil)
+
+		_, err := uc.Bookmarks(ctx, 1, 1000, -5)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Bookmarked - anonymous", func(t *testing.T) {
+		bookmarked, err := uc.Bookmarked(ctx, 0, []int64{1, 2})
+		assert.NoError(t, err)
+		assert.Empty(t, bookmarked)
+	})
+}
+
+func TestNotificationUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationRepo := mocks.NewMockNotificationRepository(ctrl)
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	commentRepo := mocks.NewMockCommentRepository(ctrl)
+	uc := usecase.NewNotificationUsecase(notificationRepo, postRepo, logger.NewStdLogger())
+	commentUC := usecase.NewCommentUsecase(commentRepo, postRepo, markdown.New(markdown.DefaultConfig()), nil, nil, uc, logger.NewStdLogger())
+	ctx := context.Background()
+
+	t.Run("CreateComment notifies subscribers", func(t *testing.T) {
+		comment := &entities.Comment{AuthorID: 3, PostID: 2, Content: "text"}
+		postRepo.EXPECT().GetPostByID(ctx, int64(2)).Return(&entities.Post{ID: 2, AuthorID: 1}, nil)
+		commentRepo.EXPECT().CreateComment(ctx, comment).DoAndReturn(func(_ context.Context, c *entities.Comment) error {
+			c.ID = 10
+			return nil
+		})
+		notificationRepo.EXPECT().NotifyComment(ctx, comment).Return(int64(1), nil)
+
+		assert.NoError(t, commentUC.CreateComment(ctx, comment))
+	})
+
+	t.Run("CreateComment - notification failure", func(t *testing.T) {
+		comment := &entities.Comment{AuthorID: 3, PostID: 2, Content: "text"}
+		postRepo.EXPECT().GetPostByID(ctx, int64(2)).Return(&entities.Post{ID: 2, AuthorID: 1}, nil)
+		commentRepo.EXPECT().CreateComment(ctx, comment).Return(nil)
+		notificationRepo.EXPECT().NotifyComment(ctx, comment).Return(int64(0), fmt.Errorf("db down"))
+
+		assert.NoError(t, commentUC.CreateComment(ctx, comment))
+	})
+
+	t.Run("Follow", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(2)).Return(&entities.Post{ID: 2}, nil)
+		notificationRepo.EXPECT().SetSubscription(ctx, int64(3), int64(2), true).Return(nil)
+
+		assert.NoError(t, uc.Follow(ctx, 3, 2))
+	})
+
+	t.Run("Unfollow - deleted post", func(t *testing.T) {
+		postRepo.EXPECT().GetPostByID(ctx, int64(4)).Return(&entities.Post{ID: 4, Deleted: true}, nil)
+
+		err := uc.Unfollow(ctx, 3, 4)
+		assert.ErrorIs(t, err, errors.ErrPostNotFound)
+	})
+
+	t.Run("Notifications - clamps page", func(t *testing.T) {
+		notificationRepo.EXPECT().Notifications(ctx, int64(3), true, repository.DefaultPostsLimit, 0).Return(nil, nil)
+
+		_, err := uc.Notifications(ctx, 3, true, 0, -1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("MarkRead - no ids", func(t *testing.T) {
+		assert.NoError(t, uc.MarkRead(ctx, 3, nil))
+	})
+}
+
+func TestMentionUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mentionRepo := mocks.NewMockMentionRepository(ctrl)
+	resolver := uc_mocks.NewMockUserResolver(ctrl)
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	chatRepo := mocks.NewMockChatRepository(ctrl)
+	uc := usecase.NewMentionUsecase(mentionRepo, resolver, "", logger.NewStdLogger())
+	postUC := usecase.NewPostUsecase(postRepo, markdown.New(markdown.DefaultConfig()), uc, nil, logger.NewStdLogger())
+	chatUC := usecase.NewChatUsecase(chatRepo, uc, nil, logger.NewStdLogger(), &pb.ChatConfig{MaxMessageLength: 1000})
+	ctx := context.Background()
+
+	t.Run("CreatePost links known users", func(t *testing.T) {
+		post := &entities.Post{Title: "title", AuthorID: 3, Content: "@alice и @mallory, но не `@bob`"}
+		resolver.EXPECT().ResolveUsernames(ctx, []string{"alice", "mallory"}).Return(map[string]int64{"alice": 4}, nil)
+		postRepo.EXPECT().CreatePost(ctx, post).DoAndReturn(func(_ context.Context, p *entities.Post) error {
+			p.ID = 1
+			return nil
+		})
+		mentionRepo.EXPECT().SaveMentions(ctx, repository.TargetTypePost, int64(1), []*entities.Mention{
+			{TargetType: repository.TargetTypePost, TargetID: 1, UserID: 4, Username: "alice", AuthorID: 3},
+		}).Return(nil)
+
+		require.NoError(t, postUC.CreatePost(ctx, post))
+		assert.Equal(t, `<p><a href="/users/4" class="mention">@alice</a> и @mallory, но не <code>@bob</code></p>`, post.ContentHTML)
+	})
+
+	t.Run("CreatePost - too many mentions", func(t *testing.T) {
+		names := make([]string, usecase.MaxMentions+1)
+		for i := range names {
+			names[i] = fmt.Sprintf("@user%d", i)
+		}
+		post := &entities.Post{Title: "title", AuthorID: 3, Content: strings.Join(names, " ")}
+
+		err := postUC.CreatePost(ctx, post)
+		assert.ErrorIs(t, err, errors.ErrTooManyMentions)
+	})
+
+	t.Run("CreatePost - saving mentions fails", func(t *testing.T) {
+		post := &entities.Post{Title: "title", AuthorID: 3, Content: "@alice"}
+		resolver.EXPECT().ResolveUsernames(ctx, []string{"alice"}).Return(map[string]int64{"alice": 4}, nil)
+		postRepo.EXPECT().CreatePost(ctx, post).Return(nil)
+		mentionRepo.EXPECT().SaveMentions(ctx, repository.TargetTypePost, int64(0), gomock.Any()).Return(fmt.Errorf("db down"))
+
+		assert.NoError(t, postUC.CreatePost(ctx, post))
+	})
+
+	t.Run("SendMessage stores chat mentions", func(t *testing.T) {
+		msg := &entities.ChatMessage{UserID: 3, Username: "carol", Content: "привет, @alice"}
+		resolver.EXPECT().ResolveUsernames(ctx, []string{"alice"}).Return(map[string]int64{"alice": 4}, nil)
+		chatRepo.EXPECT().SaveMessage(ctx, msg.UserID, msg.Username, msg.Content).Return(int64(7), nil)
+		mentionRepo.EXPECT().SaveMentions(ctx, repository.TargetTypeChat, int64(7), []*entities.Mention{
+			{TargetType: repository.TargetTypeChat, TargetID: 7, UserID: 4, Username: "alice", AuthorID: 3},
+		}).Return(nil)
+
+		require.NoError(t, chatUC.SendMessage(ctx, msg))
+		assert.Equal(t, int64(7), msg.ID)
+		assert.Equal(t, "привет, @alice", msg.Content)
+	})
+
+	t.Run("Relink uses stored mentions", func(t *testing.T) {
+		mentionRepo.EXPECT().Mentions(ctx, repository.TargetTypeComment, int64(5)).
+			Return([]*entities.Mention{{UserID: 4, Username: "alice"}}, nil)
+
+		html, err := uc.Relink(ctx, repository.TargetTypeComment, 5, "<p>@alice @bob</p>")
+		require.NoError(t, err)
+		assert.Equal(t, `<p><a href="/users/4" class="mention">@alice</a> @bob</p>`, html)
+	})
+
+	t.Run("UserMentions - clamps page", func(t *testing.T) {
+		mentionRepo.EXPECT().UserMentions(ctx, int64(4), repository.MaxPostsLimit, 0).Return(nil, nil)
+
+		_, err := uc.UserMentions(ctx, 4, 1000, 0)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRevisionUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	revisionRepo := mocks.NewMockRevisionRepository(ctrl)
+	postRepo := mocks.NewMockPostRepository(ctrl)
+	commentRepo := mocks.NewMockCommentRepository(ctrl)
+	uc := usecase.NewRevisionUsecase(revisionRepo, postRepo, commentRepo, markdown.New(markdown.DefaultConfig()), nil, logger.NewStdLogger())
+	ctx := context.Background()
+
+	t.Run("PostRevisions - diff with previous", func(t *testing.T) {
+		revisionRepo.EXPECT().Revisions(ctx, repository.TargetTypePost, int64(5)).Return([]*entities.Revision{
+			{ID: 1, Content: "a\nb"},
+			{ID: 2, Content: "a\nc"},
+		}, nil)
+
+		revisions, err := uc.PostRevisions(ctx, 5)
+		assert.NoError(t, err)
+		assert.Len(t, revisions, 2)
+		assert.Empty(t, revisions[0].Diff)
+		assert.Equal(t, []diff.Line{{Op: diff.Equal, Text: "a"}, {Op: diff.Delete, Text: "b"}, {Op: diff.Insert, Text: "c"}},
+			revisions[1].Diff)
+	})
+
+	t.Run("RollbackPost", func(t *testing.T) {
+		revisionRepo.EXPECT().GetRevision(ctx, int64(1)).Return(&entities.Revision{
+			ID: 1, TargetType: repository.TargetTypePost, TargetID: 5, Title: "Old", Content: "old",
+		}, nil)
+		postRepo.EXPECT().GetPostByID(ctx, int64(5)).Return(&entities.Post{
+			ID: 5, Title: "New", Content: "new", Tags: []string{"go"},
+		}, nil)
+		postRepo.EXPECT().UpdatePost(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, p *entities.Post) error {
+			assert.Equal(t, "Old", p.Title)
+			assert.Equal(t, int64(9), p.EditorID)
+			assert.Nil(t, p.Tags)
+			assert.True(t, p.KeepHeldEdit)
+			return nil
+		})
+
+		post, err := uc.RollbackPost(ctx, 5, 1, 9)
+		assert.NoError(t, err)
+		assert.Equal(t, "old", post.Content)
+		assert.Equal(t, []string{"go"}, post.Tags)
+	})
+
+	t.Run("RollbackComment - held edit pending", func(t *testing.T) {
+		revisionRepo.EXPECT().GetRevision(ctx, int64(3)).Return(&entities.Revision{
+			ID: 3, TargetType: repository.TargetTypeComment, TargetID: 7, Content: "old",
+		}, nil)
+		commentRepo.EXPECT().GetCommentByID(ctx, int64(7)).Return(&entities.Comment{ID: 7, Content: "new"}, nil)
+		commentRepo.EXPECT().UpdateComment(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, c *entities.Comment) error {
+			assert.True(t, c.KeepHeldEdit)
+			return errors.ErrEditHeld
+		})
+
+		_, err := uc.RollbackComment(ctx, 7, 3, 9)
+		assert.ErrorIs(t, err, errors.ErrEditHeld)
+	})
+
+	t.Run("RollbackComment - revision of another target", func(t *testing.T) {
+		revisionRepo.EXPECT().GetRevision(ctx, int64(2)).Return(&entities.Revision{
+			ID: 2, TargetType: repository.TargetTypePost, TargetID: 7,
+		}, nil)
+
+		_, err := uc.RollbackComment(ctx, 7, 2, 9)
+		assert.ErrorIs(t, err, errors.ErrRevisionNotFound)
+	})
+
+	t.Run("RollbackPost - deleted post", func(t *testing.T) {
+		revisionRepo.EXPECT().GetRevision(ctx, int64(1)).Return(&entities.Revision{
+			ID: 1, TargetType: repository.TargetTypePost, TargetID: 5, Title: "Old", Content: "old",
+		}, nil)
+		postRepo.EXPECT().GetPostByID(ctx, int64(5)).Return(&entities.Post{ID: 5, Deleted: true}, nil)
+
+		_, err := uc.RollbackPost(ctx, 5, 1, 9)
+		assert.ErrorIs(t, err, errors.ErrPostNotFound)
+	})
+
+	t.Run("RollbackComment - deleted comment", func(t *testing.T) {
+		revisionRepo.EXPECT().GetRevision(ctx, int64(3)).Return(&entities.Revision{
+			ID: 3, TargetType: repository.TargetTypeComment, TargetID: 7, Content: "old",
+		}, nil)
+		commentRepo.EXPECT().GetCommentByID(ctx, int64(7)).Return(&entities.Comment{ID: 7, Deleted: true}, nil)
+
+		_, err := uc.RollbackComment(ctx, 7, 3, 9)
+		assert.ErrorIs(t, err, errors.ErrCommentNotFound)
+	})
+}
+
+func TestAttachmentUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockAttachmentRepository(ctrl)
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+	limits := usecase.AttachmentLimits{
+		MaxFileSize:   1 << 20,
+		UserQuota:     2 << 20,
+		AllowedTypes:  []string{"image/png", "text/plain"},
+		ThumbnailSize: 16,
+	}
+	uc := usecase.NewAttachmentUsecase(repo, store, limits, logger.NewStdLogger())
+	ctx := context.Background()
+
+	var img bytes.Buffer
+	assert.NoError(t, png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 64, 32))))
+
+	t.Run("Upload - image with thumbnail", func(t *testing.T) {
+		repo.EXPECT().UserAttachmentsSize(ctx, int64(7)).Return(int64(0), nil)
+		repo.EXPECT().CreateAttachment(ctx, gomock.Any(), limits.UserQuota).DoAndReturn(func(_ context.Context, att *entities.Attachment, _ int64) error {
+			att.ID = 1
+			return nil
+		})
+
+		att := &entities.Attachment{TargetType: repository.TargetTypePost, TargetID: 5, UploaderID: 7, FileName: "../../cat.png"}
+		assert.NoError(t, uc.Upload(ctx, att, img.Bytes()))
+		assert.Equal(t, "cat.png", att.FileName)
+		assert.Equal(t, "image/png", att.ContentType)
+		assert.Equal(t, int32(64), att.Width)
+		assert.NotEmpty(t, att.ThumbnailKey)
+
+		thumb, err := uc.Content(ctx, att, true)
+		assert.NoError(t, err)
+		cfg, err := png.DecodeConfig(bytes.NewReader(thumb))
+		assert.NoError(t, err)
+		assert.Equal(t, 16, cfg.Width)
+	})
+
+	t.Run("Upload - type detected by content", func(t *testing.T) {
+		att := &entities.Attachment{UploaderID: 7, FileName: "photo.png"}
+		err := uc.Upload(ctx, att, []byte("%PDF-1.4 fake"))
+		assert.ErrorIs(t, err, errors.ErrUnsupportedFileType)
+	})
+
+	t.Run("Upload - too large", func(t *testing.T) {
+		err := uc.Upload(ctx, &entities.Attachment{UploaderID: 7}, make([]byte, limits.MaxFileSize+1))
+		assert.ErrorIs(t, err, errors.ErrFileTooLarge)
+	})
+
+	t.Run("Upload - quota exceeded", func(t *testing.T) {
+		repo.EXPECT().UserAttachmentsSize(ctx, int64(7)).Return(limits.UserQuota-2, nil)
+
+		err := uc.Upload(ctx, &entities.Attachment{UploaderID: 7}, []byte("hello"))
+		assert.ErrorIs(t, err, errors.ErrQuotaExceeded)
+	})
+
+	t.Run("Upload - quota exceeded by a concurrent upload", func(t *testing.T) {
+		repo.EXPECT().UserAttachmentsSize(ctx, int64(7)).Return(int64(0), nil)
+		repo.EXPECT().CreateAttachment(ctx, gomock.Any(), limits.UserQuota).Return(errors.ErrQuotaExceeded)
+
+		att := &entities.Attachment{UploaderID: 7}
+		err := uc.Upload(ctx, att, []byte("hello"))
+		assert.ErrorIs(t, err, errors.ErrQuotaExceeded)
+		_, err = store.Get(ctx, att.BlobKey)
+		assert.Error(t, err)
+	})
+
+	t.Run("PurgeOrphans removes blobs before rows", func(t *testing.T) {
+		key, err := blobstore.NewKey()
+		assert.NoError(t, err)
+		assert.NoError(t, store.Put(ctx, key, []byte("data")))
+		orphan := &entities.Attachment{ID: 3, BlobKey: key}
+
+		repo.EXPECT().OrphanedAttachments(ctx, gomock.Any()).Return([]*entities.Attachment{orphan}, nil)
+		repo.EXPECT().DeleteAttachment(ctx, int64(3)).DoAndReturn(func(context.Context, int64) error {
+			_, err := store.Get(ctx, key)
+			assert.ErrorIs(t, err, blobstore.ErrNotFound)
+			return nil
+		})
+
+		purged, err := uc.PurgeOrphans(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+	})
+}
+
+func TestArchiveUsecase_ExportImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	users := uc_mocks.NewMockUserArchive(ctrl)
+	categories := mocks.NewMockCategoryRepository(ctrl)
+	posts := mocks.NewMockPostRepository(ctrl)
+	repo := mocks.NewMockArchiveRepository(ctrl)
+	mentionRepo := mocks.NewMockMentionRepository(ctrl)
+	resolver := uc_mocks.NewMockUserResolver(ctrl)
+	mentionUC := usecase.NewMentionUsecase(mentionRepo, resolver, "", logger.NewStdLogger())
+	uc := usecase.NewArchiveUsecase(users, categories, posts, repo, markdown.New(markdown.DefaultConfig()), mentionUC, logger.NewStdLogger())
+
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	categoryID := int64(3)
+	parentID := int64(20)
+
+	users.EXPECT().ExportUsers(ctx, gomock.Any(), false).DoAndReturn(func(_ context.Context, w *archive.Writer, _ bool) (int, error) {
+		return 1, w.Write(archive.KindUser, archive.User{ID: 1, Username: "alice", CreatedAt: created})
+	})
+	categories.EXPECT().Categories(ctx).Return([]*entities.Category{{ID: categoryID, Slug: "news", Title: "Новости"}}, nil)
+	repo.EXPECT().ArchivePosts(ctx, int64(0), 500).Return([]*entities.Post{
+		{ID: 10, Title: "Старый", AuthorID: 1, Status: entities.PostStatusPublished, CreatedAt: created},
+		{ID: 11, Title: "Новый", AuthorID: 1, CategoryID: &categoryID, Status: entities.PostStatusDraft, CreatedAt: created,
+			Content: "привет, @bob", ContentHTML: `<p>привет, <a href="/users/2">@bob</a></p><script>alert(1)</script>`},
+	}, nil)
+	repo.EXPECT().ArchiveComments(ctx, int64(0), 500).Return([]*entities.Comment{
+		{ID: 20, PostID: 11, AuthorID: 1, Content: "корень", CreatedAt: created},
+		{ID: 21, PostID: 11, ParentID: &parentID, AuthorID: 1, Content: "ответ", CreatedAt: created},
+		{ID: 22, PostID: 11, AuthorID: 99, Content: "чужой", CreatedAt: created},
+	}, nil)
+	repo.EXPECT().ArchiveMessages(ctx, int64(0), 500).Return(nil, nil)
+
+	var buf bytes.Buffer
+	counts, err := uc.Export(ctx, &buf, usecase.ExportOptions{Source: "src"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"user": 1, "category": 1, "post": 2, "comment": 3}, counts)
+
+	// Загрузка в базу, где пост 10 уже загружен, а slug категории занят
+	users.EXPECT().ImportUser(ctx, gomock.Any(), gomock.Any()).Return(int64(77), nil)
+	repo.EXPECT().ImportedIDs(ctx, "src", archive.KindCategory).Return(map[int64]int64{}, nil)
+	repo.EXPECT().ImportedIDs(ctx, "src", archive.KindPost).Return(map[int64]int64{10: 500}, nil)
+	repo.EXPECT().ImportedIDs(ctx, "src", archive.KindComment).Return(map[int64]int64{}, nil)
+	repo.EXPECT().ImportedIDs(ctx, "src", archive.KindChatMessage).Return(map[int64]int64{}, nil)
+	categories.EXPECT().Categories(ctx).Return([]*entities.Category{{ID: 9, Slug: "news"}}, nil)
+	repo.EXPECT().MapImportedID(ctx, "src", archive.KindCategory, categoryID, int64(9)).Return(nil)
+	repo.EXPECT().ImportPost(ctx, "src", int64(11), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ int64, p *entities.Post) error {
+		assert.Equal(t, int64(77), p.AuthorID)
+		require.NotNil(t, p.CategoryID)
+		assert.Equal(t, int64(9), *p.CategoryID)
+		assert.Equal(t, entities.PostStatusDraft, p.Status)
+		assert.Equal(t, created, p.CreatedAt)
+		// HTML строится заново, упоминание ведёт на пользователя этой базы
+		assert.Equal(t, `<p>привет, <a href="/users/88" class="mention">@bob</a></p>`, p.ContentHTML)
+		p.ID = 501
+		return nil
+	})
+	resolver.EXPECT().ResolveUsernames(ctx, []string{"bob"}).Return(map[string]int64{"bob": 88}, nil)
+	mentionRepo.EXPECT().SaveMentions(ctx, repository.TargetTypePost, int64(501), []*entities.Mention{
+		{TargetType: repository.TargetTypePost, TargetID: 501, UserID: 88, Username: "bob", AuthorID: 77},
+	}).Return(nil)
+	mentionRepo.EXPECT().SaveMentions(ctx, repository.TargetTypeComment, gomock.Any(), gomock.Len(0)).Return(nil).Times(2)
+	repo.EXPECT().ImportComment(ctx, "src", int64(20), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ int64, c *entities.Comment) error {
+		assert.Equal(t, int64(501), c.PostID)
+		assert.Nil(t, c.ParentID)
+		assert.Equal(t, "<p>корень</p>", c.ContentHTML)
+		c.ID = 601
+		return nil
+	})
+	repo.EXPECT().ImportComment(ctx, "src", int64(21), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ int64, c *entities.Comment) error {
+		require.NotNil(t, c.ParentID)
+		assert.Equal(t, int64(601), *c.ParentID)
+		c.ID = 602
+		return nil
+	})
+	posts.EXPECT().RecountComments(ctx).Return(int64(1), nil)
+
+	report, err := uc.Import(ctx, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Imported[archive.KindPost])
+	assert.Equal(t, 1, report.Skipped[archive.KindPost])
+	assert.Equal(t, 2, report.Imported[archive.KindComment])
+	require.Len(t, report.Conflicts, 2)
+	assert.Equal(t, archive.KindCategory, report.Conflicts[0].Kind)
+	assert.Equal(t, archive.KindComment, report.Conflicts[1].Kind)
+	assert.Equal(t, int64(22), report.Conflicts[1].SourceID)
+}
+
+func TestArchiveUsecase_Import_OutOfOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	categories := mocks.NewMockCategoryRepository(ctrl)
+	repo := mocks.NewMockArchiveRepository(ctrl)
+	uc := usecase.NewArchiveUsecase(uc_mocks.NewMockUserArchive(ctrl), categories, mocks.NewMockPostRepository(ctrl), repo, nil, nil, logger.NewStdLogger())
+
+	repo.EXPECT().ImportedIDs(ctx, "src", gomock.Any()).Return(map[int64]int64{}, nil).Times(4)
+	categories.EXPECT().Categories(ctx).Return(nil, nil)
+
+	src := `{"version":1,"source":"src"}` + "\n" +
+		`{"kind":"chat_message","data":{"id":1,"user_id":5}}` + "\n" +
+		`{"kind":"post","data":{"id":1,"author_id":5}}` + "\n"
+	repo.EXPECT().ImportMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	report, err := uc.Import(ctx, strings.NewReader(src))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "строка 3")
+	// автора сообщения нет в архиве
+	require.Len(t, report.Conflicts, 1)
+}
+
+func TestAuthorNameSync_ApplyEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	repo := mocks.NewMockAuthorRepository(ctrl)
+	users := uc_mocks.NewMockUserDirectory(ctrl)
+	cache := uc_mocks.NewMockUserCache(ctrl)
+	sync := usecase.NewAuthorNameSync(repo, users, cache, logger.NewStdLogger())
+
+	repo.EXPECT().EventCursor(ctx, usecase.UserEventsLog).Return(int64(10), nil)
+	users.EXPECT().UserEvents(ctx, int64(10), 100).Return([]*entities.UserEvent{
+		{ID: 11, UserID: 1, Type: entities.UserEventUpdated, Username: "alice2"},
+		{ID: 12, UserID: 2, Type: "user.unknown"},
+		{ID: 13, UserID: 1, Type: entities.UserEventUpdated, Username: "alice3"},
+	}, nil)
+	// закешированные токены сбрасываются до переименования
+	gomock.InOrder(
+		cache.EXPECT().ForgetUser(int64(1)),
+		repo.EXPECT().RenameAuthor(ctx, int64(1), "alice2").Return(int64(4), nil),
+		cache.EXPECT().ForgetUser(int64(1)),
+		repo.EXPECT().RenameAuthor(ctx, int64(1), "alice3").Return(int64(4), nil),
+	)
+	repo.EXPECT().SetEventCursor(ctx, usecase.UserEventsLog, int64(13)).Return(nil)
+
+	applied, err := sync.ApplyEvents(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, applied)
+}
+
+func TestAuthorNameSync_ApplyEvents_RenameFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	repo := mocks.NewMockAuthorRepository(ctrl)
+	users := uc_mocks.NewMockUserDirectory(ctrl)
+	sync := usecase.NewAuthorNameSync(repo, users, nil, logger.NewStdLogger())
+
+	repo.EXPECT().EventCursor(ctx, usecase.UserEventsLog).Return(int64(0), nil)
+	users.EXPECT().UserEvents(ctx, int64(0), 100).Return([]*entities.UserEvent{
+		{ID: 1, UserID: 1, Type: entities.UserEventUpdated, Username: "alice2"},
+		{ID: 2, UserID: 2, Type: entities.UserEventUpdated, Username: "bob2"},
+	}, nil)
+	repo.EXPECT().RenameAuthor(ctx, int64(1), "alice2").Return(int64(1), nil)
+	repo.EXPECT().RenameAuthor(ctx, int64(2), "bob2").Return(int64(0), assert.AnError)
+	// позиция остаётся на последнем применённом событии
+	repo.EXPECT().SetEventCursor(ctx, usecase.UserEventsLog, int64(1)).Return(nil)
+
+	applied, err := sync.ApplyEvents(ctx)
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, 1, applied)
+}
+
+func TestAuthorNameSync_Resync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	repo := mocks.NewMockAuthorRepository(ctrl)
+	users := uc_mocks.NewMockUserDirectory(ctrl)
+	sync := usecase.NewAuthorNameSync(repo, users, nil, logger.NewStdLogger())
+
+	repo.EXPECT().EventCursor(ctx, usecase.UserEventsLog).Return(int64(20), nil)
+	repo.EXPECT().AuthorIDs(ctx, int64(0), 500).Return([]int64{1, 2, 3}, nil)
+	users.EXPECT().Username(ctx, int64(1)).Return("alice", nil)
+	users.EXPECT().Username(ctx, int64(2)).Return("", errors.ErrUserNotFound)
+	users.EXPECT().Username(ctx, int64(3)).Return("carol", nil)
+	repo.EXPECT().RenameAuthor(ctx, int64(1), "alice").Return(int64(0), nil)
+	repo.EXPECT().RenameAuthor(ctx, int64(3), "carol").Return(int64(2), nil)
+
+	// событие, пришедшее во время сверки, применяется поверх неё
+	users.EXPECT().UserEvents(ctx, int64(20), 100).Return([]*entities.UserEvent{
+		{ID: 21, UserID: 3, Type: entities.UserEventUpdated, Username: "carol2"},
+	}, nil)
+	repo.EXPECT().RenameAuthor(ctx, int64(3), "carol2").Return(int64(2), nil)
+
+	result, err := sync.Resync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, usecase.ResyncResult{Authors: 3, Missing: 1, Fixed: 2}, result)
 }
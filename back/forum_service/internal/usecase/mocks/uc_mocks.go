@@ -11,6 +11,8 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	entities "github.com/netabakovv/forum/back/forum_service/internal/entities"
+	filter "github.com/netabakovv/forum/back/forum_service/internal/filter"
+	archive "github.com/netabakovv/forum/back/pkg/archive"
 )
 
 // MockChatUsecaseInterface is a mock of ChatUsecaseInterface interface.
@@ -117,17 +119,47 @@ func (mr *MockPostUsecaseInterfaceMockRecorder) CreatePost(ctx, post interface{}
 }
 
 // DeletePost mocks base method.
-func (m *MockPostUsecaseInterface) DeletePost(ctx context.Context, id int64) error {
+func (m *MockPostUsecaseInterface) DeletePost(ctx context.Context, id, deletedBy int64, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePost", ctx, id)
+	ret := m.ctrl.Call(m, "DeletePost", ctx, id, deletedBy, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePost indicates an expected call of DeletePost.
-func (mr *MockPostUsecaseInterfaceMockRecorder) DeletePost(ctx, id interface{}) *gomock.Call {
+func (mr *MockPostUsecaseInterfaceMockRecorder) DeletePost(ctx, id, deletedBy, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockPostUsecaseInterface)(nil).DeletePost), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockPostUsecaseInterface)(nil).DeletePost), ctx, id, deletedBy, reason)
+}
+
+// DeletedPosts mocks base method.
+func (m *MockPostUsecaseInterface) DeletedPosts(ctx context.Context, limit, offset int) ([]*entities.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletedPosts", ctx, limit, offset)
+	ret0, _ := ret[0].([]*entities.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletedPosts indicates an expected call of DeletedPosts.
+func (mr *MockPostUsecaseInterfaceMockRecorder) DeletedPosts(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletedPosts", reflect.TypeOf((*MockPostUsecaseInterface)(nil).DeletedPosts), ctx, limit, offset)
+}
+
+// Drafts mocks base method.
+func (m *MockPostUsecaseInterface) Drafts(ctx context.Context, authorID int64, limit, offset int) ([]*entities.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drafts", ctx, authorID, limit, offset)
+	ret0, _ := ret[0].([]*entities.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drafts indicates an expected call of Drafts.
+func (mr *MockPostUsecaseInterfaceMockRecorder) Drafts(ctx, authorID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drafts", reflect.TypeOf((*MockPostUsecaseInterface)(nil).Drafts), ctx, authorID, limit, offset)
 }
 
 // GetPostByID mocks base method.
@@ -145,19 +177,123 @@ func (mr *MockPostUsecaseInterfaceMockRecorder) GetPostByID(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostByID", reflect.TypeOf((*MockPostUsecaseInterface)(nil).GetPostByID), ctx, id)
 }
 
+// LockPost mocks base method.
+func (m *MockPostUsecaseInterface) LockPost(ctx context.Context, id int64, locked bool) (*entities.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPost", ctx, id, locked)
+	ret0, _ := ret[0].(*entities.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPost indicates an expected call of LockPost.
+func (mr *MockPostUsecaseInterfaceMockRecorder) LockPost(ctx, id, locked interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPost", reflect.TypeOf((*MockPostUsecaseInterface)(nil).LockPost), ctx, id, locked)
+}
+
+// PinPost mocks base method.
+func (m *MockPostUsecaseInterface) PinPost(ctx context.Context, id int64, pinned bool) (*entities.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinPost", ctx, id, pinned)
+	ret0, _ := ret[0].(*entities.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinPost indicates an expected call of PinPost.
+func (mr *MockPostUsecaseInterfaceMockRecorder) PinPost(ctx, id, pinned interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinPost", reflect.TypeOf((*MockPostUsecaseInterface)(nil).PinPost), ctx, id, pinned)
+}
+
 // Posts mocks base method.
-func (m *MockPostUsecaseInterface) Posts(ctx context.Context) ([]*entities.Post, error) {
+func (m *MockPostUsecaseInterface) Posts(ctx context.Context, filter entities.PostFilter) (*entities.PostPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Posts", ctx)
-	ret0, _ := ret[0].([]*entities.Post)
+	ret := m.ctrl.Call(m, "Posts", ctx, filter)
+	ret0, _ := ret[0].(*entities.PostPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Posts indicates an expected call of Posts.
-func (mr *MockPostUsecaseInterfaceMockRecorder) Posts(ctx interface{}) *gomock.Call {
+func (mr *MockPostUsecaseInterfaceMockRecorder) Posts(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Posts", reflect.TypeOf((*MockPostUsecaseInterface)(nil).Posts), ctx, filter)
+}
+
+// PublishDue mocks base method.
+func (m *MockPostUsecaseInterface) PublishDue(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDue", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDue indicates an expected call of PublishDue.
+func (mr *MockPostUsecaseInterfaceMockRecorder) PublishDue(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDue", reflect.TypeOf((*MockPostUsecaseInterface)(nil).PublishDue), ctx, now)
+}
+
+// PublishPost mocks base method.
+func (m *MockPostUsecaseInterface) PublishPost(ctx context.Context, post *entities.Post, publishAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishPost", ctx, post, publishAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishPost indicates an expected call of PublishPost.
+func (mr *MockPostUsecaseInterfaceMockRecorder) PublishPost(ctx, post, publishAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishPost", reflect.TypeOf((*MockPostUsecaseInterface)(nil).PublishPost), ctx, post, publishAt)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockPostUsecaseInterface) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockPostUsecaseInterfaceMockRecorder) PurgeDeleted(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockPostUsecaseInterface)(nil).PurgeDeleted), ctx, before)
+}
+
+// RefreshHotRanks mocks base method.
+func (m *MockPostUsecaseInterface) RefreshHotRanks(ctx context.Context, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshHotRanks", ctx, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshHotRanks indicates an expected call of RefreshHotRanks.
+func (mr *MockPostUsecaseInterfaceMockRecorder) RefreshHotRanks(ctx, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshHotRanks", reflect.TypeOf((*MockPostUsecaseInterface)(nil).RefreshHotRanks), ctx, since)
+}
+
+// RestorePost mocks base method.
+func (m *MockPostUsecaseInterface) RestorePost(ctx context.Context, id int64) (*entities.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePost", ctx, id)
+	ret0, _ := ret[0].(*entities.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePost indicates an expected call of RestorePost.
+func (mr *MockPostUsecaseInterfaceMockRecorder) RestorePost(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Posts", reflect.TypeOf((*MockPostUsecaseInterface)(nil).Posts), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePost", reflect.TypeOf((*MockPostUsecaseInterface)(nil).RestorePost), ctx, id)
 }
 
 // UpdatePost mocks base method.
@@ -174,112 +310,1414 @@ func (mr *MockPostUsecaseInterfaceMockRecorder) UpdatePost(ctx, post interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockPostUsecaseInterface)(nil).UpdatePost), ctx, post)
 }
 
-// MockCommentUsecaseInterface is a mock of CommentUsecaseInterface interface.
-type MockCommentUsecaseInterface struct {
+// MockContentRenderer is a mock of ContentRenderer interface.
+type MockContentRenderer struct {
 	ctrl     *gomock.Controller
-	recorder *MockCommentUsecaseInterfaceMockRecorder
+	recorder *MockContentRendererMockRecorder
 }
 
-// MockCommentUsecaseInterfaceMockRecorder is the mock recorder for MockCommentUsecaseInterface.
-type MockCommentUsecaseInterfaceMockRecorder struct {
-	mock *MockCommentUsecaseInterface
+// MockContentRendererMockRecorder is the mock recorder for MockContentRenderer.
+type MockContentRendererMockRecorder struct {
+	mock *MockContentRenderer
 }
 
-// NewMockCommentUsecaseInterface creates a new mock instance.
-func NewMockCommentUsecaseInterface(ctrl *gomock.Controller) *MockCommentUsecaseInterface {
-	mock := &MockCommentUsecaseInterface{ctrl: ctrl}
-	mock.recorder = &MockCommentUsecaseInterfaceMockRecorder{mock}
+// NewMockContentRenderer creates a new mock instance.
+func NewMockContentRenderer(ctrl *gomock.Controller) *MockContentRenderer {
+	mock := &MockContentRenderer{ctrl: ctrl}
+	mock.recorder = &MockContentRendererMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCommentUsecaseInterface) EXPECT() *MockCommentUsecaseInterfaceMockRecorder {
+func (m *MockContentRenderer) EXPECT() *MockContentRendererMockRecorder {
 	return m.recorder
 }
 
-// CreateComment mocks base method.
-func (m *MockCommentUsecaseInterface) CreateComment(ctx context.Context, comment *entities.Comment) error {
+// Render mocks base method.
+func (m *MockContentRenderer) Render(src string) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateComment", ctx, comment)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Render", src)
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// CreateComment indicates an expected call of CreateComment.
-func (mr *MockCommentUsecaseInterfaceMockRecorder) CreateComment(ctx, comment interface{}) *gomock.Call {
+// Render indicates an expected call of Render.
+func (mr *MockContentRendererMockRecorder) Render(src interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).CreateComment), ctx, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockContentRenderer)(nil).Render), src)
 }
 
-// DeleteComment mocks base method.
-func (m *MockCommentUsecaseInterface) DeleteComment(ctx context.Context, id int64) error {
+// MockUserResolver is a mock of UserResolver interface.
+type MockUserResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserResolverMockRecorder
+}
+
+// MockUserResolverMockRecorder is the mock recorder for MockUserResolver.
+type MockUserResolverMockRecorder struct {
+	mock *MockUserResolver
+}
+
+// NewMockUserResolver creates a new mock instance.
+func NewMockUserResolver(ctrl *gomock.Controller) *MockUserResolver {
+	mock := &MockUserResolver{ctrl: ctrl}
+	mock.recorder = &MockUserResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserResolver) EXPECT() *MockUserResolverMockRecorder {
+	return m.recorder
+}
+
+// ResolveUsernames mocks base method.
+func (m *MockUserResolver) ResolveUsernames(ctx context.Context, usernames []string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveUsernames", ctx, usernames)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveUsernames indicates an expected call of ResolveUsernames.
+func (mr *MockUserResolverMockRecorder) ResolveUsernames(ctx, usernames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveUsernames", reflect.TypeOf((*MockUserResolver)(nil).ResolveUsernames), ctx, usernames)
+}
+
+// MockMentionUsecaseInterface is a mock of MentionUsecaseInterface interface.
+type MockMentionUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMentionUsecaseInterfaceMockRecorder
+}
+
+// MockMentionUsecaseInterfaceMockRecorder is the mock recorder for MockMentionUsecaseInterface.
+type MockMentionUsecaseInterfaceMockRecorder struct {
+	mock *MockMentionUsecaseInterface
+}
+
+// NewMockMentionUsecaseInterface creates a new mock instance.
+func NewMockMentionUsecaseInterface(ctrl *gomock.Controller) *MockMentionUsecaseInterface {
+	mock := &MockMentionUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockMentionUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMentionUsecaseInterface) EXPECT() *MockMentionUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Link mocks base method.
+func (m *MockMentionUsecaseInterface) Link(contentHTML string, users map[string]int64) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Link", contentHTML, users)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Link indicates an expected call of Link.
+func (mr *MockMentionUsecaseInterfaceMockRecorder) Link(contentHTML, users interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockMentionUsecaseInterface)(nil).Link), contentHTML, users)
+}
+
+// Mentions mocks base method.
+func (m *MockMentionUsecaseInterface) Mentions(ctx context.Context, targetType string, targetID int64) ([]*entities.Mention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mentions", ctx, targetType, targetID)
+	ret0, _ := ret[0].([]*entities.Mention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mentions indicates an expected call of Mentions.
+func (mr *MockMentionUsecaseInterfaceMockRecorder) Mentions(ctx, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mentions", reflect.TypeOf((*MockMentionUsecaseInterface)(nil).Mentions), ctx, targetType, targetID)
+}
+
+// Relink mocks base method.
+func (m *MockMentionUsecaseInterface) Relink(ctx context.Context, targetType string, targetID int64, contentHTML string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relink", ctx, targetType, targetID, contentHTML)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relink indicates an expected call of Relink.
+func (mr *MockMentionUsecaseInterfaceMockRecorder) Relink(ctx, targetType, targetID, contentHTML interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relink", reflect.TypeOf((*MockMentionUsecaseInterface)(nil).Relink), ctx, targetType, targetID, contentHTML)
+}
+
+// Resolve mocks base method.
+func (m *MockMentionUsecaseInterface) Resolve(ctx context.Context, usernames []string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, usernames)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockMentionUsecaseInterfaceMockRecorder) Resolve(ctx, usernames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockMentionUsecaseInterface)(nil).Resolve), ctx, usernames)
+}
+
+// Save mocks base method.
+func (m *MockMentionUsecaseInterface) Save(ctx context.Context, targetType string, targetID, authorID int64, users map[string]int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteComment", ctx, id)
+	ret := m.ctrl.Call(m, "Save", ctx, targetType, targetID, authorID, users)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteComment indicates an expected call of DeleteComment.
-func (mr *MockCommentUsecaseInterfaceMockRecorder) DeleteComment(ctx, id interface{}) *gomock.Call {
+// Save indicates an expected call of Save.
+func (mr *MockMentionUsecaseInterfaceMockRecorder) Save(ctx, targetType, targetID, authorID, users interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).DeleteComment), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockMentionUsecaseInterface)(nil).Save), ctx, targetType, targetID, authorID, users)
 }
 
-// GetByPostID mocks base method.
-func (m *MockCommentUsecaseInterface) GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error) {
+// UserMentions mocks base method.
+func (m *MockMentionUsecaseInterface) UserMentions(ctx context.Context, userID int64, limit, offset int) ([]*entities.Mention, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByPostID", ctx, postID)
-	ret0, _ := ret[0].([]*entities.Comment)
+	ret := m.ctrl.Call(m, "UserMentions", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]*entities.Mention)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByPostID indicates an expected call of GetByPostID.
-func (mr *MockCommentUsecaseInterfaceMockRecorder) GetByPostID(ctx, postID interface{}) *gomock.Call {
+// UserMentions indicates an expected call of UserMentions.
+func (mr *MockMentionUsecaseInterfaceMockRecorder) UserMentions(ctx, userID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPostID", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).GetByPostID), ctx, postID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserMentions", reflect.TypeOf((*MockMentionUsecaseInterface)(nil).UserMentions), ctx, userID, limit, offset)
 }
 
-// GetByUserID mocks base method.
-func (m *MockCommentUsecaseInterface) GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error) {
+// MockContentFilter is a mock of ContentFilter interface.
+type MockContentFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentFilterMockRecorder
+}
+
+// MockContentFilterMockRecorder is the mock recorder for MockContentFilter.
+type MockContentFilterMockRecorder struct {
+	mock *MockContentFilter
+}
+
+// NewMockContentFilter creates a new mock instance.
+func NewMockContentFilter(ctrl *gomock.Controller) *MockContentFilter {
+	mock := &MockContentFilter{ctrl: ctrl}
+	mock.recorder = &MockContentFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentFilter) EXPECT() *MockContentFilterMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockContentFilter) Check(ctx context.Context, content *filter.Content) (filter.Verdict, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
-	ret0, _ := ret[0].([]*entities.Comment)
+	ret := m.ctrl.Call(m, "Check", ctx, content)
+	ret0, _ := ret[0].(filter.Verdict)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByUserID indicates an expected call of GetByUserID.
-func (mr *MockCommentUsecaseInterfaceMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+// Check indicates an expected call of Check.
+func (mr *MockContentFilterMockRecorder) Check(ctx, content interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).GetByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockContentFilter)(nil).Check), ctx, content)
 }
 
-// GetCommentByID mocks base method.
-func (m *MockCommentUsecaseInterface) GetCommentByID(ctx context.Context, id int64) (*entities.Comment, error) {
+// MockViewUsecaseInterface is a mock of ViewUsecaseInterface interface.
+type MockViewUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewUsecaseInterfaceMockRecorder
+}
+
+// MockViewUsecaseInterfaceMockRecorder is the mock recorder for MockViewUsecaseInterface.
+type MockViewUsecaseInterfaceMockRecorder struct {
+	mock *MockViewUsecaseInterface
+}
+
+// NewMockViewUsecaseInterface creates a new mock instance.
+func NewMockViewUsecaseInterface(ctrl *gomock.Controller) *MockViewUsecaseInterface {
+	mock := &MockViewUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockViewUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewUsecaseInterface) EXPECT() *MockViewUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// RecordView mocks base method.
+func (m *MockViewUsecaseInterface) RecordView(postID int64, viewer string) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCommentByID", ctx, id)
-	ret0, _ := ret[0].(*entities.Comment)
+	ret := m.ctrl.Call(m, "RecordView", postID, viewer)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// RecordView indicates an expected call of RecordView.
+func (mr *MockViewUsecaseInterfaceMockRecorder) RecordView(postID, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordView", reflect.TypeOf((*MockViewUsecaseInterface)(nil).RecordView), postID, viewer)
+}
+
+// MockCategoryUsecaseInterface is a mock of CategoryUsecaseInterface interface.
+type MockCategoryUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryUsecaseInterfaceMockRecorder
+}
+
+// MockCategoryUsecaseInterfaceMockRecorder is the mock recorder for MockCategoryUsecaseInterface.
+type MockCategoryUsecaseInterfaceMockRecorder struct {
+	mock *MockCategoryUsecaseInterface
+}
+
+// NewMockCategoryUsecaseInterface creates a new mock instance.
+func NewMockCategoryUsecaseInterface(ctrl *gomock.Controller) *MockCategoryUsecaseInterface {
+	mock := &MockCategoryUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCategoryUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryUsecaseInterface) EXPECT() *MockCategoryUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Categories mocks base method.
+func (m *MockCategoryUsecaseInterface) Categories(ctx context.Context) ([]*entities.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Categories", ctx)
+	ret0, _ := ret[0].([]*entities.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCommentByID indicates an expected call of GetCommentByID.
-func (mr *MockCommentUsecaseInterfaceMockRecorder) GetCommentByID(ctx, id interface{}) *gomock.Call {
+// Categories indicates an expected call of Categories.
+func (mr *MockCategoryUsecaseInterfaceMockRecorder) Categories(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).GetCommentByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Categories", reflect.TypeOf((*MockCategoryUsecaseInterface)(nil).Categories), ctx)
 }
 
-// UpdateComment mocks base method.
-func (m *MockCommentUsecaseInterface) UpdateComment(ctx context.Context, comment *entities.Comment) error {
+// CreateCategory mocks base method.
+func (m *MockCategoryUsecaseInterface) CreateCategory(ctx context.Context, category *entities.Category) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateComment", ctx, comment)
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, category)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateComment indicates an expected call of UpdateComment.
-func (mr *MockCommentUsecaseInterfaceMockRecorder) UpdateComment(ctx, comment interface{}) *gomock.Call {
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoryUsecaseInterfaceMockRecorder) CreateCategory(ctx, category interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).UpdateComment), ctx, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoryUsecaseInterface)(nil).CreateCategory), ctx, category)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockCategoryUsecaseInterface) DeleteCategory(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockCategoryUsecaseInterfaceMockRecorder) DeleteCategory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryUsecaseInterface)(nil).DeleteCategory), ctx, id)
+}
+
+// GetCategoryByID mocks base method.
+func (m *MockCategoryUsecaseInterface) GetCategoryByID(ctx context.Context, id int64) (*entities.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByID indicates an expected call of GetCategoryByID.
+func (mr *MockCategoryUsecaseInterfaceMockRecorder) GetCategoryByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockCategoryUsecaseInterface)(nil).GetCategoryByID), ctx, id)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockCategoryUsecaseInterface) UpdateCategory(ctx context.Context, category *entities.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockCategoryUsecaseInterfaceMockRecorder) UpdateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryUsecaseInterface)(nil).UpdateCategory), ctx, category)
+}
+
+// MockCommentUsecaseInterface is a mock of CommentUsecaseInterface interface.
+type MockCommentUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentUsecaseInterfaceMockRecorder
+}
+
+// MockCommentUsecaseInterfaceMockRecorder is the mock recorder for MockCommentUsecaseInterface.
+type MockCommentUsecaseInterfaceMockRecorder struct {
+	mock *MockCommentUsecaseInterface
+}
+
+// NewMockCommentUsecaseInterface creates a new mock instance.
+func NewMockCommentUsecaseInterface(ctrl *gomock.Controller) *MockCommentUsecaseInterface {
+	mock := &MockCommentUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockCommentUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentUsecaseInterface) EXPECT() *MockCommentUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateComment mocks base method.
+func (m *MockCommentUsecaseInterface) CreateComment(ctx context.Context, comment *entities.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) CreateComment(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).CreateComment), ctx, comment)
+}
+
+// DeleteComment mocks base method.
+func (m *MockCommentUsecaseInterface) DeleteComment(ctx context.Context, id, deletedBy int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, id, deletedBy, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) DeleteComment(ctx, id, deletedBy, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).DeleteComment), ctx, id, deletedBy, reason)
+}
+
+// DeletedComments mocks base method.
+func (m *MockCommentUsecaseInterface) DeletedComments(ctx context.Context, limit, offset int) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletedComments", ctx, limit, offset)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletedComments indicates an expected call of DeletedComments.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) DeletedComments(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletedComments", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).DeletedComments), ctx, limit, offset)
+}
+
+// GetByPostID mocks base method.
+func (m *MockCommentUsecaseInterface) GetByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPostID", ctx, postID)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPostID indicates an expected call of GetByPostID.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) GetByPostID(ctx, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPostID", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).GetByPostID), ctx, postID)
+}
+
+// GetByUserID mocks base method.
+func (m *MockCommentUsecaseInterface) GetByUserID(ctx context.Context, userID int64) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).GetByUserID), ctx, userID)
+}
+
+// GetCommentByID mocks base method.
+func (m *MockCommentUsecaseInterface) GetCommentByID(ctx context.Context, id int64) (*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentByID indicates an expected call of GetCommentByID.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) GetCommentByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).GetCommentByID), ctx, id)
+}
+
+// GetTreeByPostID mocks base method.
+func (m *MockCommentUsecaseInterface) GetTreeByPostID(ctx context.Context, postID int64) ([]*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreeByPostID", ctx, postID)
+	ret0, _ := ret[0].([]*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreeByPostID indicates an expected call of GetTreeByPostID.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) GetTreeByPostID(ctx, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreeByPostID", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).GetTreeByPostID), ctx, postID)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockCommentUsecaseInterface) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) PurgeDeleted(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).PurgeDeleted), ctx, before)
+}
+
+// RestoreComment mocks base method.
+func (m *MockCommentUsecaseInterface) RestoreComment(ctx context.Context, id int64) (*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreComment", ctx, id)
+	ret0, _ := ret[0].(*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreComment indicates an expected call of RestoreComment.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) RestoreComment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreComment", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).RestoreComment), ctx, id)
+}
+
+// UpdateComment mocks base method.
+func (m *MockCommentUsecaseInterface) UpdateComment(ctx context.Context, comment *entities.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockCommentUsecaseInterfaceMockRecorder) UpdateComment(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockCommentUsecaseInterface)(nil).UpdateComment), ctx, comment)
+}
+
+// MockCommentNotifier is a mock of CommentNotifier interface.
+type MockCommentNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentNotifierMockRecorder
+}
+
+// MockCommentNotifierMockRecorder is the mock recorder for MockCommentNotifier.
+type MockCommentNotifierMockRecorder struct {
+	mock *MockCommentNotifier
+}
+
+// NewMockCommentNotifier creates a new mock instance.
+func NewMockCommentNotifier(ctrl *gomock.Controller) *MockCommentNotifier {
+	mock := &MockCommentNotifier{ctrl: ctrl}
+	mock.recorder = &MockCommentNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentNotifier) EXPECT() *MockCommentNotifierMockRecorder {
+	return m.recorder
+}
+
+// CommentCreated mocks base method.
+func (m *MockCommentNotifier) CommentCreated(ctx context.Context, comment *entities.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommentCreated", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommentCreated indicates an expected call of CommentCreated.
+func (mr *MockCommentNotifierMockRecorder) CommentCreated(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommentCreated", reflect.TypeOf((*MockCommentNotifier)(nil).CommentCreated), ctx, comment)
+}
+
+// MockVoteUsecaseInterface is a mock of VoteUsecaseInterface interface.
+type MockVoteUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoteUsecaseInterfaceMockRecorder
+}
+
+// MockVoteUsecaseInterfaceMockRecorder is the mock recorder for MockVoteUsecaseInterface.
+type MockVoteUsecaseInterfaceMockRecorder struct {
+	mock *MockVoteUsecaseInterface
+}
+
+// NewMockVoteUsecaseInterface creates a new mock instance.
+func NewMockVoteUsecaseInterface(ctrl *gomock.Controller) *MockVoteUsecaseInterface {
+	mock := &MockVoteUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockVoteUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoteUsecaseInterface) EXPECT() *MockVoteUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// RemoveVote mocks base method.
+func (m *MockVoteUsecaseInterface) RemoveVote(ctx context.Context, userID int64, targetType string, targetID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVote", ctx, userID, targetType, targetID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVote indicates an expected call of RemoveVote.
+func (mr *MockVoteUsecaseInterfaceMockRecorder) RemoveVote(ctx, userID, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVote", reflect.TypeOf((*MockVoteUsecaseInterface)(nil).RemoveVote), ctx, userID, targetType, targetID)
+}
+
+// UserVotes mocks base method.
+func (m *MockVoteUsecaseInterface) UserVotes(ctx context.Context, userID int64, targetType string, targetIDs []int64) (map[int64]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserVotes", ctx, userID, targetType, targetIDs)
+	ret0, _ := ret[0].(map[int64]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserVotes indicates an expected call of UserVotes.
+func (mr *MockVoteUsecaseInterfaceMockRecorder) UserVotes(ctx, userID, targetType, targetIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserVotes", reflect.TypeOf((*MockVoteUsecaseInterface)(nil).UserVotes), ctx, userID, targetType, targetIDs)
+}
+
+// Vote mocks base method.
+func (m *MockVoteUsecaseInterface) Vote(ctx context.Context, vote *entities.Vote) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", ctx, vote)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockVoteUsecaseInterfaceMockRecorder) Vote(ctx, vote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockVoteUsecaseInterface)(nil).Vote), ctx, vote)
+}
+
+// MockPollUsecaseInterface is a mock of PollUsecaseInterface interface.
+type MockPollUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPollUsecaseInterfaceMockRecorder
+}
+
+// MockPollUsecaseInterfaceMockRecorder is the mock recorder for MockPollUsecaseInterface.
+type MockPollUsecaseInterfaceMockRecorder struct {
+	mock *MockPollUsecaseInterface
+}
+
+// NewMockPollUsecaseInterface creates a new mock instance.
+func NewMockPollUsecaseInterface(ctrl *gomock.Controller) *MockPollUsecaseInterface {
+	mock := &MockPollUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockPollUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPollUsecaseInterface) EXPECT() *MockPollUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CastVote mocks base method.
+func (m *MockPollUsecaseInterface) CastVote(ctx context.Context, postID, userID int64, username string, optionIDs []int64) (*entities.Poll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CastVote", ctx, postID, userID, username, optionIDs)
+	ret0, _ := ret[0].(*entities.Poll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CastVote indicates an expected call of CastVote.
+func (mr *MockPollUsecaseInterfaceMockRecorder) CastVote(ctx, postID, userID, username, optionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CastVote", reflect.TypeOf((*MockPollUsecaseInterface)(nil).CastVote), ctx, postID, userID, username, optionIDs)
+}
+
+// Polls mocks base method.
+func (m *MockPollUsecaseInterface) Polls(ctx context.Context, postIDs []int64, viewerID int64) (map[int64]*entities.Poll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Polls", ctx, postIDs, viewerID)
+	ret0, _ := ret[0].(map[int64]*entities.Poll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Polls indicates an expected call of Polls.
+func (mr *MockPollUsecaseInterfaceMockRecorder) Polls(ctx, postIDs, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Polls", reflect.TypeOf((*MockPollUsecaseInterface)(nil).Polls), ctx, postIDs, viewerID)
+}
+
+// Results mocks base method.
+func (m *MockPollUsecaseInterface) Results(ctx context.Context, postID, viewerID int64) (*entities.Poll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Results", ctx, postID, viewerID)
+	ret0, _ := ret[0].(*entities.Poll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Results indicates an expected call of Results.
+func (mr *MockPollUsecaseInterfaceMockRecorder) Results(ctx, postID, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Results", reflect.TypeOf((*MockPollUsecaseInterface)(nil).Results), ctx, postID, viewerID)
+}
+
+// MockBookmarkUsecaseInterface is a mock of BookmarkUsecaseInterface interface.
+type MockBookmarkUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarkUsecaseInterfaceMockRecorder
+}
+
+// MockBookmarkUsecaseInterfaceMockRecorder is the mock recorder for MockBookmarkUsecaseInterface.
+type MockBookmarkUsecaseInterfaceMockRecorder struct {
+	mock *MockBookmarkUsecaseInterface
+}
+
+// NewMockBookmarkUsecaseInterface creates a new mock instance.
+func NewMockBookmarkUsecaseInterface(ctrl *gomock.Controller) *MockBookmarkUsecaseInterface {
+	mock := &MockBookmarkUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockBookmarkUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarkUsecaseInterface) EXPECT() *MockBookmarkUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddBookmark mocks base method.
+func (m *MockBookmarkUsecaseInterface) AddBookmark(ctx context.Context, bookmark *entities.Bookmark) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBookmark", ctx, bookmark)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBookmark indicates an expected call of AddBookmark.
+func (mr *MockBookmarkUsecaseInterfaceMockRecorder) AddBookmark(ctx, bookmark interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookmark", reflect.TypeOf((*MockBookmarkUsecaseInterface)(nil).AddBookmark), ctx, bookmark)
+}
+
+// Bookmarked mocks base method.
+func (m *MockBookmarkUsecaseInterface) Bookmarked(ctx context.Context, userID int64, postIDs []int64) (map[int64]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bookmarked", ctx, userID, postIDs)
+	ret0, _ := ret[0].(map[int64]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bookmarked indicates an expected call of Bookmarked.
+func (mr *MockBookmarkUsecaseInterfaceMockRecorder) Bookmarked(ctx, userID, postIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bookmarked", reflect.TypeOf((*MockBookmarkUsecaseInterface)(nil).Bookmarked), ctx, userID, postIDs)
+}
+
+// Bookmarks mocks base method.
+func (m *MockBookmarkUsecaseInterface) Bookmarks(ctx context.Context, userID int64, limit, offset int) ([]*entities.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bookmarks", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]*entities.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bookmarks indicates an expected call of Bookmarks.
+func (mr *MockBookmarkUsecaseInterfaceMockRecorder) Bookmarks(ctx, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bookmarks", reflect.TypeOf((*MockBookmarkUsecaseInterface)(nil).Bookmarks), ctx, userID, limit, offset)
+}
+
+// RemoveBookmark mocks base method.
+func (m *MockBookmarkUsecaseInterface) RemoveBookmark(ctx context.Context, userID, postID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBookmark", ctx, userID, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBookmark indicates an expected call of RemoveBookmark.
+func (mr *MockBookmarkUsecaseInterfaceMockRecorder) RemoveBookmark(ctx, userID, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBookmark", reflect.TypeOf((*MockBookmarkUsecaseInterface)(nil).RemoveBookmark), ctx, userID, postID)
+}
+
+// MockNotificationUsecaseInterface is a mock of NotificationUsecaseInterface interface.
+type MockNotificationUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationUsecaseInterfaceMockRecorder
+}
+
+// MockNotificationUsecaseInterfaceMockRecorder is the mock recorder for MockNotificationUsecaseInterface.
+type MockNotificationUsecaseInterfaceMockRecorder struct {
+	mock *MockNotificationUsecaseInterface
+}
+
+// NewMockNotificationUsecaseInterface creates a new mock instance.
+func NewMockNotificationUsecaseInterface(ctrl *gomock.Controller) *MockNotificationUsecaseInterface {
+	mock := &MockNotificationUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockNotificationUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationUsecaseInterface) EXPECT() *MockNotificationUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Follow mocks base method.
+func (m *MockNotificationUsecaseInterface) Follow(ctx context.Context, userID, postID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, userID, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockNotificationUsecaseInterfaceMockRecorder) Follow(ctx, userID, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockNotificationUsecaseInterface)(nil).Follow), ctx, userID, postID)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockNotificationUsecaseInterface) MarkAllRead(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockNotificationUsecaseInterfaceMockRecorder) MarkAllRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationUsecaseInterface)(nil).MarkAllRead), ctx, userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotificationUsecaseInterface) MarkRead(ctx context.Context, userID int64, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, userID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationUsecaseInterfaceMockRecorder) MarkRead(ctx, userID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationUsecaseInterface)(nil).MarkRead), ctx, userID, ids)
+}
+
+// Notifications mocks base method.
+func (m *MockNotificationUsecaseInterface) Notifications(ctx context.Context, userID int64, unreadOnly bool, limit, offset int) ([]*entities.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notifications", ctx, userID, unreadOnly, limit, offset)
+	ret0, _ := ret[0].([]*entities.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Notifications indicates an expected call of Notifications.
+func (mr *MockNotificationUsecaseInterfaceMockRecorder) Notifications(ctx, userID, unreadOnly, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notifications", reflect.TypeOf((*MockNotificationUsecaseInterface)(nil).Notifications), ctx, userID, unreadOnly, limit, offset)
+}
+
+// Unfollow mocks base method.
+func (m *MockNotificationUsecaseInterface) Unfollow(ctx context.Context, userID, postID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, userID, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockNotificationUsecaseInterfaceMockRecorder) Unfollow(ctx, userID, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockNotificationUsecaseInterface)(nil).Unfollow), ctx, userID, postID)
+}
+
+// UnreadCount mocks base method.
+func (m *MockNotificationUsecaseInterface) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnreadCount", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnreadCount indicates an expected call of UnreadCount.
+func (mr *MockNotificationUsecaseInterfaceMockRecorder) UnreadCount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnreadCount", reflect.TypeOf((*MockNotificationUsecaseInterface)(nil).UnreadCount), ctx, userID)
+}
+
+// MockReportUsecaseInterface is a mock of ReportUsecaseInterface interface.
+type MockReportUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportUsecaseInterfaceMockRecorder
+}
+
+// MockReportUsecaseInterfaceMockRecorder is the mock recorder for MockReportUsecaseInterface.
+type MockReportUsecaseInterfaceMockRecorder struct {
+	mock *MockReportUsecaseInterface
+}
+
+// NewMockReportUsecaseInterface creates a new mock instance.
+func NewMockReportUsecaseInterface(ctrl *gomock.Controller) *MockReportUsecaseInterface {
+	mock := &MockReportUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockReportUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportUsecaseInterface) EXPECT() *MockReportUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Report mocks base method.
+func (m *MockReportUsecaseInterface) Report(ctx context.Context, report *entities.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockReportUsecaseInterfaceMockRecorder) Report(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockReportUsecaseInterface)(nil).Report), ctx, report)
+}
+
+// Reports mocks base method.
+func (m *MockReportUsecaseInterface) Reports(ctx context.Context, filter entities.ReportFilter) ([]*entities.ReportGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reports", ctx, filter)
+	ret0, _ := ret[0].([]*entities.ReportGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reports indicates an expected call of Reports.
+func (mr *MockReportUsecaseInterfaceMockRecorder) Reports(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reports", reflect.TypeOf((*MockReportUsecaseInterface)(nil).Reports), ctx, filter)
+}
+
+// Resolve mocks base method.
+func (m *MockReportUsecaseInterface) Resolve(ctx context.Context, reportID int64, status string, resolvedBy int64, note string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, reportID, status, resolvedBy, note)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockReportUsecaseInterfaceMockRecorder) Resolve(ctx, reportID, status, resolvedBy, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockReportUsecaseInterface)(nil).Resolve), ctx, reportID, status, resolvedBy, note)
+}
+
+// Warnings mocks base method.
+func (m *MockReportUsecaseInterface) Warnings(ctx context.Context, userID int64, limit, offset int) ([]*entities.Warning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Warnings", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]*entities.Warning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Warnings indicates an expected call of Warnings.
+func (mr *MockReportUsecaseInterfaceMockRecorder) Warnings(ctx, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warnings", reflect.TypeOf((*MockReportUsecaseInterface)(nil).Warnings), ctx, userID, limit, offset)
+}
+
+// MockModerationUsecaseInterface is a mock of ModerationUsecaseInterface interface.
+type MockModerationUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationUsecaseInterfaceMockRecorder
+}
+
+// MockModerationUsecaseInterfaceMockRecorder is the mock recorder for MockModerationUsecaseInterface.
+type MockModerationUsecaseInterfaceMockRecorder struct {
+	mock *MockModerationUsecaseInterface
+}
+
+// NewMockModerationUsecaseInterface creates a new mock instance.
+func NewMockModerationUsecaseInterface(ctrl *gomock.Controller) *MockModerationUsecaseInterface {
+	mock := &MockModerationUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockModerationUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationUsecaseInterface) EXPECT() *MockModerationUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockModerationUsecaseInterface) Approve(ctx context.Context, id, moderatorID int64) (*entities.HeldContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id, moderatorID)
+	ret0, _ := ret[0].(*entities.HeldContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockModerationUsecaseInterfaceMockRecorder) Approve(ctx, id, moderatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockModerationUsecaseInterface)(nil).Approve), ctx, id, moderatorID)
+}
+
+// Held mocks base method.
+func (m *MockModerationUsecaseInterface) Held(ctx context.Context, limit, offset int) ([]*entities.HeldContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Held", ctx, limit, offset)
+	ret0, _ := ret[0].([]*entities.HeldContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Held indicates an expected call of Held.
+func (mr *MockModerationUsecaseInterfaceMockRecorder) Held(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Held", reflect.TypeOf((*MockModerationUsecaseInterface)(nil).Held), ctx, limit, offset)
+}
+
+// Reject mocks base method.
+func (m *MockModerationUsecaseInterface) Reject(ctx context.Context, id, moderatorID int64, reason string) (*entities.HeldContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, id, moderatorID, reason)
+	ret0, _ := ret[0].(*entities.HeldContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockModerationUsecaseInterfaceMockRecorder) Reject(ctx, id, moderatorID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockModerationUsecaseInterface)(nil).Reject), ctx, id, moderatorID, reason)
+}
+
+// MockRevisionUsecaseInterface is a mock of RevisionUsecaseInterface interface.
+type MockRevisionUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevisionUsecaseInterfaceMockRecorder
+}
+
+// MockRevisionUsecaseInterfaceMockRecorder is the mock recorder for MockRevisionUsecaseInterface.
+type MockRevisionUsecaseInterfaceMockRecorder struct {
+	mock *MockRevisionUsecaseInterface
+}
+
+// NewMockRevisionUsecaseInterface creates a new mock instance.
+func NewMockRevisionUsecaseInterface(ctrl *gomock.Controller) *MockRevisionUsecaseInterface {
+	mock := &MockRevisionUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRevisionUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevisionUsecaseInterface) EXPECT() *MockRevisionUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CommentRevisions mocks base method.
+func (m *MockRevisionUsecaseInterface) CommentRevisions(ctx context.Context, commentID int64) ([]*entities.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommentRevisions", ctx, commentID)
+	ret0, _ := ret[0].([]*entities.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommentRevisions indicates an expected call of CommentRevisions.
+func (mr *MockRevisionUsecaseInterfaceMockRecorder) CommentRevisions(ctx, commentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommentRevisions", reflect.TypeOf((*MockRevisionUsecaseInterface)(nil).CommentRevisions), ctx, commentID)
+}
+
+// PostRevisions mocks base method.
+func (m *MockRevisionUsecaseInterface) PostRevisions(ctx context.Context, postID int64) ([]*entities.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRevisions", ctx, postID)
+	ret0, _ := ret[0].([]*entities.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRevisions indicates an expected call of PostRevisions.
+func (mr *MockRevisionUsecaseInterfaceMockRecorder) PostRevisions(ctx, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRevisions", reflect.TypeOf((*MockRevisionUsecaseInterface)(nil).PostRevisions), ctx, postID)
+}
+
+// RollbackComment mocks base method.
+func (m *MockRevisionUsecaseInterface) RollbackComment(ctx context.Context, commentID, revisionID, editorID int64) (*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackComment", ctx, commentID, revisionID, editorID)
+	ret0, _ := ret[0].(*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackComment indicates an expected call of RollbackComment.
+func (mr *MockRevisionUsecaseInterfaceMockRecorder) RollbackComment(ctx, commentID, revisionID, editorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackComment", reflect.TypeOf((*MockRevisionUsecaseInterface)(nil).RollbackComment), ctx, commentID, revisionID, editorID)
+}
+
+// RollbackPost mocks base method.
+func (m *MockRevisionUsecaseInterface) RollbackPost(ctx context.Context, postID, revisionID, editorID int64) (*entities.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackPost", ctx, postID, revisionID, editorID)
+	ret0, _ := ret[0].(*entities.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackPost indicates an expected call of RollbackPost.
+func (mr *MockRevisionUsecaseInterfaceMockRecorder) RollbackPost(ctx, postID, revisionID, editorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackPost", reflect.TypeOf((*MockRevisionUsecaseInterface)(nil).RollbackPost), ctx, postID, revisionID, editorID)
+}
+
+// MockSearchUsecaseInterface is a mock of SearchUsecaseInterface interface.
+type MockSearchUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchUsecaseInterfaceMockRecorder
+}
+
+// MockSearchUsecaseInterfaceMockRecorder is the mock recorder for MockSearchUsecaseInterface.
+type MockSearchUsecaseInterfaceMockRecorder struct {
+	mock *MockSearchUsecaseInterface
+}
+
+// NewMockSearchUsecaseInterface creates a new mock instance.
+func NewMockSearchUsecaseInterface(ctrl *gomock.Controller) *MockSearchUsecaseInterface {
+	mock := &MockSearchUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockSearchUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchUsecaseInterface) EXPECT() *MockSearchUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchUsecaseInterface) Search(ctx context.Context, q entities.SearchQuery) (*entities.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, q)
+	ret0, _ := ret[0].(*entities.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchUsecaseInterfaceMockRecorder) Search(ctx, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchUsecaseInterface)(nil).Search), ctx, q)
+}
+
+// MockAttachmentUsecaseInterface is a mock of AttachmentUsecaseInterface interface.
+type MockAttachmentUsecaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentUsecaseInterfaceMockRecorder
+}
+
+// MockAttachmentUsecaseInterfaceMockRecorder is the mock recorder for MockAttachmentUsecaseInterface.
+type MockAttachmentUsecaseInterfaceMockRecorder struct {
+	mock *MockAttachmentUsecaseInterface
+}
+
+// NewMockAttachmentUsecaseInterface creates a new mock instance.
+func NewMockAttachmentUsecaseInterface(ctrl *gomock.Controller) *MockAttachmentUsecaseInterface {
+	mock := &MockAttachmentUsecaseInterface{ctrl: ctrl}
+	mock.recorder = &MockAttachmentUsecaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentUsecaseInterface) EXPECT() *MockAttachmentUsecaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Attachment mocks base method.
+func (m *MockAttachmentUsecaseInterface) Attachment(ctx context.Context, id, viewerID int64) (*entities.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attachment", ctx, id, viewerID)
+	ret0, _ := ret[0].(*entities.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attachment indicates an expected call of Attachment.
+func (mr *MockAttachmentUsecaseInterfaceMockRecorder) Attachment(ctx, id, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attachment", reflect.TypeOf((*MockAttachmentUsecaseInterface)(nil).Attachment), ctx, id, viewerID)
+}
+
+// Attachments mocks base method.
+func (m *MockAttachmentUsecaseInterface) Attachments(ctx context.Context, targetType string, targetIDs []int64) (map[int64][]*entities.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attachments", ctx, targetType, targetIDs)
+	ret0, _ := ret[0].(map[int64][]*entities.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attachments indicates an expected call of Attachments.
+func (mr *MockAttachmentUsecaseInterfaceMockRecorder) Attachments(ctx, targetType, targetIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attachments", reflect.TypeOf((*MockAttachmentUsecaseInterface)(nil).Attachments), ctx, targetType, targetIDs)
+}
+
+// Content mocks base method.
+func (m *MockAttachmentUsecaseInterface) Content(ctx context.Context, att *entities.Attachment, thumbnail bool) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Content", ctx, att, thumbnail)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Content indicates an expected call of Content.
+func (mr *MockAttachmentUsecaseInterfaceMockRecorder) Content(ctx, att, thumbnail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Content", reflect.TypeOf((*MockAttachmentUsecaseInterface)(nil).Content), ctx, att, thumbnail)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockAttachmentUsecaseInterface) DeleteAttachment(ctx context.Context, att *entities.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, att)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockAttachmentUsecaseInterfaceMockRecorder) DeleteAttachment(ctx, att interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockAttachmentUsecaseInterface)(nil).DeleteAttachment), ctx, att)
+}
+
+// PurgeOrphans mocks base method.
+func (m *MockAttachmentUsecaseInterface) PurgeOrphans(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeOrphans", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeOrphans indicates an expected call of PurgeOrphans.
+func (mr *MockAttachmentUsecaseInterfaceMockRecorder) PurgeOrphans(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeOrphans", reflect.TypeOf((*MockAttachmentUsecaseInterface)(nil).PurgeOrphans), ctx)
+}
+
+// Upload mocks base method.
+func (m *MockAttachmentUsecaseInterface) Upload(ctx context.Context, att *entities.Attachment, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, att, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAttachmentUsecaseInterfaceMockRecorder) Upload(ctx, att, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAttachmentUsecaseInterface)(nil).Upload), ctx, att, data)
+}
+
+// MockUserArchive is a mock of UserArchive interface.
+type MockUserArchive struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserArchiveMockRecorder
+}
+
+// MockUserArchiveMockRecorder is the mock recorder for MockUserArchive.
+type MockUserArchiveMockRecorder struct {
+	mock *MockUserArchive
+}
+
+// NewMockUserArchive creates a new mock instance.
+func NewMockUserArchive(ctrl *gomock.Controller) *MockUserArchive {
+	mock := &MockUserArchive{ctrl: ctrl}
+	mock.recorder = &MockUserArchiveMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserArchive) EXPECT() *MockUserArchiveMockRecorder {
+	return m.recorder
+}
+
+// ExportUsers mocks base method.
+func (m *MockUserArchive) ExportUsers(ctx context.Context, w *archive.Writer, withPasswords bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUsers", ctx, w, withPasswords)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUsers indicates an expected call of ExportUsers.
+func (mr *MockUserArchiveMockRecorder) ExportUsers(ctx, w, withPasswords interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUsers", reflect.TypeOf((*MockUserArchive)(nil).ExportUsers), ctx, w, withPasswords)
+}
+
+// ImportUser mocks base method.
+func (m *MockUserArchive) ImportUser(ctx context.Context, user *archive.User, report *archive.Report) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUser", ctx, user, report)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUser indicates an expected call of ImportUser.
+func (mr *MockUserArchiveMockRecorder) ImportUser(ctx, user, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUser", reflect.TypeOf((*MockUserArchive)(nil).ImportUser), ctx, user, report)
+}
+
+// MockUserDirectory is a mock of UserDirectory interface.
+type MockUserDirectory struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDirectoryMockRecorder
+}
+
+// MockUserDirectoryMockRecorder is the mock recorder for MockUserDirectory.
+type MockUserDirectoryMockRecorder struct {
+	mock *MockUserDirectory
+}
+
+// NewMockUserDirectory creates a new mock instance.
+func NewMockUserDirectory(ctrl *gomock.Controller) *MockUserDirectory {
+	mock := &MockUserDirectory{ctrl: ctrl}
+	mock.recorder = &MockUserDirectoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDirectory) EXPECT() *MockUserDirectoryMockRecorder {
+	return m.recorder
+}
+
+// UserEvents mocks base method.
+func (m *MockUserDirectory) UserEvents(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserEvents", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entities.UserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserEvents indicates an expected call of UserEvents.
+func (mr *MockUserDirectoryMockRecorder) UserEvents(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserEvents", reflect.TypeOf((*MockUserDirectory)(nil).UserEvents), ctx, afterID, limit)
+}
+
+// Username mocks base method.
+func (m *MockUserDirectory) Username(ctx context.Context, userID int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Username", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Username indicates an expected call of Username.
+func (mr *MockUserDirectoryMockRecorder) Username(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Username", reflect.TypeOf((*MockUserDirectory)(nil).Username), ctx, userID)
+}
+
+// MockUserCache is a mock of UserCache interface.
+type MockUserCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserCacheMockRecorder
+}
+
+// MockUserCacheMockRecorder is the mock recorder for MockUserCache.
+type MockUserCacheMockRecorder struct {
+	mock *MockUserCache
+}
+
+// NewMockUserCache creates a new mock instance.
+func NewMockUserCache(ctrl *gomock.Controller) *MockUserCache {
+	mock := &MockUserCache{ctrl: ctrl}
+	mock.recorder = &MockUserCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserCache) EXPECT() *MockUserCacheMockRecorder {
+	return m.recorder
+}
+
+// ForgetUser mocks base method.
+func (m *MockUserCache) ForgetUser(userID int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ForgetUser", userID)
+}
+
+// ForgetUser indicates an expected call of ForgetUser.
+func (mr *MockUserCacheMockRecorder) ForgetUser(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetUser", reflect.TypeOf((*MockUserCache)(nil).ForgetUser), userID)
 }
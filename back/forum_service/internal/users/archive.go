@@ -0,0 +1,105 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/netabakovv/forum/back/pkg/archive"
+	pb "github.com/netabakovv/forum/back/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// archivePageSize — сколько пользователей запрашивается у auth service за раз при выгрузке
+const archivePageSize = 500
+
+// Archive выгружает и загружает пользователей архива форума через auth service.
+// Эти вызовы доступны только администраторам, поэтому каждый запрос несёт токен
+// администратора, от имени которого работает forumctl.
+type Archive struct {
+	auth          pb.AuthServiceClient
+	token         string
+	mergeExisting bool
+}
+
+// NewArchive создаёт Archive; с mergeExisting записи пользователя архива, чьё имя
+// занято другим пользователем этой базы, привязываются к нему, а не пропускаются
+func NewArchive(auth pb.AuthServiceClient, token string, mergeExisting bool) *Archive {
+	return &Archive{auth: auth, token: token, mergeExisting: mergeExisting}
+}
+
+func (a *Archive) outgoing(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+a.token)
+}
+
+// ExportUsers пишет в архив всех пользователей и возвращает их число.
+// Хеши паролей попадают в архив только с withPasswords.
+func (a *Archive) ExportUsers(ctx context.Context, w *archive.Writer, withPasswords bool) (int, error) {
+	ctx = a.outgoing(ctx)
+	var afterID int64
+	count := 0
+	for {
+		resp, err := a.auth.ExportUsers(ctx, &pb.ExportUsersRequest{
+			AfterId:       afterID,
+			Limit:         archivePageSize,
+			WithPasswords: withPasswords,
+		})
+		if err != nil {
+			return count, fmt.Errorf("выгрузка пользователей: %w", err)
+		}
+		for _, user := range resp.Users {
+			rec := archive.User{
+				ID:           user.UserId,
+				Username:     user.Username,
+				PasswordHash: user.PasswordHash,
+				CreatedAt:    time.Unix(user.CreatedAt, 0).UTC(),
+			}
+			if err := w.Write(archive.KindUser, rec); err != nil {
+				return count, err
+			}
+			afterID = user.UserId
+			count++
+		}
+		if len(resp.Users) < archivePageSize {
+			return count, nil
+		}
+	}
+}
+
+// ImportUser создаёт пользователя из архива и возвращает его ID в этой базе.
+// Пользователь с тем же именем и датой регистрации считается уже загруженным.
+// Если имя занято другим пользователем, это конфликт: без mergeExisting
+// возвращается 0 и записи пользователя архива пропускаются.
+// Без хеша пароля пользователь создаётся, но войти не сможет.
+func (a *Archive) ImportUser(ctx context.Context, user *archive.User, report *archive.Report) (int64, error) {
+	resp, err := a.auth.ImportUser(a.outgoing(ctx), &pb.ImportUserRequest{
+		Username:      user.Username,
+		PasswordHash:  user.PasswordHash,
+		CreatedAt:     user.CreatedAt.Unix(),
+		MergeExisting: a.mergeExisting,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.AlreadyExists:
+		report.Conflict(archive.KindUser, user.ID, "имя %q занято другим пользователем, его записи пропущены", user.Username)
+		return 0, nil
+	case codes.InvalidArgument:
+		report.Conflict(archive.KindUser, user.ID, "имя %q не подходит: %s, записи пользователя пропущены", user.Username, status.Convert(err).Message())
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("загрузка пользователя %q: %w", user.Username, err)
+	}
+
+	switch resp.Result {
+	case pb.ImportUserResult_IMPORT_USER_CREATED:
+		report.Imported[archive.KindUser]++
+	case pb.ImportUserResult_IMPORT_USER_MERGED:
+		report.Conflict(archive.KindUser, user.ID, "имя %q занято пользователем %d, записи привязаны к нему", user.Username, resp.UserId)
+	default:
+		report.Skipped[archive.KindUser]++
+	}
+	return resp.UserId, nil
+}
@@ -0,0 +1,84 @@
+// Package users находит пользователей форума через auth service и переносит их в архив форума.
+package users
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/netabakovv/forum/back/forum_service/internal/entities"
+	"github.com/netabakovv/forum/back/pkg/errors"
+	pb "github.com/netabakovv/forum/back/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Resolver переводит имена пользователей в ID одним запросом к auth service,
+// а также читает профили и журнал событий пользователей.
+// Журнал auth service отдаёт только по служебному токену serviceToken.
+type Resolver struct {
+	auth         pb.AuthServiceClient
+	serviceToken string
+}
+
+func NewResolver(auth pb.AuthServiceClient, serviceToken string) *Resolver {
+	return &Resolver{auth: auth, serviceToken: serviceToken}
+}
+
+// ResolveUsernames возвращает ID пользователей по именам; неизвестных имён в результате нет
+func (r *Resolver) ResolveUsernames(ctx context.Context, usernames []string) (map[string]int64, error) {
+	if len(usernames) == 0 {
+		return map[string]int64{}, nil
+	}
+	resp, err := r.auth.LookupUsers(ctx, &pb.LookupUsersRequest{Usernames: usernames})
+	if err != nil {
+		return nil, fmt.Errorf("поиск пользователей по именам: %w", err)
+	}
+
+	ids := make(map[string]int64, len(resp.Users))
+	for _, user := range resp.Users {
+		ids[user.Username] = user.UserId
+	}
+	return ids, nil
+}
+
+// Username возвращает текущее имя пользователя или errors.ErrUserNotFound
+func (r *Resolver) Username(ctx context.Context, userID int64) (string, error) {
+	resp, err := r.auth.GetUserByID(ctx, &pb.GetUserRequest{UserId: userID})
+	if status.Code(err) == codes.NotFound {
+		return "", errors.ErrUserNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("профиль пользователя %d: %w", userID, err)
+	}
+	return resp.Username, nil
+}
+
+// CreatedAt возвращает время регистрации пользователя или errors.ErrUserNotFound
+func (r *Resolver) CreatedAt(ctx context.Context, userID int64) (time.Time, error) {
+	resp, err := r.auth.GetUserByID(ctx, &pb.GetUserRequest{UserId: userID})
+	if status.Code(err) == codes.NotFound {
+		return time.Time{}, errors.ErrUserNotFound
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("профиль пользователя %d: %w", userID, err)
+	}
+	return time.Unix(resp.CreatedAt, 0), nil
+}
+
+// UserEvents возвращает до limit событий журнала пользователей после afterID
+func (r *Resolver) UserEvents(ctx context.Context, afterID int64, limit int) ([]*entities.UserEvent, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+r.serviceToken)
+	resp, err := r.auth.ListUserEvents(ctx, &pb.ListUserEventsRequest{AfterId: afterID, Limit: int32(limit)})
+	if err != nil {
+		return nil, fmt.Errorf("журнал событий пользователей: %w", err)
+	}
+
+	events := make([]*entities.UserEvent, len(resp.Events))
+	for i, e := range resp.Events {
+		events[i] = &entities.UserEvent{ID: e.Id, UserID: e.UserId, Type: e.Type, Username: e.Username}
+	}
+	return events, nil
+}
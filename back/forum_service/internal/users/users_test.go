@@ -0,0 +1,199 @@
+package users_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/netabakovv/forum/back/forum_service/internal/entities"
+	"github.com/netabakovv/forum/back/forum_service/internal/users"
+	"github.com/netabakovv/forum/back/pkg/archive"
+	"github.com/netabakovv/forum/back/pkg/errors"
+	pb "github.com/netabakovv/forum/back/proto"
+	"github.com/netabakovv/forum/back/proto/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolver_ResolveUsernames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	auth := mocks.NewMockAuthServiceClient(ctrl)
+	resolver := users.NewResolver(auth, "service")
+
+	auth.EXPECT().LookupUsers(ctx, &pb.LookupUsersRequest{Usernames: []string{"alice", "ghost"}}).
+		Return(&pb.LookupUsersResponse{Users: []*pb.UserSummary{{UserId: 1, Username: "alice"}}}, nil)
+
+	ids, err := resolver.ResolveUsernames(ctx, []string{"alice", "ghost"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"alice": 1}, ids)
+
+	// Без имён auth service не вызывается
+	ids, err = resolver.ResolveUsernames(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestResolver_Username(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	auth := mocks.NewMockAuthServiceClient(ctrl)
+	resolver := users.NewResolver(auth, "service")
+
+	auth.EXPECT().GetUserByID(ctx, &pb.GetUserRequest{UserId: 1}).
+		Return(&pb.UserProfileResponse{UserId: 1, Username: "alice"}, nil)
+	auth.EXPECT().GetUserByID(ctx, &pb.GetUserRequest{UserId: 2}).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+
+	name, err := resolver.Username(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", name)
+
+	_, err = resolver.Username(ctx, 2)
+	assert.ErrorIs(t, err, errors.ErrUserNotFound)
+}
+
+func TestResolver_UserEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	auth := mocks.NewMockAuthServiceClient(ctrl)
+	resolver := users.NewResolver(auth, "service")
+
+	auth.EXPECT().ListUserEvents(bearer("service"), &pb.ListUserEventsRequest{AfterId: 3, Limit: 100}).
+		Return(&pb.ListUserEventsResponse{Events: []*pb.UserEvent{
+			{Id: 4, UserId: 1, Type: entities.UserEventUpdated, Username: "alice2"},
+		}}, nil)
+
+	events, err := resolver.UserEvents(ctx, 3, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.UserEvent{{ID: 4, UserID: 1, Type: entities.UserEventUpdated, Username: "alice2"}}, events)
+}
+
+func TestResolver_CreatedAt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	auth := mocks.NewMockAuthServiceClient(ctrl)
+	resolver := users.NewResolver(auth, "service")
+
+	auth.EXPECT().GetUserByID(ctx, &pb.GetUserRequest{UserId: 1}).
+		Return(&pb.UserProfileResponse{UserId: 1, Username: "alice", CreatedAt: 1700000000}, nil)
+	auth.EXPECT().GetUserByID(ctx, &pb.GetUserRequest{UserId: 2}).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+
+	createdAt, err := resolver.CreatedAt(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000000), createdAt.Unix())
+
+	_, err = resolver.CreatedAt(ctx, 2)
+	assert.ErrorIs(t, err, errors.ErrUserNotFound)
+}
+
+// bearer совпадает с контекстом вызова, несущим указанный токен
+type bearer string
+
+func (b bearer) Matches(x interface{}) bool {
+	ctx, ok := x.(context.Context)
+	if !ok {
+		return false
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	values := md.Get("authorization")
+	return len(values) == 1 && values[0] == "Bearer "+string(b)
+}
+
+func (b bearer) String() string {
+	return "контекст с токеном " + string(b)
+}
+
+func TestArchive_ExportUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auth := mocks.NewMockAuthServiceClient(ctrl)
+	a := users.NewArchive(auth, "admin-token", false)
+	created := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	auth.EXPECT().ExportUsers(bearer("admin-token"), &pb.ExportUsersRequest{Limit: 500, WithPasswords: true}).
+		Return(&pb.ExportUsersResponse{Users: []*pb.ExportedUser{
+			{UserId: 1, Username: "alice", PasswordHash: "hash", CreatedAt: created.Unix()},
+			{UserId: 5, Username: "bob", CreatedAt: created.Unix()},
+		}}, nil)
+
+	var buf bytes.Buffer
+	w, err := archive.NewWriter(&buf, archive.Header{Source: "test", WithPasswords: true})
+	require.NoError(t, err)
+	count, err := a.ExportUsers(context.Background(), w, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	r, _, err := archive.NewReader(&buf)
+	require.NoError(t, err)
+	_, err = r.Next()
+	require.NoError(t, err)
+	var rec archive.User
+	require.NoError(t, r.Decode(&rec))
+	assert.Equal(t, archive.User{ID: 1, Username: "alice", PasswordHash: "hash", CreatedAt: created}, rec)
+}
+
+func TestArchive_ImportUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	auth := mocks.NewMockAuthServiceClient(ctrl)
+	created := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	user := &archive.User{ID: 1, Username: "alice", PasswordHash: "hash", CreatedAt: created}
+
+	tests := []struct {
+		name      string
+		merge     bool
+		resp      *pb.ImportUserResponse
+		err       error
+		id        int64
+		imported  int
+		skipped   int
+		conflicts int
+	}{
+		{name: "создан", resp: &pb.ImportUserResponse{UserId: 42, Result: pb.ImportUserResult_IMPORT_USER_CREATED}, id: 42, imported: 1},
+		{name: "уже загружен", resp: &pb.ImportUserResponse{UserId: 42, Result: pb.ImportUserResult_IMPORT_USER_EXISTS}, id: 42, skipped: 1},
+		{name: "имя занято", err: status.Error(codes.AlreadyExists, "занято"), conflicts: 1},
+		{name: "имя занято, записи привязываются", merge: true,
+			resp: &pb.ImportUserResponse{UserId: 7, Result: pb.ImportUserResult_IMPORT_USER_MERGED}, id: 7, conflicts: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := users.NewArchive(auth, "admin-token", tt.merge)
+			want := &pb.ImportUserRequest{Username: "alice", PasswordHash: "hash", CreatedAt: created.Unix(), MergeExisting: tt.merge}
+			auth.EXPECT().ImportUser(bearer("admin-token"), want).Return(tt.resp, tt.err)
+
+			report := archive.NewReport()
+			id, err := a.ImportUser(ctx, user, report)
+			require.NoError(t, err)
+			assert.Equal(t, tt.id, id)
+			assert.Equal(t, tt.imported, report.Imported[archive.KindUser])
+			assert.Equal(t, tt.skipped, report.Skipped[archive.KindUser])
+			assert.Len(t, report.Conflicts, tt.conflicts)
+		})
+	}
+
+	t.Run("нет прав", func(t *testing.T) {
+		a := users.NewArchive(auth, "admin-token", false)
+		auth.EXPECT().ImportUser(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.PermissionDenied, "нет прав"))
+		_, err := a.ImportUser(ctx, user, archive.NewReport())
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
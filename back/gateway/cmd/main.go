@@ -12,6 +12,7 @@ package main
 // @name Authorization
 
 import (
+	"os"
 	"time"
 
 	"github.com/netabakovv/forum/back/gateway/internal/delivery/http"
@@ -35,7 +36,12 @@ func main() {
 	}
 	defer authConn.Close()
 
-	forumConn, err := grpc.Dial("forum_service:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Вложения передаются одним сообщением, поэтому лимиты сообщений подняты до размера файла
+	maxMsgSize := handler.MaxUploadSize + 1<<20
+	forumConn, err := grpc.Dial("forum_service:50051",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxMsgSize), grpc.MaxCallRecvMsgSize(maxMsgSize)),
+	)
 	if err != nil {
 		log.Fatal("не удалось подключиться к gRPC", logger.NewField("error", err))
 	}
@@ -48,17 +54,29 @@ func main() {
 
 	// Инициализация Gin
 	router := gin.Default()
+	// Gateway принимает запросы напрямую, поэтому адрес клиента — адрес соединения,
+	// а не заголовки X-Forwarded-For, которые клиент может подставить сам
+	if err := router.SetTrustedProxies(nil); err != nil {
+		log.Fatal("не удалось настроить доверенные прокси", logger.NewField("error", err))
+	}
 
 	// Разрешить CORS
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://localhost:3000"}, // адрес фронта
-		AllowMethods:     []string{"GET", "POST", "OPTIONS", "DELETE"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "OPTIONS", "DELETE"},
 		AllowHeaders:     []string{"Authorization", "Content-Type"},
+		ExposeHeaders:    []string{handler.NextCursorHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
-	handler := handler.NewHandler(forumClient, authClient, log)
+	// Внешний адрес gateway: от него строятся ссылки в лентах, заголовок Host для этого не годится
+	publicURL := os.Getenv("PUBLIC_URL")
+	if publicURL == "" {
+		publicURL = "http://localhost:8090"
+	}
+
+	handler := handler.NewHandler(forumClient, authClient, publicURL, log)
 	http.RegisterRoutes(router, handler)
 
 	// Запуск gateway
@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"strings"
 
 	pb "github.com/netabakovv/forum/back/proto"
 
@@ -39,3 +40,36 @@ func AuthMiddleware(authClient pb.AuthServiceClient) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// OptionalAuthMiddleware для публичных маршрутов: если передан валидный токен,
+// кладёт пользователя в контекст, иначе пропускает запрос как анонимный.
+func OptionalAuthMiddleware(authClient pb.AuthServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		resp, err := authClient.ValidateToken(c.Request.Context(), &pb.ValidateRequest{
+			AccessToken: token,
+		})
+		if err == nil {
+			c.Set("userID", resp.UserId)
+			c.Set("username", resp.Username)
+			c.Set("isAdmin", resp.IsAdmin)
+		}
+		c.Next()
+	}
+}
+
+// AdminMiddleware пропускает только администраторов. Должен стоять после AuthMiddleware.
+func AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.GetBool("isAdmin") {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "нужны права администратора"})
+			return
+		}
+		c.Next()
+	}
+}
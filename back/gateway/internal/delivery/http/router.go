@@ -17,6 +17,13 @@ func RegisterRoutes(r *gin.Engine, h *handler.Handler) {
 	protected := r.Group("/api")
 	protected.Use(AuthMiddleware(h.Auth))
 
+	// Публичные маршруты, которые учитывают пользователя, если он вошёл
+	optionalAuth := OptionalAuthMiddleware(h.Auth)
+
+	// Группа маршрутов администратора
+	admin := protected.Group("")
+	admin.Use(AdminMiddleware())
+
 	// Профиль пользователя
 	protected.GET("/profile", func(c *gin.Context) {
 		userID := c.GetString("userID")
@@ -30,6 +37,8 @@ func RegisterRoutes(r *gin.Engine, h *handler.Handler) {
 		})
 	})
 
+	protected.PUT("/profile/username", h.ChangeUsername())
+
 	// Аутентификация
 	r.POST("/register", h.Register())
 	r.POST("/login", h.Login())
@@ -37,16 +46,87 @@ func RegisterRoutes(r *gin.Engine, h *handler.Handler) {
 	protected.POST("/logout", h.Logout())
 
 	// Посты
-	r.GET("/posts", h.GetPosts())
-	r.GET("/posts/:id", h.GetPost())
+	r.GET("/posts", optionalAuth, h.GetPosts())
+	r.GET("/posts/:id", optionalAuth, h.GetPost())
+	r.GET("/posts/:id/poll", optionalAuth, h.GetPollResults())
+	protected.POST("/posts/:id/poll/vote", h.CastPollVote())
 	protected.POST("/posts", h.CreatePost())
+	protected.PUT("/posts/:id", h.UpdatePost())
 	protected.DELETE("/posts/:id", h.DeletePost())
+	protected.POST("/posts/:id/vote", h.VotePost())
+	protected.GET("/drafts", h.ListMyDrafts())
+	protected.POST("/posts/:id/publish", h.PublishPost())
+	admin.PUT("/posts/:id/pin", h.PinPost())
+	admin.PUT("/posts/:id/lock", h.LockPost())
+	r.GET("/tags/:tag/posts", optionalAuth, h.GetPostsByTag())
+
+	// Вложения
+	protected.POST("/posts/:id/attachments", h.UploadPostAttachment())
+	protected.POST("/comments/:id/attachments", h.UploadCommentAttachment())
+	r.GET("/attachments/:id", optionalAuth, h.GetAttachment())
+	r.GET("/attachments/:id/thumbnail", optionalAuth, h.GetAttachmentThumbnail())
+	protected.DELETE("/attachments/:id", h.DeleteAttachment())
+
+	// Закладки
+	protected.GET("/bookmarks", h.ListBookmarks())
+	protected.POST("/bookmarks", h.AddBookmark())
+	protected.DELETE("/bookmarks/:postID", h.RemoveBookmark())
+
+	// Подписки и уведомления
+	protected.POST("/posts/:id/follow", h.FollowPost())
+	protected.DELETE("/posts/:id/follow", h.UnfollowPost())
+	protected.GET("/notifications", h.ListNotifications())
+	protected.GET("/notifications/unread_count", h.GetUnreadCount())
+	protected.POST("/notifications/read", h.MarkRead())
+	protected.POST("/notifications/read_all", h.MarkAllRead())
+
+	// Упоминания
+	protected.GET("/mentions", h.ListMentions())
+
+	// Категории
+	r.GET("/categories", h.ListCategories())
+	admin.POST("/categories", h.CreateCategory())
+	admin.PUT("/categories/:id", h.UpdateCategory())
+	admin.DELETE("/categories/:id", h.DeleteCategory())
+
+	// Ленты RSS и Atom
+	r.GET("/feed.rss", h.PostsFeed(handler.FeedRSS))
+	r.GET("/feed.atom", h.PostsFeed(handler.FeedAtom))
+	r.GET("/users/:id/feed.rss", h.AuthorFeed(handler.FeedRSS))
+	r.GET("/users/:id/feed.atom", h.AuthorFeed(handler.FeedAtom))
+	r.GET("/categories/:id/feed.rss", h.CategoryFeed(handler.FeedRSS))
+	r.GET("/categories/:id/feed.atom", h.CategoryFeed(handler.FeedAtom))
+
+	// Поиск
+	r.GET("/search", h.Search())
+
+	// История правок
+	admin.GET("/posts/:id/revisions", h.GetPostRevisions())
+	admin.POST("/posts/:id/revisions/:revisionID/rollback", h.RollbackPost())
+	admin.GET("/comments/:id/revisions", h.GetCommentRevisions())
+	admin.POST("/comments/:id/revisions/:revisionID/rollback", h.RollbackComment())
+
+	// Корзина
+	admin.GET("/trash", h.ListTrash())
+	admin.POST("/posts/:id/restore", h.RestorePost())
+	admin.POST("/comments/:id/restore", h.RestoreComment())
+
+	// Жалобы и модерация
+	protected.POST("/reports", h.Report())
+	protected.GET("/warnings", h.ListMyWarnings())
+	admin.GET("/reports", h.ListReports())
+	admin.POST("/reports/:id/resolve", h.ResolveReport())
+	admin.GET("/held", h.ListHeld())
+	admin.POST("/held/:id/approve", h.ApproveHeld())
+	admin.POST("/held/:id/reject", h.RejectHeld())
 
 	// Комментарии
 	r.GET("/comments/:id", h.GetCommentByID())
-	r.GET("/comments/post/:postID", h.GetCommentsByPostID())
+	r.GET("/comments/post/:postID", optionalAuth, h.GetCommentsByPostID())
 	protected.POST("/comments", h.CreateComment())
+	protected.PUT("/comments/:id", h.UpdateComment())
 	protected.DELETE("/comments/:id", h.DeleteComment())
+	protected.POST("/comments/:id/vote", h.VoteComment())
 
 	// Чат
 	protected.POST("/chat", h.SendMessage())
@@ -2,29 +2,105 @@ package handler
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/netabakovv/forum/back/pkg/feed"
 	"github.com/netabakovv/forum/back/pkg/logger"
 	pb "github.com/netabakovv/forum/back/proto"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+// NextCursorHeader — заголовок ответа с курсором следующей страницы списка
+const NextCursorHeader = "X-Next-Cursor"
+
+// MaxUploadSize — наибольший файл, который gateway примет и передаст в forum_service.
+// Точный лимит задаёт forum_service (attachments.max_file_size), он не должен быть больше.
+const MaxUploadSize = 10 << 20
+
 type EmptyMessage struct{}
 
+// httpStatus переводит код ошибки gRPC в HTTP-статус ответа
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusRequestEntityTooLarge
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// forumContext пробрасывает заголовок Authorization в метаданные вызова forum_service:
+// пользователя сервис определяет сам по токену, а не по полям запроса
+func forumContext(c *gin.Context) context.Context {
+	ctx := c.Request.Context()
+	if token := c.GetHeader("Authorization"); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", token)
+	}
+	// forum_service видит только адрес gateway, а просмотры анонимов считаются по IP
+	return metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", c.ClientIP())
+}
+
+// bindPage читает необязательные query-параметры limit и offset. При неверном
+// значении отвечает 400 и возвращает false.
+func bindPage(c *gin.Context, limit, offset *int32) bool {
+	for _, param := range []struct {
+		name string
+		dst  *int32
+	}{{"limit", limit}, {"offset", offset}} {
+		v := c.Query(param.name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный параметр %s", param.name)})
+			return false
+		}
+		*param.dst = int32(n)
+	}
+	return true
+}
+
 type Handler struct {
 	Forum pb.ForumServiceClient
 	Auth  pb.AuthServiceClient
 	log   logger.Logger
+
+	// publicURL — внешний адрес gateway без завершающего "/", от него строятся
+	// абсолютные ссылки и GUID в лентах
+	publicURL string
 }
 
-func NewHandler(forumClient pb.ForumServiceClient, authClient pb.AuthServiceClient, log logger.Logger) *Handler {
+func NewHandler(forumClient pb.ForumServiceClient, authClient pb.AuthServiceClient, publicURL string, log logger.Logger) *Handler {
 	return &Handler{
-		Forum: forumClient,
-		Auth:  authClient,
-		log:   log,
+		Forum:     forumClient,
+		Auth:      authClient,
+		log:       log,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
 	}
 }
 
@@ -190,6 +266,45 @@ func (h *Handler) Logout() gin.HandlerFunc {
 	}
 }
 
+// @Summary Сменить имя пользователя
+// @Description Старые токены отзываются, в ответе новая пара токенов с новым именем.
+// @Description Имя в постах, комментариях и чате обновляется с небольшой задержкой.
+// @Tags Auth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param request body object{username=string} true "Новое имя"
+// @Success 200 {object} pb.ChangeUsernameResponse "Новые токены"
+// @Failure 400 {object} map[string]string "Некорректное имя"
+// @Failure 409 {object} map[string]string "Имя занято"
+// @Router /api/profile/username [put]
+func (h *Handler) ChangeUsername() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			Username string `json:"username"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+
+		resp, err := h.Auth.ChangeUsername(c, &pb.ChangeUsernameRequest{
+			AccessToken: strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "),
+			Username:    req.Username,
+		})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка смены имени: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"access_token":  resp.AccessToken,
+			"refresh_token": resp.RefreshToken,
+			"expires_at":    resp.ExpiresAt,
+			"username":      resp.Username,
+		})
+	}
+}
+
 func (h *Handler) CheckAdminStatus() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req struct {
@@ -213,19 +328,151 @@ func (h *Handler) CheckAdminStatus() gin.HandlerFunc {
 // --- Forum ---
 
 // @Summary Получить список постов
+// @Description Лента постов с курсорной пагинацией. Курсор следующей страницы
+// @Description возвращается в заголовке X-Next-Cursor, пустой заголовок означает конец ленты.
 // @Tags Posts
 // @Produce json
-// @Success 200 {object} pb.PostResponse "Созданный пост"
+// @Param cursor query string false "Курсор из X-Next-Cursor предыдущей страницы"
+// @Param limit query int false "Размер страницы (по умолчанию 20, максимум 100)"
+// @Param order query string false "Порядок сортировки: desc (по умолчанию) или asc"
+// @Param sort query string false "Режим сортировки: new (по умолчанию), top, active, hot или views"
+// @Param window query string false "Период публикации: day, week, month или all (по умолчанию)"
+// @Param from query int false "Посты, созданные не раньше (Unix timestamp)"
+// @Param to query int false "Посты, созданные раньше (Unix timestamp)"
+// @Param author_id query int false "ID автора"
+// @Param category_id query int false "ID категории"
+// @Param tag query string false "Тег"
+// @Success 200 {array} pb.Post "Страница постов"
+// @Header 200 {string} X-Next-Cursor "Курсор следующей страницы"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
 // @Failure 500 {object} map[string]string "Ошибка сервера"
 // @Router /posts [get]
 func (h *Handler) GetPosts() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		resp, err := h.Forum.Posts(c, &pb.ListPostsRequest{})
+		req, err := listPostsRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		resp, err := h.Forum.Posts(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("не удалось получить посты: %v", err)})
+			return
+		}
+		c.Header(NextCursorHeader, resp.NextCursor)
+		c.JSON(http.StatusOK, resp.Posts)
+	}
+}
+
+// listPostsRequest собирает запрос ленты из query-параметров
+func listPostsRequest(c *gin.Context) (*pb.ListPostsRequest, error) {
+	req := &pb.ListPostsRequest{Cursor: c.Query("cursor")}
+
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("неверный параметр limit")
+		}
+		req.Limit = int32(limit)
+	}
+
+	switch c.DefaultQuery("order", "desc") {
+	case "desc":
+		req.Order = pb.SortOrder_SORT_ORDER_DESC
+	case "asc":
+		req.Order = pb.SortOrder_SORT_ORDER_ASC
+	default:
+		return nil, fmt.Errorf("неверный параметр order")
+	}
+
+	switch c.DefaultQuery("sort", "new") {
+	case "new":
+		req.Sort = pb.PostSort_POST_SORT_NEW
+	case "top":
+		req.Sort = pb.PostSort_POST_SORT_TOP
+	case "active":
+		req.Sort = pb.PostSort_POST_SORT_ACTIVE
+	case "hot":
+		req.Sort = pb.PostSort_POST_SORT_HOT
+	case "views":
+		req.Sort = pb.PostSort_POST_SORT_VIEWS
+	default:
+		return nil, fmt.Errorf("неверный параметр sort")
+	}
+
+	switch c.DefaultQuery("window", "all") {
+	case "all":
+		req.Window = pb.TimeWindow_TIME_WINDOW_ALL
+	case "day":
+		req.Window = pb.TimeWindow_TIME_WINDOW_DAY
+	case "week":
+		req.Window = pb.TimeWindow_TIME_WINDOW_WEEK
+	case "month":
+		req.Window = pb.TimeWindow_TIME_WINDOW_MONTH
+	default:
+		return nil, fmt.Errorf("неверный параметр window")
+	}
+
+	optionalInt := func(name string) (*int64, error) {
+		v := c.Query(name)
+		if v == "" {
+			return nil, nil
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("неверный параметр %s", name)
+		}
+		return &n, nil
+	}
+
+	var err error
+	if req.CreatedFrom, err = optionalInt("from"); err != nil {
+		return nil, err
+	}
+	if req.CreatedTo, err = optionalInt("to"); err != nil {
+		return nil, err
+	}
+	if req.AuthorId, err = optionalInt("author_id"); err != nil {
+		return nil, err
+	}
+	if req.CategoryId, err = optionalInt("category_id"); err != nil {
+		return nil, err
+	}
+	req.Tag = c.Query("tag")
+	return req, nil
+}
+
+// @Summary Получить посты с тегом
+// @Description Та же лента, что и /posts, отфильтрованная по тегу.
+// @Tags Posts
+// @Produce json
+// @Param tag path string true "Тег"
+// @Param cursor query string false "Курсор из X-Next-Cursor предыдущей страницы"
+// @Param limit query int false "Размер страницы (по умолчанию 20, максимум 100)"
+// @Param order query string false "Порядок сортировки: desc (по умолчанию) или asc"
+// @Param sort query string false "Режим сортировки: new (по умолчанию), top, active, hot или views"
+// @Param window query string false "Период публикации: day, week, month или all (по умолчанию)"
+// @Success 200 {array} pb.Post "Страница постов"
+// @Header 200 {string} X-Next-Cursor "Курсор следующей страницы"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /tags/{tag}/posts [get]
+func (h *Handler) GetPostsByTag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := listPostsRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		req.Tag = c.Param("tag")
 
+		resp, err := h.Forum.Posts(forumContext(c), req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("не удалось получить посты: %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("не удалось получить посты: %v", err)})
 			return
 		}
+		c.Header(NextCursorHeader, resp.NextCursor)
 		c.JSON(http.StatusOK, resp.Posts)
 	}
 }
@@ -247,12 +494,150 @@ func (h *Handler) CreatePost() gin.HandlerFunc {
 			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
 			return
 		}
-		userID, _ := c.Get("userID")
-		req.AuthorId = userID.(int64)
+		resp, err := h.Forum.CreatePost(forumContext(c), &req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("не удалось создать пост: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Мои черновики
+// @Description Черновики и запланированные посты текущего пользователя, недавно изменённые первыми.
+// @Tags Posts
+// @Security ApiKeyAuth
+// @Produce json
+// @Param limit query int false "Размер страницы (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение от начала списка"
+// @Success 200 {array} pb.Post "Черновики"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/drafts [get]
+func (h *Handler) ListMyDrafts() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &pb.ListMyDraftsRequest{}
+		if !bindPage(c, &req.Limit, &req.Offset) {
+			return
+		}
+
+		resp, err := h.Forum.ListMyDrafts(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения черновиков: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp.Posts)
+	}
+}
+
+// @Summary Опубликовать черновик
+// @Description Без publish_at пост публикуется сразу, иначе публикация планируется на это время.
+// @Tags Posts
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path int true "ID поста"
+// @Param publish_at query int false "Время публикации, unix-секунды"
+// @Success 200 {object} pb.PostResponse "Пост"
+// @Failure 400 {object} map[string]string "Неверный ID или время публикации"
+// @Failure 403 {object} map[string]string "Пост принадлежит другому пользователю"
+// @Failure 404 {object} map[string]string "Пост не найден"
+// @Failure 409 {object} map[string]string "Пост уже опубликован"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/publish [post]
+func (h *Handler) PublishPost() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+		req := &pb.PublishPostRequest{PostId: postID}
+		if v := c.Query("publish_at"); v != "" {
+			req.PublishAt, err = strconv.ParseInt(v, 10, 64)
+			if err != nil || req.PublishAt <= 0 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр publish_at"})
+				return
+			}
+		}
+
+		resp, err := h.Forum.PublishPost(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка публикации поста: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Закрепить пост
+// @Description Закреплённые посты всегда идут в начале ленты.
+// @Tags Posts
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID поста"
+// @Param request body pb.PinPostRequest true "pinned: true закрепляет, false снимает закрепление"
+// @Success 200 {object} pb.PostResponse "Пост"
+// @Failure 400 {object} map[string]string "Неверный запрос"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Пост не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/pin [put]
+func (h *Handler) PinPost() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req pb.PinPostRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+		req.PostId = postID
+
+		resp, err := h.Forum.PinPost(forumContext(c), &req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка закрепления поста: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Закрыть пост для комментариев
+// @Description В закрытый пост нельзя добавить комментарий, попытка вернёт 409.
+// @Tags Posts
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID поста"
+// @Param request body pb.LockPostRequest true "locked: true закрывает, false снова открывает"
+// @Success 200 {object} pb.PostResponse "Пост"
+// @Failure 400 {object} map[string]string "Неверный запрос"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Пост не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/lock [put]
+func (h *Handler) LockPost() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req pb.LockPostRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+		req.PostId = postID
 
-		resp, err := h.Forum.CreatePost(context.Background(), &req)
+		resp, err := h.Forum.LockPost(forumContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("не удалось создать пост: %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка закрытия поста: %v", err)})
 			return
 		}
 		c.JSON(http.StatusOK, resp)
@@ -277,9 +662,9 @@ func (h *Handler) GetPost() gin.HandlerFunc {
 		}
 
 		req := &pb.GetPostRequest{PostId: postID}
-		resp, err := h.Forum.GetPost(c, req)
+		resp, err := h.Forum.GetPost(forumContext(c), req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка получения поста: %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения поста: %v", err)})
 			return
 		}
 
@@ -287,16 +672,37 @@ func (h *Handler) GetPost() gin.HandlerFunc {
 	}
 }
 
+// @Summary Изменить пост
+// @Description Менять пост могут автор и администраторы. Не переданные поля остаются прежними.
+// @Tags Posts
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID поста"
+// @Param request body pb.UpdatePostRequest true "Изменяемые поля"
+// @Success 200 {object} pb.PostResponse "Обновлённый пост"
+// @Failure 400 {object} map[string]string "Ошибка валидации запроса"
+// @Failure 403 {object} map[string]string "Пост принадлежит другому пользователю"
+// @Failure 404 {object} map[string]string "Пост не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id} [put]
 func (h *Handler) UpdatePost() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+
 		var req pb.UpdatePostRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
 			return
 		}
-		resp, err := h.Forum.UpdatePost(c, &req)
+		req.PostId = postID
+		resp, err := h.Forum.UpdatePost(forumContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка обновления поста: %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка обновления поста: %v", err)})
 			return
 		}
 		c.JSON(http.StatusOK, resp)
@@ -306,9 +712,13 @@ func (h *Handler) UpdatePost() gin.HandlerFunc {
 // @Summary Удалить пост по ID
 // @Tags Posts
 // @Security ApiKeyAuth
+// @Description Пост переносится в корзину, читатели видят заглушку.
 // @Param id path int true "ID поста"
+// @Param reason query string false "Причина удаления"
 // @Success 200 {object} pb.EmptyMessage "Пустое сообщение"
 // @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 403 {object} map[string]string "Пост принадлежит другому пользователю"
+// @Failure 404 {object} map[string]string "Пост не найден"
 // @Failure 500 {object} map[string]string "Ошибка сервера"
 // @Router /api/posts/{id} [delete]
 func (h *Handler) DeletePost() gin.HandlerFunc {
@@ -322,11 +732,12 @@ func (h *Handler) DeletePost() gin.HandlerFunc {
 
 		req := &pb.DeletePostRequest{
 			PostId: postID,
+			Reason: c.Query("reason"),
 		}
 
-		_, err = h.Forum.DeletePost(c, req)
+		_, err = h.Forum.DeletePost(forumContext(c), req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка удаления поста: %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка удаления поста: %v", err)})
 			return
 		}
 
@@ -334,116 +745,1635 @@ func (h *Handler) DeletePost() gin.HandlerFunc {
 	}
 }
 
-// --- Comment operations ---
-
-// @Summary Создать комментарий
-// @Tags Comments
-// @Security ApiKeyAuth
-// @Accept json
+// @Summary Полнотекстовый поиск по постам и комментариям
+// @Tags Search
 // @Produce json
-// @Param createCommentRequest body pb.CreateCommentRequest true "Данные нового комментария"
-// @Success 200 {object} pb.CommentResponse "Созданный комментарий"
-// @Failure 400 {object} map[string]string "Ошибка валидации запроса"
+// @Param q query string true "Поисковая строка"
+// @Param author_id query int false "ID автора"
+// @Param limit query int false "Размер страницы (по умолчанию 20, максимум 50)"
+// @Param offset query int false "Смещение от начала выдачи"
+// @Success 200 {object} pb.SearchPostsResponse "Результаты поиска"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
 // @Failure 500 {object} map[string]string "Ошибка сервера"
-// @Router /api/comments [post]
-func (h *Handler) CreateComment() gin.HandlerFunc {
+// @Router /search [get]
+func (h *Handler) Search() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req pb.CreateCommentRequest
-		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+		req := &pb.SearchPostsRequest{Query: c.Query("q")}
+
+		if !bindPage(c, &req.Limit, &req.Offset) {
 			return
 		}
-		userID, _ := c.Get("userID")
-		req.AuthorId = userID.(int64)
+		if v := c.Query("author_id"); v != "" {
+			authorID, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр author_id"})
+				return
+			}
+			req.AuthorId = &authorID
+		}
 
-		resp, err := h.Forum.CreateComment(c, &req)
+		resp, err := h.Forum.SearchPosts(forumContext(c), req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("не удалось создать комментарий: %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка поиска: %v", err)})
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	}
 }
 
-// @Summary Получить комментарий по ID
-// @Tags Comments
+// --- Votes ---
+
+// voteBody — тело запроса голосования, value = 0 снимает голос
+type voteBody struct {
+	Value int32 `json:"value"`
+}
+
+// @Summary Проголосовать за пост
+// @Tags Votes
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID поста"
+// @Param request body voteBody true "1 — за, -1 — против, 0 — снять голос"
+// @Success 200 {object} pb.VoteResponse "Новый рейтинг поста"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 403 {object} map[string]string "Голос за собственный пост"
+// @Failure 404 {object} map[string]string "Пост не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/vote [post]
+func (h *Handler) VotePost() gin.HandlerFunc {
+	return h.vote(pb.VoteTargetType_VOTE_TARGET_POST)
+}
+
+// @Summary Проголосовать за комментарий
+// @Tags Votes
+// @Security ApiKeyAuth
+// @Accept json
 // @Produce json
 // @Param id path int true "ID комментария"
-// @Success 200 {object} pb.CommentResponse "Комментарий"
-// @Failure 400 {object} map[string]string "Неверный ID"
+// @Param request body voteBody true "1 — за, -1 — против, 0 — снять голос"
+// @Success 200 {object} pb.VoteResponse "Новый рейтинг комментария"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 403 {object} map[string]string "Голос за собственный комментарий"
+// @Failure 404 {object} map[string]string "Комментарий не найден"
 // @Failure 500 {object} map[string]string "Ошибка сервера"
-// @Router /comments/{id} [get]
-func (h *Handler) GetCommentByID() gin.HandlerFunc {
+// @Router /api/comments/{id}/vote [post]
+func (h *Handler) VoteComment() gin.HandlerFunc {
+	return h.vote(pb.VoteTargetType_VOTE_TARGET_COMMENT)
+}
+
+func (h *Handler) vote(targetType pb.VoteTargetType) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		idStr := c.Param("id")
-		commentID, err := strconv.ParseInt(idStr, 10, 64)
+		targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID комментария"})
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID"})
 			return
 		}
 
-		req := &pb.GetCommentRequest{CommentId: commentID}
-		resp, err := h.Forum.GetCommentByID(c, req)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка получения комментария: %v", err)})
+		var body voteBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
 			return
 		}
 
+		var resp *pb.VoteResponse
+		if body.Value == 0 {
+			resp, err = h.Forum.RemoveVote(forumContext(c), &pb.RemoveVoteRequest{
+				TargetType: targetType,
+				TargetId:   targetID,
+			})
+		} else {
+			resp, err = h.Forum.Vote(forumContext(c), &pb.VoteRequest{
+				TargetType: targetType,
+				TargetId:   targetID,
+				Value:      body.Value,
+			})
+		}
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка голосования: %v", err)})
+			return
+		}
 		c.JSON(http.StatusOK, resp)
 	}
 }
 
-// @Summary Получить комментарии по ID поста
-// @Tags Comments
+// --- Polls ---
+
+// pollVoteBody — тело запроса голосования в опросе
+type pollVoteBody struct {
+	OptionIDs []int64 `json:"option_ids"`
+}
+
+// @Summary Проголосовать в опросе
+// @Description Голосовать можно один раз; в опросе с одним ответом передаётся один вариант.
+// @Tags Polls
+// @Security ApiKeyAuth
+// @Accept json
 // @Produce json
-// @Param postID path int true "ID поста"
-// @Success 200 {object} pb.ListCommentsResponse "Список комментариев"
-// @Failure 400 {object} map[string]string "Неверный ID поста"
+// @Param id path int true "ID поста с опросом"
+// @Param request body pollVoteBody true "Выбранные варианты"
+// @Success 200 {object} pb.PollResponse "Результаты опроса"
+// @Failure 400 {object} map[string]string "Некорректный выбор"
+// @Failure 404 {object} map[string]string "Опрос не найден"
+// @Failure 409 {object} map[string]string "Опрос закрыт или голос уже учтён"
 // @Failure 500 {object} map[string]string "Ошибка сервера"
-// @Router /comments/post/{postID} [get]
-func (h *Handler) GetCommentsByPostID() gin.HandlerFunc {
+// @Router /api/posts/{id}/poll/vote [post]
+func (h *Handler) CastPollVote() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		postIDStr := c.Param("postID")
-		postID, err := strconv.ParseInt(postIDStr, 10, 64)
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
 			return
 		}
+		var body pollVoteBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
 
-		req := &pb.GetCommentsByPostIDRequest{PostId: postID}
-		resp, err := h.Forum.GetByPostID(c, req)
+		resp, err := h.Forum.CastPollVote(forumContext(c), &pb.CastPollVoteRequest{
+			PostId:    postID,
+			OptionIds: body.OptionIDs,
+		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка получения комментариев: %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка голосования в опросе: %v", err)})
 			return
 		}
-
-		c.JSON(http.StatusOK, resp.Comments)
+		c.JSON(http.StatusOK, resp)
 	}
 }
 
-func (h *Handler) UpdateComment() gin.HandlerFunc {
+// @Summary Результаты опроса
+// @Description В открытом опросе у каждого варианта есть список проголосовавших.
+// @Tags Polls
+// @Produce json
+// @Param id path int true "ID поста с опросом"
+// @Success 200 {object} pb.PollResponse "Результаты опроса"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 404 {object} map[string]string "Опрос не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /posts/{id}/poll [get]
+func (h *Handler) GetPollResults() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req pb.UpdateCommentRequest
-		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
 			return
 		}
-		resp, err := h.Forum.UpdateComment(c, &req)
+
+		resp, err := h.Forum.GetPollResults(forumContext(c), &pb.GetPollResultsRequest{PostId: postID})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка обновления комментария: %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("не удалось получить результаты опроса: %v", err)})
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	}
 }
 
-// @Summary Удалить комментарий по ID
-// @Tags Comments
+// --- Revisions ---
+
+// @Summary История правок поста
+// @Description Версии от исходной к текущей; у каждой, кроме исходной, есть построчная разница с предыдущей.
+// @Tags Revisions
 // @Security ApiKeyAuth
-// @Param id path int true "ID комментария"
+// @Produce json
+// @Param id path int true "ID поста"
+// @Success 200 {object} pb.RevisionsResponse "Версии поста"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/revisions [get]
+func (h *Handler) GetPostRevisions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+
+		resp, err := h.Forum.GetPostRevisions(forumContext(c), &pb.GetRevisionsRequest{TargetId: postID})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения истории правок: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary История правок комментария
+// @Description Версии от исходной к текущей; у каждой, кроме исходной, есть построчная разница с предыдущей.
+// @Tags Revisions
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path int true "ID комментария"
+// @Success 200 {object} pb.RevisionsResponse "Версии комментария"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/comments/{id}/revisions [get]
+func (h *Handler) GetCommentRevisions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		commentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID комментария"})
+			return
+		}
+
+		resp, err := h.Forum.GetCommentRevisions(forumContext(c), &pb.GetRevisionsRequest{TargetId: commentID})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения истории правок: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// rollbackIDs разбирает ID цели и версии из пути
+func rollbackIDs(c *gin.Context) (*pb.RollbackRequest, error) {
+	targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	revisionID, err := strconv.ParseInt(c.Param("revisionID"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RollbackRequest{TargetId: targetID, RevisionId: revisionID}, nil
+}
+
+// @Summary Откатить пост к версии
+// @Description Откат сохраняется как новая правка, история не теряется.
+// @Tags Revisions
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path int true "ID поста"
+// @Param revisionID path int true "ID версии"
+// @Success 200 {object} pb.PostResponse "Пост после отката"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Пост или версия не найдены"
+// @Failure 409 {object} map[string]string "Правка автора ждёт модератора"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/revisions/{revisionID}/rollback [post]
+func (h *Handler) RollbackPost() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := rollbackIDs(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID"})
+			return
+		}
+
+		resp, err := h.Forum.RollbackPost(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка отката поста: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Откатить комментарий к версии
+// @Description Откат сохраняется как новая правка, история не теряется.
+// @Tags Revisions
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path int true "ID комментария"
+// @Param revisionID path int true "ID версии"
+// @Success 200 {object} pb.CommentResponse "Комментарий после отката"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Комментарий или версия не найдены"
+// @Failure 409 {object} map[string]string "Правка автора ждёт модератора"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/comments/{id}/revisions/{revisionID}/rollback [post]
+func (h *Handler) RollbackComment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := rollbackIDs(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID"})
+			return
+		}
+
+		resp, err := h.Forum.RollbackComment(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка отката комментария: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// --- Trash ---
+
+// @Summary Корзина удалённых постов или комментариев
+// @Description Недавно удалённые первыми. Записи хранятся до очистки по сроку хранения.
+// @Tags Trash
+// @Security ApiKeyAuth
+// @Produce json
+// @Param type query string false "posts (по умолчанию) или comments"
+// @Param limit query int false "Размер страницы (по умолчанию 50, максимум 100)"
+// @Param offset query int false "Смещение от начала корзины"
+// @Success 200 {object} pb.ListTrashResponse "Удалённые записи"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/trash [get]
+func (h *Handler) ListTrash() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &pb.ListTrashRequest{}
+
+		switch c.DefaultQuery("type", "posts") {
+		case "posts":
+			req.Target = pb.TrashTarget_TRASH_POSTS
+		case "comments":
+			req.Target = pb.TrashTarget_TRASH_COMMENTS
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр type"})
+			return
+		}
+		if !bindPage(c, &req.Limit, &req.Offset) {
+			return
+		}
+
+		resp, err := h.Forum.ListTrash(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения корзины: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Восстановить пост из корзины
+// @Tags Trash
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path int true "ID поста"
+// @Success 200 {object} pb.PostResponse "Восстановленный пост"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Пост не найден в корзине"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/restore [post]
+func (h *Handler) RestorePost() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+
+		resp, err := h.Forum.RestorePost(forumContext(c), &pb.RestoreRequest{TargetId: postID})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка восстановления поста: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Восстановить комментарий из корзины
+// @Tags Trash
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path int true "ID комментария"
+// @Success 200 {object} pb.CommentResponse "Восстановленный комментарий"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Комментарий не найден в корзине"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/comments/{id}/restore [post]
+func (h *Handler) RestoreComment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		commentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID комментария"})
+			return
+		}
+
+		resp, err := h.Forum.RestoreComment(forumContext(c), &pb.RestoreRequest{TargetId: commentID})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка восстановления комментария: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// --- Reports ---
+
+// reportTargets сопоставляет цели жалоб в запросах с proto
+var reportTargets = map[string]pb.ReportTarget{
+	"post":    pb.ReportTarget_REPORT_TARGET_POST,
+	"comment": pb.ReportTarget_REPORT_TARGET_COMMENT,
+	"chat":    pb.ReportTarget_REPORT_TARGET_CHAT_MESSAGE,
+}
+
+// reportReasons сопоставляет причины жалоб в запросах с proto
+var reportReasons = map[string]pb.ReportReason{
+	"spam":      pb.ReportReason_REPORT_REASON_SPAM,
+	"abuse":     pb.ReportReason_REPORT_REASON_ABUSE,
+	"off_topic": pb.ReportReason_REPORT_REASON_OFF_TOPIC,
+	"illegal":   pb.ReportReason_REPORT_REASON_ILLEGAL,
+	"other":     pb.ReportReason_REPORT_REASON_OTHER,
+}
+
+// reportBody — тело жалобы
+type reportBody struct {
+	TargetType string `json:"target_type" binding:"required"` // post, comment или chat
+	TargetID   int64  `json:"target_id" binding:"required"`
+	Reason     string `json:"reason" binding:"required"` // spam, abuse, off_topic, illegal или other
+	Details    string `json:"details"`                   // обязательно для other
+}
+
+// @Summary Пожаловаться на пост, комментарий или сообщение чата
+// @Description Пока жалоба не рассмотрена, повторно пожаловаться на тот же контент нельзя.
+// @Tags Reports
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param request body reportBody true "Цель и причина жалобы"
+// @Success 201 {object} pb.ReportResponse "Сохранённая жалоба"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 404 {object} map[string]string "Контент не найден"
+// @Failure 409 {object} map[string]string "Жалоба уже отправлена"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/reports [post]
+func (h *Handler) Report() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body reportBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+		target, ok := reportTargets[body.TargetType]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр target_type"})
+			return
+		}
+		reason, ok := reportReasons[body.Reason]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр reason"})
+			return
+		}
+
+		resp, err := h.Forum.Report(forumContext(c), &pb.ReportRequest{
+			TargetType: target,
+			TargetId:   body.TargetID,
+			Reason:     reason,
+			Details:    body.Details,
+		})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка отправки жалобы: %v", err)})
+			return
+		}
+		c.JSON(http.StatusCreated, resp)
+	}
+}
+
+// @Summary Очередь модерации
+// @Description Жалобы сгруппированы по целям; сначала цели с самой свежей жалобой.
+// @Tags Reports
+// @Security ApiKeyAuth
+// @Produce json
+// @Param status query string false "open (по умолчанию), dismissed, removed или warned"
+// @Param target_type query string false "post, comment или chat"
+// @Param limit query int false "Число целей на странице (по умолчанию 50, максимум 100)"
+// @Param offset query int false "Смещение от начала очереди"
+// @Success 200 {object} pb.ListReportsResponse "Жалобы по целям"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/reports [get]
+func (h *Handler) ListReports() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &pb.ListReportsRequest{}
+
+		switch c.DefaultQuery("status", "open") {
+		case "open":
+			req.Status = pb.ReportStatus_REPORT_STATUS_OPEN
+		case "dismissed":
+			req.Status = pb.ReportStatus_REPORT_STATUS_DISMISSED
+		case "removed":
+			req.Status = pb.ReportStatus_REPORT_STATUS_REMOVED
+		case "warned":
+			req.Status = pb.ReportStatus_REPORT_STATUS_WARNED
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр status"})
+			return
+		}
+		if v := c.Query("target_type"); v != "" {
+			target, ok := reportTargets[v]
+			if !ok {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр target_type"})
+				return
+			}
+			req.TargetType = &target
+		}
+		if !bindPage(c, &req.Limit, &req.Offset) {
+			return
+		}
+
+		resp, err := h.Forum.ListReports(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения жалоб: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// resolveReportBody — решение по жалобе
+type resolveReportBody struct {
+	Action string `json:"action" binding:"required"` // dismiss, delete или warn
+	Note   string `json:"note"`                      // для delete — причина удаления
+}
+
+// @Summary Рассмотреть жалобу
+// @Description Решение применяется ко всем открытым жалобам на тот же контент.
+// @Description delete переносит пост или комментарий в корзину, сообщение чата удаляется сразу.
+// @Tags Reports
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID жалобы"
+// @Param request body resolveReportBody true "Решение"
+// @Success 200 {object} pb.ResolveReportResponse "Число закрытых жалоб"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Жалоба не найдена"
+// @Failure 409 {object} map[string]string "Жалоба уже рассмотрена"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/reports/{id}/resolve [post]
+func (h *Handler) ResolveReport() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reportID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID жалобы"})
+			return
+		}
+		var body resolveReportBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+
+		req := &pb.ResolveReportRequest{ReportId: reportID, Note: body.Note}
+		switch body.Action {
+		case "dismiss":
+			req.Action = pb.ReportAction_REPORT_ACTION_DISMISS
+		case "delete":
+			req.Action = pb.ReportAction_REPORT_ACTION_DELETE
+		case "warn":
+			req.Action = pb.ReportAction_REPORT_ACTION_WARN
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр action"})
+			return
+		}
+
+		resp, err := h.Forum.ResolveReport(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка рассмотрения жалобы: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Мои предупреждения
+// @Description Предупреждения, вынесенные по жалобам на контент пользователя, новые первыми.
+// @Tags Reports
+// @Security ApiKeyAuth
+// @Produce json
+// @Param limit query int false "Размер страницы (по умолчанию 50, максимум 100)"
+// @Param offset query int false "Смещение"
+// @Success 200 {object} pb.ListWarningsResponse "Предупреждения"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/warnings [get]
+func (h *Handler) ListMyWarnings() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &pb.ListWarningsRequest{}
+		if !bindPage(c, &req.Limit, &req.Offset) {
+			return
+		}
+
+		resp, err := h.Forum.ListMyWarnings(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения предупреждений: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Тексты, задержанные фильтрами
+// @Description Новые посты и комментарии и правки, которые ждут решения модератора, давно ждущие первыми.
+// @Description Задержанная правка не видна читателям: они видят прежнюю версию.
+// @Tags Reports
+// @Security ApiKeyAuth
+// @Produce json
+// @Param limit query int false "Размер страницы (по умолчанию 50, максимум 100)"
+// @Param offset query int false "Смещение"
+// @Success 200 {object} pb.ListHeldResponse "Очередь модерации"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/held [get]
+func (h *Handler) ListHeld() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &pb.ListHeldRequest{}
+		if !bindPage(c, &req.Limit, &req.Offset) {
+			return
+		}
+
+		resp, err := h.Forum.ListHeld(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения очереди модерации: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// moderateHeldBody — причина отказа
+type moderateHeldBody struct {
+	Reason string `json:"reason"` // для нового поста — причина удаления в корзину
+}
+
+// @Summary Одобрить задержанный текст
+// @Description Публикует новый пост или комментарий либо заменяет опубликованную версию правкой.
+// @Tags Reports
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path int true "ID текста в очереди"
+// @Success 200 {object} pb.ModerateHeldResponse "Рассмотренный текст"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Текст не найден"
+// @Failure 409 {object} map[string]string "Текст нельзя опубликовать"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/held/{id}/approve [post]
+func (h *Handler) ApproveHeld() gin.HandlerFunc {
+	return h.moderateHeld(pb.HeldDecision_HELD_DECISION_APPROVE)
+}
+
+// @Summary Отклонить задержанный текст
+// @Description Отбрасывает правку или новый комментарий, а новый пост переносит в корзину с указанной причиной.
+// @Tags Reports
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID текста в очереди"
+// @Param request body moderateHeldBody false "Причина отказа"
+// @Success 200 {object} pb.ModerateHeldResponse "Рассмотренный текст"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Текст не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/held/{id}/reject [post]
+func (h *Handler) RejectHeld() gin.HandlerFunc {
+	return h.moderateHeld(pb.HeldDecision_HELD_DECISION_REJECT)
+}
+
+func (h *Handler) moderateHeld(decision pb.HeldDecision) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		heldID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID текста"})
+			return
+		}
+		var body moderateHeldBody
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+				return
+			}
+		}
+
+		resp, err := h.Forum.ModerateHeld(forumContext(c), &pb.ModerateHeldRequest{
+			HeldId:   heldID,
+			Decision: decision,
+			Reason:   body.Reason,
+		})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка рассмотрения текста: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// --- Attachments ---
+
+// @Summary Прикрепить файл к посту
+// @Description Прикреплять файлы могут автор поста и администраторы. Тип файла определяется по содержимому, у изображений удаляются метаданные.
+// @Tags Attachments
+// @Security ApiKeyAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "ID поста"
+// @Param file formData file true "Файл"
+// @Success 201 {object} pb.AttachmentResponse "Загруженное вложение"
+// @Failure 400 {object} map[string]string "Пустой файл или недопустимый тип"
+// @Failure 403 {object} map[string]string "Пост принадлежит другому пользователю"
+// @Failure 404 {object} map[string]string "Пост не найден"
+// @Failure 413 {object} map[string]string "Файл слишком большой или превышен лимит пользователя"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/attachments [post]
+func (h *Handler) UploadPostAttachment() gin.HandlerFunc {
+	return h.uploadAttachment(pb.AttachmentTarget_ATTACHMENT_TARGET_POST)
+}
+
+// @Summary Прикрепить файл к комментарию
+// @Description Прикреплять файлы могут автор комментария и администраторы.
+// @Tags Attachments
+// @Security ApiKeyAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "ID комментария"
+// @Param file formData file true "Файл"
+// @Success 201 {object} pb.AttachmentResponse "Загруженное вложение"
+// @Failure 400 {object} map[string]string "Пустой файл или недопустимый тип"
+// @Failure 403 {object} map[string]string "Комментарий принадлежит другому пользователю"
+// @Failure 404 {object} map[string]string "Комментарий не найден"
+// @Failure 413 {object} map[string]string "Файл слишком большой или превышен лимит пользователя"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/comments/{id}/attachments [post]
+func (h *Handler) UploadCommentAttachment() gin.HandlerFunc {
+	return h.uploadAttachment(pb.AttachmentTarget_ATTACHMENT_TARGET_COMMENT)
+}
+
+func (h *Handler) uploadAttachment(targetType pb.AttachmentTarget) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID"})
+			return
+		}
+
+		// Запас сверх MaxUploadSize — на заголовки multipart
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, MaxUploadSize+1<<20)
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("файл не передан: %v", err)})
+			return
+		}
+		if fileHeader.Size > MaxUploadSize {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "файл слишком большой"})
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("ошибка чтения файла: %v", err)})
+			return
+		}
+		defer file.Close()
+		data, err := io.ReadAll(io.LimitReader(file, MaxUploadSize))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("ошибка чтения файла: %v", err)})
+			return
+		}
+
+		resp, err := h.Forum.UploadAttachment(forumContext(c), &pb.UploadAttachmentRequest{
+			TargetType: targetType,
+			TargetId:   targetID,
+			FileName:   fileHeader.Filename,
+			Data:       data,
+		})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка загрузки файла: %v", err)})
+			return
+		}
+		c.JSON(http.StatusCreated, resp)
+	}
+}
+
+// @Summary Скачать вложение
+// @Description Изображения отдаются для показа в браузере, остальные файлы — для скачивания.
+// @Description Файлы черновиков и запланированных постов до публикации доступны только автору.
+// @Tags Attachments
+// @Produce octet-stream
+// @Param id path int true "ID вложения"
+// @Success 200 {file} file "Содержимое файла"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 404 {object} map[string]string "Вложение не найдено"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /attachments/{id} [get]
+func (h *Handler) GetAttachment() gin.HandlerFunc {
+	return h.attachmentContent(false)
+}
+
+// @Summary Превью изображения
+// @Tags Attachments
+// @Produce octet-stream
+// @Param id path int true "ID вложения"
+// @Success 200 {file} file "Превью"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 404 {object} map[string]string "Вложение не найдено или это не изображение"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /attachments/{id}/thumbnail [get]
+func (h *Handler) GetAttachmentThumbnail() gin.HandlerFunc {
+	return h.attachmentContent(true)
+}
+
+func (h *Handler) attachmentContent(thumbnail bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID вложения"})
+			return
+		}
+
+		resp, err := h.Forum.GetAttachment(forumContext(c), &pb.GetAttachmentRequest{Id: id, Thumbnail: thumbnail})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения вложения: %v", err)})
+			return
+		}
+
+		att := resp.Attachment
+		disposition := "attachment"
+		if strings.HasPrefix(att.ContentType, "image/") {
+			disposition = "inline"
+		}
+		// Тип определён по содержимому; браузеру запрещено угадывать его
+		// заново и исполнять что-либо из файла
+		c.Header("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": att.FileName}))
+		c.Header("X-Content-Type-Options", "nosniff")
+		c.Header("Content-Security-Policy", "default-src 'none'; sandbox")
+		c.Data(http.StatusOK, att.ContentType, resp.Data)
+	}
+}
+
+// @Summary Удалить вложение
+// @Description Удалять вложение могут загрузивший его пользователь и администраторы.
+// @Tags Attachments
+// @Security ApiKeyAuth
+// @Param id path int true "ID вложения"
+// @Success 200 {object} map[string]string "Вложение удалено"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 403 {object} map[string]string "Вложение загружено другим пользователем"
+// @Failure 404 {object} map[string]string "Вложение не найдено"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/attachments/{id} [delete]
+func (h *Handler) DeleteAttachment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID вложения"})
+			return
+		}
+
+		if _, err := h.Forum.DeleteAttachment(forumContext(c), &pb.DeleteAttachmentRequest{Id: id}); err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка удаления вложения: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "вложение удалено"})
+	}
+}
+
+// --- Bookmarks ---
+
+// @Summary Мои закладки
+// @Description Закладки текущего пользователя с личными заметками, недавно добавленные первыми.
+// @Tags Bookmarks
+// @Security ApiKeyAuth
+// @Produce json
+// @Param limit query int false "Размер страницы (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение от начала списка"
+// @Success 200 {array} pb.Bookmark "Закладки"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/bookmarks [get]
+func (h *Handler) ListBookmarks() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &pb.ListBookmarksRequest{}
+		if !bindPage(c, &req.Limit, &req.Offset) {
+			return
+		}
+
+		resp, err := h.Forum.ListBookmarks(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения закладок: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp.Bookmarks)
+	}
+}
+
+// @Summary Добавить пост в закладки
+// @Description Повторное добавление того же поста не создаёт дубликат, а заменяет заметку.
+// @Tags Bookmarks
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param request body pb.AddBookmarkRequest true "ID поста и необязательная заметка (до 1000 символов)"
+// @Success 200 {object} pb.BookmarkResponse "Закладка"
+// @Failure 400 {object} map[string]string "Неверный запрос или слишком длинная заметка"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 404 {object} map[string]string "Пост не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/bookmarks [post]
+func (h *Handler) AddBookmark() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req pb.AddBookmarkRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+
+		resp, err := h.Forum.AddBookmark(forumContext(c), &req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка добавления закладки: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Удалить пост из закладок
+// @Tags Bookmarks
+// @Security ApiKeyAuth
+// @Param postID path int true "ID поста"
+// @Success 200 {object} map[string]string "Закладка удалена"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 404 {object} map[string]string "Закладка не найдена"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/bookmarks/{postID} [delete]
+func (h *Handler) RemoveBookmark() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID, err := strconv.ParseInt(c.Param("postID"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+
+		if _, err := h.Forum.RemoveBookmark(forumContext(c), &pb.RemoveBookmarkRequest{PostId: postID}); err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка удаления закладки: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "закладка удалена"})
+	}
+}
+
+// --- Notifications ---
+
+// @Summary Подписаться на обсуждение поста
+// @Description Автор поста и комментаторы подписываются автоматически.
+// @Tags Notifications
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path int true "ID поста"
+// @Success 200 {object} pb.FollowPostResponse "Состояние подписки"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 404 {object} map[string]string "Пост не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/follow [post]
+func (h *Handler) FollowPost() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+
+		resp, err := h.Forum.FollowPost(forumContext(c), &pb.FollowPostRequest{PostId: postID})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка подписки на пост: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Отписаться от обсуждения поста
+// @Description Отписка сохраняется: новые комментарии пользователя в посте не подпишут его снова.
+// @Tags Notifications
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path int true "ID поста"
+// @Success 200 {object} pb.FollowPostResponse "Состояние подписки"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 404 {object} map[string]string "Пост не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/posts/{id}/follow [delete]
+func (h *Handler) UnfollowPost() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+
+		resp, err := h.Forum.UnfollowPost(forumContext(c), &pb.FollowPostRequest{PostId: postID})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка отписки от поста: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Мои уведомления
+// @Description Уведомления текущего пользователя, недавно обновлённые первыми, и число непрочитанных.
+// @Description Новые комментарии к одному посту собираются в одно уведомление, пока оно не прочитано.
+// @Tags Notifications
+// @Security ApiKeyAuth
+// @Produce json
+// @Param unread query bool false "Только непрочитанные"
+// @Param limit query int false "Размер страницы (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение от начала списка"
+// @Success 200 {object} pb.ListNotificationsResponse "Уведомления"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/notifications [get]
+func (h *Handler) ListNotifications() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &pb.ListNotificationsRequest{}
+		if !bindPage(c, &req.Limit, &req.Offset) {
+			return
+		}
+		if v := c.Query("unread"); v != "" {
+			unread, err := strconv.ParseBool(v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр unread"})
+				return
+			}
+			req.UnreadOnly = unread
+		}
+
+		resp, err := h.Forum.ListNotifications(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения уведомлений: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Число непрочитанных уведомлений
+// @Tags Notifications
+// @Security ApiKeyAuth
+// @Produce json
+// @Success 200 {object} pb.UnreadCountResponse "Число непрочитанных"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/notifications/unread_count [get]
+func (h *Handler) GetUnreadCount() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := h.Forum.GetUnreadCount(forumContext(c), &pb.EmptyMessage{})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения уведомлений: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Отметить уведомления прочитанными
+// @Tags Notifications
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param request body pb.MarkReadRequest true "ID уведомлений"
+// @Success 200 {object} pb.UnreadCountResponse "Число оставшихся непрочитанных"
+// @Failure 400 {object} map[string]string "Неверный формат запроса"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/notifications/read [post]
+func (h *Handler) MarkRead() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req pb.MarkReadRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+
+		resp, err := h.Forum.MarkRead(forumContext(c), &req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка отметки уведомлений: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Отметить все уведомления прочитанными
+// @Tags Notifications
+// @Security ApiKeyAuth
+// @Produce json
+// @Success 200 {object} pb.UnreadCountResponse "Число оставшихся непрочитанных"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/notifications/read_all [post]
+func (h *Handler) MarkAllRead() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := h.Forum.MarkAllRead(forumContext(c), &pb.EmptyMessage{})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка отметки уведомлений: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// --- Mentions ---
+
+// @Summary Мои упоминания
+// @Description Посты, комментарии и сообщения чата, где упомянули текущего пользователя, новые первыми.
+// @Tags Mentions
+// @Security ApiKeyAuth
+// @Produce json
+// @Param limit query int false "Размер страницы (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение от начала списка"
+// @Success 200 {object} pb.ListMentionsResponse "Упоминания"
+// @Failure 400 {object} map[string]string "Неверные параметры запроса"
+// @Failure 401 {object} map[string]string "Нужна авторизация"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/mentions [get]
+func (h *Handler) ListMentions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &pb.ListMentionsRequest{}
+		if !bindPage(c, &req.Limit, &req.Offset) {
+			return
+		}
+
+		resp, err := h.Forum.ListMentions(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения упоминаний: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// --- Feeds ---
+
+// FeedSize — сколько последних постов попадает в ленты RSS и Atom
+const FeedSize = 30
+
+// FeedFormat — формат ленты: тип содержимого ответа и сборщик документа
+type FeedFormat struct {
+	contentType string
+	build       func(feed.Feed) ([]byte, error)
+}
+
+// Форматы лент, по одному маршруту на каждый
+var (
+	FeedRSS  = FeedFormat{contentType: "application/rss+xml; charset=utf-8", build: feed.RSS}
+	FeedAtom = FeedFormat{contentType: "application/atom+xml; charset=utf-8", build: feed.Atom}
+)
+
+// @Summary Лента новых постов
+// @Description Последние опубликованные посты в RSS 2.0 или Atom. Ответ содержит ETag
+// @Description и Last-Modified; с If-None-Match или If-Modified-Since возвращается 304 без тела.
+// @Tags Feeds
+// @Produce xml
+// @Success 200 {string} string "Лента"
+// @Success 304 "Лента не изменилась"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /feed.rss [get]
+// @Router /feed.atom [get]
+func (h *Handler) PostsFeed(format FeedFormat) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		posts, ok := h.feedPosts(c, &pb.ListPostsRequest{})
+		if !ok {
+			return
+		}
+		f := feed.Feed{
+			Title:       "Форум — новые посты",
+			Description: "Последние посты форума",
+			Link:        h.publicURL + "/posts",
+		}
+		h.serveFeed(c, format, f, posts)
+	}
+}
+
+// @Summary Лента постов автора
+// @Description Последние опубликованные посты пользователя в RSS 2.0 или Atom.
+// @Description Заголовки кэширования те же, что у общей ленты. Имя автора берётся из его постов,
+// @Description поэтому для пользователя без постов отдаётся пустая лента.
+// @Tags Feeds
+// @Produce xml
+// @Param id path int true "ID автора"
+// @Success 200 {string} string "Лента"
+// @Success 304 "Лента не изменилась"
+// @Failure 400 {object} map[string]string "Неверный ID пользователя"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /users/{id}/feed.rss [get]
+// @Router /users/{id}/feed.atom [get]
+func (h *Handler) AuthorFeed(format FeedFormat) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID пользователя"})
+			return
+		}
+		posts, ok := h.feedPosts(c, &pb.ListPostsRequest{AuthorId: &userID})
+		if !ok {
+			return
+		}
+
+		author := fmt.Sprintf("#%d", userID)
+		if len(posts) > 0 && posts[0].AuthorUsername != "" {
+			author = posts[0].AuthorUsername
+		}
+		f := feed.Feed{
+			Title:       fmt.Sprintf("Форум — посты %s", author),
+			Description: fmt.Sprintf("Последние посты пользователя %s", author),
+			Link:        fmt.Sprintf("%s/posts?author_id=%d", h.publicURL, userID),
+		}
+		h.serveFeed(c, format, f, posts)
+	}
+}
+
+// @Summary Лента постов категории
+// @Description Последние опубликованные посты категории в RSS 2.0 или Atom.
+// @Description Заголовки кэширования те же, что у общей ленты.
+// @Tags Feeds
+// @Produce xml
+// @Param id path int true "ID категории"
+// @Success 200 {string} string "Лента"
+// @Success 304 "Лента не изменилась"
+// @Failure 400 {object} map[string]string "Неверный ID категории"
+// @Failure 404 {object} map[string]string "Категория не найдена"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /categories/{id}/feed.rss [get]
+// @Router /categories/{id}/feed.atom [get]
+func (h *Handler) CategoryFeed(format FeedFormat) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		categoryID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID категории"})
+			return
+		}
+		resp, err := h.Forum.GetCategory(c.Request.Context(), &pb.GetCategoryRequest{CategoryId: categoryID})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения категории: %v", err)})
+			return
+		}
+
+		posts, ok := h.feedPosts(c, &pb.ListPostsRequest{CategoryId: &categoryID})
+		if !ok {
+			return
+		}
+
+		f := feed.Feed{
+			Title:       fmt.Sprintf("Форум — %s", resp.Category.Title),
+			Description: resp.Category.Description,
+			Link:        fmt.Sprintf("%s/posts?category_id=%d", h.publicURL, categoryID),
+		}
+		if f.Description == "" {
+			f.Description = fmt.Sprintf("Последние посты категории %s", resp.Category.Title)
+		}
+		h.serveFeed(c, format, f, posts)
+	}
+}
+
+// feedPosts запрашивает последние неудалённые посты для ленты. Лента одна для всех
+// читателей, поэтому посты запрашиваются без токена: черновики автора в неё не
+// попадают, а ETag не зависит от того, кто опрашивает ленту. При ошибке ответ уже записан.
+func (h *Handler) feedPosts(c *gin.Context, req *pb.ListPostsRequest) ([]*pb.Post, bool) {
+	// лента — новые посты сверху; закреплённый пост не должен висеть в ней первым
+	req.Limit = FeedSize
+	req.Sort = pb.PostSort_POST_SORT_NEW
+	req.Order = pb.SortOrder_SORT_ORDER_DESC
+	req.IgnorePinned = true
+	resp, err := h.Forum.Posts(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("не удалось получить посты: %v", err)})
+		return nil, false
+	}
+	posts := make([]*pb.Post, 0, len(resp.Posts))
+	for _, p := range resp.Posts {
+		if !p.Deleted {
+			posts = append(posts, p)
+		}
+	}
+	return posts, true
+}
+
+// serveFeed дополняет ленту постами и отдаёт её с ETag и Last-Modified
+func (h *Handler) serveFeed(c *gin.Context, format FeedFormat, f feed.Feed, posts []*pb.Post) {
+	f.SelfLink = h.publicURL + c.Request.URL.Path
+	for _, p := range posts {
+		item := feed.Item{
+			Title:     p.Title,
+			Link:      fmt.Sprintf("%s/posts/%d", h.publicURL, p.Id),
+			Author:    p.AuthorUsername,
+			Summary:   feed.Summary(p.ContentHtml, feed.DefaultSummaryLength),
+			Published: time.Unix(p.CreatedAt, 0),
+		}
+		if p.UpdatedAt > 0 {
+			item.Updated = time.Unix(p.UpdatedAt, 0)
+		}
+		f.Items = append(f.Items, item)
+	}
+
+	body, err := format.build(f)
+	if err != nil {
+		h.log.Error("не удалось собрать ленту", logger.NewField("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "не удалось собрать ленту"})
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	modified := f.Updated()
+	c.Header("ETag", etag)
+	if !modified.IsZero() {
+		c.Header("Last-Modified", modified.UTC().Format(http.TimeFormat))
+	}
+	if notModified(c.Request, etag, modified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, format.contentType, body)
+}
+
+// notModified проверяет условные заголовки запроса. If-None-Match важнее
+// If-Modified-Since: если он есть, дата не сравнивается.
+func notModified(r *http.Request, etag string, modified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, tag := range strings.Split(match, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == etag || tag == "*" {
+				return true
+			}
+		}
+		return false
+	}
+	if since := r.Header.Get("If-Modified-Since"); since != "" && !modified.IsZero() {
+		t, err := http.ParseTime(since)
+		return err == nil && !modified.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
+// --- Categories ---
+
+// @Summary Получить список категорий
+// @Tags Categories
+// @Produce json
+// @Success 200 {object} pb.ListCategoriesResponse "Категории в порядке отображения"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /categories [get]
+func (h *Handler) ListCategories() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := h.Forum.ListCategories(forumContext(c), &pb.ListCategoriesRequest{})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения категорий: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Создать категорию
+// @Tags Categories
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param request body pb.CreateCategoryRequest true "Данные новой категории"
+// @Success 200 {object} pb.CategoryResponse "Созданная категория"
+// @Failure 400 {object} map[string]string "Ошибка валидации запроса"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 409 {object} map[string]string "Slug уже занят"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/categories [post]
+func (h *Handler) CreateCategory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req pb.CreateCategoryRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+
+		resp, err := h.Forum.CreateCategory(forumContext(c), &req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка создания категории: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Изменить категорию
+// @Tags Categories
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID категории"
+// @Param request body pb.UpdateCategoryRequest true "Изменяемые поля"
+// @Success 200 {object} pb.CategoryResponse "Обновлённая категория"
+// @Failure 400 {object} map[string]string "Ошибка валидации запроса"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Категория не найдена"
+// @Failure 409 {object} map[string]string "Slug уже занят"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/categories/{id} [put]
+func (h *Handler) UpdateCategory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		categoryID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID категории"})
+			return
+		}
+
+		var req pb.UpdateCategoryRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+		req.CategoryId = categoryID
+
+		resp, err := h.Forum.UpdateCategory(forumContext(c), &req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка обновления категории: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Удалить категорию
+// @Description Посты удалённой категории остаются на форуме без категории.
+// @Tags Categories
+// @Security ApiKeyAuth
+// @Param id path int true "ID категории"
+// @Success 200 {object} map[string]string "Категория удалена"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 403 {object} map[string]string "Нужны права администратора"
+// @Failure 404 {object} map[string]string "Категория не найдена"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/categories/{id} [delete]
+func (h *Handler) DeleteCategory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		categoryID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID категории"})
+			return
+		}
+
+		_, err = h.Forum.DeleteCategory(forumContext(c), &pb.DeleteCategoryRequest{CategoryId: categoryID})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка удаления категории: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "категория удалена"})
+	}
+}
+
+// --- Comment operations ---
+
+// @Summary Создать комментарий
+// @Tags Comments
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param createCommentRequest body pb.CreateCommentRequest true "Данные нового комментария"
+// @Success 200 {object} pb.CommentResponse "Созданный комментарий"
+// @Failure 400 {object} map[string]string "Ошибка валидации запроса"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/comments [post]
+func (h *Handler) CreateComment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req pb.CreateCommentRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+		resp, err := h.Forum.CreateComment(forumContext(c), &req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("не удалось создать комментарий: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Получить комментарий по ID
+// @Tags Comments
+// @Produce json
+// @Param id path int true "ID комментария"
+// @Success 200 {object} pb.CommentResponse "Комментарий"
+// @Failure 400 {object} map[string]string "Неверный ID"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /comments/{id} [get]
+func (h *Handler) GetCommentByID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		commentID, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID комментария"})
+			return
+		}
+
+		req := &pb.GetCommentRequest{CommentId: commentID}
+		resp, err := h.Forum.GetCommentByID(forumContext(c), req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка получения комментария: %v", err)})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Получить комментарии по ID поста
+// @Tags Comments
+// @Produce json
+// @Description view=flat (по умолчанию) — список в порядке обхода дерева с depth и path,
+// @Description view=tree — корневые комментарии с вложенными replies.
+// @Param postID path int true "ID поста"
+// @Param view query string false "Представление: flat или tree"
+// @Success 200 {array} pb.Comment "Список комментариев"
+// @Failure 400 {object} map[string]string "Неверный ID поста"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /comments/post/{postID} [get]
+func (h *Handler) GetCommentsByPostID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postIDStr := c.Param("postID")
+		postID, err := strconv.ParseInt(postIDStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID поста"})
+			return
+		}
+
+		req := &pb.GetCommentsByPostIDRequest{PostId: postID}
+		switch c.DefaultQuery("view", "flat") {
+		case "flat":
+			req.View = pb.CommentView_COMMENT_VIEW_FLAT
+		case "tree":
+			req.View = pb.CommentView_COMMENT_VIEW_TREE
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный параметр view"})
+			return
+		}
+
+		resp, err := h.Forum.GetByPostID(forumContext(c), req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка получения комментариев: %v", err)})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp.Comments)
+	}
+}
+
+// @Summary Изменить комментарий
+// @Description Менять комментарий могут автор и администраторы.
+// @Tags Comments
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID комментария"
+// @Param request body pb.UpdateCommentRequest true "Новый текст"
+// @Success 200 {object} pb.CommentResponse "Обновлённый комментарий"
+// @Failure 400 {object} map[string]string "Ошибка валидации запроса"
+// @Failure 403 {object} map[string]string "Комментарий принадлежит другому пользователю"
+// @Failure 404 {object} map[string]string "Комментарий не найден"
+// @Failure 500 {object} map[string]string "Ошибка сервера"
+// @Router /api/comments/{id} [put]
+func (h *Handler) UpdateComment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		commentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "неверный ID комментария"})
+			return
+		}
+
+		var req pb.UpdateCommentRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
+			return
+		}
+		req.CommentId = commentID
+		resp, err := h.Forum.UpdateComment(forumContext(c), &req)
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка обновления комментария: %v", err)})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Удалить комментарий по ID
+// @Tags Comments
+// @Security ApiKeyAuth
+// @Description Комментарий переносится в корзину; пока на него есть ответы, в ветке остаётся заглушка.
+// @Param id path int true "ID комментария"
+// @Param reason query string false "Причина удаления"
 // @Success 200 {object} pb.EmptyMessage "Пустое сообщение"
 // @Failure 400 {object} map[string]string "Неверный ID"
 // @Failure 401 {object} map[string]string "Не авторизован"
+// @Failure 403 {object} map[string]string "Комментарий принадлежит другому пользователю"
+// @Failure 404 {object} map[string]string "Комментарий не найден"
 // @Failure 500 {object} map[string]string "Ошибка сервера"
 // @Router /api/comments/{id} [delete]
 func (h *Handler) DeleteComment() gin.HandlerFunc {
@@ -455,20 +2385,14 @@ func (h *Handler) DeleteComment() gin.HandlerFunc {
 			return
 		}
 
-		// userIDstr := c.GetString("userID")
-		// userID, err := strconv.ParseInt(userIDstr, 10, 64)
-		// if userID == 0 {
-		// 	c.JSON(http.StatusUnauthorized, gin.H{"error": "не авторизован"})
-		// 	return
-		// }
-
 		req := pb.DeleteCommentRequest{
 			CommentId: commentID,
+			Reason:    c.Query("reason"),
 		}
 
-		_, err = h.Forum.DeleteComment(c, &req)
+		_, err = h.Forum.DeleteComment(forumContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка удаления комментария: %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка удаления комментария: %v", err)})
 			return
 		}
 
@@ -483,7 +2407,7 @@ func (h *Handler) Comments() gin.HandlerFunc {
 			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
 			return
 		}
-		resp, err := h.Forum.Comments(c, &req)
+		resp, err := h.Forum.Comments(forumContext(c), &req)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка получения комментариев: %v", err)})
 			return
@@ -511,9 +2435,9 @@ func (h *Handler) SendMessage() gin.HandlerFunc {
 			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("неверный формат запроса %v", err)})
 			return
 		}
-		_, err := h.Forum.SendMessage(c, &msg)
+		_, err := h.Forum.SendMessage(forumContext(c), &msg)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка отправки сообщений %v", err)})
+			c.JSON(httpStatus(err), gin.H{"error": fmt.Sprintf("ошибка отправки сообщений %v", err)})
 			return
 		}
 		c.JSON(http.StatusOK, gin.H{"message": "сообщение отправлено"})
@@ -529,7 +2453,7 @@ func (h *Handler) SendMessage() gin.HandlerFunc {
 func (h *Handler) GetMessages() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req pb.GetMessagesRequest
-		resp, err := h.Forum.GetMessages(c, &req)
+		resp, err := h.Forum.GetMessages(forumContext(c), &req)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("ошибка получения сообщений %v", err)})
 			return
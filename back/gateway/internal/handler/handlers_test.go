@@ -0,0 +1,150 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/netabakovv/forum/back/gateway/internal/handler"
+	"github.com/netabakovv/forum/back/pkg/logger"
+	pb "github.com/netabakovv/forum/back/proto"
+	mock_proto "github.com/netabakovv/forum/back/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func feedRouter(forum pb.ForumServiceClient) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	h := handler.NewHandler(forum, nil, "https://forum.example/", logger.NewStdLogger())
+
+	r := gin.New()
+	r.GET("/feed.rss", h.PostsFeed(handler.FeedRSS))
+	r.GET("/feed.atom", h.PostsFeed(handler.FeedAtom))
+	r.GET("/users/:id/feed.atom", h.AuthorFeed(handler.FeedAtom))
+	return r
+}
+
+func feedPosts() *pb.ListPostsResponse {
+	updated := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+	return &pb.ListPostsResponse{Posts: []*pb.Post{
+		{Id: 2, Title: "Второй", AuthorUsername: "alice", ContentHtml: "<p>текст</p>",
+			CreatedAt: updated.Add(-time.Hour).Unix(), UpdatedAt: updated.Unix()},
+		{Id: 1, Title: "Удалён", AuthorUsername: "alice", Deleted: true, CreatedAt: updated.Add(-2 * time.Hour).Unix()},
+	}}
+}
+
+func get(r *gin.Engine, path string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Host = "evil.example"
+	req.Header.Set("X-Forwarded-Proto", "http")
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestPostsFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	forum := mock_proto.NewMockForumServiceClient(ctrl)
+	forum.EXPECT().Posts(gomock.Any(), &pb.ListPostsRequest{Limit: handler.FeedSize, IgnorePinned: true}).Return(feedPosts(), nil).AnyTimes()
+	r := feedRouter(forum)
+
+	w := get(r, "/feed.rss", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "Thu, 02 May 2024 10:00:00 GMT", w.Header().Get("Last-Modified"))
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	body := w.Body.String()
+	// ссылки строятся от настроенного адреса, а не от заголовков запроса
+	assert.Contains(t, body, "https://forum.example/posts/2")
+	assert.Contains(t, body, "https://forum.example/feed.rss")
+	assert.NotContains(t, body, "evil.example")
+	assert.NotContains(t, body, "Удалён")
+
+	t.Run("If-None-Match", func(t *testing.T) {
+		w := get(r, "/feed.rss", map[string]string{"If-None-Match": `"other", W/` + etag})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+	})
+
+	t.Run("If-None-Match не совпал", func(t *testing.T) {
+		w := get(r, "/feed.rss", map[string]string{
+			"If-None-Match":     `"other"`,
+			"If-Modified-Since": "Fri, 03 May 2024 10:00:00 GMT",
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("If-Modified-Since", func(t *testing.T) {
+		w := get(r, "/feed.rss", map[string]string{"If-Modified-Since": "Thu, 02 May 2024 10:00:00 GMT"})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+
+		w = get(r, "/feed.rss", map[string]string{"If-Modified-Since": "Thu, 02 May 2024 09:59:59 GMT"})
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Atom", func(t *testing.T) {
+		w := get(r, "/feed.atom", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/atom+xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.NotEqual(t, etag, w.Header().Get("ETag"))
+	})
+}
+
+func TestAuthorFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	forum := mock_proto.NewMockForumServiceClient(ctrl)
+	r := feedRouter(forum)
+
+	authorID := int64(7)
+	forum.EXPECT().Posts(gomock.Any(), &pb.ListPostsRequest{AuthorId: &authorID, Limit: handler.FeedSize, IgnorePinned: true}).Return(feedPosts(), nil)
+	w := get(r, "/users/7/feed.atom", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Форум — посты alice")
+	assert.Contains(t, w.Body.String(), "https://forum.example/posts?author_id=7")
+
+	t.Run("автор без постов", func(t *testing.T) {
+		authorID := int64(8)
+		forum.EXPECT().Posts(gomock.Any(), &pb.ListPostsRequest{AuthorId: &authorID, Limit: handler.FeedSize, IgnorePinned: true}).Return(&pb.ListPostsResponse{}, nil)
+		w := get(r, "/users/8/feed.atom", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Форум — посты #8")
+	})
+
+	t.Run("неверный ID", func(t *testing.T) {
+		w := get(r, "/users/abc/feed.atom", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestListBookmarks_Page(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	forum := mock_proto.NewMockForumServiceClient(ctrl)
+	h := handler.NewHandler(forum, nil, "https://forum.example/", logger.NewStdLogger())
+	r := gin.New()
+	r.GET("/bookmarks", h.ListBookmarks())
+
+	forum.EXPECT().ListBookmarks(gomock.Any(), &pb.ListBookmarksRequest{Limit: 5, Offset: 10}).
+		Return(&pb.ListBookmarksResponse{}, nil)
+	w := get(r, "/bookmarks?limit=5&offset=10", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	for _, query := range []string{"limit=abc", "offset=-1"} {
+		w := get(r, "/bookmarks?"+query, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
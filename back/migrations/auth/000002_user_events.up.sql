@@ -0,0 +1,10 @@
+-- Журнал событий пользователей (outbox): запись добавляется в той же транзакции,
+-- что и изменение users, и читается forum_service по возрастанию id через
+-- ListUserEvents. Так изменения доходят до других сервисов без брокера сообщений.
+CREATE TABLE IF NOT EXISTS user_events (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    type VARCHAR(20) NOT NULL,        -- 'user.updated'
+    username VARCHAR(50) NOT NULL,    -- имя пользователя после изменения
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
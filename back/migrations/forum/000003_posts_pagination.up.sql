@@ -0,0 +1,3 @@
+-- Индексы для keyset-пагинации ленты постов по (created_at, id)
+CREATE INDEX IF NOT EXISTS idx_posts_created_at_id ON posts(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_posts_author_created_at_id ON posts(author_id, created_at, id);
@@ -0,0 +1,4 @@
+ALTER TABLE comments DROP COLUMN IF EXISTS score;
+ALTER TABLE posts DROP COLUMN IF EXISTS score;
+DROP INDEX IF EXISTS idx_votes_target;
+DROP TABLE IF EXISTS votes;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_revisions_target;
+DROP TABLE IF EXISTS revisions;
@@ -0,0 +1,12 @@
+-- История правок: строки только добавляются, первая строка цели — исходная версия
+CREATE TABLE IF NOT EXISTS revisions (
+    id SERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('post', 'comment')),
+    target_id INTEGER NOT NULL,
+    title TEXT,
+    content TEXT NOT NULL,
+    editor_id INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_revisions_target ON revisions(target_type, target_id, id);
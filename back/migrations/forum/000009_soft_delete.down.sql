@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_comments_deleted_at;
+DROP INDEX IF EXISTS idx_posts_deleted_at;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS delete_reason;
+ALTER TABLE comments DROP COLUMN IF EXISTS deleted_by;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS delete_reason;
+ALTER TABLE posts DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE posts DROP COLUMN IF EXISTS deleted_at;
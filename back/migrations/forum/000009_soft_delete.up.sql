@@ -0,0 +1,10 @@
+-- Мягкое удаление: строка остаётся в корзине до очистки по сроку хранения
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS deleted_by INTEGER;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS delete_reason TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS deleted_by INTEGER;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS delete_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_posts_deleted_at ON posts(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_deleted_at ON comments(deleted_at) WHERE deleted_at IS NOT NULL;
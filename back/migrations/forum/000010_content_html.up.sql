@@ -0,0 +1,4 @@
+-- Кэш отрендеренного Markdown. Пустая строка при непустом content означает,
+-- что HTML ещё не построен: его заполняет forum_service при запуске.
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS content_html TEXT NOT NULL DEFAULT '';
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS content_html TEXT NOT NULL DEFAULT '';
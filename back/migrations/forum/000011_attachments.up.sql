@@ -0,0 +1,20 @@
+-- Метаданные вложений; содержимое файлов лежит в хранилище по blob_key.
+-- Внешних ключей на цели нет, как у голосов и истории правок: строки вложений
+-- удалённых из корзины целей удаляет forum_service вместе с файлами.
+CREATE TABLE IF NOT EXISTS attachments (
+    id SERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL CHECK (target_type IN ('post', 'comment')),
+    target_id INTEGER NOT NULL,
+    uploader_id INTEGER NOT NULL,
+    file_name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    blob_key TEXT NOT NULL UNIQUE,
+    thumbnail_key TEXT NOT NULL DEFAULT '',
+    width INTEGER NOT NULL DEFAULT 0,
+    height INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_target ON attachments(target_type, target_id, id);
+CREATE INDEX IF NOT EXISTS idx_attachments_uploader ON attachments(uploader_id);
@@ -0,0 +1,9 @@
+-- Черновики и отложенная публикация. Существующие посты считаются опубликованными.
+-- При публикации created_at становится временем публикации, чтобы пост попал
+-- в начало ленты, а не туда, где был создан черновик.
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'published'
+    CHECK (status IN ('draft', 'scheduled', 'published'));
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_posts_scheduled ON posts(publish_at) WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS idx_posts_unpublished ON posts(author_id) WHERE status <> 'published';
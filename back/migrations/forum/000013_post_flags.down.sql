@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_posts_pinned;
+ALTER TABLE posts DROP COLUMN IF EXISTS is_locked;
+ALTER TABLE posts DROP COLUMN IF EXISTS is_pinned;
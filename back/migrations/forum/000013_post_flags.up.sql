@@ -0,0 +1,5 @@
+-- Закреплённые посты всегда идут в начале ленты, в закрытые нельзя писать комментарии
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS is_pinned BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS is_locked BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_posts_pinned ON posts(created_at DESC, id DESC) WHERE is_pinned;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_posts_hot_rank;
+ALTER TABLE posts DROP COLUMN IF EXISTS hot_rank;
+DROP FUNCTION IF EXISTS post_hot_rank(BIGINT, TIMESTAMP);
@@ -0,0 +1,18 @@
+-- Ранг для сортировки "hot" в духе Reddit: логарифм вовлечённости (рейтинг плюс
+-- комментарии) и время публикации, где 45000 секунд (12,5 часа) весят столько же,
+-- сколько десятикратный рост вовлечённости. Ранг хранится в колонке, чтобы
+-- сортировка шла по индексу; вовлечённость недавних постов пересчитывается фоново.
+CREATE OR REPLACE FUNCTION post_hot_rank(engagement BIGINT, created TIMESTAMP)
+RETURNS DOUBLE PRECISION AS $$
+    SELECT (SIGN(engagement) * LOG(GREATEST(ABS(engagement), 1))
+        + (EXTRACT(EPOCH FROM created) - 1134028003) / 45000)::DOUBLE PRECISION
+$$ LANGUAGE SQL IMMUTABLE;
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS hot_rank DOUBLE PRECISION NOT NULL
+    DEFAULT post_hot_rank(0, CURRENT_TIMESTAMP::TIMESTAMP);
+
+UPDATE posts p SET hot_rank = post_hot_rank(
+    p.score + (SELECT COUNT(*) FROM comments c WHERE c.post_id = p.id AND c.deleted_at IS NULL),
+    p.created_at);
+
+CREATE INDEX IF NOT EXISTS idx_posts_hot_rank ON posts(is_pinned, hot_rank, id);
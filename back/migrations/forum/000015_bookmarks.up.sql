@@ -0,0 +1,12 @@
+-- Закладки пользователей с личными заметками. Пока пост в корзине, закладка
+-- скрыта из списка; при окончательном удалении поста она удаляется каскадом.
+CREATE TABLE IF NOT EXISTS bookmarks (
+    user_id INTEGER NOT NULL,
+    post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, post_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookmarks_user_created ON bookmarks(user_id, created_at DESC, post_id DESC);
+CREATE INDEX IF NOT EXISTS idx_bookmarks_post ON bookmarks(post_id);
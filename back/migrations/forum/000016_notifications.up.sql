@@ -0,0 +1,40 @@
+-- Подписки на обсуждения постов. subscribed = FALSE — пользователь явно
+-- отписался: автоматическая подписка при комментировании его не возвращает.
+-- Автор поста подписан на своё обсуждение без записи, пока не отпишется.
+CREATE TABLE IF NOT EXISTS subscriptions (
+    user_id INTEGER NOT NULL,
+    post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    subscribed BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, post_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscriptions_post ON subscriptions(post_id) WHERE subscribed;
+
+-- Уведомления. Пока уведомление не прочитано, новые события того же типа
+-- по тому же посту не создают новых записей, а увеличивают event_count.
+CREATE TABLE IF NOT EXISTS notifications (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    comment_id INTEGER,              -- последний комментарий, вызвавший уведомление
+    actor_id INTEGER NOT NULL,       -- автор последнего события
+    actor_name VARCHAR(255) NOT NULL DEFAULT '',
+    event_count INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    read_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_unread_batch
+    ON notifications(user_id, type, post_id) WHERE read_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_notifications_user_updated ON notifications(user_id, updated_at DESC, id DESC);
+
+-- Существующие комментаторы подписываются на обсуждения, в которых участвовали
+INSERT INTO subscriptions (user_id, post_id)
+SELECT DISTINCT c.author_id, c.post_id
+FROM comments c
+JOIN posts p ON p.id = c.post_id
+WHERE c.author_id <> p.author_id
+ON CONFLICT DO NOTHING;
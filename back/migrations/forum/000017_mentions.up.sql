@@ -0,0 +1,14 @@
+-- Упоминания пользователей (@username) в постах, комментариях и сообщениях чата.
+-- target_type: 'post', 'comment' или 'chat'. Внешних ключей на цели нет:
+-- записи удаляются вместе с целью при очистке корзины и старых сообщений чата.
+CREATE TABLE IF NOT EXISTS mentions (
+    target_type VARCHAR(20) NOT NULL,
+    target_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,             -- упомянутый пользователь
+    username VARCHAR(50) NOT NULL,        -- имя, как оно было написано в тексте
+    author_id INTEGER NOT NULL,           -- кто упомянул
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (target_type, target_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_mentions_user_created ON mentions(user_id, created_at DESC);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_posts_activity;
+DROP INDEX IF EXISTS idx_posts_comment_count;
+ALTER TABLE posts DROP COLUMN IF EXISTS last_comment_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS comment_count;
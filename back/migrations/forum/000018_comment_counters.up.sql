@@ -0,0 +1,18 @@
+-- Число живых комментариев и время последнего из них хранятся в посте, а не
+-- считаются подзапросом для каждой строки ленты. Репозиторий обновляет их в той же
+-- транзакции, что и комментарий; расхождения исправляет forum_service/cmd/recount.
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS comment_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS last_comment_at TIMESTAMP;
+
+UPDATE posts p SET comment_count = s.comment_count, last_comment_at = s.last_comment_at
+FROM (
+    SELECT post_id, COUNT(*) AS comment_count, MAX(created_at) AS last_comment_at
+    FROM comments
+    WHERE deleted_at IS NULL
+    GROUP BY post_id
+) s
+WHERE s.post_id = p.id;
+
+-- Сортировки ленты top и active
+CREATE INDEX IF NOT EXISTS idx_posts_comment_count ON posts(is_pinned, comment_count, id);
+CREATE INDEX IF NOT EXISTS idx_posts_activity ON posts(is_pinned, (COALESCE(last_comment_at, created_at)), id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_posts_view_count;
+ALTER TABLE posts DROP COLUMN IF EXISTS view_count;
@@ -0,0 +1,6 @@
+-- Просмотры постов. forum_service копит их в памяти и записывает пачками,
+-- поэтому значение в базе может отставать от реального на интервал сброса.
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS view_count BIGINT NOT NULL DEFAULT 0;
+
+-- Сортировка ленты views
+CREATE INDEX IF NOT EXISTS idx_posts_view_count ON posts(is_pinned, view_count, id);
@@ -0,0 +1,10 @@
+-- Соответствие ID записей из архива forumctl и ID, под которыми они загружены.
+-- source — источник архива из его заголовка: повторная загрузка архива из того же
+-- источника пропускает записи, которые здесь уже есть, и дописывает новые.
+CREATE TABLE IF NOT EXISTS archive_imports (
+    source TEXT NOT NULL,
+    kind VARCHAR(20) NOT NULL,       -- 'category', 'post', 'comment' или 'chat_message'
+    source_id BIGINT NOT NULL,       -- ID в архиве
+    target_id BIGINT NOT NULL,       -- ID в этой базе
+    PRIMARY KEY (source, kind, source_id)
+);
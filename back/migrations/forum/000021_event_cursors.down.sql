@@ -0,0 +1 @@
+DROP TABLE IF EXISTS event_cursors;
\ No newline at end of file
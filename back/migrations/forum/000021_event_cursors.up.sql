@@ -0,0 +1,6 @@
+-- Позиции чтения журналов событий других сервисов: name — имя журнала,
+-- position — id последнего обработанного события.
+CREATE TABLE IF NOT EXISTS event_cursors (
+    name VARCHAR(50) PRIMARY KEY,
+    position BIGINT NOT NULL
+);
@@ -0,0 +1,42 @@
+-- Опросы в постах: у поста не больше одного опроса, он создаётся вместе с постом.
+CREATE TABLE IF NOT EXISTS polls (
+    post_id INTEGER PRIMARY KEY REFERENCES posts(id) ON DELETE CASCADE,
+    multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+    public BOOLEAN NOT NULL DEFAULT FALSE,  -- видно, кто за что голосовал
+    closes_at TIMESTAMP,                    -- NULL — опрос не закрывается
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS poll_options (
+    id SERIAL PRIMARY KEY,
+    post_id INTEGER NOT NULL REFERENCES polls(post_id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL,
+    text VARCHAR(200) NOT NULL,
+    UNIQUE (post_id, position),
+    UNIQUE (post_id, id)  -- для внешнего ключа poll_choices: вариант из того же опроса
+);
+
+-- Бюллетень: первичный ключ не даёт проголосовать в опросе дважды.
+-- username копируется при голосовании, как в постах и комментариях.
+CREATE TABLE IF NOT EXISTS poll_votes (
+    post_id INTEGER NOT NULL REFERENCES polls(post_id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL,
+    username VARCHAR(50),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (post_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_poll_votes_user_id ON poll_votes(user_id);
+
+-- Выбранные варианты бюллетеня. Схема не ограничивает их число: то, что в опросе
+-- с одним ответом вариант ровно один, проверяет репозиторий при записи бюллетеня
+CREATE TABLE IF NOT EXISTS poll_choices (
+    post_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    option_id INTEGER NOT NULL,
+    PRIMARY KEY (post_id, user_id, option_id),
+    FOREIGN KEY (post_id, user_id) REFERENCES poll_votes(post_id, user_id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id, option_id) REFERENCES poll_options(post_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_poll_choices_option ON poll_choices(option_id);
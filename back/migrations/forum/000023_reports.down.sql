@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS warnings;
+DROP TABLE IF EXISTS reports;
\ No newline at end of file
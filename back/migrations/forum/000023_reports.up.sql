@@ -0,0 +1,35 @@
+-- Жалобы на посты, комментарии и сообщения чата. Текст цели сохраняется на
+-- момент жалобы: сообщения чата живут недолго, а посты и комментарии правятся.
+CREATE TABLE IF NOT EXISTS reports (
+    id SERIAL PRIMARY KEY,
+    target_type VARCHAR(20) NOT NULL,       -- post, comment, chat
+    target_id INTEGER NOT NULL,
+    target_author_id INTEGER NOT NULL,
+    target_content TEXT NOT NULL DEFAULT '',
+    reporter_id INTEGER NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    details TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    resolved_by INTEGER,
+    resolved_at TIMESTAMP,
+    resolution_note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Пока жалоба открыта, пользователь не может пожаловаться на ту же цель ещё раз
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reports_open_reporter
+    ON reports(target_type, target_id, reporter_id) WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_reports_status_target ON reports(status, target_type, target_id);
+
+-- Предупреждения авторам по итогам рассмотрения жалоб
+CREATE TABLE IF NOT EXISTS warnings (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    report_id INTEGER NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    reason VARCHAR(20) NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    issued_by INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_warnings_user ON warnings(user_id, created_at DESC);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS held_content;
+UPDATE posts SET status = 'draft' WHERE status = 'held';
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS posts_status_check;
+ALTER TABLE posts ADD CONSTRAINT posts_status_check
+    CHECK (status IN ('draft', 'scheduled', 'published'));
@@ -0,0 +1,34 @@
+-- Очередь модерации текстов, задержанных фильтрами. Новый пост сохраняется со
+-- статусом held и виден только автору; его правки применяются к самому посту.
+-- Новый комментарий и правка опубликованного текста лежат только в очереди:
+-- читатели видят прежнюю версию, пока модератор не одобрит новую.
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS posts_status_check;
+ALTER TABLE posts ADD CONSTRAINT posts_status_check
+    CHECK (status IN ('draft', 'scheduled', 'published', 'held'));
+
+CREATE TABLE IF NOT EXISTS held_content (
+    id SERIAL PRIMARY KEY,
+    target_type VARCHAR(20) NOT NULL,       -- post, comment
+    target_id INTEGER,                      -- NULL у нового комментария
+    post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    parent_id INTEGER REFERENCES comments(id) ON DELETE CASCADE,
+    depth INTEGER NOT NULL DEFAULT 0,
+    is_edit BOOLEAN NOT NULL DEFAULT FALSE,
+    author_id INTEGER NOT NULL,
+    username VARCHAR(50) NOT NULL,
+    editor_id INTEGER,
+    -- Задержанная версия; у нового поста она лежит в самом посте
+    title TEXT,
+    content TEXT,
+    content_html TEXT,
+    category_id INTEGER,
+    tags TEXT[],                            -- NULL, если правка не меняет теги
+    post_status VARCHAR(16),                -- статус, который новый пост получит после одобрения
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- Одна задержанная версия на пост или комментарий: следующая её заменяет.
+    -- Новые комментарии (target_id IS NULL) ограничением не связаны.
+    UNIQUE (target_type, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_held_content_created ON held_content(created_at, id);
@@ -0,0 +1,232 @@
+// Package archive описывает формат архива форума, который пишет и читает forumctl.
+//
+// Архив — NDJSON: первая строка — заголовок, дальше по одной записи в строке
+// в порядке, в котором их нужно загружать: пользователи, категории, посты,
+// комментарии, сообщения чата. ID в записях — исходные, при загрузке они
+// заменяются новыми.
+package archive
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Version — версия формата. Reader не читает архивы новее своей версии.
+const Version = 1
+
+// maxLineSize — наибольшая длина строки архива; посты длиннее не пропускает сервис
+const maxLineSize = 16 << 20
+
+// Типы записей архива
+const (
+	KindUser        = "user"
+	KindCategory    = "category"
+	KindPost        = "post"
+	KindComment     = "comment"
+	KindChatMessage = "chat_message"
+)
+
+// Header — первая строка архива
+type Header struct {
+	Version       int       `json:"version"`
+	Source        string    `json:"source"`         // откуда выгружен архив; по нему повторная загрузка находит уже загруженные записи
+	CreatedAt     time.Time `json:"created_at"`     // время выгрузки
+	WithPasswords bool      `json:"with_passwords"` // в записях пользователей есть хеши паролей
+}
+
+// User — пользователь auth_service. Права администратора не переносятся:
+// их выдают заново в базе, куда загружен архив.
+type User struct {
+	ID           int64     `json:"id"`
+	Username     string    `json:"username"`
+	PasswordHash string    `json:"password_hash,omitempty"` // только при выгрузке с паролями
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Category — категория форума
+type Category struct {
+	ID          int64     `json:"id"`
+	Slug        string    `json:"slug"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Position    int32     `json:"position"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Deletion — сведения о мягком удалении поста или комментария
+type Deletion struct {
+	DeletedAt time.Time `json:"deleted_at"`
+	DeletedBy int64     `json:"deleted_by"`
+	Reason    string    `json:"reason"`
+}
+
+// Post — пост со всеми статусами, включая черновики и удалённые
+type Post struct {
+	ID          int64      `json:"id"`
+	Title       string     `json:"title"`
+	Content     string     `json:"content"`
+	ContentHTML string     `json:"content_html"` // для читателей архива; при загрузке HTML строится заново из Content
+	AuthorID    int64      `json:"author_id"`
+	AuthorName  string     `json:"author_name"`
+	CategoryID  *int64     `json:"category_id,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	Status      string     `json:"status"`
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
+	IsPinned    bool       `json:"is_pinned"`
+	IsLocked    bool       `json:"is_locked"`
+	ViewCount   int64      `json:"view_count"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Deletion    *Deletion  `json:"deletion,omitempty"`
+}
+
+// Comment — комментарий, включая удалённые: под ними могут быть ответы
+type Comment struct {
+	ID          int64      `json:"id"`
+	PostID      int64      `json:"post_id"`
+	ParentID    *int64     `json:"parent_id,omitempty"`
+	AuthorID    int64      `json:"author_id"`
+	AuthorName  string     `json:"author_name"`
+	Content     string     `json:"content"`
+	ContentHTML string     `json:"content_html"` // для читателей архива; при загрузке HTML строится заново из Content
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Deletion    *Deletion  `json:"deletion,omitempty"`
+}
+
+// ChatMessage — сообщение общего чата
+type ChatMessage struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Username  string    `json:"username"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// record — строка архива после заголовка
+type record struct {
+	Kind string          `json:"kind"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Writer пишет архив построчно, не держа его в памяти
+type Writer struct {
+	enc *json.Encoder
+}
+
+// NewWriter записывает заголовок; версия в нём всегда текущая
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	header.Version = Version
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(header); err != nil {
+		return nil, fmt.Errorf("запись заголовка архива: %w", err)
+	}
+	return &Writer{enc: enc}, nil
+}
+
+// Write записывает одну запись: kind — один из Kind*, v — соответствующая структура
+func (w *Writer) Write(kind string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("запись %s: %w", kind, err)
+	}
+	if err := w.enc.Encode(record{Kind: kind, Data: data}); err != nil {
+		return fmt.Errorf("запись %s: %w", kind, err)
+	}
+	return nil
+}
+
+// Reader читает архив по одной записи
+type Reader struct {
+	scanner *bufio.Scanner
+	line    int
+	kind    string
+	data    json.RawMessage
+}
+
+// NewReader читает и проверяет заголовок архива
+func NewReader(r io.Reader) (*Reader, Header, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), maxLineSize)
+	reader := &Reader{scanner: scanner}
+
+	var header Header
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, header, fmt.Errorf("чтение заголовка архива: %w", err)
+		}
+		return nil, header, fmt.Errorf("пустой архив")
+	}
+	reader.line++
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, header, fmt.Errorf("чтение заголовка архива: %w", err)
+	}
+	if header.Version < 1 || header.Version > Version {
+		return nil, header, fmt.Errorf("неподдерживаемая версия архива %d", header.Version)
+	}
+	return reader, header, nil
+}
+
+// Next переходит к следующей записи и возвращает её тип. В конце архива возвращает io.EOF.
+func (r *Reader) Next() (string, error) {
+	for r.scanner.Scan() {
+		r.line++
+		if len(r.scanner.Bytes()) == 0 {
+			continue
+		}
+		var rec record
+		if err := json.Unmarshal(r.scanner.Bytes(), &rec); err != nil {
+			return "", fmt.Errorf("строка %d: %w", r.line, err)
+		}
+		r.kind, r.data = rec.Kind, rec.Data
+		return rec.Kind, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return "", fmt.Errorf("строка %d: %w", r.line+1, err)
+	}
+	return "", io.EOF
+}
+
+// Decode разбирает текущую запись в структуру её типа
+func (r *Reader) Decode(v any) error {
+	if err := json.Unmarshal(r.data, v); err != nil {
+		return fmt.Errorf("строка %d: %s: %w", r.line, r.kind, err)
+	}
+	return nil
+}
+
+// Line — номер строки текущей записи, для сообщений об ошибках
+func (r *Reader) Line() int {
+	return r.line
+}
+
+// Conflict — запись, которую не удалось загрузить как есть
+type Conflict struct {
+	Kind     string // тип записи
+	SourceID int64  // ID записи в архиве
+	Reason   string // что случилось и что сделано вместо загрузки
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s %d: %s", c.Kind, c.SourceID, c.Reason)
+}
+
+// Report — итог загрузки архива по типам записей
+type Report struct {
+	Imported  map[string]int // загружено впервые
+	Skipped   map[string]int // уже были загружены из этого источника
+	Conflicts []Conflict
+}
+
+func NewReport() *Report {
+	return &Report{Imported: map[string]int{}, Skipped: map[string]int{}}
+}
+
+// Conflict добавляет конфликт в отчёт
+func (r *Report) Conflict(kind string, sourceID int64, format string, args ...any) {
+	r.Conflicts = append(r.Conflicts, Conflict{Kind: kind, SourceID: sourceID, Reason: fmt.Sprintf(format, args...)})
+}
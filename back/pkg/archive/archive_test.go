@@ -0,0 +1,95 @@
+package archive_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/netabakovv/forum/back/pkg/archive"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterReader_RoundTrip(t *testing.T) {
+	created := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	categoryID := int64(3)
+
+	var buf bytes.Buffer
+	w, err := archive.NewWriter(&buf, archive.Header{Source: "db:5432/forum", CreatedAt: created})
+	require.NoError(t, err)
+	require.NoError(t, w.Write(archive.KindUser, archive.User{ID: 1, Username: "alice", CreatedAt: created}))
+	require.NoError(t, w.Write(archive.KindPost, archive.Post{
+		ID: 10, Title: "Пост", Content: "<b>текст</b>", AuthorID: 1, CategoryID: &categoryID,
+		Deletion: &archive.Deletion{DeletedAt: created, DeletedBy: 1, Reason: "спам"},
+	}))
+
+	// одна запись — одна строка
+	assert.Equal(t, 3, strings.Count(buf.String(), "\n"))
+	assert.NotContains(t, buf.String(), "password_hash")
+
+	r, header, err := archive.NewReader(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, archive.Version, header.Version)
+	assert.Equal(t, "db:5432/forum", header.Source)
+	assert.True(t, created.Equal(header.CreatedAt))
+
+	kind, err := r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, archive.KindUser, kind)
+	var user archive.User
+	require.NoError(t, r.Decode(&user))
+	assert.Equal(t, "alice", user.Username)
+
+	kind, err = r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, archive.KindPost, kind)
+	var post archive.Post
+	require.NoError(t, r.Decode(&post))
+	assert.Equal(t, "<b>текст</b>", post.Content)
+	assert.Equal(t, &categoryID, post.CategoryID)
+	require.NotNil(t, post.Deletion)
+	assert.Equal(t, "спам", post.Deletion.Reason)
+	assert.Equal(t, 3, r.Line())
+
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestNewReader_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{name: "пустой архив", src: "", want: "пустой архив"},
+		{name: "не JSON", src: "forum\n", want: "заголовка"},
+		{name: "версия новее", src: `{"version":99,"source":"x"}` + "\n", want: "версия архива 99"},
+		{name: "без версии", src: `{"source":"x"}` + "\n", want: "версия архива 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := archive.NewReader(strings.NewReader(tt.src))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestReader_BadRecord(t *testing.T) {
+	src := `{"version":1,"source":"x"}` + "\n\n" + `{"kind":"user","data":{"id":"один"}}` + "\n"
+	r, _, err := archive.NewReader(strings.NewReader(src))
+	require.NoError(t, err)
+
+	kind, err := r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, archive.KindUser, kind)
+
+	var user archive.User
+	err = r.Decode(&user)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "строка 3")
+}
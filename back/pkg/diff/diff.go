@@ -0,0 +1,108 @@
+// Package diff строит построчную разницу между двумя версиями текста
+package diff
+
+import "strings"
+
+// Op — тип изменения строки
+type Op int
+
+const (
+	Equal  Op = iota // строка есть в обеих версиях
+	Insert           // строка добавлена в новой версии
+	Delete           // строка удалена из старой версии
+)
+
+// Line — строка разницы с типом изменения
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// maxCells ограничивает размер таблицы LCS. Для очень длинных текстов
+// разница вырождается в «удалить всё старое, вставить всё новое».
+const maxCells = 4_000_000
+
+// Lines возвращает построчную разницу между old и new на основе
+// наибольшей общей подпоследовательности строк.
+func Lines(old, new string) []Line {
+	a, b := split(old), split(new)
+
+	// Общие начало и конец не участвуют в таблице LCS
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	result := make([]Line, 0, len(a)+len(b))
+	for _, text := range a[:prefix] {
+		result = append(result, Line{Op: Equal, Text: text})
+	}
+	result = append(result, middle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, text := range a[len(a)-suffix:] {
+		result = append(result, Line{Op: Equal, Text: text})
+	}
+	return result
+}
+
+func split(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+func middle(a, b []string) []Line {
+	var result []Line
+	if (len(a)+1)*(len(b)+1) > maxCells {
+		for _, text := range a {
+			result = append(result, Line{Op: Delete, Text: text})
+		}
+		for _, text := range b {
+			result = append(result, Line{Op: Insert, Text: text})
+		}
+		return result
+	}
+
+	// lcs[i][j] — длина LCS для a[i:] и b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			result = append(result, Line{Op: Equal, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, Line{Op: Delete, Text: a[i]})
+			i++
+		default:
+			result = append(result, Line{Op: Insert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		result = append(result, Line{Op: Delete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		result = append(result, Line{Op: Insert, Text: b[j]})
+	}
+	return result
+}
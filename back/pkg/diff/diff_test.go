@@ -0,0 +1,50 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/netabakovv/forum/back/pkg/diff"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     []diff.Line
+	}{
+		{
+			name: "без изменений",
+			old:  "a\nb",
+			new:  "a\nb",
+			want: []diff.Line{{diff.Equal, "a"}, {diff.Equal, "b"}},
+		},
+		{
+			name: "замена строки в середине",
+			old:  "a\nb\nc",
+			new:  "a\nx\nc",
+			want: []diff.Line{{diff.Equal, "a"}, {diff.Delete, "b"}, {diff.Insert, "x"}, {diff.Equal, "c"}},
+		},
+		{
+			name: "добавление и удаление",
+			old:  "a\nb\nc\nd",
+			new:  "b\nc\ne\nd",
+			want: []diff.Line{
+				{diff.Delete, "a"}, {diff.Equal, "b"}, {diff.Equal, "c"}, {diff.Insert, "e"}, {diff.Equal, "d"},
+			},
+		},
+		{
+			name: "из пустого текста",
+			old:  "",
+			new:  "a",
+			want: []diff.Line{{diff.Insert, "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diff.Lines(tt.old, tt.new))
+		})
+	}
+}
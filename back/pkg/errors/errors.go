@@ -5,8 +5,10 @@ import "errors"
 var (
 	ErrEmptyTargetID     = errors.New("targetID не может быть пустым")
 	ErrInvalidVote       = errors.New("некорректные данные для голосования")
+	ErrSelfVote          = errors.New("нельзя голосовать за собственный контент")
 	ErrEmptyMessage      = errors.New("пустое сообщение")
 	ErrMessageTooLong    = errors.New("сообщение слишком длинное")
+	ErrMessageNotFound   = errors.New("сообщение не найдено")
 	ErrEmptyComment      = errors.New("пустой комментарий")
 	ErrCleanupOldMessage = errors.New("ошибка очистки старых сообщений")
 	ErrRegister          = errors.New("ошибка регистрации")
@@ -18,6 +20,10 @@ var (
 	ErrTokenNotFound     = errors.New("токен обновления не найден")
 	ErrTokenExpired      = errors.New("срок действия токена истек")
 	ErrCommentNotFound   = errors.New("комментарий не найден")
+	ErrPostNotFound      = errors.New("пост не найден")
+	ErrCategoryNotFound  = errors.New("категория не найдена")
+	ErrDuplicateSlug     = errors.New("категория с таким slug уже существует")
+	ErrRevisionNotFound  = errors.New("версия не найдена")
 
 	// Ошибки аутентификации
 	ErrInvalidCredentials = errors.New("неверные учетные данные")
@@ -30,12 +36,72 @@ var (
 	ErrTokenRevoked     = errors.New("токен был отозван")
 
 	// Ошибки валидации
-	ErrUsernameTooShort = errors.New("слишком маленькое имя")
-	ErrUsernameTooLong  = errors.New("слишком длинное имя")
-	ErrEmptyUsername    = errors.New("имя пользователя не может быть пустым")
-	ErrEmptyPassword    = errors.New("пароль не может быть пустым")
-	ErrInvalidUsername  = errors.New("некорректный формат имени пользователя")
-	ErrWeakPassword     = errors.New("слишком слабый пароль")
+	ErrUsernameTooShort  = errors.New("слишком маленькое имя")
+	ErrUsernameTooLong   = errors.New("слишком длинное имя")
+	ErrEmptyUsername     = errors.New("имя пользователя не может быть пустым")
+	ErrEmptyPassword     = errors.New("пароль не может быть пустым")
+	ErrInvalidUsername   = errors.New("некорректный формат имени пользователя")
+	ErrWeakPassword      = errors.New("слишком слабый пароль")
+	ErrInvalidCursor     = errors.New("некорректный курсор пагинации")
+	ErrInvalidSort       = errors.New("неизвестный режим сортировки")
+	ErrEmptySearchQuery  = errors.New("пустой поисковый запрос")
+	ErrSearchTooDeep     = errors.New("слишком глубокая страница поиска")
+	ErrInvalidSlug       = errors.New("slug может содержать только латиницу, цифры и дефис")
+	ErrEmptyTitle        = errors.New("название не может быть пустым")
+	ErrInvalidTag        = errors.New("некорректный тег")
+	ErrTooManyTags       = errors.New("слишком много тегов")
+	ErrParentNotFound    = errors.New("комментарий, на который отвечают, не найден")
+	ErrReplyPostMismatch = errors.New("ответ должен относиться к тому же посту, что и комментарий")
+	ErrReplyToDeleted    = errors.New("нельзя ответить на удалённый комментарий")
+	ErrCommentTooDeep    = errors.New("превышена максимальная вложенность комментариев")
+	ErrReasonTooLong     = errors.New("слишком длинная причина удаления")
+	ErrPostLocked        = errors.New("пост закрыт для комментариев")
+
+	// Ошибки публикации
+	ErrInvalidPostStatus    = errors.New("некорректный статус поста")
+	ErrInvalidPublishTime   = errors.New("время публикации должно быть в будущем")
+	ErrPostAlreadyPublished = errors.New("пост уже опубликован")
+	ErrPostHeld             = errors.New("пост ждёт проверки модератором")
+	ErrEditHeld             = errors.New("правка ждёт проверки модератором")
+
+	// Ошибки вложений
+	ErrAttachmentNotFound  = errors.New("вложение не найдено")
+	ErrEmptyFile           = errors.New("пустой файл")
+	ErrFileTooLarge        = errors.New("файл слишком большой")
+	ErrUnsupportedFileType = errors.New("недопустимый тип файла")
+	ErrInvalidImage        = errors.New("не удалось обработать изображение")
+	ErrQuotaExceeded       = errors.New("превышен лимит на общий размер вложений")
+
+	// Ошибки закладок
+	ErrBookmarkNotFound = errors.New("закладка не найдена")
+	ErrNoteTooLong      = errors.New("слишком длинная заметка")
+
+	// Ошибки опросов
+	ErrInvalidPoll       = errors.New("в опросе должно быть от 2 до 10 разных непустых вариантов")
+	ErrPollOptionTooLong = errors.New("слишком длинный вариант ответа")
+	ErrInvalidPollClose  = errors.New("время закрытия опроса должно быть позже публикации")
+	ErrPollNotFound      = errors.New("опрос не найден")
+	ErrPollClosed        = errors.New("опрос закрыт")
+	ErrPollAlreadyVoted  = errors.New("вы уже проголосовали в этом опросе")
+	ErrInvalidPollChoice = errors.New("некорректный выбор в опросе")
+
+	// Ошибки жалоб
+	ErrInvalidReportTarget  = errors.New("некорректная цель жалобы")
+	ErrInvalidReportReason  = errors.New("некорректная причина жалобы")
+	ErrReportDetailsMissing = errors.New("опишите причину жалобы")
+	ErrReportDetailsTooLong = errors.New("слишком длинное описание жалобы")
+	ErrAlreadyReported      = errors.New("вы уже пожаловались на этот контент")
+	ErrReportNotFound       = errors.New("жалоба не найдена")
+	ErrReportResolved       = errors.New("жалоба уже рассмотрена")
+	ErrInvalidReportStatus  = errors.New("некорректный статус жалобы")
+
+	// Ошибки фильтров
+	ErrContentRejected = errors.New("текст отклонён фильтром")
+	ErrHeldNotFound    = errors.New("текст на модерации не найден")
+
+	// Ошибки упоминаний
+	ErrTooManyMentions  = errors.New("слишком много упоминаний")
+	ErrTooManyUsernames = errors.New("слишком много имён в одном запросе")
 
 	// Ошибки базы данных
 	ErrDB                = errors.New("ошибка бд")
@@ -0,0 +1,228 @@
+// Package feed собирает ленты RSS 2.0 и Atom из записей форума
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	nethtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// DefaultSummaryLength — длина краткого содержания записи в символах
+const DefaultSummaryLength = 280
+
+// Feed — лента записей. Порядок записей сохраняется как есть.
+type Feed struct {
+	Title       string // название ленты
+	Description string // описание, в RSS обязательно
+	Link        string // адрес страницы, которую описывает лента
+	SelfLink    string // адрес самой ленты
+	Items       []Item
+}
+
+// Item — запись ленты. Link служит и постоянным идентификатором записи
+// (guid в RSS, id в Atom), поэтому не должен меняться после публикации.
+type Item struct {
+	Title     string    // заголовок
+	Link      string    // постоянный адрес записи
+	Author    string    // имя автора
+	Summary   string    // краткое содержание простым текстом
+	Published time.Time // время публикации
+	Updated   time.Time // время последней правки, нулевое, если запись не правили
+}
+
+// modified — время последнего изменения записи
+func (i Item) modified() time.Time {
+	if i.Updated.After(i.Published) {
+		return i.Updated
+	}
+	return i.Published
+}
+
+// Updated — время последнего изменения среди записей, нулевое для пустой ленты
+func (f Feed) Updated() time.Time {
+	var latest time.Time
+	for _, item := range f.Items {
+		if m := item.modified(); m.After(latest) {
+			latest = m
+		}
+	}
+	return latest
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Self          atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	Creator     string  `xml:"dc:creator,omitempty"`
+	PubDate     string  `xml:"pubDate"`
+	Description string  `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+// RSS возвращает ленту в формате RSS 2.0. Даты — в RFC 1123, правки записей
+// в RSS не выражаются, время изменения ленты уходит в lastBuildDate.
+func RSS(f Feed) ([]byte, error) {
+	doc := rss{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		DC:      "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       f.Title,
+			Link:        f.Link,
+			Description: f.Description,
+			Self:        atomLink{Href: f.SelfLink, Rel: "self", Type: "application/rss+xml"},
+		},
+	}
+	if updated := f.Updated(); !updated.IsZero() {
+		doc.Channel.LastBuildDate = updated.UTC().Format(time.RFC1123Z)
+	}
+	for _, item := range f.Items {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{IsPermaLink: true, Value: item.Link},
+			Creator:     item.Author,
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+			Description: item.Summary,
+		})
+	}
+	return marshal(doc)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	Title     string      `xml:"title"`
+	ID        string      `xml:"id"`
+	Link      atomLink    `xml:"link"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Author    *atomAuthor `xml:"author,omitempty"`
+	Summary   string      `xml:"summary"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// Atom возвращает ленту в формате Atom. updated записи — время последней правки,
+// а если правок не было, время публикации.
+func Atom(f Feed) ([]byte, error) {
+	updated := f.Updated()
+	if updated.IsZero() {
+		// updated обязателен, а у пустой ленты изменений нет
+		updated = time.Unix(0, 0)
+	}
+	doc := atomFeed{
+		Title:   f.Title,
+		ID:      f.SelfLink,
+		Updated: updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.Link},
+			{Href: f.SelfLink, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+	for _, item := range f.Items {
+		entry := atomEntry{
+			Title:     item.Title,
+			ID:        item.Link,
+			Link:      atomLink{Href: item.Link},
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Updated:   item.modified().UTC().Format(time.RFC3339),
+			Summary:   item.Summary,
+		}
+		if item.Author != "" {
+			entry.Author = &atomAuthor{Name: item.Author}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+	return marshal(doc)
+}
+
+func marshal(doc any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// blockTags — теги, границы которых в простом тексте становятся пробелом
+var blockTags = map[atom.Atom]bool{
+	atom.P: true, atom.Br: true, atom.Div: true, atom.Pre: true, atom.Blockquote: true,
+	atom.Ul: true, atom.Ol: true, atom.Li: true, atom.H1: true, atom.H2: true,
+	atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+}
+
+// Summary превращает HTML записи в простой текст и обрезает его до limit символов
+// по границе слова, добавляя многоточие
+func Summary(src string, limit int) string {
+	var text strings.Builder
+	z := nethtml.NewTokenizer(strings.NewReader(src))
+	for tt := z.Next(); tt != nethtml.ErrorToken; tt = z.Next() {
+		switch tt {
+		case nethtml.TextToken:
+			text.Write(z.Text())
+		case nethtml.StartTagToken, nethtml.EndTagToken, nethtml.SelfClosingTagToken:
+			// блоки и переводы строк не должны склеивать соседние слова,
+			// а выделение внутри слова — разрывать его
+			name, _ := z.TagName()
+			if blockTags[atom.Lookup(name)] {
+				text.WriteByte(' ')
+			}
+		}
+	}
+
+	s := strings.Join(strings.Fields(text.String()), " ")
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+	runes := []rune(s)[:limit]
+	if i := strings.LastIndexByte(string(runes), ' '); i > 0 {
+		return string(runes)[:i] + "…"
+	}
+	return string(runes) + "…"
+}
@@ -0,0 +1,132 @@
+package feed_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/netabakovv/forum/back/pkg/feed"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	published = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	edited    = time.Date(2024, 3, 2, 12, 30, 0, 0, time.UTC)
+)
+
+func testFeed() feed.Feed {
+	return feed.Feed{
+		Title:       "Форум",
+		Description: "Новые посты",
+		Link:        "https://forum.example/posts",
+		SelfLink:    "https://forum.example/feed.rss",
+		Items: []feed.Item{
+			{
+				Title:     "Правленый пост",
+				Link:      "https://forum.example/posts/2",
+				Author:    "alice",
+				Summary:   "текст & <разметка>",
+				Published: published,
+				Updated:   edited,
+			},
+			{
+				Title:     "Старый пост",
+				Link:      "https://forum.example/posts/1",
+				Published: published.Add(-time.Hour),
+			},
+		},
+	}
+}
+
+func TestFeed_Updated(t *testing.T) {
+	assert.Equal(t, edited, testFeed().Updated())
+	assert.True(t, feed.Feed{}.Updated().IsZero())
+}
+
+func TestRSS(t *testing.T) {
+	body, err := feed.RSS(testFeed())
+	require.NoError(t, err)
+
+	var doc struct {
+		Channel struct {
+			Title         string `xml:"title"`
+			LastBuildDate string `xml:"lastBuildDate"`
+			Items         []struct {
+				GUID struct {
+					IsPermaLink string `xml:"isPermaLink,attr"`
+					Value       string `xml:",chardata"`
+				} `xml:"guid"`
+				Creator     string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+				PubDate     string `xml:"pubDate"`
+				Description string `xml:"description"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc))
+
+	assert.Equal(t, "Форум", doc.Channel.Title)
+	assert.Equal(t, "Sat, 02 Mar 2024 12:30:00 +0000", doc.Channel.LastBuildDate)
+	require.Len(t, doc.Channel.Items, 2)
+	assert.Equal(t, "true", doc.Channel.Items[0].GUID.IsPermaLink)
+	assert.Equal(t, "https://forum.example/posts/2", doc.Channel.Items[0].GUID.Value)
+	assert.Equal(t, "alice", doc.Channel.Items[0].Creator)
+	assert.Equal(t, "Fri, 01 Mar 2024 10:00:00 +0000", doc.Channel.Items[0].PubDate)
+	assert.Equal(t, "текст & <разметка>", doc.Channel.Items[0].Description)
+	assert.Contains(t, string(body), `<atom:link href="https://forum.example/feed.rss" rel="self" type="application/rss+xml">`)
+}
+
+func TestAtom(t *testing.T) {
+	body, err := feed.Atom(testFeed())
+	require.NoError(t, err)
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			ID        string `xml:"id"`
+			Published string `xml:"published"`
+			Updated   string `xml:"updated"`
+			Author    *struct {
+				Name string `xml:"name"`
+			} `xml:"author"`
+		} `xml:"entry"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc))
+
+	assert.Equal(t, "2024-03-02T12:30:00Z", doc.Updated)
+	require.Len(t, doc.Entries, 2)
+	assert.Equal(t, "https://forum.example/posts/2", doc.Entries[0].ID)
+	assert.Equal(t, "2024-03-01T10:00:00Z", doc.Entries[0].Published)
+	assert.Equal(t, "2024-03-02T12:30:00Z", doc.Entries[0].Updated)
+	require.NotNil(t, doc.Entries[0].Author)
+	assert.Equal(t, "alice", doc.Entries[0].Author.Name)
+
+	// без правок updated записи совпадает с публикацией, автор необязателен
+	assert.Equal(t, "2024-03-01T09:00:00Z", doc.Entries[1].Updated)
+	assert.Nil(t, doc.Entries[1].Author)
+}
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		name  string
+		src   string
+		limit int
+		want  string
+	}{
+		{name: "теги и сущности", src: "<p>Привет, <strong>мир</strong> &amp; всем</p>", limit: 100, want: "Привет, мир & всем"},
+		{name: "блоки разделяются пробелом", src: "<p>первый</p><p>второй</p><ul><li>пункт</li></ul>", limit: 100, want: "первый второй пункт"},
+		{name: "выделение внутри слова", src: "<p>пре<em>фикс</em></p>", limit: 100, want: "префикс"},
+		{name: "обрезка по слову", src: "<p>один два три четыре</p>", limit: 12, want: "один два…"},
+		{name: "длинное слово", src: strings.Repeat("я", 10), limit: 4, want: "яяяя…"},
+		{name: "пусто", src: "", limit: 10, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, feed.Summary(tt.src, tt.limit))
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Package imaging очищает загруженные изображения от метаданных и строит превью
+// средствами стандартной библиотеки
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+)
+
+// MaxPixels ограничивает размер декодируемого изображения, чтобы маленький файл
+// не развернулся в гигабайты памяти
+const MaxPixels = 40_000_000
+
+const jpegQuality = 90
+
+var (
+	ErrUnsupportedFormat = errors.New("неподдерживаемый формат изображения")
+	ErrTooManyPixels     = errors.New("слишком большое разрешение изображения")
+)
+
+// Result — очищенное изображение и его превью
+type Result struct {
+	Data      []byte // изображение без метаданных
+	Thumbnail []byte // превью в том же формате
+	Width     int
+	Height    int
+}
+
+// Supported сообщает, умеет ли пакет обрабатывать изображения этого типа
+func Supported(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		return true
+	}
+	return false
+}
+
+// Process перекодирует изображение, отбрасывая EXIF и прочие метаданные,
+// и строит превью, вписанное в квадрат thumbSize×thumbSize.
+// GIF сохраняется как есть, чтобы не потерять анимацию: EXIF в нём не бывает.
+func Process(data []byte, contentType string, thumbSize int) (*Result, error) {
+	if !Supported(contentType) {
+		return nil, ErrUnsupportedFormat
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Data: data, Width: cfg.Width, Height: cfg.Height}
+	if contentType != "image/gif" {
+		if result.Data, err = encode(img, contentType); err != nil {
+			return nil, err
+		}
+	}
+	if result.Thumbnail, err = encode(Thumbnail(img, thumbSize), contentType); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func encode(img image.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch contentType {
+	case "image/jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	case "image/png":
+		err = png.Encode(&buf, img)
+	case "image/gif":
+		err = gif.Encode(&buf, img, nil)
+	default:
+		err = ErrUnsupportedFormat
+	}
+	return buf.Bytes(), err
+}
+
+// Thumbnail уменьшает изображение с сохранением пропорций так, чтобы большая
+// сторона не превышала size. Каждый пиксель превью — среднее по своей области
+// исходника. Изображения меньше size не увеличиваются.
+func Thumbnail(src image.Image, size int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if size <= 0 || (w <= size && h <= size) {
+		return src
+	}
+
+	tw, th := size, h*size/w
+	if h > w {
+		tw, th = w*size/h, size
+	}
+	tw, th = max(tw, 1), max(th, 1)
+
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0, y1 := b.Min.Y+y*h/th, b.Min.Y+(y+1)*h/th
+		for x := 0; x < tw; x++ {
+			x0, x1 := b.Min.X+x*w/tw, b.Min.X+(x+1)*w/tw
+			dst.Set(x, y, average(src, x0, y0, max(x1, x0+1), max(y1, y0+1)))
+		}
+	}
+	return dst
+}
+
+// average — средний цвет прямоугольника [x0,x1)×[y0,y1)
+func average(src image.Image, x0, y0, x1, y1 int) color.Color {
+	var r, g, b, a, n uint64
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			cr, cg, cb, ca := src.At(x, y).RGBA()
+			r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+			n++
+		}
+	}
+	return color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)}
+}
@@ -0,0 +1,90 @@
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/netabakovv/forum/back/pkg/imaging"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func solid(w, h int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+// withExif вставляет APP1-сегмент с EXIF сразу после маркера SOI
+func withExif(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	data := buf.Bytes()
+	payload := append([]byte("Exif\x00\x00"), []byte("GPS 55.75N 37.62E")...)
+	segment := []byte{0xFF, 0xE1, byte((len(payload) + 2) >> 8), byte(len(payload) + 2)}
+	segment = append(segment, payload...)
+	return append(append([]byte{0xFF, 0xD8}, segment...), data[2:]...)
+}
+
+func TestProcess_StripsExif(t *testing.T) {
+	data := withExif(t, solid(400, 200, color.RGBA{R: 200, A: 255}))
+	require.True(t, bytes.Contains(data, []byte("Exif")))
+
+	res, err := imaging.Process(data, "image/jpeg", 100)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(res.Data, []byte("Exif")))
+	assert.Equal(t, 400, res.Width)
+	assert.Equal(t, 200, res.Height)
+
+	thumb, err := jpeg.Decode(bytes.NewReader(res.Thumbnail))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), thumb.Bounds())
+}
+
+func TestProcess_Unsupported(t *testing.T) {
+	_, err := imaging.Process([]byte("%PDF-1.4"), "application/pdf", 100)
+	assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+
+	_, err = imaging.Process([]byte("not an image"), "image/png", 100)
+	assert.Error(t, err)
+}
+
+func TestThumbnail(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 4, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 4; x++ {
+			if x < 2 {
+				src.Set(x, y, color.White)
+			} else {
+				src.Set(x, y, color.Black)
+			}
+		}
+	}
+
+	thumb := imaging.Thumbnail(src, 4)
+	assert.Equal(t, image.Rect(0, 0, 2, 4), thumb.Bounds())
+	r, _, _, _ := thumb.At(0, 0).RGBA()
+	assert.Equal(t, uint32(0xFFFF), r)
+	r, _, _, _ = thumb.At(1, 3).RGBA()
+	assert.Zero(t, r)
+
+	small := solid(3, 3, color.Black)
+	assert.Same(t, small, imaging.Thumbnail(small, 10), "маленькие изображения не увеличиваются")
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, solid(10, 10, color.Black)))
+	res, err := imaging.Process(buf.Bytes(), "image/png", 4)
+	require.NoError(t, err)
+	cfg, err := png.DecodeConfig(bytes.NewReader(res.Thumbnail))
+	require.NoError(t, err)
+	assert.Equal(t, 4, cfg.Width)
+}
@@ -0,0 +1,84 @@
+// Package markdown переводит Markdown постов и комментариев в безопасный HTML
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+)
+
+// Config — разрешённые в итоговом HTML теги и атрибуты по тегам
+type Config struct {
+	AllowedTags       []string
+	AllowedAttributes map[string][]string
+}
+
+// DefaultConfig — ограниченный диалект: абзацы, выделение, код, ссылки, списки и цитаты
+func DefaultConfig() Config {
+	return Config{
+		AllowedTags: []string{
+			"p", "br", "em", "strong", "code", "pre",
+			"a", "ul", "ol", "li", "blockquote",
+		},
+		AllowedAttributes: map[string][]string{
+			"a":    {"href", "title"},
+			"code": {"class"},
+		},
+	}
+}
+
+// codeLanguage — класс, который goldmark ставит блоку кода с указанным языком
+var codeLanguage = regexp.MustCompile(`^language-[\w+-]+$`)
+
+// Renderer рендерит Markdown и вычищает из результата всё, чего нет в Config.
+// Безопасен для одновременного использования.
+type Renderer struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+}
+
+// New создаёт рендерер; пустой список тегов означает DefaultConfig
+func New(cfg Config) *Renderer {
+	if len(cfg.AllowedTags) == 0 {
+		cfg = DefaultConfig()
+	}
+
+	policy := bluemonday.NewPolicy()
+	policy.AllowElements(cfg.AllowedTags...)
+	for tag, attrs := range cfg.AllowedAttributes {
+		for _, attr := range attrs {
+			attr = strings.ToLower(attr)
+			switch {
+			case tag == "code" && attr == "class":
+				policy.AllowAttrs(attr).Matching(codeLanguage).OnElements(tag)
+			default:
+				policy.AllowAttrs(attr).OnElements(tag)
+			}
+		}
+	}
+	policy.AllowURLSchemes("http", "https", "mailto")
+	policy.RequireParseableURLs(true)
+	policy.RequireNoFollowOnLinks(true)
+	policy.AddTargetBlankToFullyQualifiedLinks(true)
+
+	// Сырой HTML в исходнике goldmark не пропускает: без WithUnsafe он заменяется комментарием,
+	// который затем вычищает bluemonday
+	return &Renderer{md: goldmark.New(), policy: policy}
+}
+
+// Render возвращает безопасный HTML для текста src
+func (r *Renderer) Render(src string) string {
+	if src == "" {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(src), &buf); err != nil {
+		// Рендер в память не падает, но на всякий случай отдаём экранированный текст
+		return "<p>" + html.EscapeString(src) + "</p>"
+	}
+	return strings.TrimSpace(r.policy.Sanitize(buf.String()))
+}
@@ -0,0 +1,72 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/netabakovv/forum/back/pkg/markdown"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	r := markdown.New(markdown.DefaultConfig())
+
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "выделение и абзац",
+			src:  "**жирный** и *курсив*",
+			want: "<p><strong>жирный</strong> и <em>курсив</em></p>",
+		},
+		{
+			name: "блок кода с языком",
+			src:  "```go\nfmt.Println(\"<b>\")\n```",
+			want: "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;b&gt;&#34;)\n</code></pre>",
+		},
+		{
+			name: "список и цитата",
+			src:  "- раз\n- два\n\n> цитата",
+			want: "<ul>\n<li>раз</li>\n<li>два</li>\n</ul>\n<blockquote>\n<p>цитата</p>\n</blockquote>",
+		},
+		{
+			name: "ссылка получает nofollow",
+			src:  "[сайт](https://example.com)",
+			want: "<p><a href=\"https://example.com\" rel=\"nofollow noopener\" target=\"_blank\">сайт</a></p>",
+		},
+		{
+			name: "javascript-ссылка вычищается",
+			src:  "[клик](javascript:alert(1))",
+			want: "<p>клик</p>",
+		},
+		{
+			name: "сырой HTML не проходит",
+			src:  "<script>alert(1)</script>\n\n<img src=x onerror=alert(1)>",
+			want: "",
+		},
+		{
+			name: "заголовок вне списка тегов остаётся текстом",
+			src:  "# Заголовок",
+			want: "Заголовок",
+		},
+		{
+			name: "пустой текст",
+			src:  "",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, r.Render(tt.src))
+		})
+	}
+}
+
+func TestRender_CustomConfig(t *testing.T) {
+	r := markdown.New(markdown.Config{AllowedTags: []string{"p"}})
+
+	assert.Equal(t, "<p>код и ссылка</p>", r.Render("`код` и [ссылка](https://example.com)"))
+}
@@ -0,0 +1,140 @@
+// Package mention находит упоминания пользователей вида @username и превращает
+// их в ссылки на профили
+package mention
+
+import (
+	"bytes"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	nethtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// MaxUsernameLength — максимальная длина имени пользователя в символах, как в таблице users
+const MaxUsernameLength = 50
+
+// candidate — @ и следующие за ним символы, допустимые в имени. Граница слева
+// проверяется отдельно: в Go нет просмотра назад.
+var candidate = regexp.MustCompile(`@([\p{L}\p{N}_][\p{L}\p{N}_.-]*)`)
+
+// match — упоминание в тексте: text[start:end] целиком, включая @
+type match struct {
+	start, end int
+	username   string
+}
+
+// find возвращает упоминания в тексте по порядку. Адреса почты вроде bob@example.com
+// и точка или дефис в конце предложения («@alice.») упоминаниями не считаются.
+func find(text string) []match {
+	var matches []match
+	for _, loc := range candidate.FindAllStringSubmatchIndex(text, -1) {
+		if loc[0] > 0 {
+			prev, _ := utf8.DecodeLastRuneInString(text[:loc[0]])
+			if prev == '@' || prev == '.' || prev == '-' || prev == '_' || unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+				continue
+			}
+		}
+		username := strings.TrimRight(text[loc[2]:loc[3]], ".-")
+		if utf8.RuneCountInString(username) > MaxUsernameLength {
+			continue
+		}
+		matches = append(matches, match{start: loc[0], end: loc[2] + len(username), username: username})
+	}
+	return matches
+}
+
+// Parse возвращает имена, упомянутые в простом тексте, без повторов, в порядке появления
+func Parse(text string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, m := range find(text) {
+		if !seen[m.username] {
+			seen[m.username] = true
+			usernames = append(usernames, m.username)
+		}
+	}
+	return usernames
+}
+
+// ParseHTML возвращает имена, упомянутые в HTML, без повторов, в порядке появления.
+// Текст внутри ссылок и кода не просматривается.
+func ParseHTML(src string) []string {
+	var text strings.Builder
+	rewriteText(src, func(s string) (string, bool) {
+		text.WriteString(s)
+		text.WriteByte(' ')
+		return "", false
+	})
+	return Parse(text.String())
+}
+
+// Link заменяет в HTML упоминания пользователей из users ссылками href(id).
+// Упоминания неизвестных имён остаются обычным текстом, текст внутри ссылок и кода не меняется.
+func Link(src string, users map[string]int64, href func(id int64) string) string {
+	if len(users) == 0 {
+		return src
+	}
+	return rewriteText(src, func(text string) (string, bool) {
+		var out strings.Builder
+		last, linked := 0, false
+		for _, m := range find(text) {
+			id, ok := users[m.username]
+			if !ok {
+				continue
+			}
+			out.WriteString(html.EscapeString(text[last:m.start]))
+			out.WriteString(`<a href="` + html.EscapeString(href(id)) + `" class="mention">@` + html.EscapeString(m.username) + `</a>`)
+			last, linked = m.end, true
+		}
+		if !linked {
+			return "", false
+		}
+		out.WriteString(html.EscapeString(text[last:]))
+		return out.String(), true
+	})
+}
+
+// rewriteText проходит по текстовым узлам HTML вне ссылок и кода и подставляет
+// вместо узла HTML, который вернула fn; если fn вернула false, узел остаётся как был.
+// Остальная разметка переносится в результат байт в байт.
+func rewriteText(src string, fn func(text string) (string, bool)) string {
+	var out bytes.Buffer
+	z := nethtml.NewTokenizer(strings.NewReader(src))
+	skip := 0 // глубина вложенности в <a>, <code> и <pre>
+	for {
+		tt := z.Next()
+		if tt == nethtml.ErrorToken {
+			if z.Err() != io.EOF {
+				// Токенизатор не падает на кривом HTML, но на всякий случай не теряем текст
+				return src
+			}
+			return out.String()
+		}
+		raw := z.Raw()
+		switch tt {
+		case nethtml.StartTagToken, nethtml.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.A, atom.Code, atom.Pre:
+				if tt == nethtml.StartTagToken {
+					skip++
+				} else if skip > 0 {
+					skip--
+				}
+			}
+		case nethtml.TextToken:
+			if skip == 0 {
+				if replaced, ok := fn(html.UnescapeString(string(raw))); ok {
+					out.WriteString(replaced)
+					continue
+				}
+			}
+		}
+		out.Write(raw)
+	}
+}
@@ -0,0 +1,82 @@
+package mention_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/netabakovv/forum/back/pkg/mention"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "без упоминаний", text: "просто текст", want: nil},
+		{name: "повторы и порядок", text: "@bob, @alice и снова @bob", want: []string{"bob", "alice"}},
+		{name: "кириллица", text: "привет, @Вася!", want: []string{"Вася"}},
+		{name: "точка в конце предложения", text: "спроси @alice.", want: []string{"alice"}},
+		{name: "точка внутри имени", text: "@john.smith ответил", want: []string{"john.smith"}},
+		{name: "адрес почты", text: "пиши на bob@example.com", want: nil},
+		{name: "двойная собака", text: "@@bob", want: nil},
+		{name: "слишком длинное имя", text: "@" + strings.Repeat("a", mention.MaxUsernameLength+1), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mention.Parse(tt.text))
+		})
+	}
+}
+
+func TestParseHTML_SkipsCodeAndLinks(t *testing.T) {
+	src := `<p>@alice смотри <code>@bob</code> и <a href="/x">@carol</a></p><pre><code>@dave</code></pre><p>@eve</p>`
+	assert.Equal(t, []string{"alice", "eve"}, mention.ParseHTML(src))
+}
+
+func TestLink(t *testing.T) {
+	users := map[string]int64{"alice": 1, "Вася": 2}
+	href := func(id int64) string { return "/users/" + strconv.FormatInt(id, 10) }
+
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "известные имена становятся ссылками",
+			src:  "<p>@alice и @Вася</p>",
+			want: `<p><a href="/users/1" class="mention">@alice</a> и <a href="/users/2" class="mention">@Вася</a></p>`,
+		},
+		{
+			name: "неизвестное имя остаётся текстом",
+			src:  "<p>@alice и @mallory</p>",
+			want: `<p><a href="/users/1" class="mention">@alice</a> и @mallory</p>`,
+		},
+		{
+			name: "код и ссылки не трогаются",
+			src:  `<p><code>@alice</code> <a href="/a">@alice</a></p>`,
+			want: `<p><code>@alice</code> <a href="/a">@alice</a></p>`,
+		},
+		{
+			name: "экранированный текст остаётся экранированным",
+			src:  "<p>&lt;b&gt; @alice &amp; всё</p>",
+			want: `<p>&lt;b&gt; <a href="/users/1" class="mention">@alice</a> &amp; всё</p>`,
+		},
+		{
+			name: "без упоминаний HTML не меняется",
+			src:  "<p>a &#34;b&#34;</p>",
+			want: "<p>a &#34;b&#34;</p>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mention.Link(tt.src, users, href))
+		})
+	}
+}
@@ -21,87 +21,6620 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// ================== Error Handling ==================
-type ErrorCode int32
+type ImportUserResult int32
 
 const (
-	ErrorCode_ERROR_UNSPECIFIED         ErrorCode = 0
-	ErrorCode_ERROR_INVALID_CREDENTIALS ErrorCode = 1
-	ErrorCode_ERROR_USER_NOT_FOUND      ErrorCode = 2
-	ErrorCode_ERROR_USER_ALREADY_EXISTS ErrorCode = 3
-	ErrorCode_ERROR_TOKEN_EXPIRED       ErrorCode = 4
-	ErrorCode_ERROR_PERMISSION_DENIED   ErrorCode = 5
+	ImportUserResult_IMPORT_USER_RESULT_UNSPECIFIED ImportUserResult = 0
+	ImportUserResult_IMPORT_USER_CREATED            ImportUserResult = 1 // создан новый пользователь
+	ImportUserResult_IMPORT_USER_EXISTS             ImportUserResult = 2 // уже загружен раньше: то же имя и дата регистрации
+	ImportUserResult_IMPORT_USER_MERGED             ImportUserResult = 3 // имя занято другим пользователем, возвращён он (merge_existing)
 )
 
-// Enum value maps for ErrorCode.
+// Enum value maps for ImportUserResult.
 var (
-	ErrorCode_name = map[int32]string{
-		0: "ERROR_UNSPECIFIED",
-		1: "ERROR_INVALID_CREDENTIALS",
-		2: "ERROR_USER_NOT_FOUND",
-		3: "ERROR_USER_ALREADY_EXISTS",
-		4: "ERROR_TOKEN_EXPIRED",
-		5: "ERROR_PERMISSION_DENIED",
+	ImportUserResult_name = map[int32]string{
+		0: "IMPORT_USER_RESULT_UNSPECIFIED",
+		1: "IMPORT_USER_CREATED",
+		2: "IMPORT_USER_EXISTS",
+		3: "IMPORT_USER_MERGED",
 	}
-	ErrorCode_value = map[string]int32{
-		"ERROR_UNSPECIFIED":         0,
-		"ERROR_INVALID_CREDENTIALS": 1,
-		"ERROR_USER_NOT_FOUND":      2,
-		"ERROR_USER_ALREADY_EXISTS": 3,
-		"ERROR_TOKEN_EXPIRED":       4,
-		"ERROR_PERMISSION_DENIED":   5,
+	ImportUserResult_value = map[string]int32{
+		"IMPORT_USER_RESULT_UNSPECIFIED": 0,
+		"IMPORT_USER_CREATED":            1,
+		"IMPORT_USER_EXISTS":             2,
+		"IMPORT_USER_MERGED":             3,
 	}
 )
 
-func (x ErrorCode) Enum() *ErrorCode {
-	p := new(ErrorCode)
+func (x ImportUserResult) Enum() *ImportUserResult {
+	p := new(ImportUserResult)
 	*p = x
 	return p
 }
 
-func (x ErrorCode) String() string {
+func (x ImportUserResult) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+func (ImportUserResult) Descriptor() protoreflect.EnumDescriptor {
 	return file_proto_forum_proto_enumTypes[0].Descriptor()
 }
 
-func (ErrorCode) Type() protoreflect.EnumType {
+func (ImportUserResult) Type() protoreflect.EnumType {
 	return &file_proto_forum_proto_enumTypes[0]
 }
 
-func (x ErrorCode) Number() protoreflect.EnumNumber {
+func (x ImportUserResult) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use ErrorCode.Descriptor instead.
-func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use ImportUserResult.Descriptor instead.
+func (ImportUserResult) EnumDescriptor() ([]byte, []int) {
 	return file_proto_forum_proto_rawDescGZIP(), []int{0}
 }
 
-// Определяем собственное пустое сообщение
-type EmptyMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PostStatus int32
+
+const (
+	PostStatus_POST_STATUS_PUBLISHED PostStatus = 0
+	PostStatus_POST_STATUS_DRAFT     PostStatus = 1 // виден только автору
+	PostStatus_POST_STATUS_SCHEDULED PostStatus = 2 // будет опубликован в publish_at
+	PostStatus_POST_STATUS_HELD      PostStatus = 3 // задержан фильтром до решения модератора, виден только автору
+)
+
+// Enum value maps for PostStatus.
+var (
+	PostStatus_name = map[int32]string{
+		0: "POST_STATUS_PUBLISHED",
+		1: "POST_STATUS_DRAFT",
+		2: "POST_STATUS_SCHEDULED",
+		3: "POST_STATUS_HELD",
+	}
+	PostStatus_value = map[string]int32{
+		"POST_STATUS_PUBLISHED": 0,
+		"POST_STATUS_DRAFT":     1,
+		"POST_STATUS_SCHEDULED": 2,
+		"POST_STATUS_HELD":      3,
+	}
+)
+
+func (x PostStatus) Enum() *PostStatus {
+	p := new(PostStatus)
+	*p = x
+	return p
 }
 
-func (x *EmptyMessage) Reset() {
-	*x = EmptyMessage{}
-	mi := &file_proto_forum_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x PostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *EmptyMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (PostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[1].Descriptor()
 }
 
-func (*EmptyMessage) ProtoMessage() {}
+func (PostStatus) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[1]
+}
 
-func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[0]
+func (x PostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostStatus.Descriptor instead.
+func (PostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{1}
+}
+
+// Направление сортировки ленты постов по дате создания
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_DESC SortOrder = 0 // сначала новые
+	SortOrder_SORT_ORDER_ASC  SortOrder = 1 // сначала старые
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_DESC",
+		1: "SORT_ORDER_ASC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_DESC": 0,
+		"SORT_ORDER_ASC":  1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[2].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[2]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{2}
+}
+
+type PostSort int32
+
+const (
+	PostSort_POST_SORT_NEW    PostSort = 0 // по времени публикации
+	PostSort_POST_SORT_TOP    PostSort = 1 // по числу комментариев
+	PostSort_POST_SORT_ACTIVE PostSort = 2 // по времени последнего комментария
+	PostSort_POST_SORT_HOT    PostSort = 3 // по вовлечённости с поправкой на возраст
+	PostSort_POST_SORT_VIEWS  PostSort = 4 // по числу просмотров
+)
+
+// Enum value maps for PostSort.
+var (
+	PostSort_name = map[int32]string{
+		0: "POST_SORT_NEW",
+		1: "POST_SORT_TOP",
+		2: "POST_SORT_ACTIVE",
+		3: "POST_SORT_HOT",
+		4: "POST_SORT_VIEWS",
+	}
+	PostSort_value = map[string]int32{
+		"POST_SORT_NEW":    0,
+		"POST_SORT_TOP":    1,
+		"POST_SORT_ACTIVE": 2,
+		"POST_SORT_HOT":    3,
+		"POST_SORT_VIEWS":  4,
+	}
+)
+
+func (x PostSort) Enum() *PostSort {
+	p := new(PostSort)
+	*p = x
+	return p
+}
+
+func (x PostSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[3].Descriptor()
+}
+
+func (PostSort) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[3]
+}
+
+func (x PostSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostSort.Descriptor instead.
+func (PostSort) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{3}
+}
+
+type TimeWindow int32
+
+const (
+	TimeWindow_TIME_WINDOW_ALL   TimeWindow = 0
+	TimeWindow_TIME_WINDOW_DAY   TimeWindow = 1
+	TimeWindow_TIME_WINDOW_WEEK  TimeWindow = 2
+	TimeWindow_TIME_WINDOW_MONTH TimeWindow = 3
+)
+
+// Enum value maps for TimeWindow.
+var (
+	TimeWindow_name = map[int32]string{
+		0: "TIME_WINDOW_ALL",
+		1: "TIME_WINDOW_DAY",
+		2: "TIME_WINDOW_WEEK",
+		3: "TIME_WINDOW_MONTH",
+	}
+	TimeWindow_value = map[string]int32{
+		"TIME_WINDOW_ALL":   0,
+		"TIME_WINDOW_DAY":   1,
+		"TIME_WINDOW_WEEK":  2,
+		"TIME_WINDOW_MONTH": 3,
+	}
+)
+
+func (x TimeWindow) Enum() *TimeWindow {
+	p := new(TimeWindow)
+	*p = x
+	return p
+}
+
+func (x TimeWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[4].Descriptor()
+}
+
+func (TimeWindow) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[4]
+}
+
+func (x TimeWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeWindow.Descriptor instead.
+func (TimeWindow) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{4}
+}
+
+type CommentView int32
+
+const (
+	CommentView_COMMENT_VIEW_FLAT CommentView = 0 // плоский список в порядке обхода дерева
+	CommentView_COMMENT_VIEW_TREE CommentView = 1 // корневые комментарии с вложенными ответами
+)
+
+// Enum value maps for CommentView.
+var (
+	CommentView_name = map[int32]string{
+		0: "COMMENT_VIEW_FLAT",
+		1: "COMMENT_VIEW_TREE",
+	}
+	CommentView_value = map[string]int32{
+		"COMMENT_VIEW_FLAT": 0,
+		"COMMENT_VIEW_TREE": 1,
+	}
+)
+
+func (x CommentView) Enum() *CommentView {
+	p := new(CommentView)
+	*p = x
+	return p
+}
+
+func (x CommentView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentView) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[5].Descriptor()
+}
+
+func (CommentView) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[5]
+}
+
+func (x CommentView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentView.Descriptor instead.
+func (CommentView) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{5}
+}
+
+// ================== Search ==================
+type SearchHitType int32
+
+const (
+	SearchHitType_SEARCH_HIT_POST    SearchHitType = 0
+	SearchHitType_SEARCH_HIT_COMMENT SearchHitType = 1
+)
+
+// Enum value maps for SearchHitType.
+var (
+	SearchHitType_name = map[int32]string{
+		0: "SEARCH_HIT_POST",
+		1: "SEARCH_HIT_COMMENT",
+	}
+	SearchHitType_value = map[string]int32{
+		"SEARCH_HIT_POST":    0,
+		"SEARCH_HIT_COMMENT": 1,
+	}
+)
+
+func (x SearchHitType) Enum() *SearchHitType {
+	p := new(SearchHitType)
+	*p = x
+	return p
+}
+
+func (x SearchHitType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchHitType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[6].Descriptor()
+}
+
+func (SearchHitType) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[6]
+}
+
+func (x SearchHitType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchHitType.Descriptor instead.
+func (SearchHitType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{6}
+}
+
+// ================== Votes ==================
+type VoteTargetType int32
+
+const (
+	VoteTargetType_VOTE_TARGET_POST    VoteTargetType = 0
+	VoteTargetType_VOTE_TARGET_COMMENT VoteTargetType = 1
+)
+
+// Enum value maps for VoteTargetType.
+var (
+	VoteTargetType_name = map[int32]string{
+		0: "VOTE_TARGET_POST",
+		1: "VOTE_TARGET_COMMENT",
+	}
+	VoteTargetType_value = map[string]int32{
+		"VOTE_TARGET_POST":    0,
+		"VOTE_TARGET_COMMENT": 1,
+	}
+)
+
+func (x VoteTargetType) Enum() *VoteTargetType {
+	p := new(VoteTargetType)
+	*p = x
+	return p
+}
+
+func (x VoteTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoteTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[7].Descriptor()
+}
+
+func (VoteTargetType) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[7]
+}
+
+func (x VoteTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoteTargetType.Descriptor instead.
+func (VoteTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{7}
+}
+
+// ================== Notifications ==================
+type NotificationType int32
+
+const (
+	NotificationType_NOTIFICATION_COMMENT NotificationType = 0 // новые комментарии в обсуждении, на которое подписан пользователь
+)
+
+// Enum value maps for NotificationType.
+var (
+	NotificationType_name = map[int32]string{
+		0: "NOTIFICATION_COMMENT",
+	}
+	NotificationType_value = map[string]int32{
+		"NOTIFICATION_COMMENT": 0,
+	}
+)
+
+func (x NotificationType) Enum() *NotificationType {
+	p := new(NotificationType)
+	*p = x
+	return p
+}
+
+func (x NotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[8].Descriptor()
+}
+
+func (NotificationType) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[8]
+}
+
+func (x NotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationType.Descriptor instead.
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{8}
+}
+
+// ================== Revisions ==================
+type DiffOp int32
+
+const (
+	DiffOp_DIFF_EQUAL  DiffOp = 0
+	DiffOp_DIFF_INSERT DiffOp = 1
+	DiffOp_DIFF_DELETE DiffOp = 2
+)
+
+// Enum value maps for DiffOp.
+var (
+	DiffOp_name = map[int32]string{
+		0: "DIFF_EQUAL",
+		1: "DIFF_INSERT",
+		2: "DIFF_DELETE",
+	}
+	DiffOp_value = map[string]int32{
+		"DIFF_EQUAL":  0,
+		"DIFF_INSERT": 1,
+		"DIFF_DELETE": 2,
+	}
+)
+
+func (x DiffOp) Enum() *DiffOp {
+	p := new(DiffOp)
+	*p = x
+	return p
+}
+
+func (x DiffOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[9].Descriptor()
+}
+
+func (DiffOp) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[9]
+}
+
+func (x DiffOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffOp.Descriptor instead.
+func (DiffOp) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{9}
+}
+
+type TrashTarget int32
+
+const (
+	TrashTarget_TRASH_POSTS    TrashTarget = 0
+	TrashTarget_TRASH_COMMENTS TrashTarget = 1
+)
+
+// Enum value maps for TrashTarget.
+var (
+	TrashTarget_name = map[int32]string{
+		0: "TRASH_POSTS",
+		1: "TRASH_COMMENTS",
+	}
+	TrashTarget_value = map[string]int32{
+		"TRASH_POSTS":    0,
+		"TRASH_COMMENTS": 1,
+	}
+)
+
+func (x TrashTarget) Enum() *TrashTarget {
+	p := new(TrashTarget)
+	*p = x
+	return p
+}
+
+func (x TrashTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrashTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[10].Descriptor()
+}
+
+func (TrashTarget) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[10]
+}
+
+func (x TrashTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrashTarget.Descriptor instead.
+func (TrashTarget) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{10}
+}
+
+type ReportTarget int32
+
+const (
+	ReportTarget_REPORT_TARGET_UNSPECIFIED  ReportTarget = 0
+	ReportTarget_REPORT_TARGET_POST         ReportTarget = 1
+	ReportTarget_REPORT_TARGET_COMMENT      ReportTarget = 2
+	ReportTarget_REPORT_TARGET_CHAT_MESSAGE ReportTarget = 3
+)
+
+// Enum value maps for ReportTarget.
+var (
+	ReportTarget_name = map[int32]string{
+		0: "REPORT_TARGET_UNSPECIFIED",
+		1: "REPORT_TARGET_POST",
+		2: "REPORT_TARGET_COMMENT",
+		3: "REPORT_TARGET_CHAT_MESSAGE",
+	}
+	ReportTarget_value = map[string]int32{
+		"REPORT_TARGET_UNSPECIFIED":  0,
+		"REPORT_TARGET_POST":         1,
+		"REPORT_TARGET_COMMENT":      2,
+		"REPORT_TARGET_CHAT_MESSAGE": 3,
+	}
+)
+
+func (x ReportTarget) Enum() *ReportTarget {
+	p := new(ReportTarget)
+	*p = x
+	return p
+}
+
+func (x ReportTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[11].Descriptor()
+}
+
+func (ReportTarget) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[11]
+}
+
+func (x ReportTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportTarget.Descriptor instead.
+func (ReportTarget) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{11}
+}
+
+type ReportReason int32
+
+const (
+	ReportReason_REPORT_REASON_UNSPECIFIED ReportReason = 0
+	ReportReason_REPORT_REASON_SPAM        ReportReason = 1
+	ReportReason_REPORT_REASON_ABUSE       ReportReason = 2 // оскорбления и травля
+	ReportReason_REPORT_REASON_OFF_TOPIC   ReportReason = 3
+	ReportReason_REPORT_REASON_ILLEGAL     ReportReason = 4 // запрещённый контент
+	ReportReason_REPORT_REASON_OTHER       ReportReason = 5 // требует описания в details
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "REPORT_REASON_UNSPECIFIED",
+		1: "REPORT_REASON_SPAM",
+		2: "REPORT_REASON_ABUSE",
+		3: "REPORT_REASON_OFF_TOPIC",
+		4: "REPORT_REASON_ILLEGAL",
+		5: "REPORT_REASON_OTHER",
+	}
+	ReportReason_value = map[string]int32{
+		"REPORT_REASON_UNSPECIFIED": 0,
+		"REPORT_REASON_SPAM":        1,
+		"REPORT_REASON_ABUSE":       2,
+		"REPORT_REASON_OFF_TOPIC":   3,
+		"REPORT_REASON_ILLEGAL":     4,
+		"REPORT_REASON_OTHER":       5,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[12].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[12]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{12}
+}
+
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED ReportStatus = 0
+	ReportStatus_REPORT_STATUS_OPEN        ReportStatus = 1
+	ReportStatus_REPORT_STATUS_DISMISSED   ReportStatus = 2 // жалоба отклонена
+	ReportStatus_REPORT_STATUS_REMOVED     ReportStatus = 3 // контент удалён
+	ReportStatus_REPORT_STATUS_WARNED      ReportStatus = 4 // автор получил предупреждение
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_OPEN",
+		2: "REPORT_STATUS_DISMISSED",
+		3: "REPORT_STATUS_REMOVED",
+		4: "REPORT_STATUS_WARNED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_STATUS_OPEN":        1,
+		"REPORT_STATUS_DISMISSED":   2,
+		"REPORT_STATUS_REMOVED":     3,
+		"REPORT_STATUS_WARNED":      4,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[13].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[13]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{13}
+}
+
+type ReportAction int32
+
+const (
+	ReportAction_REPORT_ACTION_UNSPECIFIED ReportAction = 0
+	ReportAction_REPORT_ACTION_DISMISS     ReportAction = 1
+	ReportAction_REPORT_ACTION_DELETE      ReportAction = 2 // посты и комментарии попадают в корзину
+	ReportAction_REPORT_ACTION_WARN        ReportAction = 3
+)
+
+// Enum value maps for ReportAction.
+var (
+	ReportAction_name = map[int32]string{
+		0: "REPORT_ACTION_UNSPECIFIED",
+		1: "REPORT_ACTION_DISMISS",
+		2: "REPORT_ACTION_DELETE",
+		3: "REPORT_ACTION_WARN",
+	}
+	ReportAction_value = map[string]int32{
+		"REPORT_ACTION_UNSPECIFIED": 0,
+		"REPORT_ACTION_DISMISS":     1,
+		"REPORT_ACTION_DELETE":      2,
+		"REPORT_ACTION_WARN":        3,
+	}
+)
+
+func (x ReportAction) Enum() *ReportAction {
+	p := new(ReportAction)
+	*p = x
+	return p
+}
+
+func (x ReportAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[14].Descriptor()
+}
+
+func (ReportAction) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[14]
+}
+
+func (x ReportAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportAction.Descriptor instead.
+func (ReportAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{14}
+}
+
+type HeldTarget int32
+
+const (
+	HeldTarget_HELD_TARGET_UNSPECIFIED HeldTarget = 0
+	HeldTarget_HELD_TARGET_POST        HeldTarget = 1
+	HeldTarget_HELD_TARGET_COMMENT     HeldTarget = 2
+)
+
+// Enum value maps for HeldTarget.
+var (
+	HeldTarget_name = map[int32]string{
+		0: "HELD_TARGET_UNSPECIFIED",
+		1: "HELD_TARGET_POST",
+		2: "HELD_TARGET_COMMENT",
+	}
+	HeldTarget_value = map[string]int32{
+		"HELD_TARGET_UNSPECIFIED": 0,
+		"HELD_TARGET_POST":        1,
+		"HELD_TARGET_COMMENT":     2,
+	}
+)
+
+func (x HeldTarget) Enum() *HeldTarget {
+	p := new(HeldTarget)
+	*p = x
+	return p
+}
+
+func (x HeldTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeldTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[15].Descriptor()
+}
+
+func (HeldTarget) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[15]
+}
+
+func (x HeldTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HeldTarget.Descriptor instead.
+func (HeldTarget) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{15}
+}
+
+type HeldDecision int32
+
+const (
+	HeldDecision_HELD_DECISION_UNSPECIFIED HeldDecision = 0
+	HeldDecision_HELD_DECISION_APPROVE     HeldDecision = 1
+	HeldDecision_HELD_DECISION_REJECT      HeldDecision = 2 // новый пост уходит в корзину
+)
+
+// Enum value maps for HeldDecision.
+var (
+	HeldDecision_name = map[int32]string{
+		0: "HELD_DECISION_UNSPECIFIED",
+		1: "HELD_DECISION_APPROVE",
+		2: "HELD_DECISION_REJECT",
+	}
+	HeldDecision_value = map[string]int32{
+		"HELD_DECISION_UNSPECIFIED": 0,
+		"HELD_DECISION_APPROVE":     1,
+		"HELD_DECISION_REJECT":      2,
+	}
+)
+
+func (x HeldDecision) Enum() *HeldDecision {
+	p := new(HeldDecision)
+	*p = x
+	return p
+}
+
+func (x HeldDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeldDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[16].Descriptor()
+}
+
+func (HeldDecision) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[16]
+}
+
+func (x HeldDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HeldDecision.Descriptor instead.
+func (HeldDecision) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{16}
+}
+
+// ================== Error Handling ==================
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_UNSPECIFIED         ErrorCode = 0
+	ErrorCode_ERROR_INVALID_CREDENTIALS ErrorCode = 1
+	ErrorCode_ERROR_USER_NOT_FOUND      ErrorCode = 2
+	ErrorCode_ERROR_USER_ALREADY_EXISTS ErrorCode = 3
+	ErrorCode_ERROR_TOKEN_EXPIRED       ErrorCode = 4
+	ErrorCode_ERROR_PERMISSION_DENIED   ErrorCode = 5
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "ERROR_UNSPECIFIED",
+		1: "ERROR_INVALID_CREDENTIALS",
+		2: "ERROR_USER_NOT_FOUND",
+		3: "ERROR_USER_ALREADY_EXISTS",
+		4: "ERROR_TOKEN_EXPIRED",
+		5: "ERROR_PERMISSION_DENIED",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_UNSPECIFIED":         0,
+		"ERROR_INVALID_CREDENTIALS": 1,
+		"ERROR_USER_NOT_FOUND":      2,
+		"ERROR_USER_ALREADY_EXISTS": 3,
+		"ERROR_TOKEN_EXPIRED":       4,
+		"ERROR_PERMISSION_DENIED":   5,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[17].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[17]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{17}
+}
+
+// ================== Attachments ==================
+type AttachmentTarget int32
+
+const (
+	AttachmentTarget_ATTACHMENT_TARGET_POST    AttachmentTarget = 0
+	AttachmentTarget_ATTACHMENT_TARGET_COMMENT AttachmentTarget = 1
+)
+
+// Enum value maps for AttachmentTarget.
+var (
+	AttachmentTarget_name = map[int32]string{
+		0: "ATTACHMENT_TARGET_POST",
+		1: "ATTACHMENT_TARGET_COMMENT",
+	}
+	AttachmentTarget_value = map[string]int32{
+		"ATTACHMENT_TARGET_POST":    0,
+		"ATTACHMENT_TARGET_COMMENT": 1,
+	}
+)
+
+func (x AttachmentTarget) Enum() *AttachmentTarget {
+	p := new(AttachmentTarget)
+	*p = x
+	return p
+}
+
+func (x AttachmentTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_forum_proto_enumTypes[18].Descriptor()
+}
+
+func (AttachmentTarget) Type() protoreflect.EnumType {
+	return &file_proto_forum_proto_enumTypes[18]
+}
+
+func (x AttachmentTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentTarget.Descriptor instead.
+func (AttachmentTarget) EnumDescriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{18}
+}
+
+// Определяем собственное пустое сообщение
+type EmptyMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyMessage) Reset() {
+	*x = EmptyMessage{}
+	mi := &file_proto_forum_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyMessage) ProtoMessage() {}
+
+func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyMessage.ProtoReflect.Descriptor instead.
+func (*EmptyMessage) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{0}
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_proto_forum_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_proto_forum_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisterResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_proto_forum_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	User          *UserProfileResponse   `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_proto_forum_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LoginResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *LoginResponse) GetUser() *UserProfileResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_proto_forum_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_proto_forum_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RefreshTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ValidateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_proto_forum_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidateRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsValid       bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_proto_forum_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidateResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type ExportUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterId       int64                  `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                      // 0 — значение по умолчанию
+	WithPasswords bool                   `protobuf:"varint,3,opt,name=with_passwords,json=withPasswords,proto3" json:"with_passwords,omitempty"` // отдавать хеши паролей
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersRequest) Reset() {
+	*x = ExportUsersRequest{}
+	mi := &file_proto_forum_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersRequest) ProtoMessage() {}
+
+func (x *ExportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ExportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportUsersRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ExportUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ExportUsersRequest) GetWithPasswords() bool {
+	if x != nil {
+		return x.WithPasswords
+	}
+	return false
+}
+
+type ExportedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	PasswordHash  string                 `protobuf:"bytes,3,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"` // только с with_passwords
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportedUser) Reset() {
+	*x = ExportedUser{}
+	mi := &file_proto_forum_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedUser) ProtoMessage() {}
+
+func (x *ExportedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedUser.ProtoReflect.Descriptor instead.
+func (*ExportedUser) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportedUser) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportedUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ExportedUser) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *ExportedUser) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ExportUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*ExportedUser        `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersResponse) Reset() {
+	*x = ExportUsersResponse{}
+	mi := &file_proto_forum_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersResponse) ProtoMessage() {}
+
+func (x *ExportUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersResponse.ProtoReflect.Descriptor instead.
+func (*ExportUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExportUsersResponse) GetUsers() []*ExportedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ImportUserRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Username     string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PasswordHash string                 `protobuf:"bytes,2,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"` // без хеша пользователь не сможет войти
+	CreatedAt    int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp
+	// Если имя занято пользователем с другой датой регистрации, вернуть его
+	// вместо ошибки AlreadyExists
+	MergeExisting bool `protobuf:"varint,4,opt,name=merge_existing,json=mergeExisting,proto3" json:"merge_existing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUserRequest) Reset() {
+	*x = ImportUserRequest{}
+	mi := &file_proto_forum_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUserRequest) ProtoMessage() {}
+
+func (x *ImportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUserRequest.ProtoReflect.Descriptor instead.
+func (*ImportUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ImportUserRequest) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *ImportUserRequest) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ImportUserRequest) GetMergeExisting() bool {
+	if x != nil {
+		return x.MergeExisting
+	}
+	return false
+}
+
+type ImportUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Result        ImportUserResult       `protobuf:"varint,2,opt,name=result,proto3,enum=proto.ImportUserResult" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUserResponse) Reset() {
+	*x = ImportUserResponse{}
+	mi := &file_proto_forum_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUserResponse) ProtoMessage() {}
+
+func (x *ImportUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUserResponse.ProtoReflect.Descriptor instead.
+func (*ImportUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportUserResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportUserResponse) GetResult() ImportUserResult {
+	if x != nil {
+		return x.Result
+	}
+	return ImportUserResult_IMPORT_USER_RESULT_UNSPECIFIED
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_proto_forum_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LogoutRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_proto_forum_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LogoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ================== Post Service ==================
+type Post struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content        string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	AuthorId       int64                  `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorUsername string                 `protobuf:"bytes,5,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CommentCount   int32                  `protobuf:"varint,7,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	CategoryId     int64                  `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 0, если пост вне категорий
+	Tags           []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Score          int64                  `protobuf:"varint,10,opt,name=score,proto3" json:"score,omitempty"`                               // сумма голосов
+	MyVote         int32                  `protobuf:"varint,11,opt,name=my_vote,json=myVote,proto3" json:"my_vote,omitempty"`               // голос viewer_id из запроса: 1, -1 или 0
+	Deleted        bool                   `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`                           // заглушка удалённого поста: без заголовка и текста
+	Deletion       *Deletion              `protobuf:"bytes,13,opt,name=deletion,proto3" json:"deletion,omitempty"`                          // заполняется только в корзине
+	ContentHtml    string                 `protobuf:"bytes,14,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"` // content, отрендеренный из Markdown и очищенный от опасного HTML
+	Attachments    []*Attachment          `protobuf:"bytes,15,rep,name=attachments,proto3" json:"attachments,omitempty"`                    // в порядке загрузки
+	Status         PostStatus             `protobuf:"varint,16,opt,name=status,proto3,enum=proto.PostStatus" json:"status,omitempty"`
+	PublishAt      int64                  `protobuf:"varint,17,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`               // Unix timestamp отложенной публикации, 0 если не запланирована
+	IsPinned       bool                   `protobuf:"varint,18,opt,name=is_pinned,json=isPinned,proto3" json:"is_pinned,omitempty"`                  // закреплён в начале ленты
+	IsLocked       bool                   `protobuf:"varint,19,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`                  // закрыт для новых комментариев
+	LastCommentAt  int64                  `protobuf:"varint,20,opt,name=last_comment_at,json=lastCommentAt,proto3" json:"last_comment_at,omitempty"` // Unix timestamp последнего комментария, 0 если их нет
+	Bookmarked     bool                   `protobuf:"varint,21,opt,name=bookmarked,proto3" json:"bookmarked,omitempty"`                              // пост в закладках вызывающего пользователя
+	ViewCount      int64                  `protobuf:"varint,22,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`               // просмотры; записываются пачками и могут отставать на интервал записи
+	UpdatedAt      int64                  `protobuf:"varint,23,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`               // Unix timestamp последней правки, 0 если пост не правили
+	Poll           *Poll                  `protobuf:"bytes,24,opt,name=poll,proto3" json:"poll,omitempty"`                                           // опрос с результатами, если он есть
+	Hold           *Hold                  `protobuf:"bytes,25,opt,name=hold,proto3" json:"hold,omitempty"`                                           // правка или новый пост задержаны фильтром до решения модератора
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_proto_forum_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Post) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Post) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Post) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *Post) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Post) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Post) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Post) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Post) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Post) GetMyVote() int32 {
+	if x != nil {
+		return x.MyVote
+	}
+	return 0
+}
+
+func (x *Post) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Post) GetDeletion() *Deletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+func (x *Post) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
+func (x *Post) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Post) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_POST_STATUS_PUBLISHED
+}
+
+func (x *Post) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *Post) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+func (x *Post) GetIsLocked() bool {
+	if x != nil {
+		return x.IsLocked
+	}
+	return false
+}
+
+func (x *Post) GetLastCommentAt() int64 {
+	if x != nil {
+		return x.LastCommentAt
+	}
+	return 0
+}
+
+func (x *Post) GetBookmarked() bool {
+	if x != nil {
+		return x.Bookmarked
+	}
+	return false
+}
+
+func (x *Post) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *Post) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Post) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *Post) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// Задержка текста фильтром. Задержанная правка не видна читателям, пока её не одобрят.
+type Hold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	HeldAt        int64                  `protobuf:"varint,2,opt,name=held_at,json=heldAt,proto3" json:"held_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	mi := &file_proto_forum_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Hold) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Hold) GetHeldAt() int64 {
+	if x != nil {
+		return x.HeldAt
+	}
+	return 0
+}
+
+type PostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostResponse) Reset() {
+	*x = PostResponse{}
+	mi := &file_proto_forum_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostResponse) ProtoMessage() {}
+
+func (x *PostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostResponse.ProtoReflect.Descriptor instead.
+func (*PostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type CreatePostRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Title   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	AuthorId int64 `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	AuthorUsername string     `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	CategoryId     *int64     `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Tags           []string   `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Status         PostStatus `protobuf:"varint,7,opt,name=status,proto3,enum=proto.PostStatus" json:"status,omitempty"`  // по умолчанию пост публикуется сразу
+	PublishAt      int64      `protobuf:"varint,8,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // Unix timestamp, обязателен для POST_STATUS_SCHEDULED
+	Poll           *PollInput `protobuf:"bytes,9,opt,name=poll,proto3" json:"poll,omitempty"`                             // опрос в посте, необязателен
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	mi := &file_proto_forum_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreatePostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *CreatePostRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *CreatePostRequest) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_POST_STATUS_PUBLISHED
+}
+
+func (x *CreatePostRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetPoll() *PollInput {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+type PollInput struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Options        []string               `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`                                      // от 2 до 10 вариантов
+	MultipleChoice bool                   `protobuf:"varint,2,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"` // можно выбрать несколько вариантов
+	Public         bool                   `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`                                       // видно, кто за что голосовал; по умолчанию голоса анонимны
+	ClosesAt       int64                  `protobuf:"varint,4,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`                   // Unix timestamp закрытия, 0 — опрос не закрывается
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PollInput) Reset() {
+	*x = PollInput{}
+	mi := &file_proto_forum_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollInput) ProtoMessage() {}
+
+func (x *PollInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollInput.ProtoReflect.Descriptor instead.
+func (*PollInput) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PollInput) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PollInput) GetMultipleChoice() bool {
+	if x != nil {
+		return x.MultipleChoice
+	}
+	return false
+}
+
+func (x *PollInput) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *PollInput) GetClosesAt() int64 {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return 0
+}
+
+type GetPostRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	ViewerId      int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	mi := &file_proto_forum_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRequest) ProtoMessage() {}
+
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *GetPostRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+// Обёртка над списком тегов, чтобы отличать «не менять» от «очистить»
+type TagList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagList) Reset() {
+	*x = TagList{}
+	mi := &file_proto_forum_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagList) ProtoMessage() {}
+
+func (x *TagList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagList.ProtoReflect.Descriptor instead.
+func (*TagList) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TagList) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdatePostRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PostId     int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Title      *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Content    *string                `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	CategoryId *int64                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"` // 0 убирает пост из категории
+	Tags       *TagList               `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`                                      // не задан — теги не меняются
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	EditorId      int64 `protobuf:"varint,6,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	mi := &file_proto_forum_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdatePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *UpdatePostRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdatePostRequest) GetTags() *TagList {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *UpdatePostRequest) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+type DeletePostRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	DeletedBy     int64  `protobuf:"varint,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_proto_forum_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeletePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *DeletePostRequest) GetDeletedBy() int64 {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return 0
+}
+
+func (x *DeletePostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListPostsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId    *int64                 `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3,oneof" json:"author_id,omitempty"`
+	Cursor      string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // непрозрачный курсор из next_cursor предыдущей страницы
+	Limit       int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`  // размер страницы, по умолчанию 20
+	Order       SortOrder              `protobuf:"varint,4,opt,name=order,proto3,enum=proto.SortOrder" json:"order,omitempty"`
+	CreatedFrom *int64                 `protobuf:"varint,5,opt,name=created_from,json=createdFrom,proto3,oneof" json:"created_from,omitempty"` // Unix timestamp, включительно
+	CreatedTo   *int64                 `protobuf:"varint,6,opt,name=created_to,json=createdTo,proto3,oneof" json:"created_to,omitempty"`       // Unix timestamp, не включительно
+	CategoryId  *int64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Tag         string                 `protobuf:"bytes,8,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	ViewerId      int64      `protobuf:"varint,9,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	Sort          PostSort   `protobuf:"varint,10,opt,name=sort,proto3,enum=proto.PostSort" json:"sort,omitempty"`
+	Window        TimeWindow `protobuf:"varint,11,opt,name=window,proto3,enum=proto.TimeWindow" json:"window,omitempty"`           // только посты, опубликованные за этот период
+	IgnorePinned  bool       `protobuf:"varint,12,opt,name=ignore_pinned,json=ignorePinned,proto3" json:"ignore_pinned,omitempty"` // не поднимать закреплённые посты в начало ленты
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsRequest) Reset() {
+	*x = ListPostsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsRequest) ProtoMessage() {}
+
+func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPostsRequest) GetAuthorId() int64 {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return 0
+}
+
+func (x *ListPostsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPostsRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_DESC
+}
+
+func (x *ListPostsRequest) GetCreatedFrom() int64 {
+	if x != nil && x.CreatedFrom != nil {
+		return *x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *ListPostsRequest) GetCreatedTo() int64 {
+	if x != nil && x.CreatedTo != nil {
+		return *x.CreatedTo
+	}
+	return 0
+}
+
+func (x *ListPostsRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListPostsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *ListPostsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *ListPostsRequest) GetSort() PostSort {
+	if x != nil {
+		return x.Sort
+	}
+	return PostSort_POST_SORT_NEW
+}
+
+func (x *ListPostsRequest) GetWindow() TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TimeWindow_TIME_WINDOW_ALL
+}
+
+func (x *ListPostsRequest) GetIgnorePinned() bool {
+	if x != nil {
+		return x.IgnorePinned
+	}
+	return false
+}
+
+type ListPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // пустой, если страниц больше нет
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsResponse) Reset() {
+	*x = ListPostsResponse{}
+	mi := &file_proto_forum_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsResponse) ProtoMessage() {}
+
+func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListPostsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPostsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListMyDraftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию 20
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyDraftsRequest) Reset() {
+	*x = ListMyDraftsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyDraftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyDraftsRequest) ProtoMessage() {}
+
+func (x *ListMyDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyDraftsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMyDraftsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMyDraftsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type PublishPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PublishAt     int64                  `protobuf:"varint,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // Unix timestamp отложенной публикации, 0 — опубликовать сразу
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPostRequest) Reset() {
+	*x = PublishPostRequest{}
+	mi := &file_proto_forum_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostRequest) ProtoMessage() {}
+
+func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
+func (*PublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PublishPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PublishPostRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+type PinPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"` // false снимает закрепление
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinPostRequest) Reset() {
+	*x = PinPostRequest{}
+	mi := &file_proto_forum_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostRequest) ProtoMessage() {}
+
+func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
+func (*PinPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PinPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PinPostRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type LockPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Locked        bool                   `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"` // false снова открывает комментарии
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockPostRequest) Reset() {
+	*x = LockPostRequest{}
+	mi := &file_proto_forum_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockPostRequest) ProtoMessage() {}
+
+func (x *LockPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockPostRequest.ProtoReflect.Descriptor instead.
+func (*LockPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LockPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *LockPostRequest) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+// ================== Categories ==================
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"` // порядок вывода, по возрастанию
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_proto_forum_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Category) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryResponse) Reset() {
+	*x = CategoryResponse{}
+	mi := &file_proto_forum_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryResponse) ProtoMessage() {}
+
+func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryResponse.ProtoReflect.Descriptor instead.
+func (*CategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_proto_forum_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateCategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_proto_forum_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Slug          *string                `protobuf:"bytes,2,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Position      *int32                 `protobuf:"varint,5,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_proto_forum_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_proto_forum_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_proto_forum_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{37}
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_proto_forum_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// ================== Comment Service ==================
+type Comment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	AuthorId       int64                  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorUsername string                 `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	PostId         int64                  `protobuf:"varint,5,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ParentId       int64                  `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 для корневых комментариев
+	Depth          int32                  `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	Path           []int64                `protobuf:"varint,9,rep,packed,name=path,proto3" json:"path,omitempty"`                           // ID комментариев от корня ветки до текущего
+	Deleted        bool                   `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`                           // заглушка удалённого комментария с ответами
+	Replies        []*Comment             `protobuf:"bytes,11,rep,name=replies,proto3" json:"replies,omitempty"`                            // заполняется только в COMMENT_VIEW_TREE
+	Score          int64                  `protobuf:"varint,12,opt,name=score,proto3" json:"score,omitempty"`                               // сумма голосов
+	MyVote         int32                  `protobuf:"varint,13,opt,name=my_vote,json=myVote,proto3" json:"my_vote,omitempty"`               // голос viewer_id из запроса: 1, -1 или 0
+	Deletion       *Deletion              `protobuf:"bytes,14,opt,name=deletion,proto3" json:"deletion,omitempty"`                          // заполняется только в корзине
+	ContentHtml    string                 `protobuf:"bytes,15,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"` // content, отрендеренный из Markdown и очищенный от опасного HTML
+	Attachments    []*Attachment          `protobuf:"bytes,16,rep,name=attachments,proto3" json:"attachments,omitempty"`                    // в порядке загрузки
+	Hold           *Hold                  `protobuf:"bytes,17,opt,name=hold,proto3" json:"hold,omitempty"`                                  // задержан фильтром: у нового комментария id = 0 до одобрения
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_proto_forum_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Comment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Comment) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Comment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Comment) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Comment) GetPath() []int64 {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *Comment) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Comment) GetMyVote() int32 {
+	if x != nil {
+		return x.MyVote
+	}
+	return 0
+}
+
+func (x *Comment) GetDeletion() *Deletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+func (x *Comment) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
+func (x *Comment) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Comment) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type CommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentResponse) Reset() {
+	*x = CommentResponse{}
+	mi := &file_proto_forum_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentResponse) ProtoMessage() {}
+
+func (x *CommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
+func (*CommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type CreateCommentRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Content string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	AuthorId int64 `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	PostId   int64 `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	AuthorUsername string `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	ParentId       *int64 `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_proto_forum_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *CreateCommentRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *CreateCommentRequest) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type GetCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentRequest) Reset() {
+	*x = GetCommentRequest{}
+	mi := &file_proto_forum_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentRequest) ProtoMessage() {}
+
+func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type GetCommentsByPostIDRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	View   CommentView            `protobuf:"varint,2,opt,name=view,proto3,enum=proto.CommentView" json:"view,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	ViewerId      int64 `protobuf:"varint,3,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentsByPostIDRequest) Reset() {
+	*x = GetCommentsByPostIDRequest{}
+	mi := &file_proto_forum_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsByPostIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsByPostIDRequest) ProtoMessage() {}
+
+func (x *GetCommentsByPostIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsByPostIDRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentsByPostIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCommentsByPostIDRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetCommentsByPostIDRequest) GetView() CommentView {
+	if x != nil {
+		return x.View
+	}
+	return CommentView_COMMENT_VIEW_FLAT
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *GetCommentsByPostIDRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type ListCommentsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	View   CommentView            `protobuf:"varint,2,opt,name=view,proto3,enum=proto.CommentView" json:"view,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	ViewerId      int64 `protobuf:"varint,3,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListCommentsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetView() CommentView {
+	if x != nil {
+		return x.View
+	}
+	return CommentView_COMMENT_VIEW_FLAT
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *ListCommentsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_proto_forum_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateCommentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CommentId int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Content   *string                `protobuf:"bytes,2,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	EditorId      int64 `protobuf:"varint,3,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_proto_forum_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *UpdateCommentRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *UpdateCommentRequest) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+type DeleteCommentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CommentId int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	DeletedBy     int64  `protobuf:"varint,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_proto_forum_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *DeleteCommentRequest) GetDeletedBy() int64 {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SearchPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // поисковая строка, поддерживает синтаксис websearch
+	AuthorId      *int64                 `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3,oneof" json:"author_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию 20
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsRequest) ProtoMessage() {}
+
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetAuthorId() int64 {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchHit struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           SearchHitType          `protobuf:"varint,1,opt,name=type,proto3,enum=proto.SearchHitType" json:"type,omitempty"`
+	PostId         int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CommentId      int64                  `protobuf:"varint,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 0 для постов
+	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                           // заголовок поста, в котором найдено совпадение
+	Snippet        string                 `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"`                       // фрагмент текста, совпадения обёрнуты в <mark></mark>
+	AuthorId       int64                  `protobuf:"varint,6,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorUsername string                 `protobuf:"bytes,7,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	Rank           float32                `protobuf:"fixed32,9,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_proto_forum_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SearchHit) GetType() SearchHitType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchHitType_SEARCH_HIT_POST
+}
+
+func (x *SearchHit) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SearchHit) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *SearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *SearchHit) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *SearchHit) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SearchHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type SearchPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsResponse) Reset() {
+	*x = SearchPostsResponse{}
+	mi := &file_proto_forum_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsResponse) ProtoMessage() {}
+
+func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SearchPostsResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchPostsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type VoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	UserId        int64          `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	TargetType    VoteTargetType `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=proto.VoteTargetType" json:"target_type,omitempty"`
+	TargetId      int64          `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Value         int32          `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"` // 1 — за, -1 — против
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteRequest) Reset() {
+	*x = VoteRequest{}
+	mi := &file_proto_forum_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteRequest) ProtoMessage() {}
+
+func (x *VoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{51}
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *VoteRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VoteRequest) GetTargetType() VoteTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return VoteTargetType_VOTE_TARGET_POST
+}
+
+func (x *VoteRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *VoteRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type RemoveVoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	UserId        int64          `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	TargetType    VoteTargetType `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=proto.VoteTargetType" json:"target_type,omitempty"`
+	TargetId      int64          `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveVoteRequest) Reset() {
+	*x = RemoveVoteRequest{}
+	mi := &file_proto_forum_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveVoteRequest) ProtoMessage() {}
+
+func (x *RemoveVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveVoteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveVoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{52}
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *RemoveVoteRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveVoteRequest) GetTargetType() VoteTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return VoteTargetType_VOTE_TARGET_POST
+}
+
+func (x *RemoveVoteRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+// ================== Poll Service ==================
+type Poll struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Options        []*PollOption          `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	MultipleChoice bool                   `protobuf:"varint,2,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	Public         bool                   `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
+	ClosesAt       int64                  `protobuf:"varint,4,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"` // Unix timestamp, 0 — опрос не закрывается
+	Closed         bool                   `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	TotalVoters    int64                  `protobuf:"varint,6,opt,name=total_voters,json=totalVoters,proto3" json:"total_voters,omitempty"`
+	MyChoices      []int64                `protobuf:"varint,7,rep,packed,name=my_choices,json=myChoices,proto3" json:"my_choices,omitempty"` // варианты, выбранные вызывающим пользователем
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	mi := &file_proto_forum_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Poll) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Poll) GetMultipleChoice() bool {
+	if x != nil {
+		return x.MultipleChoice
+	}
+	return false
+}
+
+func (x *Poll) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *Poll) GetClosesAt() int64 {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return 0
+}
+
+func (x *Poll) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *Poll) GetTotalVoters() int64 {
+	if x != nil {
+		return x.TotalVoters
+	}
+	return 0
+}
+
+func (x *Poll) GetMyChoices() []int64 {
+	if x != nil {
+		return x.MyChoices
+	}
+	return nil
+}
+
+type PollOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Votes         int64                  `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	Voters        []*UserSummary         `protobuf:"bytes,4,rep,name=voters,proto3" json:"voters,omitempty"` // только в открытых опросах и только в GetPollResults
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	mi := &file_proto_forum_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOption) ProtoMessage() {}
+
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PollOption) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PollOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PollOption) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *PollOption) GetVoters() []*UserSummary {
+	if x != nil {
+		return x.Voters
+	}
+	return nil
+}
+
+type CastPollVoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	OptionIds     []int64                `protobuf:"varint,2,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"` // в опросе с одним ответом — ровно один вариант
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CastPollVoteRequest) Reset() {
+	*x = CastPollVoteRequest{}
+	mi := &file_proto_forum_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CastPollVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastPollVoteRequest) ProtoMessage() {}
+
+func (x *CastPollVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastPollVoteRequest.ProtoReflect.Descriptor instead.
+func (*CastPollVoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CastPollVoteRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CastPollVoteRequest) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+type GetPollResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPollResultsRequest) Reset() {
+	*x = GetPollResultsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPollResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultsRequest) ProtoMessage() {}
+
+func (x *GetPollResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetPollResultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetPollResultsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type PollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Poll          *Poll                  `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollResponse) Reset() {
+	*x = PollResponse{}
+	mi := &file_proto_forum_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollResponse) ProtoMessage() {}
+
+func (x *PollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollResponse.ProtoReflect.Descriptor instead.
+func (*PollResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PollResponse) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+type VoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         int64                  `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`                 // итоговый рейтинг цели
+	MyVote        int32                  `protobuf:"varint,2,opt,name=my_vote,json=myVote,proto3" json:"my_vote,omitempty"` // текущий голос пользователя, 0 после снятия
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteResponse) Reset() {
+	*x = VoteResponse{}
+	mi := &file_proto_forum_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteResponse) ProtoMessage() {}
+
+func (x *VoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteResponse.ProtoReflect.Descriptor instead.
+func (*VoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *VoteResponse) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *VoteResponse) GetMyVote() int32 {
+	if x != nil {
+		return x.MyVote
+	}
+	return 0
+}
+
+// ================== Bookmarks ==================
+type Bookmark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`                             // личная заметка, видна только владельцу закладки
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp добавления в закладки
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bookmark) Reset() {
+	*x = Bookmark{}
+	mi := &file_proto_forum_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bookmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bookmark) ProtoMessage() {}
+
+func (x *Bookmark) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bookmark.ProtoReflect.Descriptor instead.
+func (*Bookmark) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Bookmark) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *Bookmark) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Bookmark) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AddBookmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"` // повторное добавление заменяет заметку
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookmarkRequest) Reset() {
+	*x = AddBookmarkRequest{}
+	mi := &file_proto_forum_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookmarkRequest) ProtoMessage() {}
+
+func (x *AddBookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*AddBookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AddBookmarkRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *AddBookmarkRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RemoveBookmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBookmarkRequest) Reset() {
+	*x = RemoveBookmarkRequest{}
+	mi := &file_proto_forum_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBookmarkRequest) ProtoMessage() {}
+
+func (x *RemoveBookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RemoveBookmarkRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type ListBookmarksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию 20
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarksRequest) Reset() {
+	*x = ListBookmarksRequest{}
+	mi := &file_proto_forum_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksRequest) ProtoMessage() {}
+
+func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListBookmarksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBookmarksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type BookmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookmark      *Bookmark              `protobuf:"bytes,1,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookmarkResponse) Reset() {
+	*x = BookmarkResponse{}
+	mi := &file_proto_forum_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkResponse) ProtoMessage() {}
+
+func (x *BookmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkResponse.ProtoReflect.Descriptor instead.
+func (*BookmarkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BookmarkResponse) GetBookmark() *Bookmark {
+	if x != nil {
+		return x.Bookmark
+	}
+	return nil
+}
+
+type ListBookmarksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookmarks     []*Bookmark            `protobuf:"bytes,1,rep,name=bookmarks,proto3" json:"bookmarks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarksResponse) Reset() {
+	*x = ListBookmarksResponse{}
+	mi := &file_proto_forum_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksResponse) ProtoMessage() {}
+
+func (x *ListBookmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksResponse.ProtoReflect.Descriptor instead.
+func (*ListBookmarksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListBookmarksResponse) GetBookmarks() []*Bookmark {
+	if x != nil {
+		return x.Bookmarks
+	}
+	return nil
+}
+
+// Пока уведомление не прочитано, новые события того же типа по тому же посту
+// не создают новых уведомлений, а увеличивают count
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          NotificationType       `protobuf:"varint,2,opt,name=type,proto3,enum=proto.NotificationType" json:"type,omitempty"`
+	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PostTitle     string                 `protobuf:"bytes,4,opt,name=post_title,json=postTitle,proto3" json:"post_title,omitempty"`
+	CommentId     int64                  `protobuf:"varint,5,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // последний комментарий
+	ActorId       int64                  `protobuf:"varint,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`       // автор последнего события
+	ActorUsername string                 `protobuf:"bytes,7,opt,name=actor_username,json=actorUsername,proto3" json:"actor_username,omitempty"`
+	Count         int32                  `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`                           // сколько событий объединено
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // Unix timestamp первого события
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp последнего события
+	Read          bool                   `protobuf:"varint,11,opt,name=read,proto3" json:"read,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_proto_forum_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Notification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Notification) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_NOTIFICATION_COMMENT
+}
+
+func (x *Notification) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Notification) GetPostTitle() string {
+	if x != nil {
+		return x.PostTitle
+	}
+	return ""
+}
+
+func (x *Notification) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *Notification) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *Notification) GetActorUsername() string {
+	if x != nil {
+		return x.ActorUsername
+	}
+	return ""
+}
+
+func (x *Notification) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Notification) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Notification) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+type FollowPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowPostRequest) Reset() {
+	*x = FollowPostRequest{}
+	mi := &file_proto_forum_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowPostRequest) ProtoMessage() {}
+
+func (x *FollowPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowPostRequest.ProtoReflect.Descriptor instead.
+func (*FollowPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *FollowPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type FollowPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Subscribed    bool                   `protobuf:"varint,2,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowPostResponse) Reset() {
+	*x = FollowPostResponse{}
+	mi := &file_proto_forum_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowPostResponse) ProtoMessage() {}
+
+func (x *FollowPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowPostResponse.ProtoReflect.Descriptor instead.
+func (*FollowPostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *FollowPostResponse) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *FollowPostResponse) GetSubscribed() bool {
+	if x != nil {
+		return x.Subscribed
+	}
+	return false
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию 20
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	UnreadCount   int64                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_proto_forum_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // чужие и уже прочитанные уведомления пропускаются
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	mi := &file_proto_forum_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *MarkReadRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type UnreadCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int64                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadCountResponse) Reset() {
+	*x = UnreadCountResponse{}
+	mi := &file_proto_forum_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountResponse) ProtoMessage() {}
+
+func (x *UnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*UnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UnreadCountResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// ================== Mentions ==================
+type Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // post, comment или chat
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // кого упомянули
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`    // кто упомянул
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_proto_forum_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Mention) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *Mention) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *Mention) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Mention) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Mention) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Mention) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListMentionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию 20
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionsRequest) Reset() {
+	*x = ListMentionsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsRequest) ProtoMessage() {}
+
+func (x *ListMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListMentionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMentionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListMentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mentions      []*Mention             `protobuf:"bytes,1,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionsResponse) Reset() {
+	*x = ListMentionsResponse{}
+	mi := &file_proto_forum_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsResponse) ProtoMessage() {}
+
+func (x *ListMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListMentionsResponse) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type DiffLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            DiffOp                 `protobuf:"varint,1,opt,name=op,proto3,enum=proto.DiffOp" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffLine) Reset() {
+	*x = DiffLine{}
+	mi := &file_proto_forum_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLine) ProtoMessage() {}
+
+func (x *DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DiffLine) GetOp() DiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return DiffOp_DIFF_EQUAL
+}
+
+func (x *DiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Revision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"` // пусто для комментариев
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	EditorId      int64                  `protobuf:"varint,5,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	Diff          []*DiffLine            `protobuf:"bytes,7,rep,name=diff,proto3" json:"diff,omitempty"`                             // построчная разница с предыдущей версией, пусто для исходной
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_proto_forum_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Revision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Revision) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *Revision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Revision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Revision) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+func (x *Revision) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Revision) GetDiff() []*DiffLine {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type GetRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevisionsRequest) Reset() {
+	*x = GetRevisionsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevisionsRequest) ProtoMessage() {}
+
+func (x *GetRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetRevisionsRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type RevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Revision            `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // от исходной версии к текущей
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevisionsResponse) Reset() {
+	*x = RevisionsResponse{}
+	mi := &file_proto_forum_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionsResponse) ProtoMessage() {}
+
+func (x *RevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionsResponse.ProtoReflect.Descriptor instead.
+func (*RevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RevisionsResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type RollbackRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TargetId   int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	RevisionId int64                  `protobuf:"varint,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/forum.proto.
+	EditorId      int64 `protobuf:"varint,3,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"` // устарело: пользователь берётся из токена в метаданных authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackRequest) Reset() {
+	*x = RollbackRequest{}
+	mi := &file_proto_forum_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackRequest) ProtoMessage() {}
+
+func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RollbackRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *RollbackRequest) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/forum.proto.
+func (x *RollbackRequest) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+// ================== Trash ==================
+type Deletion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedAt     int64                  `protobuf:"varint,1,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Unix timestamp
+	DeletedBy     int64                  `protobuf:"varint,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deletion) Reset() {
+	*x = Deletion{}
+	mi := &file_proto_forum_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deletion) ProtoMessage() {}
+
+func (x *Deletion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deletion.ProtoReflect.Descriptor instead.
+func (*Deletion) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Deletion) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Deletion) GetDeletedBy() int64 {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return 0
+}
+
+func (x *Deletion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        TrashTarget            `protobuf:"varint,1,opt,name=target,proto3,enum=proto.TrashTarget" json:"target,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию 50
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_proto_forum_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListTrashRequest) GetTarget() TrashTarget {
+	if x != nil {
+		return x.Target
+	}
+	return TrashTarget_TRASH_POSTS
+}
+
+func (x *ListTrashRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`       // для TRASH_POSTS
+	Comments      []*Comment             `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"` // для TRASH_COMMENTS
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_proto_forum_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListTrashResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type RestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_proto_forum_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RestoreRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type ReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    ReportTarget           `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=proto.ReportTarget" json:"target_type,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,3,opt,name=reason,proto3,enum=proto.ReportReason" json:"reason,omitempty"`
+	Details       string                 `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"` // до 1000 символов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportRequest) Reset() {
+	*x = ReportRequest{}
+	mi := &file_proto_forum_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRequest) ProtoMessage() {}
+
+func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ReportRequest) GetTargetType() ReportTarget {
+	if x != nil {
+		return x.TargetType
+	}
+	return ReportTarget_REPORT_TARGET_UNSPECIFIED
+}
+
+func (x *ReportRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ReportRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ReportRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type Report struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetType     ReportTarget           `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=proto.ReportTarget" json:"target_type,omitempty"`
+	TargetId       int64                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	TargetAuthorId int64                  `protobuf:"varint,4,opt,name=target_author_id,json=targetAuthorId,proto3" json:"target_author_id,omitempty"`
+	TargetContent  string                 `protobuf:"bytes,5,opt,name=target_content,json=targetContent,proto3" json:"target_content,omitempty"` // текст на момент жалобы
+	ReporterId     int64                  `protobuf:"varint,6,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Reason         ReportReason           `protobuf:"varint,7,opt,name=reason,proto3,enum=proto.ReportReason" json:"reason,omitempty"`
+	Details        string                 `protobuf:"bytes,8,opt,name=details,proto3" json:"details,omitempty"`
+	Status         ReportStatus           `protobuf:"varint,9,opt,name=status,proto3,enum=proto.ReportStatus" json:"status,omitempty"`
+	ResolvedBy     int64                  `protobuf:"varint,10,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolvedAt     int64                  `protobuf:"varint,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"` // Unix timestamp, 0 для открытых
+	ResolutionNote string                 `protobuf:"bytes,12,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_proto_forum_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Report) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Report) GetTargetType() ReportTarget {
+	if x != nil {
+		return x.TargetType
+	}
+	return ReportTarget_REPORT_TARGET_UNSPECIFIED
+}
+
+func (x *Report) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *Report) GetTargetAuthorId() int64 {
+	if x != nil {
+		return x.TargetAuthorId
+	}
+	return 0
+}
+
+func (x *Report) GetTargetContent() string {
+	if x != nil {
+		return x.TargetContent
+	}
+	return ""
+}
+
+func (x *Report) GetReporterId() int64 {
+	if x != nil {
+		return x.ReporterId
+	}
+	return 0
+}
+
+func (x *Report) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *Report) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Report) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *Report) GetResolvedBy() int64 {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return 0
+}
+
+func (x *Report) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+func (x *Report) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *Report) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportResponse) Reset() {
+	*x = ReportResponse{}
+	mi := &file_proto_forum_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportResponse) ProtoMessage() {}
+
+func (x *ReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportResponse.ProtoReflect.Descriptor instead.
+func (*ReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ReportResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ListReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ReportStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=proto.ReportStatus" json:"status,omitempty"` // REPORT_STATUS_UNSPECIFIED — открытые
+	TargetType    *ReportTarget          `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=proto.ReportTarget,oneof" json:"target_type,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // число целей, по умолчанию 50
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsRequest) ProtoMessage() {}
+
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListReportsRequest) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ListReportsRequest) GetTargetType() ReportTarget {
+	if x != nil && x.TargetType != nil {
+		return *x.TargetType
+	}
+	return ReportTarget_REPORT_TARGET_UNSPECIFIED
+}
+
+func (x *ListReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Жалобы с одним статусом на одну цель
+type ReportGroup struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TargetType     ReportTarget           `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=proto.ReportTarget" json:"target_type,omitempty"`
+	TargetId       int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	TargetAuthorId int64                  `protobuf:"varint,3,opt,name=target_author_id,json=targetAuthorId,proto3" json:"target_author_id,omitempty"`
+	Count          int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	LastReportedAt int64                  `protobuf:"varint,5,opt,name=last_reported_at,json=lastReportedAt,proto3" json:"last_reported_at,omitempty"` // Unix timestamp
+	Reports        []*Report              `protobuf:"bytes,6,rep,name=reports,proto3" json:"reports,omitempty"`                                        // новые первыми
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportGroup) Reset() {
+	*x = ReportGroup{}
+	mi := &file_proto_forum_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportGroup) ProtoMessage() {}
+
+func (x *ReportGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportGroup.ProtoReflect.Descriptor instead.
+func (*ReportGroup) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ReportGroup) GetTargetType() ReportTarget {
+	if x != nil {
+		return x.TargetType
+	}
+	return ReportTarget_REPORT_TARGET_UNSPECIFIED
+}
+
+func (x *ReportGroup) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ReportGroup) GetTargetAuthorId() int64 {
+	if x != nil {
+		return x.TargetAuthorId
+	}
+	return 0
+}
+
+func (x *ReportGroup) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReportGroup) GetLastReportedAt() int64 {
+	if x != nil {
+		return x.LastReportedAt
+	}
+	return 0
+}
+
+func (x *ReportGroup) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type ListReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*ReportGroup         `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_proto_forum_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,34 +6645,43 @@ func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyMessage.ProtoReflect.Descriptor instead.
-func (*EmptyMessage) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{89}
 }
 
-type RegisterRequest struct {
+func (x *ListReportsResponse) GetGroups() []*ReportGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// Итог применяется ко всем открытым жалобам на ту же цель
+type ResolveReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Action        ReportAction           `protobuf:"varint,2,opt,name=action,proto3,enum=proto.ReportAction" json:"action,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"` // для REPORT_ACTION_DELETE — причина удаления
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterRequest) Reset() {
-	*x = RegisterRequest{}
-	mi := &file_proto_forum_proto_msgTypes[1]
+func (x *ResolveReportRequest) Reset() {
+	*x = ResolveReportRequest{}
+	mi := &file_proto_forum_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterRequest) String() string {
+func (x *ResolveReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterRequest) ProtoMessage() {}
+func (*ResolveReportRequest) ProtoMessage() {}
 
-func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[1]
+func (x *ResolveReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -150,49 +6692,54 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ResolveReportRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RegisterRequest) GetUsername() string {
+func (x *ResolveReportRequest) GetReportId() int64 {
 	if x != nil {
-		return x.Username
+		return x.ReportId
 	}
-	return ""
+	return 0
 }
 
-func (x *RegisterRequest) GetPassword() string {
+func (x *ResolveReportRequest) GetAction() ReportAction {
 	if x != nil {
-		return x.Password
+		return x.Action
+	}
+	return ReportAction_REPORT_ACTION_UNSPECIFIED
+}
+
+func (x *ResolveReportRequest) GetNote() string {
+	if x != nil {
+		return x.Note
 	}
 	return ""
 }
 
-type RegisterResponse struct {
+type ResolveReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	Resolved      int32                  `protobuf:"varint,1,opt,name=resolved,proto3" json:"resolved,omitempty"` // сколько жалоб закрыто
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterResponse) Reset() {
-	*x = RegisterResponse{}
-	mi := &file_proto_forum_proto_msgTypes[2]
+func (x *ResolveReportResponse) Reset() {
+	*x = ResolveReportResponse{}
+	mi := &file_proto_forum_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterResponse) String() string {
+func (x *ResolveReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterResponse) ProtoMessage() {}
+func (*ResolveReportResponse) ProtoMessage() {}
 
-func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[2]
+func (x *ResolveReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,55 +6750,41 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
-func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *RegisterResponse) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *RegisterResponse) GetRefreshToken() string {
-	if x != nil {
-		return x.RefreshToken
-	}
-	return ""
+// Deprecated: Use ResolveReportResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *RegisterResponse) GetExpiresAt() int64 {
+func (x *ResolveReportResponse) GetResolved() int32 {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Resolved
 	}
 	return 0
 }
 
-type LoginRequest struct {
+type ListWarningsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию 50
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
-	mi := &file_proto_forum_proto_msgTypes[3]
+func (x *ListWarningsRequest) Reset() {
+	*x = ListWarningsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *ListWarningsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*ListWarningsRequest) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[3]
+func (x *ListWarningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,50 +6795,51 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListWarningsRequest.ProtoReflect.Descriptor instead.
+func (*ListWarningsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *LoginRequest) GetUsername() string {
+func (x *ListWarningsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Username
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *LoginRequest) GetPassword() string {
+func (x *ListWarningsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Password
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-type LoginResponse struct {
+type Warning struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
-	User          *UserProfileResponse   `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReportId      int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,3,opt,name=reason,proto3,enum=proto.ReportReason" json:"reason,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
-	mi := &file_proto_forum_proto_msgTypes[4]
+func (x *Warning) Reset() {
+	*x = Warning{}
+	mi := &file_proto_forum_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResponse) String() string {
+func (x *Warning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*Warning) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[4]
+func (x *Warning) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,61 +6850,68 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Warning.ProtoReflect.Descriptor instead.
+func (*Warning) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *LoginResponse) GetAccessToken() string {
+func (x *Warning) GetId() int64 {
 	if x != nil {
-		return x.AccessToken
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *LoginResponse) GetRefreshToken() string {
+func (x *Warning) GetReportId() int64 {
 	if x != nil {
-		return x.RefreshToken
+		return x.ReportId
 	}
-	return ""
+	return 0
 }
 
-func (x *LoginResponse) GetExpiresAt() int64 {
+func (x *Warning) GetReason() ReportReason {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Reason
 	}
-	return 0
+	return ReportReason_REPORT_REASON_UNSPECIFIED
 }
 
-func (x *LoginResponse) GetUser() *UserProfileResponse {
+func (x *Warning) GetNote() string {
 	if x != nil {
-		return x.User
+		return x.Note
 	}
-	return nil
+	return ""
 }
 
-type RefreshTokenRequest struct {
+func (x *Warning) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListWarningsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Warnings      []*Warning             `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshTokenRequest) Reset() {
-	*x = RefreshTokenRequest{}
-	mi := &file_proto_forum_proto_msgTypes[5]
+func (x *ListWarningsResponse) Reset() {
+	*x = ListWarningsResponse{}
+	mi := &file_proto_forum_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshTokenRequest) String() string {
+func (x *ListWarningsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenRequest) ProtoMessage() {}
+func (*ListWarningsResponse) ProtoMessage() {}
 
-func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[5]
+func (x *ListWarningsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,42 +6922,55 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
-func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListWarningsResponse.ProtoReflect.Descriptor instead.
+func (*ListWarningsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *RefreshTokenRequest) GetRefreshToken() string {
+func (x *ListWarningsResponse) GetWarnings() []*Warning {
 	if x != nil {
-		return x.RefreshToken
+		return x.Warnings
 	}
-	return ""
+	return nil
 }
 
-type RefreshTokenResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Текст, задержанный фильтром: новый пост или комментарий либо правка
+type HeldContent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetType     HeldTarget             `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=proto.HeldTarget" json:"target_type,omitempty"`
+	TargetId       int64                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // 0 у нового комментария до одобрения
+	PostId         int64                  `protobuf:"varint,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ParentId       int64                  `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // родитель нового комментария, 0 для корневых
+	IsEdit         bool                   `protobuf:"varint,6,opt,name=is_edit,json=isEdit,proto3" json:"is_edit,omitempty"`       // правка опубликованного текста
+	AuthorId       int64                  `protobuf:"varint,7,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorUsername string                 `protobuf:"bytes,8,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	Title          string                 `protobuf:"bytes,9,opt,name=title,proto3" json:"title,omitempty"` // у комментария — заголовок поста
+	Content        string                 `protobuf:"bytes,10,opt,name=content,proto3" json:"content,omitempty"`
+	ContentHtml    string                 `protobuf:"bytes,11,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"`
+	CategoryId     int64                  `protobuf:"varint,12,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // категория поста после правки
+	Tags           []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	Reason         string                 `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`                         // почему текст задержан
+	CreatedAt      int64                  `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp задержки
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RefreshTokenResponse) Reset() {
-	*x = RefreshTokenResponse{}
-	mi := &file_proto_forum_proto_msgTypes[6]
+func (x *HeldContent) Reset() {
+	*x = HeldContent{}
+	mi := &file_proto_forum_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshTokenResponse) String() string {
+func (x *HeldContent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenResponse) ProtoMessage() {}
+func (*HeldContent) ProtoMessage() {}
 
-func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[6]
+func (x *HeldContent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,166 +6981,139 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
-func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use HeldContent.ProtoReflect.Descriptor instead.
+func (*HeldContent) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *RefreshTokenResponse) GetAccessToken() string {
+func (x *HeldContent) GetId() int64 {
 	if x != nil {
-		return x.AccessToken
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *RefreshTokenResponse) GetRefreshToken() string {
+func (x *HeldContent) GetTargetType() HeldTarget {
 	if x != nil {
-		return x.RefreshToken
+		return x.TargetType
 	}
-	return ""
+	return HeldTarget_HELD_TARGET_UNSPECIFIED
 }
 
-func (x *RefreshTokenResponse) GetExpiresAt() int64 {
+func (x *HeldContent) GetTargetId() int64 {
 	if x != nil {
-		return x.ExpiresAt
+		return x.TargetId
 	}
 	return 0
 }
 
-type ValidateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ValidateRequest) Reset() {
-	*x = ValidateRequest{}
-	mi := &file_proto_forum_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *HeldContent) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
 }
 
-func (x *ValidateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HeldContent) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
 }
 
-func (*ValidateRequest) ProtoMessage() {}
-
-func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[7]
+func (x *HeldContent) GetIsEdit() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IsEdit
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
-func (*ValidateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{7}
+func (x *HeldContent) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
 }
 
-func (x *ValidateRequest) GetAccessToken() string {
+func (x *HeldContent) GetAuthorUsername() string {
 	if x != nil {
-		return x.AccessToken
+		return x.AuthorUsername
 	}
 	return ""
 }
 
-type ValidateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsValid       bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ValidateResponse) Reset() {
-	*x = ValidateResponse{}
-	mi := &file_proto_forum_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ValidateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HeldContent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (*ValidateResponse) ProtoMessage() {}
-
-func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[8]
+func (x *HeldContent) GetContent() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Content
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
-func (*ValidateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{8}
+func (x *HeldContent) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
 }
 
-func (x *ValidateResponse) GetIsValid() bool {
+func (x *HeldContent) GetCategoryId() int64 {
 	if x != nil {
-		return x.IsValid
+		return x.CategoryId
 	}
-	return false
+	return 0
 }
 
-func (x *ValidateResponse) GetUserId() int64 {
+func (x *HeldContent) GetTags() []string {
 	if x != nil {
-		return x.UserId
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *ValidateResponse) GetUsername() string {
+func (x *HeldContent) GetReason() string {
 	if x != nil {
-		return x.Username
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *ValidateResponse) GetIsAdmin() bool {
+func (x *HeldContent) GetCreatedAt() int64 {
 	if x != nil {
-		return x.IsAdmin
+		return x.CreatedAt
 	}
-	return false
+	return 0
 }
 
-type LogoutRequest struct {
+type ListHeldRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию 50
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutRequest) Reset() {
-	*x = LogoutRequest{}
-	mi := &file_proto_forum_proto_msgTypes[9]
+func (x *ListHeldRequest) Reset() {
+	*x = ListHeldRequest{}
+	mi := &file_proto_forum_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutRequest) String() string {
+func (x *ListHeldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutRequest) ProtoMessage() {}
+func (*ListHeldRequest) ProtoMessage() {}
 
-func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[9]
+func (x *ListHeldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,40 +7124,47 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
-func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListHeldRequest.ProtoReflect.Descriptor instead.
+func (*ListHeldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *LogoutRequest) GetAccessToken() string {
+func (x *ListHeldRequest) GetLimit() int32 {
 	if x != nil {
-		return x.AccessToken
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type LogoutResponse struct {
+func (x *ListHeldRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListHeldResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Items         []*HeldContent         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // давно ждущие первыми
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
-	mi := &file_proto_forum_proto_msgTypes[10]
+func (x *ListHeldResponse) Reset() {
+	*x = ListHeldResponse{}
+	mi := &file_proto_forum_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutResponse) String() string {
+func (x *ListHeldResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutResponse) ProtoMessage() {}
+func (*ListHeldResponse) ProtoMessage() {}
 
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[10]
+func (x *ListHeldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,47 +7175,42 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListHeldResponse.ProtoReflect.Descriptor instead.
+func (*ListHeldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *LogoutResponse) GetSuccess() bool {
+func (x *ListHeldResponse) GetItems() []*HeldContent {
 	if x != nil {
-		return x.Success
+		return x.Items
 	}
-	return false
+	return nil
 }
 
-// ================== Post Service ==================
-type Post struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content        string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorId       int64                  `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	AuthorUsername string                 `protobuf:"bytes,5,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
-	CreatedAt      int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CommentCount   int32                  `protobuf:"varint,7,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ModerateHeldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HeldId        int64                  `protobuf:"varint,1,opt,name=held_id,json=heldId,proto3" json:"held_id,omitempty"`
+	Decision      HeldDecision           `protobuf:"varint,2,opt,name=decision,proto3,enum=proto.HeldDecision" json:"decision,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // для HELD_DECISION_REJECT — причина удаления нового поста
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
-	mi := &file_proto_forum_proto_msgTypes[11]
+func (x *ModerateHeldRequest) Reset() {
+	*x = ModerateHeldRequest{}
+	mi := &file_proto_forum_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) String() string {
+func (x *ModerateHeldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*ModerateHeldRequest) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[11]
+func (x *ModerateHeldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,82 +7221,54 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ModerateHeldRequest.ProtoReflect.Descriptor instead.
+func (*ModerateHeldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *Post) GetId() int64 {
+func (x *ModerateHeldRequest) GetHeldId() int64 {
 	if x != nil {
-		return x.Id
+		return x.HeldId
 	}
 	return 0
 }
 
-func (x *Post) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Post) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
-
-func (x *Post) GetAuthorId() int64 {
+func (x *ModerateHeldRequest) GetDecision() HeldDecision {
 	if x != nil {
-		return x.AuthorId
+		return x.Decision
 	}
-	return 0
+	return HeldDecision_HELD_DECISION_UNSPECIFIED
 }
 
-func (x *Post) GetAuthorUsername() string {
+func (x *ModerateHeldRequest) GetReason() string {
 	if x != nil {
-		return x.AuthorUsername
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Post) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *Post) GetCommentCount() int32 {
-	if x != nil {
-		return x.CommentCount
-	}
-	return 0
-}
-
-type PostResponse struct {
+type ModerateHeldResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Held          *HeldContent           `protobuf:"bytes,1,opt,name=held,proto3" json:"held,omitempty"` // для одобренного нового комментария target_id — его ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostResponse) Reset() {
-	*x = PostResponse{}
-	mi := &file_proto_forum_proto_msgTypes[12]
+func (x *ModerateHeldResponse) Reset() {
+	*x = ModerateHeldResponse{}
+	mi := &file_proto_forum_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostResponse) String() string {
+func (x *ModerateHeldResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostResponse) ProtoMessage() {}
+func (*ModerateHeldResponse) ProtoMessage() {}
 
-func (x *PostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[12]
+func (x *ModerateHeldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,43 +7279,44 @@ func (x *PostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostResponse.ProtoReflect.Descriptor instead.
-func (*PostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ModerateHeldResponse.ProtoReflect.Descriptor instead.
+func (*ModerateHeldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *PostResponse) GetPost() *Post {
+func (x *ModerateHeldResponse) GetHeld() *HeldContent {
 	if x != nil {
-		return x.Post
+		return x.Held
 	}
 	return nil
 }
 
-type CreatePostRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorId       int64                  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	AuthorUsername string                 `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// ================== Chat Service ==================
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	Id            int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`                                // для жалоб; при отправке не заполняется
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	mi := &file_proto_forum_proto_msgTypes[13]
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_proto_forum_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *ChatMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*ChatMessage) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[13]
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,61 +7327,60 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *CreatePostRequest) GetTitle() string {
+func (x *ChatMessage) GetUserId() int64 {
 	if x != nil {
-		return x.Title
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreatePostRequest) GetContent() string {
+func (x *ChatMessage) GetContent() string {
 	if x != nil {
 		return x.Content
 	}
 	return ""
 }
 
-func (x *CreatePostRequest) GetAuthorId() int64 {
+func (x *ChatMessage) GetCreatedAt() int64 {
 	if x != nil {
-		return x.AuthorId
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *CreatePostRequest) GetAuthorUsername() string {
+func (x *ChatMessage) GetId() int64 {
 	if x != nil {
-		return x.AuthorUsername
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type GetPostRequest struct {
+type GetMessagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostRequest) Reset() {
-	*x = GetPostRequest{}
-	mi := &file_proto_forum_proto_msgTypes[14]
+func (x *GetMessagesRequest) Reset() {
+	*x = GetMessagesRequest{}
+	mi := &file_proto_forum_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostRequest) String() string {
+func (x *GetMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostRequest) ProtoMessage() {}
+func (*GetMessagesRequest) ProtoMessage() {}
 
-func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[14]
+func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,42 +7391,34 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
-func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetPostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{101}
 }
 
-type UpdatePostRequest struct {
+type GetMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Content       *string                `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostRequest) Reset() {
-	*x = UpdatePostRequest{}
-	mi := &file_proto_forum_proto_msgTypes[15]
+func (x *GetMessagesResponse) Reset() {
+	*x = GetMessagesResponse{}
+	mi := &file_proto_forum_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostRequest) String() string {
+func (x *GetMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostRequest) ProtoMessage() {}
+func (*GetMessagesResponse) ProtoMessage() {}
 
-func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[15]
+func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,54 +7429,49 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *UpdatePostRequest) GetPostId() int64 {
+func (x *GetMessagesResponse) GetMessages() []*ChatMessage {
 	if x != nil {
-		return x.PostId
-	}
-	return 0
-}
-
-func (x *UpdatePostRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdatePostRequest) GetContent() string {
-	if x != nil && x.Content != nil {
-		return *x.Content
+func (x *GetMessagesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-type DeletePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ChatConfig struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	MessageLifetimeMinutes int32                  `protobuf:"varint,1,opt,name=message_lifetime_minutes,json=messageLifetimeMinutes,proto3" json:"message_lifetime_minutes,omitempty"` // Время жизни сообщений
+	MaxMessageLength       int32                  `protobuf:"varint,2,opt,name=max_message_length,json=maxMessageLength,proto3" json:"max_message_length,omitempty"`                   // Максимальная длина сообщения
+	OnlyAuthenticated      bool                   `protobuf:"varint,3,opt,name=only_authenticated,json=onlyAuthenticated,proto3" json:"only_authenticated,omitempty"`                  // Только для авторизованных
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
-
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	mi := &file_proto_forum_proto_msgTypes[16]
+
+func (x *ChatConfig) Reset() {
+	*x = ChatConfig{}
+	mi := &file_proto_forum_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostRequest) String() string {
+func (x *ChatConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (*ChatConfig) ProtoMessage() {}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[16]
+func (x *ChatConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,40 +7482,57 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ChatConfig.ProtoReflect.Descriptor instead.
+func (*ChatConfig) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *DeletePostRequest) GetPostId() int64 {
+func (x *ChatConfig) GetMessageLifetimeMinutes() int32 {
 	if x != nil {
-		return x.PostId
+		return x.MessageLifetimeMinutes
 	}
 	return 0
 }
 
-type ListPostsRequest struct {
+func (x *ChatConfig) GetMaxMessageLength() int32 {
+	if x != nil {
+		return x.MaxMessageLength
+	}
+	return 0
+}
+
+func (x *ChatConfig) GetOnlyAuthenticated() bool {
+	if x != nil {
+		return x.OnlyAuthenticated
+	}
+	return false
+}
+
+// Общая модель пользователя для всех сервисов
+type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuthorId      *int64                 `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3,oneof" json:"author_id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPostsRequest) Reset() {
-	*x = ListPostsRequest{}
-	mi := &file_proto_forum_proto_msgTypes[17]
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_proto_forum_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPostsRequest) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPostsRequest) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[17]
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,41 +7543,54 @@ func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
-func (*ListPostsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *ListPostsRequest) GetAuthorId() int64 {
-	if x != nil && x.AuthorId != nil {
-		return *x.AuthorId
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-type ListPostsResponse struct {
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPostsResponse) Reset() {
-	*x = ListPostsResponse{}
-	mi := &file_proto_forum_proto_msgTypes[18]
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_proto_forum_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPostsResponse) String() string {
+func (x *GetUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPostsResponse) ProtoMessage() {}
+func (*GetUserRequest) ProtoMessage() {}
 
-func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[18]
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,53 +7601,45 @@ func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
-func (*ListPostsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *ListPostsResponse) GetPosts() []*Post {
-	if x != nil {
-		return x.Posts
-	}
-	return nil
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *ListPostsResponse) GetTotalCount() int32 {
+func (x *GetUserRequest) GetUserId() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.UserId
 	}
 	return 0
 }
 
-// ================== Comment Service ==================
-type Comment struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorId       int64                  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	AuthorUsername string                 `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
-	PostId         int64                  `protobuf:"varint,5,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	CreatedAt      int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	PostCount     int32                  `protobuf:"varint,4,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	CommentCount  int32                  `protobuf:"varint,5,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,6,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
-	mi := &file_proto_forum_proto_msgTypes[19]
+func (x *UserProfileResponse) Reset() {
+	*x = UserProfileResponse{}
+	mi := &file_proto_forum_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) String() string {
+func (x *UserProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*UserProfileResponse) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[19]
+func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,122 +7650,76 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UserProfileResponse.ProtoReflect.Descriptor instead.
+func (*UserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *Comment) GetId() int64 {
+func (x *UserProfileResponse) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *Comment) GetContent() string {
+func (x *UserProfileResponse) GetUsername() string {
 	if x != nil {
-		return x.Content
+		return x.Username
 	}
 	return ""
 }
 
-func (x *Comment) GetAuthorId() int64 {
+func (x *UserProfileResponse) GetCreatedAt() int64 {
 	if x != nil {
-		return x.AuthorId
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *Comment) GetAuthorUsername() string {
+func (x *UserProfileResponse) GetPostCount() int32 {
 	if x != nil {
-		return x.AuthorUsername
+		return x.PostCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Comment) GetPostId() int64 {
+func (x *UserProfileResponse) GetCommentCount() int32 {
 	if x != nil {
-		return x.PostId
+		return x.CommentCount
 	}
 	return 0
 }
 
-func (x *Comment) GetCreatedAt() int64 {
+func (x *UserProfileResponse) GetIsAdmin() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.IsAdmin
 	}
-	return 0
+	return false
 }
 
-type CommentResponse struct {
+type Error struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Code          ErrorCode              `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ErrorCode" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommentResponse) Reset() {
-	*x = CommentResponse{}
-	mi := &file_proto_forum_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CommentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CommentResponse) ProtoMessage() {}
-
-func (x *CommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[20]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
-func (*CommentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *CommentResponse) GetComment() *Comment {
-	if x != nil {
-		return x.Comment
-	}
-	return nil
-}
-
-type CreateCommentRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Content        string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorId       int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	PostId         int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	AuthorUsername string                 `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *CreateCommentRequest) Reset() {
-	*x = CreateCommentRequest{}
-	mi := &file_proto_forum_proto_msgTypes[21]
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_proto_forum_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentRequest) String() string {
+func (x *Error) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentRequest) ProtoMessage() {}
+func (*Error) ProtoMessage() {}
 
-func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[21]
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1266,61 +7730,47 @@ func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *CreateCommentRequest) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
-
-func (x *CreateCommentRequest) GetAuthorId() int64 {
-	if x != nil {
-		return x.AuthorId
-	}
-	return 0
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *CreateCommentRequest) GetPostId() int64 {
+func (x *Error) GetCode() ErrorCode {
 	if x != nil {
-		return x.PostId
+		return x.Code
 	}
-	return 0
+	return ErrorCode_ERROR_UNSPECIFIED
 }
 
-func (x *CreateCommentRequest) GetAuthorUsername() string {
+func (x *Error) GetMessage() string {
 	if x != nil {
-		return x.AuthorUsername
+		return x.Message
 	}
 	return ""
 }
 
-type GetCommentRequest struct {
+type LookupUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Usernames     []string               `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"` // не больше 100 имён
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentRequest) Reset() {
-	*x = GetCommentRequest{}
-	mi := &file_proto_forum_proto_msgTypes[22]
+func (x *LookupUsersRequest) Reset() {
+	*x = LookupUsersRequest{}
+	mi := &file_proto_forum_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentRequest) String() string {
+func (x *LookupUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentRequest) ProtoMessage() {}
+func (*LookupUsersRequest) ProtoMessage() {}
 
-func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[22]
+func (x *LookupUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,40 +7781,41 @@ func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use LookupUsersRequest.ProtoReflect.Descriptor instead.
+func (*LookupUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetCommentRequest) GetCommentId() int64 {
+func (x *LookupUsersRequest) GetUsernames() []string {
 	if x != nil {
-		return x.CommentId
+		return x.Usernames
 	}
-	return 0
+	return nil
 }
 
-type GetCommentsByPostIDRequest struct {
+type UserSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentsByPostIDRequest) Reset() {
-	*x = GetCommentsByPostIDRequest{}
-	mi := &file_proto_forum_proto_msgTypes[23]
+func (x *UserSummary) Reset() {
+	*x = UserSummary{}
+	mi := &file_proto_forum_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsByPostIDRequest) String() string {
+func (x *UserSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsByPostIDRequest) ProtoMessage() {}
+func (*UserSummary) ProtoMessage() {}
 
-func (x *GetCommentsByPostIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[23]
+func (x *UserSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1375,40 +7826,47 @@ func (x *GetCommentsByPostIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsByPostIDRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentsByPostIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
+func (*UserSummary) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetCommentsByPostIDRequest) GetPostId() int64 {
+func (x *UserSummary) GetUserId() int64 {
 	if x != nil {
-		return x.PostId
+		return x.UserId
 	}
 	return 0
 }
 
-type ListCommentsRequest struct {
+func (x *UserSummary) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type LookupUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Users         []*UserSummary         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommentsRequest) Reset() {
-	*x = ListCommentsRequest{}
-	mi := &file_proto_forum_proto_msgTypes[24]
+func (x *LookupUsersResponse) Reset() {
+	*x = LookupUsersResponse{}
+	mi := &file_proto_forum_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentsRequest) String() string {
+func (x *LookupUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentsRequest) ProtoMessage() {}
+func (*LookupUsersResponse) ProtoMessage() {}
 
-func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[24]
+func (x *LookupUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,41 +7877,41 @@ func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use LookupUsersResponse.ProtoReflect.Descriptor instead.
+func (*LookupUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ListCommentsRequest) GetPostId() int64 {
+func (x *LookupUsersResponse) GetUsers() []*UserSummary {
 	if x != nil {
-		return x.PostId
+		return x.Users
 	}
-	return 0
+	return nil
 }
 
-type ListCommentsResponse struct {
+type ChangeUsernameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommentsResponse) Reset() {
-	*x = ListCommentsResponse{}
-	mi := &file_proto_forum_proto_msgTypes[25]
+func (x *ChangeUsernameRequest) Reset() {
+	*x = ChangeUsernameRequest{}
+	mi := &file_proto_forum_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentsResponse) String() string {
+func (x *ChangeUsernameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentsResponse) ProtoMessage() {}
+func (*ChangeUsernameRequest) ProtoMessage() {}
 
-func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[25]
+func (x *ChangeUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,48 +7922,50 @@ func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ChangeUsernameRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *ListCommentsResponse) GetComments() []*Comment {
+func (x *ChangeUsernameRequest) GetAccessToken() string {
 	if x != nil {
-		return x.Comments
+		return x.AccessToken
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCommentsResponse) GetTotalCount() int32 {
+func (x *ChangeUsernameRequest) GetUsername() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-type UpdateCommentRequest struct {
+type ChangeUsernameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	Content       *string                `protobuf:"bytes,2,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCommentRequest) Reset() {
-	*x = UpdateCommentRequest{}
-	mi := &file_proto_forum_proto_msgTypes[26]
+func (x *ChangeUsernameResponse) Reset() {
+	*x = ChangeUsernameResponse{}
+	mi := &file_proto_forum_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCommentRequest) String() string {
+func (x *ChangeUsernameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCommentRequest) ProtoMessage() {}
+func (*ChangeUsernameResponse) ProtoMessage() {}
 
-func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[26]
+func (x *ChangeUsernameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,47 +7976,62 @@ func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ChangeUsernameResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUsernameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *UpdateCommentRequest) GetCommentId() int64 {
+func (x *ChangeUsernameResponse) GetAccessToken() string {
 	if x != nil {
-		return x.CommentId
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ChangeUsernameResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ChangeUsernameResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-func (x *UpdateCommentRequest) GetContent() string {
-	if x != nil && x.Content != nil {
-		return *x.Content
+func (x *ChangeUsernameResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-type DeleteCommentRequest struct {
+type ListUserEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	AfterId       int64                  `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // не больше 500, по умолчанию 100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
-	mi := &file_proto_forum_proto_msgTypes[27]
+func (x *ListUserEventsRequest) Reset() {
+	*x = ListUserEventsRequest{}
+	mi := &file_proto_forum_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *ListUserEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*ListUserEventsRequest) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[27]
+func (x *ListUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,43 +8042,51 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *DeleteCommentRequest) GetCommentId() int64 {
+func (x *ListUserEventsRequest) GetAfterId() int64 {
 	if x != nil {
-		return x.CommentId
+		return x.AfterId
 	}
 	return 0
 }
 
-// ================== Chat Service ==================
-type ChatMessage struct {
+func (x *ListUserEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UserEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                             // "user.updated"
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`                     // имя после изменения
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatMessage) Reset() {
-	*x = ChatMessage{}
-	mi := &file_proto_forum_proto_msgTypes[28]
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	mi := &file_proto_forum_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatMessage) String() string {
+func (x *UserEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatMessage) ProtoMessage() {}
+func (*UserEvent) ProtoMessage() {}
 
-func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[28]
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,53 +8097,68 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
-func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ChatMessage) GetUserId() int64 {
+func (x *UserEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserEvent) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *ChatMessage) GetContent() string {
+func (x *UserEvent) GetType() string {
 	if x != nil {
-		return x.Content
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetCreatedAt() int64 {
+func (x *UserEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserEvent) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-type GetMessagesRequest struct {
+type ListUserEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*UserEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessagesRequest) Reset() {
-	*x = GetMessagesRequest{}
-	mi := &file_proto_forum_proto_msgTypes[29]
+func (x *ListUserEventsResponse) Reset() {
+	*x = ListUserEventsResponse{}
+	mi := &file_proto_forum_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessagesRequest) String() string {
+func (x *ListUserEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessagesRequest) ProtoMessage() {}
+func (*ListUserEventsResponse) ProtoMessage() {}
 
-func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[29]
+func (x *ListUserEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1671,34 +8169,40 @@ func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListUserEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{115}
 }
 
-type GetMessagesResponse struct {
+func (x *ListUserEventsResponse) GetEvents() []*UserEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CheckAdminRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessagesResponse) Reset() {
-	*x = GetMessagesResponse{}
-	mi := &file_proto_forum_proto_msgTypes[30]
+func (x *CheckAdminRequest) Reset() {
+	*x = CheckAdminRequest{}
+	mi := &file_proto_forum_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessagesResponse) String() string {
+func (x *CheckAdminRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessagesResponse) ProtoMessage() {}
+func (*CheckAdminRequest) ProtoMessage() {}
 
-func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[30]
+func (x *CheckAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1709,49 +8213,94 @@ func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use CheckAdminRequest.ProtoReflect.Descriptor instead.
+func (*CheckAdminRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetMessagesResponse) GetMessages() []*ChatMessage {
+func (x *CheckAdminRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Messages
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetMessagesResponse) GetTotalCount() int32 {
+type CheckAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsAdmin       bool                   `protobuf:"varint,1,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAdminResponse) Reset() {
+	*x = CheckAdminResponse{}
+	mi := &file_proto_forum_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAdminResponse) ProtoMessage() {}
+
+func (x *CheckAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAdminResponse.ProtoReflect.Descriptor instead.
+func (*CheckAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CheckAdminResponse) GetIsAdmin() bool {
 	if x != nil {
-		return x.TotalCount
+		return x.IsAdmin
 	}
-	return 0
+	return false
 }
 
-type ChatConfig struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	MessageLifetimeMinutes int32                  `protobuf:"varint,1,opt,name=message_lifetime_minutes,json=messageLifetimeMinutes,proto3" json:"message_lifetime_minutes,omitempty"` // Время жизни сообщений
-	MaxMessageLength       int32                  `protobuf:"varint,2,opt,name=max_message_length,json=maxMessageLength,proto3" json:"max_message_length,omitempty"`                   // Максимальная длина сообщения
-	OnlyAuthenticated      bool                   `protobuf:"varint,3,opt,name=only_authenticated,json=onlyAuthenticated,proto3" json:"only_authenticated,omitempty"`                  // Только для авторизованных
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetType    AttachmentTarget       `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=proto.AttachmentTarget" json:"target_type,omitempty"`
+	TargetId      int64                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	UploaderId    int64                  `protobuf:"varint,4,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`     // определяется по содержимому файла
+	Size          int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`                                     // в байтах, вместе с превью
+	HasThumbnail  bool                   `protobuf:"varint,8,opt,name=has_thumbnail,json=hasThumbnail,proto3" json:"has_thumbnail,omitempty"` // есть превью: только у изображений
+	Width         int32                  `protobuf:"varint,9,opt,name=width,proto3" json:"width,omitempty"`                                   // 0 для не-изображений
+	Height        int32                  `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatConfig) Reset() {
-	*x = ChatConfig{}
-	mi := &file_proto_forum_proto_msgTypes[31]
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_proto_forum_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatConfig) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatConfig) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *ChatConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[31]
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1762,115 +8311,110 @@ func (x *ChatConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatConfig.ProtoReflect.Descriptor instead.
-func (*ChatConfig) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *ChatConfig) GetMessageLifetimeMinutes() int32 {
+func (x *Attachment) GetId() int64 {
 	if x != nil {
-		return x.MessageLifetimeMinutes
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ChatConfig) GetMaxMessageLength() int32 {
+func (x *Attachment) GetTargetType() AttachmentTarget {
 	if x != nil {
-		return x.MaxMessageLength
+		return x.TargetType
 	}
-	return 0
+	return AttachmentTarget_ATTACHMENT_TARGET_POST
 }
 
-func (x *ChatConfig) GetOnlyAuthenticated() bool {
+func (x *Attachment) GetTargetId() int64 {
 	if x != nil {
-		return x.OnlyAuthenticated
+		return x.TargetId
 	}
-	return false
+	return 0
 }
 
-// Общая модель пользователя для всех сервисов
-type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Attachment) GetUploaderId() int64 {
+	if x != nil {
+		return x.UploaderId
+	}
+	return 0
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_proto_forum_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
 }
 
-func (x *User) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
-func (*User) ProtoMessage() {}
-
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[32]
+func (x *Attachment) GetSize() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Size
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{32}
+func (x *Attachment) GetHasThumbnail() bool {
+	if x != nil {
+		return x.HasThumbnail
+	}
+	return false
 }
 
-func (x *User) GetId() int64 {
+func (x *Attachment) GetWidth() int32 {
 	if x != nil {
-		return x.Id
+		return x.Width
 	}
 	return 0
 }
 
-func (x *User) GetUsername() string {
+func (x *Attachment) GetHeight() int32 {
 	if x != nil {
-		return x.Username
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *User) GetCreatedAt() int64 {
+func (x *Attachment) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-type GetUserRequest struct {
+type AttachmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
-	mi := &file_proto_forum_proto_msgTypes[33]
+func (x *AttachmentResponse) Reset() {
+	*x = AttachmentResponse{}
+	mi := &file_proto_forum_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *AttachmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*AttachmentResponse) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[33]
+func (x *AttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,45 +8425,43 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use AttachmentResponse.ProtoReflect.Descriptor instead.
+func (*AttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetUserRequest) GetUserId() int64 {
+func (x *AttachmentResponse) GetAttachment() *Attachment {
 	if x != nil {
-		return x.UserId
+		return x.Attachment
 	}
-	return 0
+	return nil
 }
 
-type UserProfileResponse struct {
+type UploadAttachmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
-	PostCount     int32                  `protobuf:"varint,4,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
-	CommentCount  int32                  `protobuf:"varint,5,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	IsAdmin       bool                   `protobuf:"varint,6,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	TargetType    AttachmentTarget       `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=proto.AttachmentTarget" json:"target_type,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserProfileResponse) Reset() {
-	*x = UserProfileResponse{}
-	mi := &file_proto_forum_proto_msgTypes[34]
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_proto_forum_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserProfileResponse) String() string {
+func (x *UploadAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfileResponse) ProtoMessage() {}
+func (*UploadAttachmentRequest) ProtoMessage() {}
 
-func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[34]
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1930,76 +8472,62 @@ func (x *UserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfileResponse.ProtoReflect.Descriptor instead.
-func (*UserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *UserProfileResponse) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *UserProfileResponse) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *UserProfileResponse) GetCreatedAt() int64 {
+func (x *UploadAttachmentRequest) GetTargetType() AttachmentTarget {
 	if x != nil {
-		return x.CreatedAt
+		return x.TargetType
 	}
-	return 0
+	return AttachmentTarget_ATTACHMENT_TARGET_POST
 }
 
-func (x *UserProfileResponse) GetPostCount() int32 {
+func (x *UploadAttachmentRequest) GetTargetId() int64 {
 	if x != nil {
-		return x.PostCount
+		return x.TargetId
 	}
 	return 0
 }
 
-func (x *UserProfileResponse) GetCommentCount() int32 {
+func (x *UploadAttachmentRequest) GetFileName() string {
 	if x != nil {
-		return x.CommentCount
+		return x.FileName
 	}
-	return 0
+	return ""
 }
 
-func (x *UserProfileResponse) GetIsAdmin() bool {
+func (x *UploadAttachmentRequest) GetData() []byte {
 	if x != nil {
-		return x.IsAdmin
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-type Error struct {
+type GetAttachmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          ErrorCode              `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ErrorCode" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Thumbnail     bool                   `protobuf:"varint,2,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"` // отдать превью вместо исходного файла
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Error) Reset() {
-	*x = Error{}
-	mi := &file_proto_forum_proto_msgTypes[35]
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	mi := &file_proto_forum_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Error) String() string {
+func (x *GetAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Error) ProtoMessage() {}
+func (*GetAttachmentRequest) ProtoMessage() {}
 
-func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[35]
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2010,47 +8538,48 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Error.ProtoReflect.Descriptor instead.
-func (*Error) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *Error) GetCode() ErrorCode {
+func (x *GetAttachmentRequest) GetId() int64 {
 	if x != nil {
-		return x.Code
+		return x.Id
 	}
-	return ErrorCode_ERROR_UNSPECIFIED
+	return 0
 }
 
-func (x *Error) GetMessage() string {
+func (x *GetAttachmentRequest) GetThumbnail() bool {
 	if x != nil {
-		return x.Message
+		return x.Thumbnail
 	}
-	return ""
+	return false
 }
 
-type CheckAdminRequest struct {
+type AttachmentContentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckAdminRequest) Reset() {
-	*x = CheckAdminRequest{}
-	mi := &file_proto_forum_proto_msgTypes[36]
+func (x *AttachmentContentResponse) Reset() {
+	*x = AttachmentContentResponse{}
+	mi := &file_proto_forum_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAdminRequest) String() string {
+func (x *AttachmentContentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAdminRequest) ProtoMessage() {}
+func (*AttachmentContentResponse) ProtoMessage() {}
 
-func (x *CheckAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[36]
+func (x *AttachmentContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2061,40 +8590,47 @@ func (x *CheckAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAdminRequest.ProtoReflect.Descriptor instead.
-func (*CheckAdminRequest) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AttachmentContentResponse.ProtoReflect.Descriptor instead.
+func (*AttachmentContentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *CheckAdminRequest) GetUserId() int64 {
+func (x *AttachmentContentResponse) GetAttachment() *Attachment {
 	if x != nil {
-		return x.UserId
+		return x.Attachment
 	}
-	return 0
+	return nil
 }
 
-type CheckAdminResponse struct {
+func (x *AttachmentContentResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteAttachmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsAdmin       bool                   `protobuf:"varint,1,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckAdminResponse) Reset() {
-	*x = CheckAdminResponse{}
-	mi := &file_proto_forum_proto_msgTypes[37]
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_proto_forum_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAdminResponse) String() string {
+func (x *DeleteAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAdminResponse) ProtoMessage() {}
+func (*DeleteAttachmentRequest) ProtoMessage() {}
 
-func (x *CheckAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_forum_proto_msgTypes[37]
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_forum_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2105,16 +8641,16 @@ func (x *CheckAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAdminResponse.ProtoReflect.Descriptor instead.
-func (*CheckAdminResponse) Descriptor() ([]byte, []int) {
-	return file_proto_forum_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_forum_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *CheckAdminResponse) GetIsAdmin() bool {
+func (x *DeleteAttachmentRequest) GetId() int64 {
 	if x != nil {
-		return x.IsAdmin
+		return x.Id
 	}
-	return false
+	return 0
 }
 
 var File_proto_forum_proto protoreflect.FileDescriptor
@@ -2153,11 +8689,32 @@ const file_proto_forum_proto_rawDesc = "" +
 	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x19\n" +
-	"\bis_admin\x18\x04 \x01(\bR\aisAdmin\"2\n" +
+	"\bis_admin\x18\x04 \x01(\bR\aisAdmin\"l\n" +
+	"\x12ExportUsersRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12%\n" +
+	"\x0ewith_passwords\x18\x03 \x01(\bR\rwithPasswords\"\x87\x01\n" +
+	"\fExportedUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12#\n" +
+	"\rpassword_hash\x18\x03 \x01(\tR\fpasswordHash\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"@\n" +
+	"\x13ExportUsersResponse\x12)\n" +
+	"\x05users\x18\x01 \x03(\v2\x13.proto.ExportedUserR\x05users\"\x9a\x01\n" +
+	"\x11ImportUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12#\n" +
+	"\rpassword_hash\x18\x02 \x01(\tR\fpasswordHash\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12%\n" +
+	"\x0emerge_existing\x18\x04 \x01(\bR\rmergeExisting\"^\n" +
+	"\x12ImportUserResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12/\n" +
+	"\x06result\x18\x02 \x01(\x0e2\x17.proto.ImportUserResultR\x06result\"2\n" +
 	"\rLogoutRequest\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"*\n" +
 	"\x0eLogoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd0\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9f\x06\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -2166,33 +8723,152 @@ const file_proto_forum_proto_rawDesc = "" +
 	"\x0fauthor_username\x18\x05 \x01(\tR\x0eauthorUsername\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12#\n" +
-	"\rcomment_count\x18\a \x01(\x05R\fcommentCount\"/\n" +
+	"\rcomment_count\x18\a \x01(\x05R\fcommentCount\x12\x1f\n" +
+	"\vcategory_id\x18\b \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x14\n" +
+	"\x05score\x18\n" +
+	" \x01(\x03R\x05score\x12\x17\n" +
+	"\amy_vote\x18\v \x01(\x05R\x06myVote\x12\x18\n" +
+	"\adeleted\x18\f \x01(\bR\adeleted\x12+\n" +
+	"\bdeletion\x18\r \x01(\v2\x0f.proto.DeletionR\bdeletion\x12!\n" +
+	"\fcontent_html\x18\x0e \x01(\tR\vcontentHtml\x123\n" +
+	"\vattachments\x18\x0f \x03(\v2\x11.proto.AttachmentR\vattachments\x12)\n" +
+	"\x06status\x18\x10 \x01(\x0e2\x11.proto.PostStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x11 \x01(\x03R\tpublishAt\x12\x1b\n" +
+	"\tis_pinned\x18\x12 \x01(\bR\bisPinned\x12\x1b\n" +
+	"\tis_locked\x18\x13 \x01(\bR\bisLocked\x12&\n" +
+	"\x0flast_comment_at\x18\x14 \x01(\x03R\rlastCommentAt\x12\x1e\n" +
+	"\n" +
+	"bookmarked\x18\x15 \x01(\bR\n" +
+	"bookmarked\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x16 \x01(\x03R\tviewCount\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x17 \x01(\x03R\tupdatedAt\x12\x1f\n" +
+	"\x04poll\x18\x18 \x01(\v2\v.proto.PollR\x04poll\x12\x1f\n" +
+	"\x04hold\x18\x19 \x01(\v2\v.proto.HoldR\x04hold\"7\n" +
+	"\x04Hold\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x17\n" +
+	"\aheld_at\x18\x02 \x01(\x03R\x06heldAt\"/\n" +
 	"\fPostResponse\x12\x1f\n" +
-	"\x04post\x18\x01 \x01(\v2\v.proto.PostR\x04post\"\x89\x01\n" +
+	"\x04post\x18\x01 \x01(\v2\v.proto.PostR\x04post\"\xcb\x02\n" +
 	"\x11CreatePostRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
-	"\tauthor_id\x18\x03 \x01(\x03R\bauthorId\x12'\n" +
-	"\x0fauthor_username\x18\x04 \x01(\tR\x0eauthorUsername\")\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1f\n" +
+	"\tauthor_id\x18\x03 \x01(\x03B\x02\x18\x01R\bauthorId\x12+\n" +
+	"\x0fauthor_username\x18\x04 \x01(\tB\x02\x18\x01R\x0eauthorUsername\x12$\n" +
+	"\vcategory_id\x18\x05 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12)\n" +
+	"\x06status\x18\a \x01(\x0e2\x11.proto.PostStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\b \x01(\x03R\tpublishAt\x12$\n" +
+	"\x04poll\x18\t \x01(\v2\x10.proto.PollInputR\x04pollB\x0e\n" +
+	"\f_category_id\"\x83\x01\n" +
+	"\tPollInput\x12\x18\n" +
+	"\aoptions\x18\x01 \x03(\tR\aoptions\x12'\n" +
+	"\x0fmultiple_choice\x18\x02 \x01(\bR\x0emultipleChoice\x12\x16\n" +
+	"\x06public\x18\x03 \x01(\bR\x06public\x12\x1b\n" +
+	"\tcloses_at\x18\x04 \x01(\x03R\bclosesAt\"J\n" +
 	"\x0eGetPostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\x03R\x06postId\"|\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1f\n" +
+	"\tviewer_id\x18\x02 \x01(\x03B\x02\x18\x01R\bviewerId\"\x1d\n" +
+	"\aTagList\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"\xf7\x01\n" +
 	"\x11UpdatePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
-	"\acontent\x18\x03 \x01(\tH\x01R\acontent\x88\x01\x01B\b\n" +
+	"\acontent\x18\x03 \x01(\tH\x01R\acontent\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x04 \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x12\"\n" +
+	"\x04tags\x18\x05 \x01(\v2\x0e.proto.TagListR\x04tags\x12\x1f\n" +
+	"\teditor_id\x18\x06 \x01(\x03B\x02\x18\x01R\beditorIdB\b\n" +
 	"\x06_titleB\n" +
 	"\n" +
-	"\b_content\",\n" +
+	"\b_contentB\x0e\n" +
+	"\f_category_id\"g\n" +
 	"\x11DeletePostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\x03R\x06postId\"B\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12!\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\x03B\x02\x18\x01R\tdeletedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xe2\x03\n" +
 	"\x10ListPostsRequest\x12 \n" +
-	"\tauthor_id\x18\x01 \x01(\x03H\x00R\bauthorId\x88\x01\x01B\f\n" +
+	"\tauthor_id\x18\x01 \x01(\x03H\x00R\bauthorId\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12&\n" +
+	"\x05order\x18\x04 \x01(\x0e2\x10.proto.SortOrderR\x05order\x12&\n" +
+	"\fcreated_from\x18\x05 \x01(\x03H\x01R\vcreatedFrom\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_to\x18\x06 \x01(\x03H\x02R\tcreatedTo\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\a \x01(\x03H\x03R\n" +
+	"categoryId\x88\x01\x01\x12\x10\n" +
+	"\x03tag\x18\b \x01(\tR\x03tag\x12\x1f\n" +
+	"\tviewer_id\x18\t \x01(\x03B\x02\x18\x01R\bviewerId\x12#\n" +
+	"\x04sort\x18\n" +
+	" \x01(\x0e2\x0f.proto.PostSortR\x04sort\x12)\n" +
+	"\x06window\x18\v \x01(\x0e2\x11.proto.TimeWindowR\x06window\x12#\n" +
+	"\rignore_pinned\x18\f \x01(\bR\fignorePinnedB\f\n" +
 	"\n" +
-	"_author_id\"W\n" +
+	"_author_idB\x0f\n" +
+	"\r_created_fromB\r\n" +
+	"\v_created_toB\x0e\n" +
+	"\f_category_id\"x\n" +
 	"\x11ListPostsResponse\x12!\n" +
 	"\x05posts\x18\x01 \x03(\v2\v.proto.PostR\x05posts\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xb1\x01\n" +
+	"totalCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"C\n" +
+	"\x13ListMyDraftsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"L\n" +
+	"\x12PublishPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\x03R\tpublishAt\"A\n" +
+	"\x0ePinPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x16\n" +
+	"\x06pinned\x18\x02 \x01(\bR\x06pinned\"B\n" +
+	"\x0fLockPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x16\n" +
+	"\x06locked\x18\x02 \x01(\bR\x06locked\"\x82\x01\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\"?\n" +
+	"\x10CategoryResponse\x12+\n" +
+	"\bcategory\x18\x01 \x01(\v2\x0f.proto.CategoryR\bcategory\"\x7f\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"5\n" +
+	"\x12GetCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\"\xe4\x01\n" +
+	"\x15UpdateCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x17\n" +
+	"\x04slug\x18\x02 \x01(\tH\x00R\x04slug\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x01R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\x05 \x01(\x05H\x03R\bposition\x88\x01\x01B\a\n" +
+	"\x05_slugB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_position\"8\n" +
+	"\x15DeleteCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\"\x17\n" +
+	"\x15ListCategoriesRequest\"I\n" +
+	"\x16ListCategoriesResponse\x12/\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x0f.proto.CategoryR\n" +
+	"categories\"\x91\x04\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
@@ -2200,39 +8876,320 @@ const file_proto_forum_proto_rawDesc = "" +
 	"\x0fauthor_username\x18\x04 \x01(\tR\x0eauthorUsername\x12\x17\n" +
 	"\apost_id\x18\x05 \x01(\x03R\x06postId\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\x03R\tcreatedAt\";\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1b\n" +
+	"\tparent_id\x18\a \x01(\x03R\bparentId\x12\x14\n" +
+	"\x05depth\x18\b \x01(\x05R\x05depth\x12\x12\n" +
+	"\x04path\x18\t \x03(\x03R\x04path\x12\x18\n" +
+	"\adeleted\x18\n" +
+	" \x01(\bR\adeleted\x12(\n" +
+	"\areplies\x18\v \x03(\v2\x0e.proto.CommentR\areplies\x12\x14\n" +
+	"\x05score\x18\f \x01(\x03R\x05score\x12\x17\n" +
+	"\amy_vote\x18\r \x01(\x05R\x06myVote\x12+\n" +
+	"\bdeletion\x18\x0e \x01(\v2\x0f.proto.DeletionR\bdeletion\x12!\n" +
+	"\fcontent_html\x18\x0f \x01(\tR\vcontentHtml\x123\n" +
+	"\vattachments\x18\x10 \x03(\v2\x11.proto.AttachmentR\vattachments\x12\x1f\n" +
+	"\x04hold\x18\x11 \x01(\v2\v.proto.HoldR\x04hold\";\n" +
 	"\x0fCommentResponse\x12(\n" +
-	"\acomment\x18\x01 \x01(\v2\x0e.proto.CommentR\acomment\"\x8f\x01\n" +
+	"\acomment\x18\x01 \x01(\v2\x0e.proto.CommentR\acomment\"\xc7\x01\n" +
 	"\x14CreateCommentRequest\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1b\n" +
-	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12\x17\n" +
-	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12'\n" +
-	"\x0fauthor_username\x18\x04 \x01(\tR\x0eauthorUsername\"2\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1f\n" +
+	"\tauthor_id\x18\x02 \x01(\x03B\x02\x18\x01R\bauthorId\x12\x17\n" +
+	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12+\n" +
+	"\x0fauthor_username\x18\x04 \x01(\tB\x02\x18\x01R\x0eauthorUsername\x12 \n" +
+	"\tparent_id\x18\x05 \x01(\x03H\x00R\bparentId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_id\"2\n" +
 	"\x11GetCommentRequest\x12\x1d\n" +
 	"\n" +
-	"comment_id\x18\x01 \x01(\x03R\tcommentId\"5\n" +
+	"comment_id\x18\x01 \x01(\x03R\tcommentId\"~\n" +
 	"\x1aGetCommentsByPostIDRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\x03R\x06postId\".\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12&\n" +
+	"\x04view\x18\x02 \x01(\x0e2\x12.proto.CommentViewR\x04view\x12\x1f\n" +
+	"\tviewer_id\x18\x03 \x01(\x03B\x02\x18\x01R\bviewerId\"w\n" +
 	"\x13ListCommentsRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\x03R\x06postId\"c\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12&\n" +
+	"\x04view\x18\x02 \x01(\x0e2\x12.proto.CommentViewR\x04view\x12\x1f\n" +
+	"\tviewer_id\x18\x03 \x01(\x03B\x02\x18\x01R\bviewerId\"c\n" +
 	"\x14ListCommentsResponse\x12*\n" +
 	"\bcomments\x18\x01 \x03(\v2\x0e.proto.CommentR\bcomments\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"`\n" +
+	"totalCount\"\x81\x01\n" +
 	"\x14UpdateCommentRequest\x12\x1d\n" +
 	"\n" +
 	"comment_id\x18\x01 \x01(\x03R\tcommentId\x12\x1d\n" +
-	"\acontent\x18\x02 \x01(\tH\x00R\acontent\x88\x01\x01B\n" +
+	"\acontent\x18\x02 \x01(\tH\x00R\acontent\x88\x01\x01\x12\x1f\n" +
+	"\teditor_id\x18\x03 \x01(\x03B\x02\x18\x01R\beditorIdB\n" +
 	"\n" +
-	"\b_content\"5\n" +
+	"\b_content\"p\n" +
 	"\x14DeleteCommentRequest\x12\x1d\n" +
 	"\n" +
-	"comment_id\x18\x01 \x01(\x03R\tcommentId\"_\n" +
+	"comment_id\x18\x01 \x01(\x03R\tcommentId\x12!\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\x03B\x02\x18\x01R\tdeletedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x88\x01\n" +
+	"\x12SearchPostsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12 \n" +
+	"\tauthor_id\x18\x02 \x01(\x03H\x00R\bauthorId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offsetB\f\n" +
+	"\n" +
+	"_author_id\"\x96\x02\n" +
+	"\tSearchHit\x12(\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x14.proto.SearchHitTypeR\x04type\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x03 \x01(\x03R\tcommentId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\asnippet\x18\x05 \x01(\tR\asnippet\x12\x1b\n" +
+	"\tauthor_id\x18\x06 \x01(\x03R\bauthorId\x12'\n" +
+	"\x0fauthor_username\x18\a \x01(\tR\x0eauthorUsername\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x12\n" +
+	"\x04rank\x18\t \x01(\x02R\x04rank\"\\\n" +
+	"\x13SearchPostsResponse\x12$\n" +
+	"\x04hits\x18\x01 \x03(\v2\x10.proto.SearchHitR\x04hits\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x95\x01\n" +
+	"\vVoteRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x126\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x15.proto.VoteTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x05R\x05value\"\x85\x01\n" +
+	"\x11RemoveVoteRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x126\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x15.proto.VoteTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\"\xeb\x01\n" +
+	"\x04Poll\x12+\n" +
+	"\aoptions\x18\x01 \x03(\v2\x11.proto.PollOptionR\aoptions\x12'\n" +
+	"\x0fmultiple_choice\x18\x02 \x01(\bR\x0emultipleChoice\x12\x16\n" +
+	"\x06public\x18\x03 \x01(\bR\x06public\x12\x1b\n" +
+	"\tcloses_at\x18\x04 \x01(\x03R\bclosesAt\x12\x16\n" +
+	"\x06closed\x18\x05 \x01(\bR\x06closed\x12!\n" +
+	"\ftotal_voters\x18\x06 \x01(\x03R\vtotalVoters\x12\x1d\n" +
+	"\n" +
+	"my_choices\x18\a \x03(\x03R\tmyChoices\"r\n" +
+	"\n" +
+	"PollOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
+	"\x05votes\x18\x03 \x01(\x03R\x05votes\x12*\n" +
+	"\x06voters\x18\x04 \x03(\v2\x12.proto.UserSummaryR\x06voters\"M\n" +
+	"\x13CastPollVoteRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x02 \x03(\x03R\toptionIds\"0\n" +
+	"\x15GetPollResultsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"/\n" +
+	"\fPollResponse\x12\x1f\n" +
+	"\x04poll\x18\x01 \x01(\v2\v.proto.PollR\x04poll\"=\n" +
+	"\fVoteResponse\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x03R\x05score\x12\x17\n" +
+	"\amy_vote\x18\x02 \x01(\x05R\x06myVote\"^\n" +
+	"\bBookmark\x12\x1f\n" +
+	"\x04post\x18\x01 \x01(\v2\v.proto.PostR\x04post\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\"A\n" +
+	"\x12AddBookmarkRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"0\n" +
+	"\x15RemoveBookmarkRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"D\n" +
+	"\x14ListBookmarksRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"?\n" +
+	"\x10BookmarkResponse\x12+\n" +
+	"\bbookmark\x18\x01 \x01(\v2\x0f.proto.BookmarkR\bbookmark\"F\n" +
+	"\x15ListBookmarksResponse\x12-\n" +
+	"\tbookmarks\x18\x01 \x03(\v2\x0f.proto.BookmarkR\tbookmarks\"\xcc\x02\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12+\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.proto.NotificationTypeR\x04type\x12\x17\n" +
+	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12\x1d\n" +
+	"\n" +
+	"post_title\x18\x04 \x01(\tR\tpostTitle\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x05 \x01(\x03R\tcommentId\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\x03R\aactorId\x12%\n" +
+	"\x0eactor_username\x18\a \x01(\tR\ractorUsername\x12\x14\n" +
+	"\x05count\x18\b \x01(\x05R\x05count\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12\x12\n" +
+	"\x04read\x18\v \x01(\bR\x04read\",\n" +
+	"\x11FollowPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"M\n" +
+	"\x12FollowPostResponse\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1e\n" +
+	"\n" +
+	"subscribed\x18\x02 \x01(\bR\n" +
+	"subscribed\"i\n" +
+	"\x18ListNotificationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\"y\n" +
+	"\x19ListNotificationsResponse\x129\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x13.proto.NotificationR\rnotifications\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x03R\vunreadCount\"#\n" +
+	"\x0fMarkReadRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"8\n" +
+	"\x13UnreadCountResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x03R\vunreadCount\"\xb8\x01\n" +
+	"\aMention\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1b\n" +
+	"\tauthor_id\x18\x05 \x01(\x03R\bauthorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"C\n" +
+	"\x13ListMentionsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"B\n" +
+	"\x14ListMentionsResponse\x12*\n" +
+	"\bmentions\x18\x01 \x03(\v2\x0e.proto.MentionR\bmentions\"=\n" +
+	"\bDiffLine\x12\x1d\n" +
+	"\x02op\x18\x01 \x01(\x0e2\r.proto.DiffOpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\xc8\x01\n" +
+	"\bRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1b\n" +
+	"\teditor_id\x18\x05 \x01(\x03R\beditorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12#\n" +
+	"\x04diff\x18\a \x03(\v2\x0f.proto.DiffLineR\x04diff\"2\n" +
+	"\x13GetRevisionsRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\"B\n" +
+	"\x11RevisionsResponse\x12-\n" +
+	"\trevisions\x18\x01 \x03(\v2\x0f.proto.RevisionR\trevisions\"p\n" +
+	"\x0fRollbackRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\x03R\n" +
+	"revisionId\x12\x1f\n" +
+	"\teditor_id\x18\x03 \x01(\x03B\x02\x18\x01R\beditorId\"`\n" +
+	"\bDeletion\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x01 \x01(\x03R\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\x03R\tdeletedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"l\n" +
+	"\x10ListTrashRequest\x12*\n" +
+	"\x06target\x18\x01 \x01(\x0e2\x12.proto.TrashTargetR\x06target\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"b\n" +
+	"\x11ListTrashResponse\x12!\n" +
+	"\x05posts\x18\x01 \x03(\v2\v.proto.PostR\x05posts\x12*\n" +
+	"\bcomments\x18\x02 \x03(\v2\x0e.proto.CommentR\bcomments\"-\n" +
+	"\x0eRestoreRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\"\xa9\x01\n" +
+	"\rReportRequest\x124\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2\x13.proto.ReportTargetR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12+\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x13.proto.ReportReasonR\x06reason\x12\x18\n" +
+	"\adetails\x18\x04 \x01(\tR\adetails\"\xdb\x03\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x124\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x13.proto.ReportTargetR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\x12(\n" +
+	"\x10target_author_id\x18\x04 \x01(\x03R\x0etargetAuthorId\x12%\n" +
+	"\x0etarget_content\x18\x05 \x01(\tR\rtargetContent\x12\x1f\n" +
+	"\vreporter_id\x18\x06 \x01(\x03R\n" +
+	"reporterId\x12+\n" +
+	"\x06reason\x18\a \x01(\x0e2\x13.proto.ReportReasonR\x06reason\x12\x18\n" +
+	"\adetails\x18\b \x01(\tR\adetails\x12+\n" +
+	"\x06status\x18\t \x01(\x0e2\x13.proto.ReportStatusR\x06status\x12\x1f\n" +
+	"\vresolved_by\x18\n" +
+	" \x01(\x03R\n" +
+	"resolvedBy\x12\x1f\n" +
+	"\vresolved_at\x18\v \x01(\x03R\n" +
+	"resolvedAt\x12'\n" +
+	"\x0fresolution_note\x18\f \x01(\tR\x0eresolutionNote\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\"7\n" +
+	"\x0eReportResponse\x12%\n" +
+	"\x06report\x18\x01 \x01(\v2\r.proto.ReportR\x06report\"\xba\x01\n" +
+	"\x12ListReportsRequest\x12+\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x13.proto.ReportStatusR\x06status\x129\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x13.proto.ReportTargetH\x00R\n" +
+	"targetType\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offsetB\x0e\n" +
+	"\f_target_type\"\xf3\x01\n" +
+	"\vReportGroup\x124\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2\x13.proto.ReportTargetR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12(\n" +
+	"\x10target_author_id\x18\x03 \x01(\x03R\x0etargetAuthorId\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12(\n" +
+	"\x10last_reported_at\x18\x05 \x01(\x03R\x0elastReportedAt\x12'\n" +
+	"\areports\x18\x06 \x03(\v2\r.proto.ReportR\areports\"A\n" +
+	"\x13ListReportsResponse\x12*\n" +
+	"\x06groups\x18\x01 \x03(\v2\x12.proto.ReportGroupR\x06groups\"t\n" +
+	"\x14ResolveReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\x12+\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x13.proto.ReportActionR\x06action\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"3\n" +
+	"\x15ResolveReportResponse\x12\x1a\n" +
+	"\bresolved\x18\x01 \x01(\x05R\bresolved\"C\n" +
+	"\x13ListWarningsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"\x96\x01\n" +
+	"\aWarning\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\x12+\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x13.proto.ReportReasonR\x06reason\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"B\n" +
+	"\x14ListWarningsResponse\x12*\n" +
+	"\bwarnings\x18\x01 \x03(\v2\x0e.proto.WarningR\bwarnings\"\xc2\x03\n" +
+	"\vHeldContent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x122\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x11.proto.HeldTargetR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\x12\x17\n" +
+	"\apost_id\x18\x04 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\x03R\bparentId\x12\x17\n" +
+	"\ais_edit\x18\x06 \x01(\bR\x06isEdit\x12\x1b\n" +
+	"\tauthor_id\x18\a \x01(\x03R\bauthorId\x12'\n" +
+	"\x0fauthor_username\x18\b \x01(\tR\x0eauthorUsername\x12\x14\n" +
+	"\x05title\x18\t \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\n" +
+	" \x01(\tR\acontent\x12!\n" +
+	"\fcontent_html\x18\v \x01(\tR\vcontentHtml\x12\x1f\n" +
+	"\vcategory_id\x18\f \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tags\x12\x16\n" +
+	"\x06reason\x18\x0e \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\x03R\tcreatedAt\"?\n" +
+	"\x0fListHeldRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"<\n" +
+	"\x10ListHeldResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.proto.HeldContentR\x05items\"w\n" +
+	"\x13ModerateHeldRequest\x12\x17\n" +
+	"\aheld_id\x18\x01 \x01(\x03R\x06heldId\x12/\n" +
+	"\bdecision\x18\x02 \x01(\x0e2\x13.proto.HeldDecisionR\bdecision\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\">\n" +
+	"\x14ModerateHeldResponse\x12&\n" +
+	"\x04held\x18\x01 \x01(\v2\x12.proto.HeldContentR\x04held\"o\n" +
 	"\vChatMessage\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x03 \x01(\x03R\tcreatedAt\"\x14\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\x03R\x02id\"\x14\n" +
 	"\x12GetMessagesRequest\"f\n" +
 	"\x13GetMessagesResponse\x12.\n" +
 	"\bmessages\x18\x01 \x03(\v2\x12.proto.ChatMessageR\bmessages\x12\x1f\n" +
@@ -2261,26 +9218,177 @@ const file_proto_forum_proto_rawDesc = "" +
 	"\bis_admin\x18\x06 \x01(\bR\aisAdmin\"G\n" +
 	"\x05Error\x12$\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x10.proto.ErrorCodeR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"2\n" +
+	"\x12LookupUsersRequest\x12\x1c\n" +
+	"\tusernames\x18\x01 \x03(\tR\tusernames\"B\n" +
+	"\vUserSummary\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"?\n" +
+	"\x13LookupUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.proto.UserSummaryR\x05users\"V\n" +
+	"\x15ChangeUsernameRequest\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\x9b\x01\n" +
+	"\x16ChangeUsernameResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\"H\n" +
+	"\x15ListUserEventsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x83\x01\n" +
+	"\tUserEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"B\n" +
+	"\x16ListUserEventsResponse\x12(\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.proto.UserEventR\x06events\",\n" +
 	"\x11CheckAdminRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"/\n" +
 	"\x12CheckAdminResponse\x12\x19\n" +
-	"\bis_admin\x18\x01 \x01(\bR\aisAdmin*\xb0\x01\n" +
+	"\bis_admin\x18\x01 \x01(\bR\aisAdmin\"\xda\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x128\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x17.proto.AttachmentTargetR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\x12\x1f\n" +
+	"\vuploader_id\x18\x04 \x01(\x03R\n" +
+	"uploaderId\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x06 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\x12#\n" +
+	"\rhas_thumbnail\x18\b \x01(\bR\fhasThumbnail\x12\x14\n" +
+	"\x05width\x18\t \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\n" +
+	" \x01(\x05R\x06height\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\"G\n" +
+	"\x12AttachmentResponse\x121\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x11.proto.AttachmentR\n" +
+	"attachment\"\xa1\x01\n" +
+	"\x17UploadAttachmentRequest\x128\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2\x17.proto.AttachmentTargetR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"D\n" +
+	"\x14GetAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\tthumbnail\x18\x02 \x01(\bR\tthumbnail\"b\n" +
+	"\x19AttachmentContentResponse\x121\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x11.proto.AttachmentR\n" +
+	"attachment\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\")\n" +
+	"\x17DeleteAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id*\x7f\n" +
+	"\x10ImportUserResult\x12\"\n" +
+	"\x1eIMPORT_USER_RESULT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13IMPORT_USER_CREATED\x10\x01\x12\x16\n" +
+	"\x12IMPORT_USER_EXISTS\x10\x02\x12\x16\n" +
+	"\x12IMPORT_USER_MERGED\x10\x03*o\n" +
+	"\n" +
+	"PostStatus\x12\x19\n" +
+	"\x15POST_STATUS_PUBLISHED\x10\x00\x12\x15\n" +
+	"\x11POST_STATUS_DRAFT\x10\x01\x12\x19\n" +
+	"\x15POST_STATUS_SCHEDULED\x10\x02\x12\x14\n" +
+	"\x10POST_STATUS_HELD\x10\x03*4\n" +
+	"\tSortOrder\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x00\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x01*n\n" +
+	"\bPostSort\x12\x11\n" +
+	"\rPOST_SORT_NEW\x10\x00\x12\x11\n" +
+	"\rPOST_SORT_TOP\x10\x01\x12\x14\n" +
+	"\x10POST_SORT_ACTIVE\x10\x02\x12\x11\n" +
+	"\rPOST_SORT_HOT\x10\x03\x12\x13\n" +
+	"\x0fPOST_SORT_VIEWS\x10\x04*c\n" +
+	"\n" +
+	"TimeWindow\x12\x13\n" +
+	"\x0fTIME_WINDOW_ALL\x10\x00\x12\x13\n" +
+	"\x0fTIME_WINDOW_DAY\x10\x01\x12\x14\n" +
+	"\x10TIME_WINDOW_WEEK\x10\x02\x12\x15\n" +
+	"\x11TIME_WINDOW_MONTH\x10\x03*;\n" +
+	"\vCommentView\x12\x15\n" +
+	"\x11COMMENT_VIEW_FLAT\x10\x00\x12\x15\n" +
+	"\x11COMMENT_VIEW_TREE\x10\x01*<\n" +
+	"\rSearchHitType\x12\x13\n" +
+	"\x0fSEARCH_HIT_POST\x10\x00\x12\x16\n" +
+	"\x12SEARCH_HIT_COMMENT\x10\x01*?\n" +
+	"\x0eVoteTargetType\x12\x14\n" +
+	"\x10VOTE_TARGET_POST\x10\x00\x12\x17\n" +
+	"\x13VOTE_TARGET_COMMENT\x10\x01*,\n" +
+	"\x10NotificationType\x12\x18\n" +
+	"\x14NOTIFICATION_COMMENT\x10\x00*:\n" +
+	"\x06DiffOp\x12\x0e\n" +
+	"\n" +
+	"DIFF_EQUAL\x10\x00\x12\x0f\n" +
+	"\vDIFF_INSERT\x10\x01\x12\x0f\n" +
+	"\vDIFF_DELETE\x10\x02*2\n" +
+	"\vTrashTarget\x12\x0f\n" +
+	"\vTRASH_POSTS\x10\x00\x12\x12\n" +
+	"\x0eTRASH_COMMENTS\x10\x01*\x80\x01\n" +
+	"\fReportTarget\x12\x1d\n" +
+	"\x19REPORT_TARGET_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_TARGET_POST\x10\x01\x12\x19\n" +
+	"\x15REPORT_TARGET_COMMENT\x10\x02\x12\x1e\n" +
+	"\x1aREPORT_TARGET_CHAT_MESSAGE\x10\x03*\xaf\x01\n" +
+	"\fReportReason\x12\x1d\n" +
+	"\x19REPORT_REASON_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_REASON_SPAM\x10\x01\x12\x17\n" +
+	"\x13REPORT_REASON_ABUSE\x10\x02\x12\x1b\n" +
+	"\x17REPORT_REASON_OFF_TOPIC\x10\x03\x12\x19\n" +
+	"\x15REPORT_REASON_ILLEGAL\x10\x04\x12\x17\n" +
+	"\x13REPORT_REASON_OTHER\x10\x05*\x97\x01\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_STATUS_OPEN\x10\x01\x12\x1b\n" +
+	"\x17REPORT_STATUS_DISMISSED\x10\x02\x12\x19\n" +
+	"\x15REPORT_STATUS_REMOVED\x10\x03\x12\x18\n" +
+	"\x14REPORT_STATUS_WARNED\x10\x04*z\n" +
+	"\fReportAction\x12\x1d\n" +
+	"\x19REPORT_ACTION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15REPORT_ACTION_DISMISS\x10\x01\x12\x18\n" +
+	"\x14REPORT_ACTION_DELETE\x10\x02\x12\x16\n" +
+	"\x12REPORT_ACTION_WARN\x10\x03*X\n" +
+	"\n" +
+	"HeldTarget\x12\x1b\n" +
+	"\x17HELD_TARGET_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10HELD_TARGET_POST\x10\x01\x12\x17\n" +
+	"\x13HELD_TARGET_COMMENT\x10\x02*b\n" +
+	"\fHeldDecision\x12\x1d\n" +
+	"\x19HELD_DECISION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15HELD_DECISION_APPROVE\x10\x01\x12\x18\n" +
+	"\x14HELD_DECISION_REJECT\x10\x02*\xb0\x01\n" +
 	"\tErrorCode\x12\x15\n" +
 	"\x11ERROR_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19ERROR_INVALID_CREDENTIALS\x10\x01\x12\x18\n" +
 	"\x14ERROR_USER_NOT_FOUND\x10\x02\x12\x1d\n" +
 	"\x19ERROR_USER_ALREADY_EXISTS\x10\x03\x12\x17\n" +
 	"\x13ERROR_TOKEN_EXPIRED\x10\x04\x12\x1b\n" +
-	"\x17ERROR_PERMISSION_DENIED\x10\x052\xcb\x03\n" +
+	"\x17ERROR_PERMISSION_DENIED\x10\x05*M\n" +
+	"\x10AttachmentTarget\x12\x1a\n" +
+	"\x16ATTACHMENT_TARGET_POST\x10\x00\x12\x1d\n" +
+	"\x19ATTACHMENT_TARGET_COMMENT\x10\x012\xb8\x06\n" +
 	"\vAuthService\x12;\n" +
 	"\bRegister\x12\x16.proto.RegisterRequest\x1a\x17.proto.RegisterResponse\x12@\n" +
-	"\vGetUserByID\x12\x15.proto.GetUserRequest\x1a\x1a.proto.UserProfileResponse\x122\n" +
+	"\vGetUserByID\x12\x15.proto.GetUserRequest\x1a\x1a.proto.UserProfileResponse\x12D\n" +
+	"\vLookupUsers\x12\x19.proto.LookupUsersRequest\x1a\x1a.proto.LookupUsersResponse\x12M\n" +
+	"\x0eChangeUsername\x12\x1c.proto.ChangeUsernameRequest\x1a\x1d.proto.ChangeUsernameResponse\x12M\n" +
+	"\x0eListUserEvents\x12\x1c.proto.ListUserEventsRequest\x1a\x1d.proto.ListUserEventsResponse\x12D\n" +
+	"\vExportUsers\x12\x19.proto.ExportUsersRequest\x1a\x1a.proto.ExportUsersResponse\x12A\n" +
+	"\n" +
+	"ImportUser\x12\x18.proto.ImportUserRequest\x1a\x19.proto.ImportUserResponse\x122\n" +
 	"\x05Login\x12\x13.proto.LoginRequest\x1a\x14.proto.LoginResponse\x12G\n" +
 	"\fRefreshToken\x12\x1a.proto.RefreshTokenRequest\x1a\x1b.proto.RefreshTokenResponse\x12@\n" +
 	"\rValidateToken\x12\x16.proto.ValidateRequest\x1a\x17.proto.ValidateResponse\x125\n" +
 	"\x06Logout\x12\x14.proto.LogoutRequest\x1a\x15.proto.LogoutResponse\x12G\n" +
-	"\x10CheckAdminStatus\x12\x18.proto.CheckAdminRequest\x1a\x19.proto.CheckAdminResponse2\xdd\x06\n" +
+	"\x10CheckAdminStatus\x12\x18.proto.CheckAdminRequest\x1a\x19.proto.CheckAdminResponse2\x8a\x1c\n" +
 	"\fForumService\x12;\n" +
 	"\n" +
 	"CreatePost\x12\x18.proto.CreatePostRequest\x1a\x13.proto.PostResponse\x125\n" +
@@ -2290,12 +9398,54 @@ const file_proto_forum_proto_rawDesc = "" +
 	"\n" +
 	"DeletePost\x12\x18.proto.DeletePostRequest\x1a\x13.proto.EmptyMessage\x12:\n" +
 	"\x05Posts\x12\x17.proto.ListPostsRequest\x1a\x18.proto.ListPostsResponse\x12D\n" +
+	"\fListMyDrafts\x12\x1a.proto.ListMyDraftsRequest\x1a\x18.proto.ListPostsResponse\x12=\n" +
+	"\vPublishPost\x12\x19.proto.PublishPostRequest\x1a\x13.proto.PostResponse\x125\n" +
+	"\aPinPost\x12\x15.proto.PinPostRequest\x1a\x13.proto.PostResponse\x127\n" +
+	"\bLockPost\x12\x16.proto.LockPostRequest\x1a\x13.proto.PostResponse\x12D\n" +
 	"\rCreateComment\x12\x1b.proto.CreateCommentRequest\x1a\x16.proto.CommentResponse\x12B\n" +
 	"\x0eGetCommentByID\x12\x18.proto.GetCommentRequest\x1a\x16.proto.CommentResponse\x12M\n" +
 	"\vGetByPostID\x12!.proto.GetCommentsByPostIDRequest\x1a\x1b.proto.ListCommentsResponse\x12C\n" +
 	"\bComments\x12\x1a.proto.ListCommentsRequest\x1a\x1b.proto.ListCommentsResponse\x12D\n" +
 	"\rUpdateComment\x12\x1b.proto.UpdateCommentRequest\x1a\x16.proto.CommentResponse\x12A\n" +
-	"\rDeleteComment\x12\x1b.proto.DeleteCommentRequest\x1a\x13.proto.EmptyMessage\x126\n" +
+	"\rDeleteComment\x12\x1b.proto.DeleteCommentRequest\x1a\x13.proto.EmptyMessage\x12D\n" +
+	"\vSearchPosts\x12\x19.proto.SearchPostsRequest\x1a\x1a.proto.SearchPostsResponse\x12G\n" +
+	"\x0eCreateCategory\x12\x1c.proto.CreateCategoryRequest\x1a\x17.proto.CategoryResponse\x12A\n" +
+	"\vGetCategory\x12\x19.proto.GetCategoryRequest\x1a\x17.proto.CategoryResponse\x12G\n" +
+	"\x0eUpdateCategory\x12\x1c.proto.UpdateCategoryRequest\x1a\x17.proto.CategoryResponse\x12C\n" +
+	"\x0eDeleteCategory\x12\x1c.proto.DeleteCategoryRequest\x1a\x13.proto.EmptyMessage\x12M\n" +
+	"\x0eListCategories\x12\x1c.proto.ListCategoriesRequest\x1a\x1d.proto.ListCategoriesResponse\x12/\n" +
+	"\x04Vote\x12\x12.proto.VoteRequest\x1a\x13.proto.VoteResponse\x12;\n" +
+	"\n" +
+	"RemoveVote\x12\x18.proto.RemoveVoteRequest\x1a\x13.proto.VoteResponse\x12?\n" +
+	"\fCastPollVote\x12\x1a.proto.CastPollVoteRequest\x1a\x13.proto.PollResponse\x12C\n" +
+	"\x0eGetPollResults\x12\x1c.proto.GetPollResultsRequest\x1a\x13.proto.PollResponse\x12A\n" +
+	"\vAddBookmark\x12\x19.proto.AddBookmarkRequest\x1a\x17.proto.BookmarkResponse\x12C\n" +
+	"\x0eRemoveBookmark\x12\x1c.proto.RemoveBookmarkRequest\x1a\x13.proto.EmptyMessage\x12J\n" +
+	"\rListBookmarks\x12\x1b.proto.ListBookmarksRequest\x1a\x1c.proto.ListBookmarksResponse\x12A\n" +
+	"\n" +
+	"FollowPost\x12\x18.proto.FollowPostRequest\x1a\x19.proto.FollowPostResponse\x12C\n" +
+	"\fUnfollowPost\x12\x18.proto.FollowPostRequest\x1a\x19.proto.FollowPostResponse\x12V\n" +
+	"\x11ListNotifications\x12\x1f.proto.ListNotificationsRequest\x1a .proto.ListNotificationsResponse\x12A\n" +
+	"\x0eGetUnreadCount\x12\x13.proto.EmptyMessage\x1a\x1a.proto.UnreadCountResponse\x12>\n" +
+	"\bMarkRead\x12\x16.proto.MarkReadRequest\x1a\x1a.proto.UnreadCountResponse\x12>\n" +
+	"\vMarkAllRead\x12\x13.proto.EmptyMessage\x1a\x1a.proto.UnreadCountResponse\x12G\n" +
+	"\fListMentions\x12\x1a.proto.ListMentionsRequest\x1a\x1b.proto.ListMentionsResponse\x12H\n" +
+	"\x10GetPostRevisions\x12\x1a.proto.GetRevisionsRequest\x1a\x18.proto.RevisionsResponse\x12K\n" +
+	"\x13GetCommentRevisions\x12\x1a.proto.GetRevisionsRequest\x1a\x18.proto.RevisionsResponse\x12;\n" +
+	"\fRollbackPost\x12\x16.proto.RollbackRequest\x1a\x13.proto.PostResponse\x12A\n" +
+	"\x0fRollbackComment\x12\x16.proto.RollbackRequest\x1a\x16.proto.CommentResponse\x12>\n" +
+	"\tListTrash\x12\x17.proto.ListTrashRequest\x1a\x18.proto.ListTrashResponse\x129\n" +
+	"\vRestorePost\x12\x15.proto.RestoreRequest\x1a\x13.proto.PostResponse\x12?\n" +
+	"\x0eRestoreComment\x12\x15.proto.RestoreRequest\x1a\x16.proto.CommentResponse\x125\n" +
+	"\x06Report\x12\x14.proto.ReportRequest\x1a\x15.proto.ReportResponse\x12D\n" +
+	"\vListReports\x12\x19.proto.ListReportsRequest\x1a\x1a.proto.ListReportsResponse\x12J\n" +
+	"\rResolveReport\x12\x1b.proto.ResolveReportRequest\x1a\x1c.proto.ResolveReportResponse\x12I\n" +
+	"\x0eListMyWarnings\x12\x1a.proto.ListWarningsRequest\x1a\x1b.proto.ListWarningsResponse\x12;\n" +
+	"\bListHeld\x12\x16.proto.ListHeldRequest\x1a\x17.proto.ListHeldResponse\x12G\n" +
+	"\fModerateHeld\x12\x1a.proto.ModerateHeldRequest\x1a\x1b.proto.ModerateHeldResponse\x12M\n" +
+	"\x10UploadAttachment\x12\x1e.proto.UploadAttachmentRequest\x1a\x19.proto.AttachmentResponse\x12N\n" +
+	"\rGetAttachment\x12\x1b.proto.GetAttachmentRequest\x1a .proto.AttachmentContentResponse\x12G\n" +
+	"\x10DeleteAttachment\x12\x1e.proto.DeleteAttachmentRequest\x1a\x13.proto.EmptyMessage\x126\n" +
 	"\vSendMessage\x12\x12.proto.ChatMessage\x1a\x13.proto.EmptyMessage\x12D\n" +
 	"\vGetMessages\x12\x19.proto.GetMessagesRequest\x1a\x1a.proto.GetMessagesResponseB\fZ\n" +
 	"back/protob\x06proto3"
@@ -2312,102 +9462,360 @@ func file_proto_forum_proto_rawDescGZIP() []byte {
 	return file_proto_forum_proto_rawDescData
 }
 
-var file_proto_forum_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_forum_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_proto_forum_proto_enumTypes = make([]protoimpl.EnumInfo, 19)
+var file_proto_forum_proto_msgTypes = make([]protoimpl.MessageInfo, 124)
 var file_proto_forum_proto_goTypes = []any{
-	(ErrorCode)(0),                     // 0: proto.ErrorCode
-	(*EmptyMessage)(nil),               // 1: proto.EmptyMessage
-	(*RegisterRequest)(nil),            // 2: proto.RegisterRequest
-	(*RegisterResponse)(nil),           // 3: proto.RegisterResponse
-	(*LoginRequest)(nil),               // 4: proto.LoginRequest
-	(*LoginResponse)(nil),              // 5: proto.LoginResponse
-	(*RefreshTokenRequest)(nil),        // 6: proto.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),       // 7: proto.RefreshTokenResponse
-	(*ValidateRequest)(nil),            // 8: proto.ValidateRequest
-	(*ValidateResponse)(nil),           // 9: proto.ValidateResponse
-	(*LogoutRequest)(nil),              // 10: proto.LogoutRequest
-	(*LogoutResponse)(nil),             // 11: proto.LogoutResponse
-	(*Post)(nil),                       // 12: proto.Post
-	(*PostResponse)(nil),               // 13: proto.PostResponse
-	(*CreatePostRequest)(nil),          // 14: proto.CreatePostRequest
-	(*GetPostRequest)(nil),             // 15: proto.GetPostRequest
-	(*UpdatePostRequest)(nil),          // 16: proto.UpdatePostRequest
-	(*DeletePostRequest)(nil),          // 17: proto.DeletePostRequest
-	(*ListPostsRequest)(nil),           // 18: proto.ListPostsRequest
-	(*ListPostsResponse)(nil),          // 19: proto.ListPostsResponse
-	(*Comment)(nil),                    // 20: proto.Comment
-	(*CommentResponse)(nil),            // 21: proto.CommentResponse
-	(*CreateCommentRequest)(nil),       // 22: proto.CreateCommentRequest
-	(*GetCommentRequest)(nil),          // 23: proto.GetCommentRequest
-	(*GetCommentsByPostIDRequest)(nil), // 24: proto.GetCommentsByPostIDRequest
-	(*ListCommentsRequest)(nil),        // 25: proto.ListCommentsRequest
-	(*ListCommentsResponse)(nil),       // 26: proto.ListCommentsResponse
-	(*UpdateCommentRequest)(nil),       // 27: proto.UpdateCommentRequest
-	(*DeleteCommentRequest)(nil),       // 28: proto.DeleteCommentRequest
-	(*ChatMessage)(nil),                // 29: proto.ChatMessage
-	(*GetMessagesRequest)(nil),         // 30: proto.GetMessagesRequest
-	(*GetMessagesResponse)(nil),        // 31: proto.GetMessagesResponse
-	(*ChatConfig)(nil),                 // 32: proto.ChatConfig
-	(*User)(nil),                       // 33: proto.User
-	(*GetUserRequest)(nil),             // 34: proto.GetUserRequest
-	(*UserProfileResponse)(nil),        // 35: proto.UserProfileResponse
-	(*Error)(nil),                      // 36: proto.Error
-	(*CheckAdminRequest)(nil),          // 37: proto.CheckAdminRequest
-	(*CheckAdminResponse)(nil),         // 38: proto.CheckAdminResponse
+	(ImportUserResult)(0),              // 0: proto.ImportUserResult
+	(PostStatus)(0),                    // 1: proto.PostStatus
+	(SortOrder)(0),                     // 2: proto.SortOrder
+	(PostSort)(0),                      // 3: proto.PostSort
+	(TimeWindow)(0),                    // 4: proto.TimeWindow
+	(CommentView)(0),                   // 5: proto.CommentView
+	(SearchHitType)(0),                 // 6: proto.SearchHitType
+	(VoteTargetType)(0),                // 7: proto.VoteTargetType
+	(NotificationType)(0),              // 8: proto.NotificationType
+	(DiffOp)(0),                        // 9: proto.DiffOp
+	(TrashTarget)(0),                   // 10: proto.TrashTarget
+	(ReportTarget)(0),                  // 11: proto.ReportTarget
+	(ReportReason)(0),                  // 12: proto.ReportReason
+	(ReportStatus)(0),                  // 13: proto.ReportStatus
+	(ReportAction)(0),                  // 14: proto.ReportAction
+	(HeldTarget)(0),                    // 15: proto.HeldTarget
+	(HeldDecision)(0),                  // 16: proto.HeldDecision
+	(ErrorCode)(0),                     // 17: proto.ErrorCode
+	(AttachmentTarget)(0),              // 18: proto.AttachmentTarget
+	(*EmptyMessage)(nil),               // 19: proto.EmptyMessage
+	(*RegisterRequest)(nil),            // 20: proto.RegisterRequest
+	(*RegisterResponse)(nil),           // 21: proto.RegisterResponse
+	(*LoginRequest)(nil),               // 22: proto.LoginRequest
+	(*LoginResponse)(nil),              // 23: proto.LoginResponse
+	(*RefreshTokenRequest)(nil),        // 24: proto.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),       // 25: proto.RefreshTokenResponse
+	(*ValidateRequest)(nil),            // 26: proto.ValidateRequest
+	(*ValidateResponse)(nil),           // 27: proto.ValidateResponse
+	(*ExportUsersRequest)(nil),         // 28: proto.ExportUsersRequest
+	(*ExportedUser)(nil),               // 29: proto.ExportedUser
+	(*ExportUsersResponse)(nil),        // 30: proto.ExportUsersResponse
+	(*ImportUserRequest)(nil),          // 31: proto.ImportUserRequest
+	(*ImportUserResponse)(nil),         // 32: proto.ImportUserResponse
+	(*LogoutRequest)(nil),              // 33: proto.LogoutRequest
+	(*LogoutResponse)(nil),             // 34: proto.LogoutResponse
+	(*Post)(nil),                       // 35: proto.Post
+	(*Hold)(nil),                       // 36: proto.Hold
+	(*PostResponse)(nil),               // 37: proto.PostResponse
+	(*CreatePostRequest)(nil),          // 38: proto.CreatePostRequest
+	(*PollInput)(nil),                  // 39: proto.PollInput
+	(*GetPostRequest)(nil),             // 40: proto.GetPostRequest
+	(*TagList)(nil),                    // 41: proto.TagList
+	(*UpdatePostRequest)(nil),          // 42: proto.UpdatePostRequest
+	(*DeletePostRequest)(nil),          // 43: proto.DeletePostRequest
+	(*ListPostsRequest)(nil),           // 44: proto.ListPostsRequest
+	(*ListPostsResponse)(nil),          // 45: proto.ListPostsResponse
+	(*ListMyDraftsRequest)(nil),        // 46: proto.ListMyDraftsRequest
+	(*PublishPostRequest)(nil),         // 47: proto.PublishPostRequest
+	(*PinPostRequest)(nil),             // 48: proto.PinPostRequest
+	(*LockPostRequest)(nil),            // 49: proto.LockPostRequest
+	(*Category)(nil),                   // 50: proto.Category
+	(*CategoryResponse)(nil),           // 51: proto.CategoryResponse
+	(*CreateCategoryRequest)(nil),      // 52: proto.CreateCategoryRequest
+	(*GetCategoryRequest)(nil),         // 53: proto.GetCategoryRequest
+	(*UpdateCategoryRequest)(nil),      // 54: proto.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),      // 55: proto.DeleteCategoryRequest
+	(*ListCategoriesRequest)(nil),      // 56: proto.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),     // 57: proto.ListCategoriesResponse
+	(*Comment)(nil),                    // 58: proto.Comment
+	(*CommentResponse)(nil),            // 59: proto.CommentResponse
+	(*CreateCommentRequest)(nil),       // 60: proto.CreateCommentRequest
+	(*GetCommentRequest)(nil),          // 61: proto.GetCommentRequest
+	(*GetCommentsByPostIDRequest)(nil), // 62: proto.GetCommentsByPostIDRequest
+	(*ListCommentsRequest)(nil),        // 63: proto.ListCommentsRequest
+	(*ListCommentsResponse)(nil),       // 64: proto.ListCommentsResponse
+	(*UpdateCommentRequest)(nil),       // 65: proto.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),       // 66: proto.DeleteCommentRequest
+	(*SearchPostsRequest)(nil),         // 67: proto.SearchPostsRequest
+	(*SearchHit)(nil),                  // 68: proto.SearchHit
+	(*SearchPostsResponse)(nil),        // 69: proto.SearchPostsResponse
+	(*VoteRequest)(nil),                // 70: proto.VoteRequest
+	(*RemoveVoteRequest)(nil),          // 71: proto.RemoveVoteRequest
+	(*Poll)(nil),                       // 72: proto.Poll
+	(*PollOption)(nil),                 // 73: proto.PollOption
+	(*CastPollVoteRequest)(nil),        // 74: proto.CastPollVoteRequest
+	(*GetPollResultsRequest)(nil),      // 75: proto.GetPollResultsRequest
+	(*PollResponse)(nil),               // 76: proto.PollResponse
+	(*VoteResponse)(nil),               // 77: proto.VoteResponse
+	(*Bookmark)(nil),                   // 78: proto.Bookmark
+	(*AddBookmarkRequest)(nil),         // 79: proto.AddBookmarkRequest
+	(*RemoveBookmarkRequest)(nil),      // 80: proto.RemoveBookmarkRequest
+	(*ListBookmarksRequest)(nil),       // 81: proto.ListBookmarksRequest
+	(*BookmarkResponse)(nil),           // 82: proto.BookmarkResponse
+	(*ListBookmarksResponse)(nil),      // 83: proto.ListBookmarksResponse
+	(*Notification)(nil),               // 84: proto.Notification
+	(*FollowPostRequest)(nil),          // 85: proto.FollowPostRequest
+	(*FollowPostResponse)(nil),         // 86: proto.FollowPostResponse
+	(*ListNotificationsRequest)(nil),   // 87: proto.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),  // 88: proto.ListNotificationsResponse
+	(*MarkReadRequest)(nil),            // 89: proto.MarkReadRequest
+	(*UnreadCountResponse)(nil),        // 90: proto.UnreadCountResponse
+	(*Mention)(nil),                    // 91: proto.Mention
+	(*ListMentionsRequest)(nil),        // 92: proto.ListMentionsRequest
+	(*ListMentionsResponse)(nil),       // 93: proto.ListMentionsResponse
+	(*DiffLine)(nil),                   // 94: proto.DiffLine
+	(*Revision)(nil),                   // 95: proto.Revision
+	(*GetRevisionsRequest)(nil),        // 96: proto.GetRevisionsRequest
+	(*RevisionsResponse)(nil),          // 97: proto.RevisionsResponse
+	(*RollbackRequest)(nil),            // 98: proto.RollbackRequest
+	(*Deletion)(nil),                   // 99: proto.Deletion
+	(*ListTrashRequest)(nil),           // 100: proto.ListTrashRequest
+	(*ListTrashResponse)(nil),          // 101: proto.ListTrashResponse
+	(*RestoreRequest)(nil),             // 102: proto.RestoreRequest
+	(*ReportRequest)(nil),              // 103: proto.ReportRequest
+	(*Report)(nil),                     // 104: proto.Report
+	(*ReportResponse)(nil),             // 105: proto.ReportResponse
+	(*ListReportsRequest)(nil),         // 106: proto.ListReportsRequest
+	(*ReportGroup)(nil),                // 107: proto.ReportGroup
+	(*ListReportsResponse)(nil),        // 108: proto.ListReportsResponse
+	(*ResolveReportRequest)(nil),       // 109: proto.ResolveReportRequest
+	(*ResolveReportResponse)(nil),      // 110: proto.ResolveReportResponse
+	(*ListWarningsRequest)(nil),        // 111: proto.ListWarningsRequest
+	(*Warning)(nil),                    // 112: proto.Warning
+	(*ListWarningsResponse)(nil),       // 113: proto.ListWarningsResponse
+	(*HeldContent)(nil),                // 114: proto.HeldContent
+	(*ListHeldRequest)(nil),            // 115: proto.ListHeldRequest
+	(*ListHeldResponse)(nil),           // 116: proto.ListHeldResponse
+	(*ModerateHeldRequest)(nil),        // 117: proto.ModerateHeldRequest
+	(*ModerateHeldResponse)(nil),       // 118: proto.ModerateHeldResponse
+	(*ChatMessage)(nil),                // 119: proto.ChatMessage
+	(*GetMessagesRequest)(nil),         // 120: proto.GetMessagesRequest
+	(*GetMessagesResponse)(nil),        // 121: proto.GetMessagesResponse
+	(*ChatConfig)(nil),                 // 122: proto.ChatConfig
+	(*User)(nil),                       // 123: proto.User
+	(*GetUserRequest)(nil),             // 124: proto.GetUserRequest
+	(*UserProfileResponse)(nil),        // 125: proto.UserProfileResponse
+	(*Error)(nil),                      // 126: proto.Error
+	(*LookupUsersRequest)(nil),         // 127: proto.LookupUsersRequest
+	(*UserSummary)(nil),                // 128: proto.UserSummary
+	(*LookupUsersResponse)(nil),        // 129: proto.LookupUsersResponse
+	(*ChangeUsernameRequest)(nil),      // 130: proto.ChangeUsernameRequest
+	(*ChangeUsernameResponse)(nil),     // 131: proto.ChangeUsernameResponse
+	(*ListUserEventsRequest)(nil),      // 132: proto.ListUserEventsRequest
+	(*UserEvent)(nil),                  // 133: proto.UserEvent
+	(*ListUserEventsResponse)(nil),     // 134: proto.ListUserEventsResponse
+	(*CheckAdminRequest)(nil),          // 135: proto.CheckAdminRequest
+	(*CheckAdminResponse)(nil),         // 136: proto.CheckAdminResponse
+	(*Attachment)(nil),                 // 137: proto.Attachment
+	(*AttachmentResponse)(nil),         // 138: proto.AttachmentResponse
+	(*UploadAttachmentRequest)(nil),    // 139: proto.UploadAttachmentRequest
+	(*GetAttachmentRequest)(nil),       // 140: proto.GetAttachmentRequest
+	(*AttachmentContentResponse)(nil),  // 141: proto.AttachmentContentResponse
+	(*DeleteAttachmentRequest)(nil),    // 142: proto.DeleteAttachmentRequest
 }
 var file_proto_forum_proto_depIdxs = []int32{
-	35, // 0: proto.LoginResponse.user:type_name -> proto.UserProfileResponse
-	12, // 1: proto.PostResponse.post:type_name -> proto.Post
-	12, // 2: proto.ListPostsResponse.posts:type_name -> proto.Post
-	20, // 3: proto.CommentResponse.comment:type_name -> proto.Comment
-	20, // 4: proto.ListCommentsResponse.comments:type_name -> proto.Comment
-	29, // 5: proto.GetMessagesResponse.messages:type_name -> proto.ChatMessage
-	0,  // 6: proto.Error.code:type_name -> proto.ErrorCode
-	2,  // 7: proto.AuthService.Register:input_type -> proto.RegisterRequest
-	34, // 8: proto.AuthService.GetUserByID:input_type -> proto.GetUserRequest
-	4,  // 9: proto.AuthService.Login:input_type -> proto.LoginRequest
-	6,  // 10: proto.AuthService.RefreshToken:input_type -> proto.RefreshTokenRequest
-	8,  // 11: proto.AuthService.ValidateToken:input_type -> proto.ValidateRequest
-	10, // 12: proto.AuthService.Logout:input_type -> proto.LogoutRequest
-	37, // 13: proto.AuthService.CheckAdminStatus:input_type -> proto.CheckAdminRequest
-	14, // 14: proto.ForumService.CreatePost:input_type -> proto.CreatePostRequest
-	15, // 15: proto.ForumService.GetPost:input_type -> proto.GetPostRequest
-	16, // 16: proto.ForumService.UpdatePost:input_type -> proto.UpdatePostRequest
-	17, // 17: proto.ForumService.DeletePost:input_type -> proto.DeletePostRequest
-	18, // 18: proto.ForumService.Posts:input_type -> proto.ListPostsRequest
-	22, // 19: proto.ForumService.CreateComment:input_type -> proto.CreateCommentRequest
-	23, // 20: proto.ForumService.GetCommentByID:input_type -> proto.GetCommentRequest
-	24, // 21: proto.ForumService.GetByPostID:input_type -> proto.GetCommentsByPostIDRequest
-	25, // 22: proto.ForumService.Comments:input_type -> proto.ListCommentsRequest
-	27, // 23: proto.ForumService.UpdateComment:input_type -> proto.UpdateCommentRequest
-	28, // 24: proto.ForumService.DeleteComment:input_type -> proto.DeleteCommentRequest
-	29, // 25: proto.ForumService.SendMessage:input_type -> proto.ChatMessage
-	30, // 26: proto.ForumService.GetMessages:input_type -> proto.GetMessagesRequest
-	3,  // 27: proto.AuthService.Register:output_type -> proto.RegisterResponse
-	35, // 28: proto.AuthService.GetUserByID:output_type -> proto.UserProfileResponse
-	5,  // 29: proto.AuthService.Login:output_type -> proto.LoginResponse
-	7,  // 30: proto.AuthService.RefreshToken:output_type -> proto.RefreshTokenResponse
-	9,  // 31: proto.AuthService.ValidateToken:output_type -> proto.ValidateResponse
-	11, // 32: proto.AuthService.Logout:output_type -> proto.LogoutResponse
-	38, // 33: proto.AuthService.CheckAdminStatus:output_type -> proto.CheckAdminResponse
-	13, // 34: proto.ForumService.CreatePost:output_type -> proto.PostResponse
-	13, // 35: proto.ForumService.GetPost:output_type -> proto.PostResponse
-	13, // 36: proto.ForumService.UpdatePost:output_type -> proto.PostResponse
-	1,  // 37: proto.ForumService.DeletePost:output_type -> proto.EmptyMessage
-	19, // 38: proto.ForumService.Posts:output_type -> proto.ListPostsResponse
-	21, // 39: proto.ForumService.CreateComment:output_type -> proto.CommentResponse
-	21, // 40: proto.ForumService.GetCommentByID:output_type -> proto.CommentResponse
-	26, // 41: proto.ForumService.GetByPostID:output_type -> proto.ListCommentsResponse
-	26, // 42: proto.ForumService.Comments:output_type -> proto.ListCommentsResponse
-	21, // 43: proto.ForumService.UpdateComment:output_type -> proto.CommentResponse
-	1,  // 44: proto.ForumService.DeleteComment:output_type -> proto.EmptyMessage
-	1,  // 45: proto.ForumService.SendMessage:output_type -> proto.EmptyMessage
-	31, // 46: proto.ForumService.GetMessages:output_type -> proto.GetMessagesResponse
-	27, // [27:47] is the sub-list for method output_type
-	7,  // [7:27] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	125, // 0: proto.LoginResponse.user:type_name -> proto.UserProfileResponse
+	29,  // 1: proto.ExportUsersResponse.users:type_name -> proto.ExportedUser
+	0,   // 2: proto.ImportUserResponse.result:type_name -> proto.ImportUserResult
+	99,  // 3: proto.Post.deletion:type_name -> proto.Deletion
+	137, // 4: proto.Post.attachments:type_name -> proto.Attachment
+	1,   // 5: proto.Post.status:type_name -> proto.PostStatus
+	72,  // 6: proto.Post.poll:type_name -> proto.Poll
+	36,  // 7: proto.Post.hold:type_name -> proto.Hold
+	35,  // 8: proto.PostResponse.post:type_name -> proto.Post
+	1,   // 9: proto.CreatePostRequest.status:type_name -> proto.PostStatus
+	39,  // 10: proto.CreatePostRequest.poll:type_name -> proto.PollInput
+	41,  // 11: proto.UpdatePostRequest.tags:type_name -> proto.TagList
+	2,   // 12: proto.ListPostsRequest.order:type_name -> proto.SortOrder
+	3,   // 13: proto.ListPostsRequest.sort:type_name -> proto.PostSort
+	4,   // 14: proto.ListPostsRequest.window:type_name -> proto.TimeWindow
+	35,  // 15: proto.ListPostsResponse.posts:type_name -> proto.Post
+	50,  // 16: proto.CategoryResponse.category:type_name -> proto.Category
+	50,  // 17: proto.ListCategoriesResponse.categories:type_name -> proto.Category
+	58,  // 18: proto.Comment.replies:type_name -> proto.Comment
+	99,  // 19: proto.Comment.deletion:type_name -> proto.Deletion
+	137, // 20: proto.Comment.attachments:type_name -> proto.Attachment
+	36,  // 21: proto.Comment.hold:type_name -> proto.Hold
+	58,  // 22: proto.CommentResponse.comment:type_name -> proto.Comment
+	5,   // 23: proto.GetCommentsByPostIDRequest.view:type_name -> proto.CommentView
+	5,   // 24: proto.ListCommentsRequest.view:type_name -> proto.CommentView
+	58,  // 25: proto.ListCommentsResponse.comments:type_name -> proto.Comment
+	6,   // 26: proto.SearchHit.type:type_name -> proto.SearchHitType
+	68,  // 27: proto.SearchPostsResponse.hits:type_name -> proto.SearchHit
+	7,   // 28: proto.VoteRequest.target_type:type_name -> proto.VoteTargetType
+	7,   // 29: proto.RemoveVoteRequest.target_type:type_name -> proto.VoteTargetType
+	73,  // 30: proto.Poll.options:type_name -> proto.PollOption
+	128, // 31: proto.PollOption.voters:type_name -> proto.UserSummary
+	72,  // 32: proto.PollResponse.poll:type_name -> proto.Poll
+	35,  // 33: proto.Bookmark.post:type_name -> proto.Post
+	78,  // 34: proto.BookmarkResponse.bookmark:type_name -> proto.Bookmark
+	78,  // 35: proto.ListBookmarksResponse.bookmarks:type_name -> proto.Bookmark
+	8,   // 36: proto.Notification.type:type_name -> proto.NotificationType
+	84,  // 37: proto.ListNotificationsResponse.notifications:type_name -> proto.Notification
+	91,  // 38: proto.ListMentionsResponse.mentions:type_name -> proto.Mention
+	9,   // 39: proto.DiffLine.op:type_name -> proto.DiffOp
+	94,  // 40: proto.Revision.diff:type_name -> proto.DiffLine
+	95,  // 41: proto.RevisionsResponse.revisions:type_name -> proto.Revision
+	10,  // 42: proto.ListTrashRequest.target:type_name -> proto.TrashTarget
+	35,  // 43: proto.ListTrashResponse.posts:type_name -> proto.Post
+	58,  // 44: proto.ListTrashResponse.comments:type_name -> proto.Comment
+	11,  // 45: proto.ReportRequest.target_type:type_name -> proto.ReportTarget
+	12,  // 46: proto.ReportRequest.reason:type_name -> proto.ReportReason
+	11,  // 47: proto.Report.target_type:type_name -> proto.ReportTarget
+	12,  // 48: proto.Report.reason:type_name -> proto.ReportReason
+	13,  // 49: proto.Report.status:type_name -> proto.ReportStatus
+	104, // 50: proto.ReportResponse.report:type_name -> proto.Report
+	13,  // 51: proto.ListReportsRequest.status:type_name -> proto.ReportStatus
+	11,  // 52: proto.ListReportsRequest.target_type:type_name -> proto.ReportTarget
+	11,  // 53: proto.ReportGroup.target_type:type_name -> proto.ReportTarget
+	104, // 54: proto.ReportGroup.reports:type_name -> proto.Report
+	107, // 55: proto.ListReportsResponse.groups:type_name -> proto.ReportGroup
+	14,  // 56: proto.ResolveReportRequest.action:type_name -> proto.ReportAction
+	12,  // 57: proto.Warning.reason:type_name -> proto.ReportReason
+	112, // 58: proto.ListWarningsResponse.warnings:type_name -> proto.Warning
+	15,  // 59: proto.HeldContent.target_type:type_name -> proto.HeldTarget
+	114, // 60: proto.ListHeldResponse.items:type_name -> proto.HeldContent
+	16,  // 61: proto.ModerateHeldRequest.decision:type_name -> proto.HeldDecision
+	114, // 62: proto.ModerateHeldResponse.held:type_name -> proto.HeldContent
+	119, // 63: proto.GetMessagesResponse.messages:type_name -> proto.ChatMessage
+	17,  // 64: proto.Error.code:type_name -> proto.ErrorCode
+	128, // 65: proto.LookupUsersResponse.users:type_name -> proto.UserSummary
+	133, // 66: proto.ListUserEventsResponse.events:type_name -> proto.UserEvent
+	18,  // 67: proto.Attachment.target_type:type_name -> proto.AttachmentTarget
+	137, // 68: proto.AttachmentResponse.attachment:type_name -> proto.Attachment
+	18,  // 69: proto.UploadAttachmentRequest.target_type:type_name -> proto.AttachmentTarget
+	137, // 70: proto.AttachmentContentResponse.attachment:type_name -> proto.Attachment
+	20,  // 71: proto.AuthService.Register:input_type -> proto.RegisterRequest
+	124, // 72: proto.AuthService.GetUserByID:input_type -> proto.GetUserRequest
+	127, // 73: proto.AuthService.LookupUsers:input_type -> proto.LookupUsersRequest
+	130, // 74: proto.AuthService.ChangeUsername:input_type -> proto.ChangeUsernameRequest
+	132, // 75: proto.AuthService.ListUserEvents:input_type -> proto.ListUserEventsRequest
+	28,  // 76: proto.AuthService.ExportUsers:input_type -> proto.ExportUsersRequest
+	31,  // 77: proto.AuthService.ImportUser:input_type -> proto.ImportUserRequest
+	22,  // 78: proto.AuthService.Login:input_type -> proto.LoginRequest
+	24,  // 79: proto.AuthService.RefreshToken:input_type -> proto.RefreshTokenRequest
+	26,  // 80: proto.AuthService.ValidateToken:input_type -> proto.ValidateRequest
+	33,  // 81: proto.AuthService.Logout:input_type -> proto.LogoutRequest
+	135, // 82: proto.AuthService.CheckAdminStatus:input_type -> proto.CheckAdminRequest
+	38,  // 83: proto.ForumService.CreatePost:input_type -> proto.CreatePostRequest
+	40,  // 84: proto.ForumService.GetPost:input_type -> proto.GetPostRequest
+	42,  // 85: proto.ForumService.UpdatePost:input_type -> proto.UpdatePostRequest
+	43,  // 86: proto.ForumService.DeletePost:input_type -> proto.DeletePostRequest
+	44,  // 87: proto.ForumService.Posts:input_type -> proto.ListPostsRequest
+	46,  // 88: proto.ForumService.ListMyDrafts:input_type -> proto.ListMyDraftsRequest
+	47,  // 89: proto.ForumService.PublishPost:input_type -> proto.PublishPostRequest
+	48,  // 90: proto.ForumService.PinPost:input_type -> proto.PinPostRequest
+	49,  // 91: proto.ForumService.LockPost:input_type -> proto.LockPostRequest
+	60,  // 92: proto.ForumService.CreateComment:input_type -> proto.CreateCommentRequest
+	61,  // 93: proto.ForumService.GetCommentByID:input_type -> proto.GetCommentRequest
+	62,  // 94: proto.ForumService.GetByPostID:input_type -> proto.GetCommentsByPostIDRequest
+	63,  // 95: proto.ForumService.Comments:input_type -> proto.ListCommentsRequest
+	65,  // 96: proto.ForumService.UpdateComment:input_type -> proto.UpdateCommentRequest
+	66,  // 97: proto.ForumService.DeleteComment:input_type -> proto.DeleteCommentRequest
+	67,  // 98: proto.ForumService.SearchPosts:input_type -> proto.SearchPostsRequest
+	52,  // 99: proto.ForumService.CreateCategory:input_type -> proto.CreateCategoryRequest
+	53,  // 100: proto.ForumService.GetCategory:input_type -> proto.GetCategoryRequest
+	54,  // 101: proto.ForumService.UpdateCategory:input_type -> proto.UpdateCategoryRequest
+	55,  // 102: proto.ForumService.DeleteCategory:input_type -> proto.DeleteCategoryRequest
+	56,  // 103: proto.ForumService.ListCategories:input_type -> proto.ListCategoriesRequest
+	70,  // 104: proto.ForumService.Vote:input_type -> proto.VoteRequest
+	71,  // 105: proto.ForumService.RemoveVote:input_type -> proto.RemoveVoteRequest
+	74,  // 106: proto.ForumService.CastPollVote:input_type -> proto.CastPollVoteRequest
+	75,  // 107: proto.ForumService.GetPollResults:input_type -> proto.GetPollResultsRequest
+	79,  // 108: proto.ForumService.AddBookmark:input_type -> proto.AddBookmarkRequest
+	80,  // 109: proto.ForumService.RemoveBookmark:input_type -> proto.RemoveBookmarkRequest
+	81,  // 110: proto.ForumService.ListBookmarks:input_type -> proto.ListBookmarksRequest
+	85,  // 111: proto.ForumService.FollowPost:input_type -> proto.FollowPostRequest
+	85,  // 112: proto.ForumService.UnfollowPost:input_type -> proto.FollowPostRequest
+	87,  // 113: proto.ForumService.ListNotifications:input_type -> proto.ListNotificationsRequest
+	19,  // 114: proto.ForumService.GetUnreadCount:input_type -> proto.EmptyMessage
+	89,  // 115: proto.ForumService.MarkRead:input_type -> proto.MarkReadRequest
+	19,  // 116: proto.ForumService.MarkAllRead:input_type -> proto.EmptyMessage
+	92,  // 117: proto.ForumService.ListMentions:input_type -> proto.ListMentionsRequest
+	96,  // 118: proto.ForumService.GetPostRevisions:input_type -> proto.GetRevisionsRequest
+	96,  // 119: proto.ForumService.GetCommentRevisions:input_type -> proto.GetRevisionsRequest
+	98,  // 120: proto.ForumService.RollbackPost:input_type -> proto.RollbackRequest
+	98,  // 121: proto.ForumService.RollbackComment:input_type -> proto.RollbackRequest
+	100, // 122: proto.ForumService.ListTrash:input_type -> proto.ListTrashRequest
+	102, // 123: proto.ForumService.RestorePost:input_type -> proto.RestoreRequest
+	102, // 124: proto.ForumService.RestoreComment:input_type -> proto.RestoreRequest
+	103, // 125: proto.ForumService.Report:input_type -> proto.ReportRequest
+	106, // 126: proto.ForumService.ListReports:input_type -> proto.ListReportsRequest
+	109, // 127: proto.ForumService.ResolveReport:input_type -> proto.ResolveReportRequest
+	111, // 128: proto.ForumService.ListMyWarnings:input_type -> proto.ListWarningsRequest
+	115, // 129: proto.ForumService.ListHeld:input_type -> proto.ListHeldRequest
+	117, // 130: proto.ForumService.ModerateHeld:input_type -> proto.ModerateHeldRequest
+	139, // 131: proto.ForumService.UploadAttachment:input_type -> proto.UploadAttachmentRequest
+	140, // 132: proto.ForumService.GetAttachment:input_type -> proto.GetAttachmentRequest
+	142, // 133: proto.ForumService.DeleteAttachment:input_type -> proto.DeleteAttachmentRequest
+	119, // 134: proto.ForumService.SendMessage:input_type -> proto.ChatMessage
+	120, // 135: proto.ForumService.GetMessages:input_type -> proto.GetMessagesRequest
+	21,  // 136: proto.AuthService.Register:output_type -> proto.RegisterResponse
+	125, // 137: proto.AuthService.GetUserByID:output_type -> proto.UserProfileResponse
+	129, // 138: proto.AuthService.LookupUsers:output_type -> proto.LookupUsersResponse
+	131, // 139: proto.AuthService.ChangeUsername:output_type -> proto.ChangeUsernameResponse
+	134, // 140: proto.AuthService.ListUserEvents:output_type -> proto.ListUserEventsResponse
+	30,  // 141: proto.AuthService.ExportUsers:output_type -> proto.ExportUsersResponse
+	32,  // 142: proto.AuthService.ImportUser:output_type -> proto.ImportUserResponse
+	23,  // 143: proto.AuthService.Login:output_type -> proto.LoginResponse
+	25,  // 144: proto.AuthService.RefreshToken:output_type -> proto.RefreshTokenResponse
+	27,  // 145: proto.AuthService.ValidateToken:output_type -> proto.ValidateResponse
+	34,  // 146: proto.AuthService.Logout:output_type -> proto.LogoutResponse
+	136, // 147: proto.AuthService.CheckAdminStatus:output_type -> proto.CheckAdminResponse
+	37,  // 148: proto.ForumService.CreatePost:output_type -> proto.PostResponse
+	37,  // 149: proto.ForumService.GetPost:output_type -> proto.PostResponse
+	37,  // 150: proto.ForumService.UpdatePost:output_type -> proto.PostResponse
+	19,  // 151: proto.ForumService.DeletePost:output_type -> proto.EmptyMessage
+	45,  // 152: proto.ForumService.Posts:output_type -> proto.ListPostsResponse
+	45,  // 153: proto.ForumService.ListMyDrafts:output_type -> proto.ListPostsResponse
+	37,  // 154: proto.ForumService.PublishPost:output_type -> proto.PostResponse
+	37,  // 155: proto.ForumService.PinPost:output_type -> proto.PostResponse
+	37,  // 156: proto.ForumService.LockPost:output_type -> proto.PostResponse
+	59,  // 157: proto.ForumService.CreateComment:output_type -> proto.CommentResponse
+	59,  // 158: proto.ForumService.GetCommentByID:output_type -> proto.CommentResponse
+	64,  // 159: proto.ForumService.GetByPostID:output_type -> proto.ListCommentsResponse
+	64,  // 160: proto.ForumService.Comments:output_type -> proto.ListCommentsResponse
+	59,  // 161: proto.ForumService.UpdateComment:output_type -> proto.CommentResponse
+	19,  // 162: proto.ForumService.DeleteComment:output_type -> proto.EmptyMessage
+	69,  // 163: proto.ForumService.SearchPosts:output_type -> proto.SearchPostsResponse
+	51,  // 164: proto.ForumService.CreateCategory:output_type -> proto.CategoryResponse
+	51,  // 165: proto.ForumService.GetCategory:output_type -> proto.CategoryResponse
+	51,  // 166: proto.ForumService.UpdateCategory:output_type -> proto.CategoryResponse
+	19,  // 167: proto.ForumService.DeleteCategory:output_type -> proto.EmptyMessage
+	57,  // 168: proto.ForumService.ListCategories:output_type -> proto.ListCategoriesResponse
+	77,  // 169: proto.ForumService.Vote:output_type -> proto.VoteResponse
+	77,  // 170: proto.ForumService.RemoveVote:output_type -> proto.VoteResponse
+	76,  // 171: proto.ForumService.CastPollVote:output_type -> proto.PollResponse
+	76,  // 172: proto.ForumService.GetPollResults:output_type -> proto.PollResponse
+	82,  // 173: proto.ForumService.AddBookmark:output_type -> proto.BookmarkResponse
+	19,  // 174: proto.ForumService.RemoveBookmark:output_type -> proto.EmptyMessage
+	83,  // 175: proto.ForumService.ListBookmarks:output_type -> proto.ListBookmarksResponse
+	86,  // 176: proto.ForumService.FollowPost:output_type -> proto.FollowPostResponse
+	86,  // 177: proto.ForumService.UnfollowPost:output_type -> proto.FollowPostResponse
+	88,  // 178: proto.ForumService.ListNotifications:output_type -> proto.ListNotificationsResponse
+	90,  // 179: proto.ForumService.GetUnreadCount:output_type -> proto.UnreadCountResponse
+	90,  // 180: proto.ForumService.MarkRead:output_type -> proto.UnreadCountResponse
+	90,  // 181: proto.ForumService.MarkAllRead:output_type -> proto.UnreadCountResponse
+	93,  // 182: proto.ForumService.ListMentions:output_type -> proto.ListMentionsResponse
+	97,  // 183: proto.ForumService.GetPostRevisions:output_type -> proto.RevisionsResponse
+	97,  // 184: proto.ForumService.GetCommentRevisions:output_type -> proto.RevisionsResponse
+	37,  // 185: proto.ForumService.RollbackPost:output_type -> proto.PostResponse
+	59,  // 186: proto.ForumService.RollbackComment:output_type -> proto.CommentResponse
+	101, // 187: proto.ForumService.ListTrash:output_type -> proto.ListTrashResponse
+	37,  // 188: proto.ForumService.RestorePost:output_type -> proto.PostResponse
+	59,  // 189: proto.ForumService.RestoreComment:output_type -> proto.CommentResponse
+	105, // 190: proto.ForumService.Report:output_type -> proto.ReportResponse
+	108, // 191: proto.ForumService.ListReports:output_type -> proto.ListReportsResponse
+	110, // 192: proto.ForumService.ResolveReport:output_type -> proto.ResolveReportResponse
+	113, // 193: proto.ForumService.ListMyWarnings:output_type -> proto.ListWarningsResponse
+	116, // 194: proto.ForumService.ListHeld:output_type -> proto.ListHeldResponse
+	118, // 195: proto.ForumService.ModerateHeld:output_type -> proto.ModerateHeldResponse
+	138, // 196: proto.ForumService.UploadAttachment:output_type -> proto.AttachmentResponse
+	141, // 197: proto.ForumService.GetAttachment:output_type -> proto.AttachmentContentResponse
+	19,  // 198: proto.ForumService.DeleteAttachment:output_type -> proto.EmptyMessage
+	19,  // 199: proto.ForumService.SendMessage:output_type -> proto.EmptyMessage
+	121, // 200: proto.ForumService.GetMessages:output_type -> proto.GetMessagesResponse
+	136, // [136:201] is the sub-list for method output_type
+	71,  // [71:136] is the sub-list for method input_type
+	71,  // [71:71] is the sub-list for extension type_name
+	71,  // [71:71] is the sub-list for extension extendee
+	0,   // [0:71] is the sub-list for field type_name
 }
 
 func init() { file_proto_forum_proto_init() }
@@ -2415,16 +9823,21 @@ func file_proto_forum_proto_init() {
 	if File_proto_forum_proto != nil {
 		return
 	}
-	file_proto_forum_proto_msgTypes[15].OneofWrappers = []any{}
-	file_proto_forum_proto_msgTypes[17].OneofWrappers = []any{}
-	file_proto_forum_proto_msgTypes[26].OneofWrappers = []any{}
+	file_proto_forum_proto_msgTypes[19].OneofWrappers = []any{}
+	file_proto_forum_proto_msgTypes[23].OneofWrappers = []any{}
+	file_proto_forum_proto_msgTypes[25].OneofWrappers = []any{}
+	file_proto_forum_proto_msgTypes[35].OneofWrappers = []any{}
+	file_proto_forum_proto_msgTypes[41].OneofWrappers = []any{}
+	file_proto_forum_proto_msgTypes[46].OneofWrappers = []any{}
+	file_proto_forum_proto_msgTypes[48].OneofWrappers = []any{}
+	file_proto_forum_proto_msgTypes[87].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_forum_proto_rawDesc), len(file_proto_forum_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   38,
+			NumEnums:      19,
+			NumMessages:   124,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
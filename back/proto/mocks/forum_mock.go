@@ -36,6 +36,26 @@ func (m *MockAuthServiceClient) EXPECT() *MockAuthServiceClientMockRecorder {
 	return m.recorder
 }
 
+// ChangeUsername mocks base method.
+func (m *MockAuthServiceClient) ChangeUsername(ctx context.Context, in *proto.ChangeUsernameRequest, opts ...grpc.CallOption) (*proto.ChangeUsernameResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeUsername", varargs...)
+	ret0, _ := ret[0].(*proto.ChangeUsernameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeUsername indicates an expected call of ChangeUsername.
+func (mr *MockAuthServiceClientMockRecorder) ChangeUsername(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUsername", reflect.TypeOf((*MockAuthServiceClient)(nil).ChangeUsername), varargs...)
+}
+
 // CheckAdminStatus mocks base method.
 func (m *MockAuthServiceClient) CheckAdminStatus(ctx context.Context, in *proto.CheckAdminRequest, opts ...grpc.CallOption) (*proto.CheckAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +76,26 @@ func (mr *MockAuthServiceClientMockRecorder) CheckAdminStatus(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAdminStatus", reflect.TypeOf((*MockAuthServiceClient)(nil).CheckAdminStatus), varargs...)
 }
 
+// ExportUsers mocks base method.
+func (m *MockAuthServiceClient) ExportUsers(ctx context.Context, in *proto.ExportUsersRequest, opts ...grpc.CallOption) (*proto.ExportUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportUsers", varargs...)
+	ret0, _ := ret[0].(*proto.ExportUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUsers indicates an expected call of ExportUsers.
+func (mr *MockAuthServiceClientMockRecorder) ExportUsers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUsers", reflect.TypeOf((*MockAuthServiceClient)(nil).ExportUsers), varargs...)
+}
+
 // GetUserByID mocks base method.
 func (m *MockAuthServiceClient) GetUserByID(ctx context.Context, in *proto.GetUserRequest, opts ...grpc.CallOption) (*proto.UserProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +116,46 @@ func (mr *MockAuthServiceClientMockRecorder) GetUserByID(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockAuthServiceClient)(nil).GetUserByID), varargs...)
 }
 
+// ImportUser mocks base method.
+func (m *MockAuthServiceClient) ImportUser(ctx context.Context, in *proto.ImportUserRequest, opts ...grpc.CallOption) (*proto.ImportUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportUser", varargs...)
+	ret0, _ := ret[0].(*proto.ImportUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUser indicates an expected call of ImportUser.
+func (mr *MockAuthServiceClientMockRecorder) ImportUser(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUser", reflect.TypeOf((*MockAuthServiceClient)(nil).ImportUser), varargs...)
+}
+
+// ListUserEvents mocks base method.
+func (m *MockAuthServiceClient) ListUserEvents(ctx context.Context, in *proto.ListUserEventsRequest, opts ...grpc.CallOption) (*proto.ListUserEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUserEvents", varargs...)
+	ret0, _ := ret[0].(*proto.ListUserEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserEvents indicates an expected call of ListUserEvents.
+func (mr *MockAuthServiceClientMockRecorder) ListUserEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserEvents", reflect.TypeOf((*MockAuthServiceClient)(nil).ListUserEvents), varargs...)
+}
+
 // Login mocks base method.
 func (m *MockAuthServiceClient) Login(ctx context.Context, in *proto.LoginRequest, opts ...grpc.CallOption) (*proto.LoginResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +196,26 @@ func (mr *MockAuthServiceClientMockRecorder) Logout(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceClient)(nil).Logout), varargs...)
 }
 
+// LookupUsers mocks base method.
+func (m *MockAuthServiceClient) LookupUsers(ctx context.Context, in *proto.LookupUsersRequest, opts ...grpc.CallOption) (*proto.LookupUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LookupUsers", varargs...)
+	ret0, _ := ret[0].(*proto.LookupUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupUsers indicates an expected call of LookupUsers.
+func (mr *MockAuthServiceClientMockRecorder) LookupUsers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupUsers", reflect.TypeOf((*MockAuthServiceClient)(nil).LookupUsers), varargs...)
+}
+
 // RefreshToken mocks base method.
 func (m *MockAuthServiceClient) RefreshToken(ctx context.Context, in *proto.RefreshTokenRequest, opts ...grpc.CallOption) (*proto.RefreshTokenResponse, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +299,21 @@ func (m *MockAuthServiceServer) EXPECT() *MockAuthServiceServerMockRecorder {
 	return m.recorder
 }
 
+// ChangeUsername mocks base method.
+func (m *MockAuthServiceServer) ChangeUsername(arg0 context.Context, arg1 *proto.ChangeUsernameRequest) (*proto.ChangeUsernameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUsername", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ChangeUsernameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeUsername indicates an expected call of ChangeUsername.
+func (mr *MockAuthServiceServerMockRecorder) ChangeUsername(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUsername", reflect.TypeOf((*MockAuthServiceServer)(nil).ChangeUsername), arg0, arg1)
+}
+
 // CheckAdminStatus mocks base method.
 func (m *MockAuthServiceServer) CheckAdminStatus(arg0 context.Context, arg1 *proto.CheckAdminRequest) (*proto.CheckAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +329,21 @@ func (mr *MockAuthServiceServerMockRecorder) CheckAdminStatus(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAdminStatus", reflect.TypeOf((*MockAuthServiceServer)(nil).CheckAdminStatus), arg0, arg1)
 }
 
+// ExportUsers mocks base method.
+func (m *MockAuthServiceServer) ExportUsers(arg0 context.Context, arg1 *proto.ExportUsersRequest) (*proto.ExportUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUsers", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ExportUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUsers indicates an expected call of ExportUsers.
+func (mr *MockAuthServiceServerMockRecorder) ExportUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUsers", reflect.TypeOf((*MockAuthServiceServer)(nil).ExportUsers), arg0, arg1)
+}
+
 // GetUserByID mocks base method.
 func (m *MockAuthServiceServer) GetUserByID(arg0 context.Context, arg1 *proto.GetUserRequest) (*proto.UserProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +359,36 @@ func (mr *MockAuthServiceServerMockRecorder) GetUserByID(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockAuthServiceServer)(nil).GetUserByID), arg0, arg1)
 }
 
+// ImportUser mocks base method.
+func (m *MockAuthServiceServer) ImportUser(arg0 context.Context, arg1 *proto.ImportUserRequest) (*proto.ImportUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUser", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ImportUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUser indicates an expected call of ImportUser.
+func (mr *MockAuthServiceServerMockRecorder) ImportUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUser", reflect.TypeOf((*MockAuthServiceServer)(nil).ImportUser), arg0, arg1)
+}
+
+// ListUserEvents mocks base method.
+func (m *MockAuthServiceServer) ListUserEvents(arg0 context.Context, arg1 *proto.ListUserEventsRequest) (*proto.ListUserEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserEvents", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListUserEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserEvents indicates an expected call of ListUserEvents.
+func (mr *MockAuthServiceServerMockRecorder) ListUserEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserEvents", reflect.TypeOf((*MockAuthServiceServer)(nil).ListUserEvents), arg0, arg1)
+}
+
 // Login mocks base method.
 func (m *MockAuthServiceServer) Login(arg0 context.Context, arg1 *proto.LoginRequest) (*proto.LoginResponse, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +419,21 @@ func (mr *MockAuthServiceServerMockRecorder) Logout(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceServer)(nil).Logout), arg0, arg1)
 }
 
+// LookupUsers mocks base method.
+func (m *MockAuthServiceServer) LookupUsers(arg0 context.Context, arg1 *proto.LookupUsersRequest) (*proto.LookupUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupUsers", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LookupUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupUsers indicates an expected call of LookupUsers.
+func (mr *MockAuthServiceServerMockRecorder) LookupUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupUsers", reflect.TypeOf((*MockAuthServiceServer)(nil).LookupUsers), arg0, arg1)
+}
+
 // RefreshToken mocks base method.
 func (m *MockAuthServiceServer) RefreshToken(arg0 context.Context, arg1 *proto.RefreshTokenRequest) (*proto.RefreshTokenResponse, error) {
 	m.ctrl.T.Helper()
@@ -374,6 +549,46 @@ func (m *MockForumServiceClient) EXPECT() *MockForumServiceClientMockRecorder {
 	return m.recorder
 }
 
+// AddBookmark mocks base method.
+func (m *MockForumServiceClient) AddBookmark(ctx context.Context, in *proto.AddBookmarkRequest, opts ...grpc.CallOption) (*proto.BookmarkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddBookmark", varargs...)
+	ret0, _ := ret[0].(*proto.BookmarkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBookmark indicates an expected call of AddBookmark.
+func (mr *MockForumServiceClientMockRecorder) AddBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookmark", reflect.TypeOf((*MockForumServiceClient)(nil).AddBookmark), varargs...)
+}
+
+// CastPollVote mocks base method.
+func (m *MockForumServiceClient) CastPollVote(ctx context.Context, in *proto.CastPollVoteRequest, opts ...grpc.CallOption) (*proto.PollResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CastPollVote", varargs...)
+	ret0, _ := ret[0].(*proto.PollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CastPollVote indicates an expected call of CastPollVote.
+func (mr *MockForumServiceClientMockRecorder) CastPollVote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CastPollVote", reflect.TypeOf((*MockForumServiceClient)(nil).CastPollVote), varargs...)
+}
+
 // Comments mocks base method.
 func (m *MockForumServiceClient) Comments(ctx context.Context, in *proto.ListCommentsRequest, opts ...grpc.CallOption) (*proto.ListCommentsResponse, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +609,26 @@ func (mr *MockForumServiceClientMockRecorder) Comments(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Comments", reflect.TypeOf((*MockForumServiceClient)(nil).Comments), varargs...)
 }
 
+// CreateCategory mocks base method.
+func (m *MockForumServiceClient) CreateCategory(ctx context.Context, in *proto.CreateCategoryRequest, opts ...grpc.CallOption) (*proto.CategoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCategory", varargs...)
+	ret0, _ := ret[0].(*proto.CategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockForumServiceClientMockRecorder) CreateCategory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockForumServiceClient)(nil).CreateCategory), varargs...)
+}
+
 // CreateComment mocks base method.
 func (m *MockForumServiceClient) CreateComment(ctx context.Context, in *proto.CreateCommentRequest, opts ...grpc.CallOption) (*proto.CommentResponse, error) {
 	m.ctrl.T.Helper()
@@ -434,6 +669,46 @@ func (mr *MockForumServiceClientMockRecorder) CreatePost(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePost", reflect.TypeOf((*MockForumServiceClient)(nil).CreatePost), varargs...)
 }
 
+// DeleteAttachment mocks base method.
+func (m *MockForumServiceClient) DeleteAttachment(ctx context.Context, in *proto.DeleteAttachmentRequest, opts ...grpc.CallOption) (*proto.EmptyMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAttachment", varargs...)
+	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockForumServiceClientMockRecorder) DeleteAttachment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockForumServiceClient)(nil).DeleteAttachment), varargs...)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockForumServiceClient) DeleteCategory(ctx context.Context, in *proto.DeleteCategoryRequest, opts ...grpc.CallOption) (*proto.EmptyMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCategory", varargs...)
+	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockForumServiceClientMockRecorder) DeleteCategory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockForumServiceClient)(nil).DeleteCategory), varargs...)
+}
+
 // DeleteComment mocks base method.
 func (m *MockForumServiceClient) DeleteComment(ctx context.Context, in *proto.DeleteCommentRequest, opts ...grpc.CallOption) (*proto.EmptyMessage, error) {
 	m.ctrl.T.Helper()
@@ -474,6 +749,46 @@ func (mr *MockForumServiceClientMockRecorder) DeletePost(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockForumServiceClient)(nil).DeletePost), varargs...)
 }
 
+// FollowPost mocks base method.
+func (m *MockForumServiceClient) FollowPost(ctx context.Context, in *proto.FollowPostRequest, opts ...grpc.CallOption) (*proto.FollowPostResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FollowPost", varargs...)
+	ret0, _ := ret[0].(*proto.FollowPostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowPost indicates an expected call of FollowPost.
+func (mr *MockForumServiceClientMockRecorder) FollowPost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowPost", reflect.TypeOf((*MockForumServiceClient)(nil).FollowPost), varargs...)
+}
+
+// GetAttachment mocks base method.
+func (m *MockForumServiceClient) GetAttachment(ctx context.Context, in *proto.GetAttachmentRequest, opts ...grpc.CallOption) (*proto.AttachmentContentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAttachment", varargs...)
+	ret0, _ := ret[0].(*proto.AttachmentContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockForumServiceClientMockRecorder) GetAttachment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockForumServiceClient)(nil).GetAttachment), varargs...)
+}
+
 // GetByPostID mocks base method.
 func (m *MockForumServiceClient) GetByPostID(ctx context.Context, in *proto.GetCommentsByPostIDRequest, opts ...grpc.CallOption) (*proto.ListCommentsResponse, error) {
 	m.ctrl.T.Helper()
@@ -494,6 +809,26 @@ func (mr *MockForumServiceClientMockRecorder) GetByPostID(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPostID", reflect.TypeOf((*MockForumServiceClient)(nil).GetByPostID), varargs...)
 }
 
+// GetCategory mocks base method.
+func (m *MockForumServiceClient) GetCategory(ctx context.Context, in *proto.GetCategoryRequest, opts ...grpc.CallOption) (*proto.CategoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCategory", varargs...)
+	ret0, _ := ret[0].(*proto.CategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockForumServiceClientMockRecorder) GetCategory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockForumServiceClient)(nil).GetCategory), varargs...)
+}
+
 // GetCommentByID mocks base method.
 func (m *MockForumServiceClient) GetCommentByID(ctx context.Context, in *proto.GetCommentRequest, opts ...grpc.CallOption) (*proto.CommentResponse, error) {
 	m.ctrl.T.Helper()
@@ -514,6 +849,26 @@ func (mr *MockForumServiceClientMockRecorder) GetCommentByID(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockForumServiceClient)(nil).GetCommentByID), varargs...)
 }
 
+// GetCommentRevisions mocks base method.
+func (m *MockForumServiceClient) GetCommentRevisions(ctx context.Context, in *proto.GetRevisionsRequest, opts ...grpc.CallOption) (*proto.RevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCommentRevisions", varargs...)
+	ret0, _ := ret[0].(*proto.RevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentRevisions indicates an expected call of GetCommentRevisions.
+func (mr *MockForumServiceClientMockRecorder) GetCommentRevisions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentRevisions", reflect.TypeOf((*MockForumServiceClient)(nil).GetCommentRevisions), varargs...)
+}
+
 // GetMessages mocks base method.
 func (m *MockForumServiceClient) GetMessages(ctx context.Context, in *proto.GetMessagesRequest, opts ...grpc.CallOption) (*proto.GetMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -534,6 +889,26 @@ func (mr *MockForumServiceClientMockRecorder) GetMessages(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockForumServiceClient)(nil).GetMessages), varargs...)
 }
 
+// GetPollResults mocks base method.
+func (m *MockForumServiceClient) GetPollResults(ctx context.Context, in *proto.GetPollResultsRequest, opts ...grpc.CallOption) (*proto.PollResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPollResults", varargs...)
+	ret0, _ := ret[0].(*proto.PollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPollResults indicates an expected call of GetPollResults.
+func (mr *MockForumServiceClientMockRecorder) GetPollResults(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPollResults", reflect.TypeOf((*MockForumServiceClient)(nil).GetPollResults), varargs...)
+}
+
 // GetPost mocks base method.
 func (m *MockForumServiceClient) GetPost(ctx context.Context, in *proto.GetPostRequest, opts ...grpc.CallOption) (*proto.PostResponse, error) {
 	m.ctrl.T.Helper()
@@ -554,242 +929,1232 @@ func (mr *MockForumServiceClientMockRecorder) GetPost(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPost", reflect.TypeOf((*MockForumServiceClient)(nil).GetPost), varargs...)
 }
 
-// Posts mocks base method.
-func (m *MockForumServiceClient) Posts(ctx context.Context, in *proto.ListPostsRequest, opts ...grpc.CallOption) (*proto.ListPostsResponse, error) {
+// GetPostRevisions mocks base method.
+func (m *MockForumServiceClient) GetPostRevisions(ctx context.Context, in *proto.GetRevisionsRequest, opts ...grpc.CallOption) (*proto.RevisionsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Posts", varargs...)
-	ret0, _ := ret[0].(*proto.ListPostsResponse)
+	ret := m.ctrl.Call(m, "GetPostRevisions", varargs...)
+	ret0, _ := ret[0].(*proto.RevisionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Posts indicates an expected call of Posts.
-func (mr *MockForumServiceClientMockRecorder) Posts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetPostRevisions indicates an expected call of GetPostRevisions.
+func (mr *MockForumServiceClientMockRecorder) GetPostRevisions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Posts", reflect.TypeOf((*MockForumServiceClient)(nil).Posts), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostRevisions", reflect.TypeOf((*MockForumServiceClient)(nil).GetPostRevisions), varargs...)
 }
 
-// SendMessage mocks base method.
-func (m *MockForumServiceClient) SendMessage(ctx context.Context, in *proto.ChatMessage, opts ...grpc.CallOption) (*proto.EmptyMessage, error) {
+// GetUnreadCount mocks base method.
+func (m *MockForumServiceClient) GetUnreadCount(ctx context.Context, in *proto.EmptyMessage, opts ...grpc.CallOption) (*proto.UnreadCountResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SendMessage", varargs...)
-	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret := m.ctrl.Call(m, "GetUnreadCount", varargs...)
+	ret0, _ := ret[0].(*proto.UnreadCountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendMessage indicates an expected call of SendMessage.
-func (mr *MockForumServiceClientMockRecorder) SendMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockForumServiceClientMockRecorder) GetUnreadCount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockForumServiceClient)(nil).SendMessage), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockForumServiceClient)(nil).GetUnreadCount), varargs...)
 }
 
-// UpdateComment mocks base method.
-func (m *MockForumServiceClient) UpdateComment(ctx context.Context, in *proto.UpdateCommentRequest, opts ...grpc.CallOption) (*proto.CommentResponse, error) {
+// ListBookmarks mocks base method.
+func (m *MockForumServiceClient) ListBookmarks(ctx context.Context, in *proto.ListBookmarksRequest, opts ...grpc.CallOption) (*proto.ListBookmarksResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateComment", varargs...)
-	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret := m.ctrl.Call(m, "ListBookmarks", varargs...)
+	ret0, _ := ret[0].(*proto.ListBookmarksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateComment indicates an expected call of UpdateComment.
+// ListBookmarks indicates an expected call of ListBookmarks.
+func (mr *MockForumServiceClientMockRecorder) ListBookmarks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarks", reflect.TypeOf((*MockForumServiceClient)(nil).ListBookmarks), varargs...)
+}
+
+// ListCategories mocks base method.
+func (m *MockForumServiceClient) ListCategories(ctx context.Context, in *proto.ListCategoriesRequest, opts ...grpc.CallOption) (*proto.ListCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCategories", varargs...)
+	ret0, _ := ret[0].(*proto.ListCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockForumServiceClientMockRecorder) ListCategories(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockForumServiceClient)(nil).ListCategories), varargs...)
+}
+
+// ListHeld mocks base method.
+func (m *MockForumServiceClient) ListHeld(ctx context.Context, in *proto.ListHeldRequest, opts ...grpc.CallOption) (*proto.ListHeldResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHeld", varargs...)
+	ret0, _ := ret[0].(*proto.ListHeldResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHeld indicates an expected call of ListHeld.
+func (mr *MockForumServiceClientMockRecorder) ListHeld(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHeld", reflect.TypeOf((*MockForumServiceClient)(nil).ListHeld), varargs...)
+}
+
+// ListMentions mocks base method.
+func (m *MockForumServiceClient) ListMentions(ctx context.Context, in *proto.ListMentionsRequest, opts ...grpc.CallOption) (*proto.ListMentionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMentions", varargs...)
+	ret0, _ := ret[0].(*proto.ListMentionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMentions indicates an expected call of ListMentions.
+func (mr *MockForumServiceClientMockRecorder) ListMentions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMentions", reflect.TypeOf((*MockForumServiceClient)(nil).ListMentions), varargs...)
+}
+
+// ListMyDrafts mocks base method.
+func (m *MockForumServiceClient) ListMyDrafts(ctx context.Context, in *proto.ListMyDraftsRequest, opts ...grpc.CallOption) (*proto.ListPostsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMyDrafts", varargs...)
+	ret0, _ := ret[0].(*proto.ListPostsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyDrafts indicates an expected call of ListMyDrafts.
+func (mr *MockForumServiceClientMockRecorder) ListMyDrafts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyDrafts", reflect.TypeOf((*MockForumServiceClient)(nil).ListMyDrafts), varargs...)
+}
+
+// ListMyWarnings mocks base method.
+func (m *MockForumServiceClient) ListMyWarnings(ctx context.Context, in *proto.ListWarningsRequest, opts ...grpc.CallOption) (*proto.ListWarningsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMyWarnings", varargs...)
+	ret0, _ := ret[0].(*proto.ListWarningsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyWarnings indicates an expected call of ListMyWarnings.
+func (mr *MockForumServiceClientMockRecorder) ListMyWarnings(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyWarnings", reflect.TypeOf((*MockForumServiceClient)(nil).ListMyWarnings), varargs...)
+}
+
+// ListNotifications mocks base method.
+func (m *MockForumServiceClient) ListNotifications(ctx context.Context, in *proto.ListNotificationsRequest, opts ...grpc.CallOption) (*proto.ListNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
+	ret0, _ := ret[0].(*proto.ListNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockForumServiceClientMockRecorder) ListNotifications(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockForumServiceClient)(nil).ListNotifications), varargs...)
+}
+
+// ListReports mocks base method.
+func (m *MockForumServiceClient) ListReports(ctx context.Context, in *proto.ListReportsRequest, opts ...grpc.CallOption) (*proto.ListReportsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReports", varargs...)
+	ret0, _ := ret[0].(*proto.ListReportsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReports indicates an expected call of ListReports.
+func (mr *MockForumServiceClientMockRecorder) ListReports(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockForumServiceClient)(nil).ListReports), varargs...)
+}
+
+// ListTrash mocks base method.
+func (m *MockForumServiceClient) ListTrash(ctx context.Context, in *proto.ListTrashRequest, opts ...grpc.CallOption) (*proto.ListTrashResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrash", varargs...)
+	ret0, _ := ret[0].(*proto.ListTrashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockForumServiceClientMockRecorder) ListTrash(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockForumServiceClient)(nil).ListTrash), varargs...)
+}
+
+// LockPost mocks base method.
+func (m *MockForumServiceClient) LockPost(ctx context.Context, in *proto.LockPostRequest, opts ...grpc.CallOption) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LockPost", varargs...)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPost indicates an expected call of LockPost.
+func (mr *MockForumServiceClientMockRecorder) LockPost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPost", reflect.TypeOf((*MockForumServiceClient)(nil).LockPost), varargs...)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockForumServiceClient) MarkAllRead(ctx context.Context, in *proto.EmptyMessage, opts ...grpc.CallOption) (*proto.UnreadCountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkAllRead", varargs...)
+	ret0, _ := ret[0].(*proto.UnreadCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockForumServiceClientMockRecorder) MarkAllRead(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockForumServiceClient)(nil).MarkAllRead), varargs...)
+}
+
+// MarkRead mocks base method.
+func (m *MockForumServiceClient) MarkRead(ctx context.Context, in *proto.MarkReadRequest, opts ...grpc.CallOption) (*proto.UnreadCountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkRead", varargs...)
+	ret0, _ := ret[0].(*proto.UnreadCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockForumServiceClientMockRecorder) MarkRead(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockForumServiceClient)(nil).MarkRead), varargs...)
+}
+
+// ModerateHeld mocks base method.
+func (m *MockForumServiceClient) ModerateHeld(ctx context.Context, in *proto.ModerateHeldRequest, opts ...grpc.CallOption) (*proto.ModerateHeldResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModerateHeld", varargs...)
+	ret0, _ := ret[0].(*proto.ModerateHeldResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateHeld indicates an expected call of ModerateHeld.
+func (mr *MockForumServiceClientMockRecorder) ModerateHeld(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateHeld", reflect.TypeOf((*MockForumServiceClient)(nil).ModerateHeld), varargs...)
+}
+
+// PinPost mocks base method.
+func (m *MockForumServiceClient) PinPost(ctx context.Context, in *proto.PinPostRequest, opts ...grpc.CallOption) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PinPost", varargs...)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinPost indicates an expected call of PinPost.
+func (mr *MockForumServiceClientMockRecorder) PinPost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinPost", reflect.TypeOf((*MockForumServiceClient)(nil).PinPost), varargs...)
+}
+
+// Posts mocks base method.
+func (m *MockForumServiceClient) Posts(ctx context.Context, in *proto.ListPostsRequest, opts ...grpc.CallOption) (*proto.ListPostsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Posts", varargs...)
+	ret0, _ := ret[0].(*proto.ListPostsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Posts indicates an expected call of Posts.
+func (mr *MockForumServiceClientMockRecorder) Posts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Posts", reflect.TypeOf((*MockForumServiceClient)(nil).Posts), varargs...)
+}
+
+// PublishPost mocks base method.
+func (m *MockForumServiceClient) PublishPost(ctx context.Context, in *proto.PublishPostRequest, opts ...grpc.CallOption) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishPost", varargs...)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishPost indicates an expected call of PublishPost.
+func (mr *MockForumServiceClientMockRecorder) PublishPost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishPost", reflect.TypeOf((*MockForumServiceClient)(nil).PublishPost), varargs...)
+}
+
+// RemoveBookmark mocks base method.
+func (m *MockForumServiceClient) RemoveBookmark(ctx context.Context, in *proto.RemoveBookmarkRequest, opts ...grpc.CallOption) (*proto.EmptyMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveBookmark", varargs...)
+	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveBookmark indicates an expected call of RemoveBookmark.
+func (mr *MockForumServiceClientMockRecorder) RemoveBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBookmark", reflect.TypeOf((*MockForumServiceClient)(nil).RemoveBookmark), varargs...)
+}
+
+// RemoveVote mocks base method.
+func (m *MockForumServiceClient) RemoveVote(ctx context.Context, in *proto.RemoveVoteRequest, opts ...grpc.CallOption) (*proto.VoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveVote", varargs...)
+	ret0, _ := ret[0].(*proto.VoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVote indicates an expected call of RemoveVote.
+func (mr *MockForumServiceClientMockRecorder) RemoveVote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVote", reflect.TypeOf((*MockForumServiceClient)(nil).RemoveVote), varargs...)
+}
+
+// Report mocks base method.
+func (m *MockForumServiceClient) Report(ctx context.Context, in *proto.ReportRequest, opts ...grpc.CallOption) (*proto.ReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Report", varargs...)
+	ret0, _ := ret[0].(*proto.ReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockForumServiceClientMockRecorder) Report(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockForumServiceClient)(nil).Report), varargs...)
+}
+
+// ResolveReport mocks base method.
+func (m *MockForumServiceClient) ResolveReport(ctx context.Context, in *proto.ResolveReportRequest, opts ...grpc.CallOption) (*proto.ResolveReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveReport", varargs...)
+	ret0, _ := ret[0].(*proto.ResolveReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockForumServiceClientMockRecorder) ResolveReport(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*MockForumServiceClient)(nil).ResolveReport), varargs...)
+}
+
+// RestoreComment mocks base method.
+func (m *MockForumServiceClient) RestoreComment(ctx context.Context, in *proto.RestoreRequest, opts ...grpc.CallOption) (*proto.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreComment", varargs...)
+	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreComment indicates an expected call of RestoreComment.
+func (mr *MockForumServiceClientMockRecorder) RestoreComment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreComment", reflect.TypeOf((*MockForumServiceClient)(nil).RestoreComment), varargs...)
+}
+
+// RestorePost mocks base method.
+func (m *MockForumServiceClient) RestorePost(ctx context.Context, in *proto.RestoreRequest, opts ...grpc.CallOption) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestorePost", varargs...)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePost indicates an expected call of RestorePost.
+func (mr *MockForumServiceClientMockRecorder) RestorePost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePost", reflect.TypeOf((*MockForumServiceClient)(nil).RestorePost), varargs...)
+}
+
+// RollbackComment mocks base method.
+func (m *MockForumServiceClient) RollbackComment(ctx context.Context, in *proto.RollbackRequest, opts ...grpc.CallOption) (*proto.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackComment", varargs...)
+	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackComment indicates an expected call of RollbackComment.
+func (mr *MockForumServiceClientMockRecorder) RollbackComment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackComment", reflect.TypeOf((*MockForumServiceClient)(nil).RollbackComment), varargs...)
+}
+
+// RollbackPost mocks base method.
+func (m *MockForumServiceClient) RollbackPost(ctx context.Context, in *proto.RollbackRequest, opts ...grpc.CallOption) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackPost", varargs...)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackPost indicates an expected call of RollbackPost.
+func (mr *MockForumServiceClientMockRecorder) RollbackPost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackPost", reflect.TypeOf((*MockForumServiceClient)(nil).RollbackPost), varargs...)
+}
+
+// SearchPosts mocks base method.
+func (m *MockForumServiceClient) SearchPosts(ctx context.Context, in *proto.SearchPostsRequest, opts ...grpc.CallOption) (*proto.SearchPostsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchPosts", varargs...)
+	ret0, _ := ret[0].(*proto.SearchPostsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPosts indicates an expected call of SearchPosts.
+func (mr *MockForumServiceClientMockRecorder) SearchPosts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPosts", reflect.TypeOf((*MockForumServiceClient)(nil).SearchPosts), varargs...)
+}
+
+// SendMessage mocks base method.
+func (m *MockForumServiceClient) SendMessage(ctx context.Context, in *proto.ChatMessage, opts ...grpc.CallOption) (*proto.EmptyMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendMessage", varargs...)
+	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendMessage indicates an expected call of SendMessage.
+func (mr *MockForumServiceClientMockRecorder) SendMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockForumServiceClient)(nil).SendMessage), varargs...)
+}
+
+// UnfollowPost mocks base method.
+func (m *MockForumServiceClient) UnfollowPost(ctx context.Context, in *proto.FollowPostRequest, opts ...grpc.CallOption) (*proto.FollowPostResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnfollowPost", varargs...)
+	ret0, _ := ret[0].(*proto.FollowPostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfollowPost indicates an expected call of UnfollowPost.
+func (mr *MockForumServiceClientMockRecorder) UnfollowPost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowPost", reflect.TypeOf((*MockForumServiceClient)(nil).UnfollowPost), varargs...)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockForumServiceClient) UpdateCategory(ctx context.Context, in *proto.UpdateCategoryRequest, opts ...grpc.CallOption) (*proto.CategoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCategory", varargs...)
+	ret0, _ := ret[0].(*proto.CategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockForumServiceClientMockRecorder) UpdateCategory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockForumServiceClient)(nil).UpdateCategory), varargs...)
+}
+
+// UpdateComment mocks base method.
+func (m *MockForumServiceClient) UpdateComment(ctx context.Context, in *proto.UpdateCommentRequest, opts ...grpc.CallOption) (*proto.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateComment", varargs...)
+	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
 func (mr *MockForumServiceClientMockRecorder) UpdateComment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockForumServiceClient)(nil).UpdateComment), varargs...)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockForumServiceClient)(nil).UpdateComment), varargs...)
+}
+
+// UpdatePost mocks base method.
+func (m *MockForumServiceClient) UpdatePost(ctx context.Context, in *proto.UpdatePostRequest, opts ...grpc.CallOption) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePost", varargs...)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePost indicates an expected call of UpdatePost.
+func (mr *MockForumServiceClientMockRecorder) UpdatePost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockForumServiceClient)(nil).UpdatePost), varargs...)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockForumServiceClient) UploadAttachment(ctx context.Context, in *proto.UploadAttachmentRequest, opts ...grpc.CallOption) (*proto.AttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadAttachment", varargs...)
+	ret0, _ := ret[0].(*proto.AttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockForumServiceClientMockRecorder) UploadAttachment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockForumServiceClient)(nil).UploadAttachment), varargs...)
+}
+
+// Vote mocks base method.
+func (m *MockForumServiceClient) Vote(ctx context.Context, in *proto.VoteRequest, opts ...grpc.CallOption) (*proto.VoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Vote", varargs...)
+	ret0, _ := ret[0].(*proto.VoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockForumServiceClientMockRecorder) Vote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockForumServiceClient)(nil).Vote), varargs...)
+}
+
+// MockForumServiceServer is a mock of ForumServiceServer interface.
+type MockForumServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockForumServiceServerMockRecorder
+}
+
+// MockForumServiceServerMockRecorder is the mock recorder for MockForumServiceServer.
+type MockForumServiceServerMockRecorder struct {
+	mock *MockForumServiceServer
+}
+
+// NewMockForumServiceServer creates a new mock instance.
+func NewMockForumServiceServer(ctrl *gomock.Controller) *MockForumServiceServer {
+	mock := &MockForumServiceServer{ctrl: ctrl}
+	mock.recorder = &MockForumServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForumServiceServer) EXPECT() *MockForumServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddBookmark mocks base method.
+func (m *MockForumServiceServer) AddBookmark(arg0 context.Context, arg1 *proto.AddBookmarkRequest) (*proto.BookmarkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*proto.BookmarkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBookmark indicates an expected call of AddBookmark.
+func (mr *MockForumServiceServerMockRecorder) AddBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookmark", reflect.TypeOf((*MockForumServiceServer)(nil).AddBookmark), arg0, arg1)
+}
+
+// CastPollVote mocks base method.
+func (m *MockForumServiceServer) CastPollVote(arg0 context.Context, arg1 *proto.CastPollVoteRequest) (*proto.PollResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CastPollVote", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CastPollVote indicates an expected call of CastPollVote.
+func (mr *MockForumServiceServerMockRecorder) CastPollVote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CastPollVote", reflect.TypeOf((*MockForumServiceServer)(nil).CastPollVote), arg0, arg1)
+}
+
+// Comments mocks base method.
+func (m *MockForumServiceServer) Comments(arg0 context.Context, arg1 *proto.ListCommentsRequest) (*proto.ListCommentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Comments", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListCommentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Comments indicates an expected call of Comments.
+func (mr *MockForumServiceServerMockRecorder) Comments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Comments", reflect.TypeOf((*MockForumServiceServer)(nil).Comments), arg0, arg1)
+}
+
+// CreateCategory mocks base method.
+func (m *MockForumServiceServer) CreateCategory(arg0 context.Context, arg1 *proto.CreateCategoryRequest) (*proto.CategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockForumServiceServerMockRecorder) CreateCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockForumServiceServer)(nil).CreateCategory), arg0, arg1)
+}
+
+// CreateComment mocks base method.
+func (m *MockForumServiceServer) CreateComment(arg0 context.Context, arg1 *proto.CreateCommentRequest) (*proto.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockForumServiceServerMockRecorder) CreateComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockForumServiceServer)(nil).CreateComment), arg0, arg1)
+}
+
+// CreatePost mocks base method.
+func (m *MockForumServiceServer) CreatePost(arg0 context.Context, arg1 *proto.CreatePostRequest) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePost", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePost indicates an expected call of CreatePost.
+func (mr *MockForumServiceServerMockRecorder) CreatePost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePost", reflect.TypeOf((*MockForumServiceServer)(nil).CreatePost), arg0, arg1)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockForumServiceServer) DeleteAttachment(arg0 context.Context, arg1 *proto.DeleteAttachmentRequest) (*proto.EmptyMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", arg0, arg1)
+	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockForumServiceServerMockRecorder) DeleteAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockForumServiceServer)(nil).DeleteAttachment), arg0, arg1)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockForumServiceServer) DeleteCategory(arg0 context.Context, arg1 *proto.DeleteCategoryRequest) (*proto.EmptyMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", arg0, arg1)
+	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockForumServiceServerMockRecorder) DeleteCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockForumServiceServer)(nil).DeleteCategory), arg0, arg1)
+}
+
+// DeleteComment mocks base method.
+func (m *MockForumServiceServer) DeleteComment(arg0 context.Context, arg1 *proto.DeleteCommentRequest) (*proto.EmptyMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", arg0, arg1)
+	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockForumServiceServerMockRecorder) DeleteComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockForumServiceServer)(nil).DeleteComment), arg0, arg1)
+}
+
+// DeletePost mocks base method.
+func (m *MockForumServiceServer) DeletePost(arg0 context.Context, arg1 *proto.DeletePostRequest) (*proto.EmptyMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePost", arg0, arg1)
+	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePost indicates an expected call of DeletePost.
+func (mr *MockForumServiceServerMockRecorder) DeletePost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockForumServiceServer)(nil).DeletePost), arg0, arg1)
+}
+
+// FollowPost mocks base method.
+func (m *MockForumServiceServer) FollowPost(arg0 context.Context, arg1 *proto.FollowPostRequest) (*proto.FollowPostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowPost", arg0, arg1)
+	ret0, _ := ret[0].(*proto.FollowPostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowPost indicates an expected call of FollowPost.
+func (mr *MockForumServiceServerMockRecorder) FollowPost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowPost", reflect.TypeOf((*MockForumServiceServer)(nil).FollowPost), arg0, arg1)
+}
+
+// GetAttachment mocks base method.
+func (m *MockForumServiceServer) GetAttachment(arg0 context.Context, arg1 *proto.GetAttachmentRequest) (*proto.AttachmentContentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AttachmentContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockForumServiceServerMockRecorder) GetAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockForumServiceServer)(nil).GetAttachment), arg0, arg1)
+}
+
+// GetByPostID mocks base method.
+func (m *MockForumServiceServer) GetByPostID(arg0 context.Context, arg1 *proto.GetCommentsByPostIDRequest) (*proto.ListCommentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPostID", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListCommentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPostID indicates an expected call of GetByPostID.
+func (mr *MockForumServiceServerMockRecorder) GetByPostID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPostID", reflect.TypeOf((*MockForumServiceServer)(nil).GetByPostID), arg0, arg1)
+}
+
+// GetCategory mocks base method.
+func (m *MockForumServiceServer) GetCategory(arg0 context.Context, arg1 *proto.GetCategoryRequest) (*proto.CategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockForumServiceServerMockRecorder) GetCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockForumServiceServer)(nil).GetCategory), arg0, arg1)
+}
+
+// GetCommentByID mocks base method.
+func (m *MockForumServiceServer) GetCommentByID(arg0 context.Context, arg1 *proto.GetCommentRequest) (*proto.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentByID", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentByID indicates an expected call of GetCommentByID.
+func (mr *MockForumServiceServerMockRecorder) GetCommentByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockForumServiceServer)(nil).GetCommentByID), arg0, arg1)
+}
+
+// GetCommentRevisions mocks base method.
+func (m *MockForumServiceServer) GetCommentRevisions(arg0 context.Context, arg1 *proto.GetRevisionsRequest) (*proto.RevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentRevisions", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentRevisions indicates an expected call of GetCommentRevisions.
+func (mr *MockForumServiceServerMockRecorder) GetCommentRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentRevisions", reflect.TypeOf((*MockForumServiceServer)(nil).GetCommentRevisions), arg0, arg1)
+}
+
+// GetMessages mocks base method.
+func (m *MockForumServiceServer) GetMessages(arg0 context.Context, arg1 *proto.GetMessagesRequest) (*proto.GetMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessages", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessages indicates an expected call of GetMessages.
+func (mr *MockForumServiceServerMockRecorder) GetMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockForumServiceServer)(nil).GetMessages), arg0, arg1)
+}
+
+// GetPollResults mocks base method.
+func (m *MockForumServiceServer) GetPollResults(arg0 context.Context, arg1 *proto.GetPollResultsRequest) (*proto.PollResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPollResults", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPollResults indicates an expected call of GetPollResults.
+func (mr *MockForumServiceServerMockRecorder) GetPollResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPollResults", reflect.TypeOf((*MockForumServiceServer)(nil).GetPollResults), arg0, arg1)
+}
+
+// GetPost mocks base method.
+func (m *MockForumServiceServer) GetPost(arg0 context.Context, arg1 *proto.GetPostRequest) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPost", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPost indicates an expected call of GetPost.
+func (mr *MockForumServiceServerMockRecorder) GetPost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPost", reflect.TypeOf((*MockForumServiceServer)(nil).GetPost), arg0, arg1)
 }
 
-// UpdatePost mocks base method.
-func (m *MockForumServiceClient) UpdatePost(ctx context.Context, in *proto.UpdatePostRequest, opts ...grpc.CallOption) (*proto.PostResponse, error) {
+// GetPostRevisions mocks base method.
+func (m *MockForumServiceServer) GetPostRevisions(arg0 context.Context, arg1 *proto.GetRevisionsRequest) (*proto.RevisionsResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpdatePost", varargs...)
-	ret0, _ := ret[0].(*proto.PostResponse)
+	ret := m.ctrl.Call(m, "GetPostRevisions", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RevisionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdatePost indicates an expected call of UpdatePost.
-func (mr *MockForumServiceClientMockRecorder) UpdatePost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetPostRevisions indicates an expected call of GetPostRevisions.
+func (mr *MockForumServiceServerMockRecorder) GetPostRevisions(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockForumServiceClient)(nil).UpdatePost), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostRevisions", reflect.TypeOf((*MockForumServiceServer)(nil).GetPostRevisions), arg0, arg1)
 }
 
-// MockForumServiceServer is a mock of ForumServiceServer interface.
-type MockForumServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockForumServiceServerMockRecorder
+// GetUnreadCount mocks base method.
+func (m *MockForumServiceServer) GetUnreadCount(arg0 context.Context, arg1 *proto.EmptyMessage) (*proto.UnreadCountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UnreadCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockForumServiceServerMockRecorder is the mock recorder for MockForumServiceServer.
-type MockForumServiceServerMockRecorder struct {
-	mock *MockForumServiceServer
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockForumServiceServerMockRecorder) GetUnreadCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockForumServiceServer)(nil).GetUnreadCount), arg0, arg1)
 }
 
-// NewMockForumServiceServer creates a new mock instance.
-func NewMockForumServiceServer(ctrl *gomock.Controller) *MockForumServiceServer {
-	mock := &MockForumServiceServer{ctrl: ctrl}
-	mock.recorder = &MockForumServiceServerMockRecorder{mock}
-	return mock
+// ListBookmarks mocks base method.
+func (m *MockForumServiceServer) ListBookmarks(arg0 context.Context, arg1 *proto.ListBookmarksRequest) (*proto.ListBookmarksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookmarks", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListBookmarksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockForumServiceServer) EXPECT() *MockForumServiceServerMockRecorder {
-	return m.recorder
+// ListBookmarks indicates an expected call of ListBookmarks.
+func (mr *MockForumServiceServerMockRecorder) ListBookmarks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarks", reflect.TypeOf((*MockForumServiceServer)(nil).ListBookmarks), arg0, arg1)
 }
 
-// Comments mocks base method.
-func (m *MockForumServiceServer) Comments(arg0 context.Context, arg1 *proto.ListCommentsRequest) (*proto.ListCommentsResponse, error) {
+// ListCategories mocks base method.
+func (m *MockForumServiceServer) ListCategories(arg0 context.Context, arg1 *proto.ListCategoriesRequest) (*proto.ListCategoriesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Comments", arg0, arg1)
-	ret0, _ := ret[0].(*proto.ListCommentsResponse)
+	ret := m.ctrl.Call(m, "ListCategories", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListCategoriesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Comments indicates an expected call of Comments.
-func (mr *MockForumServiceServerMockRecorder) Comments(arg0, arg1 interface{}) *gomock.Call {
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockForumServiceServerMockRecorder) ListCategories(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Comments", reflect.TypeOf((*MockForumServiceServer)(nil).Comments), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockForumServiceServer)(nil).ListCategories), arg0, arg1)
 }
 
-// CreateComment mocks base method.
-func (m *MockForumServiceServer) CreateComment(arg0 context.Context, arg1 *proto.CreateCommentRequest) (*proto.CommentResponse, error) {
+// ListHeld mocks base method.
+func (m *MockForumServiceServer) ListHeld(arg0 context.Context, arg1 *proto.ListHeldRequest) (*proto.ListHeldResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateComment", arg0, arg1)
-	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret := m.ctrl.Call(m, "ListHeld", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListHeldResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateComment indicates an expected call of CreateComment.
-func (mr *MockForumServiceServerMockRecorder) CreateComment(arg0, arg1 interface{}) *gomock.Call {
+// ListHeld indicates an expected call of ListHeld.
+func (mr *MockForumServiceServerMockRecorder) ListHeld(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockForumServiceServer)(nil).CreateComment), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHeld", reflect.TypeOf((*MockForumServiceServer)(nil).ListHeld), arg0, arg1)
 }
 
-// CreatePost mocks base method.
-func (m *MockForumServiceServer) CreatePost(arg0 context.Context, arg1 *proto.CreatePostRequest) (*proto.PostResponse, error) {
+// ListMentions mocks base method.
+func (m *MockForumServiceServer) ListMentions(arg0 context.Context, arg1 *proto.ListMentionsRequest) (*proto.ListMentionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePost", arg0, arg1)
-	ret0, _ := ret[0].(*proto.PostResponse)
+	ret := m.ctrl.Call(m, "ListMentions", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListMentionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePost indicates an expected call of CreatePost.
-func (mr *MockForumServiceServerMockRecorder) CreatePost(arg0, arg1 interface{}) *gomock.Call {
+// ListMentions indicates an expected call of ListMentions.
+func (mr *MockForumServiceServerMockRecorder) ListMentions(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePost", reflect.TypeOf((*MockForumServiceServer)(nil).CreatePost), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMentions", reflect.TypeOf((*MockForumServiceServer)(nil).ListMentions), arg0, arg1)
 }
 
-// DeleteComment mocks base method.
-func (m *MockForumServiceServer) DeleteComment(arg0 context.Context, arg1 *proto.DeleteCommentRequest) (*proto.EmptyMessage, error) {
+// ListMyDrafts mocks base method.
+func (m *MockForumServiceServer) ListMyDrafts(arg0 context.Context, arg1 *proto.ListMyDraftsRequest) (*proto.ListPostsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteComment", arg0, arg1)
-	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret := m.ctrl.Call(m, "ListMyDrafts", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListPostsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteComment indicates an expected call of DeleteComment.
-func (mr *MockForumServiceServerMockRecorder) DeleteComment(arg0, arg1 interface{}) *gomock.Call {
+// ListMyDrafts indicates an expected call of ListMyDrafts.
+func (mr *MockForumServiceServerMockRecorder) ListMyDrafts(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockForumServiceServer)(nil).DeleteComment), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyDrafts", reflect.TypeOf((*MockForumServiceServer)(nil).ListMyDrafts), arg0, arg1)
 }
 
-// DeletePost mocks base method.
-func (m *MockForumServiceServer) DeletePost(arg0 context.Context, arg1 *proto.DeletePostRequest) (*proto.EmptyMessage, error) {
+// ListMyWarnings mocks base method.
+func (m *MockForumServiceServer) ListMyWarnings(arg0 context.Context, arg1 *proto.ListWarningsRequest) (*proto.ListWarningsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePost", arg0, arg1)
-	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret := m.ctrl.Call(m, "ListMyWarnings", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListWarningsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeletePost indicates an expected call of DeletePost.
-func (mr *MockForumServiceServerMockRecorder) DeletePost(arg0, arg1 interface{}) *gomock.Call {
+// ListMyWarnings indicates an expected call of ListMyWarnings.
+func (mr *MockForumServiceServerMockRecorder) ListMyWarnings(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockForumServiceServer)(nil).DeletePost), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyWarnings", reflect.TypeOf((*MockForumServiceServer)(nil).ListMyWarnings), arg0, arg1)
 }
 
-// GetByPostID mocks base method.
-func (m *MockForumServiceServer) GetByPostID(arg0 context.Context, arg1 *proto.GetCommentsByPostIDRequest) (*proto.ListCommentsResponse, error) {
+// ListNotifications mocks base method.
+func (m *MockForumServiceServer) ListNotifications(arg0 context.Context, arg1 *proto.ListNotificationsRequest) (*proto.ListNotificationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByPostID", arg0, arg1)
-	ret0, _ := ret[0].(*proto.ListCommentsResponse)
+	ret := m.ctrl.Call(m, "ListNotifications", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListNotificationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByPostID indicates an expected call of GetByPostID.
-func (mr *MockForumServiceServerMockRecorder) GetByPostID(arg0, arg1 interface{}) *gomock.Call {
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockForumServiceServerMockRecorder) ListNotifications(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPostID", reflect.TypeOf((*MockForumServiceServer)(nil).GetByPostID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockForumServiceServer)(nil).ListNotifications), arg0, arg1)
 }
 
-// GetCommentByID mocks base method.
-func (m *MockForumServiceServer) GetCommentByID(arg0 context.Context, arg1 *proto.GetCommentRequest) (*proto.CommentResponse, error) {
+// ListReports mocks base method.
+func (m *MockForumServiceServer) ListReports(arg0 context.Context, arg1 *proto.ListReportsRequest) (*proto.ListReportsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCommentByID", arg0, arg1)
-	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret := m.ctrl.Call(m, "ListReports", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListReportsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCommentByID indicates an expected call of GetCommentByID.
-func (mr *MockForumServiceServerMockRecorder) GetCommentByID(arg0, arg1 interface{}) *gomock.Call {
+// ListReports indicates an expected call of ListReports.
+func (mr *MockForumServiceServerMockRecorder) ListReports(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockForumServiceServer)(nil).GetCommentByID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockForumServiceServer)(nil).ListReports), arg0, arg1)
 }
 
-// GetMessages mocks base method.
-func (m *MockForumServiceServer) GetMessages(arg0 context.Context, arg1 *proto.GetMessagesRequest) (*proto.GetMessagesResponse, error) {
+// ListTrash mocks base method.
+func (m *MockForumServiceServer) ListTrash(arg0 context.Context, arg1 *proto.ListTrashRequest) (*proto.ListTrashResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMessages", arg0, arg1)
-	ret0, _ := ret[0].(*proto.GetMessagesResponse)
+	ret := m.ctrl.Call(m, "ListTrash", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListTrashResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMessages indicates an expected call of GetMessages.
-func (mr *MockForumServiceServerMockRecorder) GetMessages(arg0, arg1 interface{}) *gomock.Call {
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockForumServiceServerMockRecorder) ListTrash(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockForumServiceServer)(nil).GetMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockForumServiceServer)(nil).ListTrash), arg0, arg1)
 }
 
-// GetPost mocks base method.
-func (m *MockForumServiceServer) GetPost(arg0 context.Context, arg1 *proto.GetPostRequest) (*proto.PostResponse, error) {
+// LockPost mocks base method.
+func (m *MockForumServiceServer) LockPost(arg0 context.Context, arg1 *proto.LockPostRequest) (*proto.PostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPost", arg0, arg1)
+	ret := m.ctrl.Call(m, "LockPost", arg0, arg1)
 	ret0, _ := ret[0].(*proto.PostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPost indicates an expected call of GetPost.
-func (mr *MockForumServiceServerMockRecorder) GetPost(arg0, arg1 interface{}) *gomock.Call {
+// LockPost indicates an expected call of LockPost.
+func (mr *MockForumServiceServerMockRecorder) LockPost(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPost", reflect.TypeOf((*MockForumServiceServer)(nil).GetPost), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPost", reflect.TypeOf((*MockForumServiceServer)(nil).LockPost), arg0, arg1)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockForumServiceServer) MarkAllRead(arg0 context.Context, arg1 *proto.EmptyMessage) (*proto.UnreadCountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UnreadCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockForumServiceServerMockRecorder) MarkAllRead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockForumServiceServer)(nil).MarkAllRead), arg0, arg1)
+}
+
+// MarkRead mocks base method.
+func (m *MockForumServiceServer) MarkRead(arg0 context.Context, arg1 *proto.MarkReadRequest) (*proto.UnreadCountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UnreadCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockForumServiceServerMockRecorder) MarkRead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockForumServiceServer)(nil).MarkRead), arg0, arg1)
+}
+
+// ModerateHeld mocks base method.
+func (m *MockForumServiceServer) ModerateHeld(arg0 context.Context, arg1 *proto.ModerateHeldRequest) (*proto.ModerateHeldResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateHeld", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ModerateHeldResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateHeld indicates an expected call of ModerateHeld.
+func (mr *MockForumServiceServerMockRecorder) ModerateHeld(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateHeld", reflect.TypeOf((*MockForumServiceServer)(nil).ModerateHeld), arg0, arg1)
+}
+
+// PinPost mocks base method.
+func (m *MockForumServiceServer) PinPost(arg0 context.Context, arg1 *proto.PinPostRequest) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinPost", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinPost indicates an expected call of PinPost.
+func (mr *MockForumServiceServerMockRecorder) PinPost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinPost", reflect.TypeOf((*MockForumServiceServer)(nil).PinPost), arg0, arg1)
 }
 
 // Posts mocks base method.
@@ -807,6 +2172,156 @@ func (mr *MockForumServiceServerMockRecorder) Posts(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Posts", reflect.TypeOf((*MockForumServiceServer)(nil).Posts), arg0, arg1)
 }
 
+// PublishPost mocks base method.
+func (m *MockForumServiceServer) PublishPost(arg0 context.Context, arg1 *proto.PublishPostRequest) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishPost", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishPost indicates an expected call of PublishPost.
+func (mr *MockForumServiceServerMockRecorder) PublishPost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishPost", reflect.TypeOf((*MockForumServiceServer)(nil).PublishPost), arg0, arg1)
+}
+
+// RemoveBookmark mocks base method.
+func (m *MockForumServiceServer) RemoveBookmark(arg0 context.Context, arg1 *proto.RemoveBookmarkRequest) (*proto.EmptyMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*proto.EmptyMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveBookmark indicates an expected call of RemoveBookmark.
+func (mr *MockForumServiceServerMockRecorder) RemoveBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBookmark", reflect.TypeOf((*MockForumServiceServer)(nil).RemoveBookmark), arg0, arg1)
+}
+
+// RemoveVote mocks base method.
+func (m *MockForumServiceServer) RemoveVote(arg0 context.Context, arg1 *proto.RemoveVoteRequest) (*proto.VoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVote", arg0, arg1)
+	ret0, _ := ret[0].(*proto.VoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVote indicates an expected call of RemoveVote.
+func (mr *MockForumServiceServerMockRecorder) RemoveVote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVote", reflect.TypeOf((*MockForumServiceServer)(nil).RemoveVote), arg0, arg1)
+}
+
+// Report mocks base method.
+func (m *MockForumServiceServer) Report(arg0 context.Context, arg1 *proto.ReportRequest) (*proto.ReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockForumServiceServerMockRecorder) Report(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockForumServiceServer)(nil).Report), arg0, arg1)
+}
+
+// ResolveReport mocks base method.
+func (m *MockForumServiceServer) ResolveReport(arg0 context.Context, arg1 *proto.ResolveReportRequest) (*proto.ResolveReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReport", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ResolveReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockForumServiceServerMockRecorder) ResolveReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*MockForumServiceServer)(nil).ResolveReport), arg0, arg1)
+}
+
+// RestoreComment mocks base method.
+func (m *MockForumServiceServer) RestoreComment(arg0 context.Context, arg1 *proto.RestoreRequest) (*proto.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreComment", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreComment indicates an expected call of RestoreComment.
+func (mr *MockForumServiceServerMockRecorder) RestoreComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreComment", reflect.TypeOf((*MockForumServiceServer)(nil).RestoreComment), arg0, arg1)
+}
+
+// RestorePost mocks base method.
+func (m *MockForumServiceServer) RestorePost(arg0 context.Context, arg1 *proto.RestoreRequest) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePost", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePost indicates an expected call of RestorePost.
+func (mr *MockForumServiceServerMockRecorder) RestorePost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePost", reflect.TypeOf((*MockForumServiceServer)(nil).RestorePost), arg0, arg1)
+}
+
+// RollbackComment mocks base method.
+func (m *MockForumServiceServer) RollbackComment(arg0 context.Context, arg1 *proto.RollbackRequest) (*proto.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackComment", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackComment indicates an expected call of RollbackComment.
+func (mr *MockForumServiceServerMockRecorder) RollbackComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackComment", reflect.TypeOf((*MockForumServiceServer)(nil).RollbackComment), arg0, arg1)
+}
+
+// RollbackPost mocks base method.
+func (m *MockForumServiceServer) RollbackPost(arg0 context.Context, arg1 *proto.RollbackRequest) (*proto.PostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackPost", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackPost indicates an expected call of RollbackPost.
+func (mr *MockForumServiceServerMockRecorder) RollbackPost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackPost", reflect.TypeOf((*MockForumServiceServer)(nil).RollbackPost), arg0, arg1)
+}
+
+// SearchPosts mocks base method.
+func (m *MockForumServiceServer) SearchPosts(arg0 context.Context, arg1 *proto.SearchPostsRequest) (*proto.SearchPostsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPosts", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SearchPostsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPosts indicates an expected call of SearchPosts.
+func (mr *MockForumServiceServerMockRecorder) SearchPosts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPosts", reflect.TypeOf((*MockForumServiceServer)(nil).SearchPosts), arg0, arg1)
+}
+
 // SendMessage mocks base method.
 func (m *MockForumServiceServer) SendMessage(arg0 context.Context, arg1 *proto.ChatMessage) (*proto.EmptyMessage, error) {
 	m.ctrl.T.Helper()
@@ -822,6 +2337,36 @@ func (mr *MockForumServiceServerMockRecorder) SendMessage(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockForumServiceServer)(nil).SendMessage), arg0, arg1)
 }
 
+// UnfollowPost mocks base method.
+func (m *MockForumServiceServer) UnfollowPost(arg0 context.Context, arg1 *proto.FollowPostRequest) (*proto.FollowPostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowPost", arg0, arg1)
+	ret0, _ := ret[0].(*proto.FollowPostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfollowPost indicates an expected call of UnfollowPost.
+func (mr *MockForumServiceServerMockRecorder) UnfollowPost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowPost", reflect.TypeOf((*MockForumServiceServer)(nil).UnfollowPost), arg0, arg1)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockForumServiceServer) UpdateCategory(arg0 context.Context, arg1 *proto.UpdateCategoryRequest) (*proto.CategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockForumServiceServerMockRecorder) UpdateCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockForumServiceServer)(nil).UpdateCategory), arg0, arg1)
+}
+
 // UpdateComment mocks base method.
 func (m *MockForumServiceServer) UpdateComment(arg0 context.Context, arg1 *proto.UpdateCommentRequest) (*proto.CommentResponse, error) {
 	m.ctrl.T.Helper()
@@ -852,6 +2397,36 @@ func (mr *MockForumServiceServerMockRecorder) UpdatePost(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockForumServiceServer)(nil).UpdatePost), arg0, arg1)
 }
 
+// UploadAttachment mocks base method.
+func (m *MockForumServiceServer) UploadAttachment(arg0 context.Context, arg1 *proto.UploadAttachmentRequest) (*proto.AttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockForumServiceServerMockRecorder) UploadAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockForumServiceServer)(nil).UploadAttachment), arg0, arg1)
+}
+
+// Vote mocks base method.
+func (m *MockForumServiceServer) Vote(arg0 context.Context, arg1 *proto.VoteRequest) (*proto.VoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", arg0, arg1)
+	ret0, _ := ret[0].(*proto.VoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockForumServiceServerMockRecorder) Vote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockForumServiceServer)(nil).Vote), arg0, arg1)
+}
+
 // mustEmbedUnimplementedForumServiceServer mocks base method.
 func (m *MockForumServiceServer) mustEmbedUnimplementedForumServiceServer() {
 	m.ctrl.T.Helper()